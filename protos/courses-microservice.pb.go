@@ -7,8 +7,10 @@
 package protos
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,30 +22,6844 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Whether a student of a batch was enrolled, or why they were skipped.
+type BatchEnrollmentOutcome int32
+
+const (
+	BatchEnrollmentOutcome_STUDENT_ADDED BatchEnrollmentOutcome = 0
+	// The student already was in the course, in any status, or is listed twice in the batch.
+	BatchEnrollmentOutcome_ALREADY_IN_COURSE BatchEnrollmentOutcome = 1
+	// The student is staff of the course and allowConflictingRole was not set.
+	BatchEnrollmentOutcome_STAFF_OF_COURSE BatchEnrollmentOutcome = 2
+	// The student reached the semester enrollment limit and overrideLimit was not set.
+	BatchEnrollmentOutcome_SEMESTER_LIMIT_REACHED BatchEnrollmentOutcome = 3
+	// The places of the course were all taken and overrideLimit was not set.
+	BatchEnrollmentOutcome_COURSE_FULL BatchEnrollmentOutcome = 4
+	// The enrollment window of the course is not open and overrideLimit was not set.
+	BatchEnrollmentOutcome_ENROLLMENT_CLOSED BatchEnrollmentOutcome = 5
+	// The credits of the course would take the student past the credit limit and overrideLimit was not set.
+	BatchEnrollmentOutcome_CREDIT_LIMIT_REACHED BatchEnrollmentOutcome = 6
+)
+
+// Enum value maps for BatchEnrollmentOutcome.
+var (
+	BatchEnrollmentOutcome_name = map[int32]string{
+		0: "STUDENT_ADDED",
+		1: "ALREADY_IN_COURSE",
+		2: "STAFF_OF_COURSE",
+		3: "SEMESTER_LIMIT_REACHED",
+		4: "COURSE_FULL",
+		5: "ENROLLMENT_CLOSED",
+		6: "CREDIT_LIMIT_REACHED",
+	}
+	BatchEnrollmentOutcome_value = map[string]int32{
+		"STUDENT_ADDED":          0,
+		"ALREADY_IN_COURSE":      1,
+		"STAFF_OF_COURSE":        2,
+		"SEMESTER_LIMIT_REACHED": 3,
+		"COURSE_FULL":            4,
+		"ENROLLMENT_CLOSED":      5,
+		"CREDIT_LIMIT_REACHED":   6,
+	}
+)
+
+func (x BatchEnrollmentOutcome) Enum() *BatchEnrollmentOutcome {
+	p := new(BatchEnrollmentOutcome)
+	*p = x
+	return p
+}
+
+func (x BatchEnrollmentOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchEnrollmentOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_courses_microservice_proto_enumTypes[0].Descriptor()
+}
+
+func (BatchEnrollmentOutcome) Type() protoreflect.EnumType {
+	return &file_courses_microservice_proto_enumTypes[0]
+}
+
+func (x BatchEnrollmentOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchEnrollmentOutcome.Descriptor instead.
+func (BatchEnrollmentOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{0}
+}
+
+// Period announcements are grouped by. Weeks start on Monday, as ISO weeks do.
+type AnnouncementGrouping int32
+
+const (
+	AnnouncementGrouping_WEEK  AnnouncementGrouping = 0
+	AnnouncementGrouping_MONTH AnnouncementGrouping = 1
+)
+
+// Enum value maps for AnnouncementGrouping.
+var (
+	AnnouncementGrouping_name = map[int32]string{
+		0: "WEEK",
+		1: "MONTH",
+	}
+	AnnouncementGrouping_value = map[string]int32{
+		"WEEK":  0,
+		"MONTH": 1,
+	}
+)
+
+func (x AnnouncementGrouping) Enum() *AnnouncementGrouping {
+	p := new(AnnouncementGrouping)
+	*p = x
+	return p
+}
+
+func (x AnnouncementGrouping) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnnouncementGrouping) Descriptor() protoreflect.EnumDescriptor {
+	return file_courses_microservice_proto_enumTypes[1].Descriptor()
+}
+
+func (AnnouncementGrouping) Type() protoreflect.EnumType {
+	return &file_courses_microservice_proto_enumTypes[1]
+}
+
+func (x AnnouncementGrouping) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnnouncementGrouping.Descriptor instead.
+func (AnnouncementGrouping) EnumDescriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{1}
+}
+
+// A reason a student cannot enroll in a course.
+type EnrollmentBlocker int32
+
+const (
+	EnrollmentBlocker_BLOCKER_UNSPECIFIED EnrollmentBlocker = 0
+	// The enrollment window of the course has not opened yet.
+	EnrollmentBlocker_BLOCKER_NOT_YET_OPEN EnrollmentBlocker = 1
+	// The enrollment window of the course has closed.
+	EnrollmentBlocker_BLOCKER_CLOSED EnrollmentBlocker = 2
+	// Every place in the course is taken.
+	EnrollmentBlocker_BLOCKER_COURSE_FULL EnrollmentBlocker = 3
+	// The student is already enrolled in the course.
+	EnrollmentBlocker_BLOCKER_ALREADY_ENROLLED EnrollmentBlocker = 4
+	// The student is staff of the course, and students and staff must be different people.
+	EnrollmentBlocker_BLOCKER_STAFF_OF_COURSE EnrollmentBlocker = 5
+	// The student reached the limit of courses per semester.
+	EnrollmentBlocker_BLOCKER_SEMESTER_LIMIT_REACHED EnrollmentBlocker = 6
+	// The credits of the course would take the student past the limit of credits per semester.
+	EnrollmentBlocker_BLOCKER_CREDIT_LIMIT_REACHED EnrollmentBlocker = 7
+	// The student is enrolling themselves in a course that has self-enrollment disabled.
+	EnrollmentBlocker_BLOCKER_SELF_ENROLLMENT_DISABLED EnrollmentBlocker = 8
+)
+
+// Enum value maps for EnrollmentBlocker.
+var (
+	EnrollmentBlocker_name = map[int32]string{
+		0: "BLOCKER_UNSPECIFIED",
+		1: "BLOCKER_NOT_YET_OPEN",
+		2: "BLOCKER_CLOSED",
+		3: "BLOCKER_COURSE_FULL",
+		4: "BLOCKER_ALREADY_ENROLLED",
+		5: "BLOCKER_STAFF_OF_COURSE",
+		6: "BLOCKER_SEMESTER_LIMIT_REACHED",
+		7: "BLOCKER_CREDIT_LIMIT_REACHED",
+		8: "BLOCKER_SELF_ENROLLMENT_DISABLED",
+	}
+	EnrollmentBlocker_value = map[string]int32{
+		"BLOCKER_UNSPECIFIED":              0,
+		"BLOCKER_NOT_YET_OPEN":             1,
+		"BLOCKER_CLOSED":                   2,
+		"BLOCKER_COURSE_FULL":              3,
+		"BLOCKER_ALREADY_ENROLLED":         4,
+		"BLOCKER_STAFF_OF_COURSE":          5,
+		"BLOCKER_SEMESTER_LIMIT_REACHED":   6,
+		"BLOCKER_CREDIT_LIMIT_REACHED":     7,
+		"BLOCKER_SELF_ENROLLMENT_DISABLED": 8,
+	}
+)
+
+func (x EnrollmentBlocker) Enum() *EnrollmentBlocker {
+	p := new(EnrollmentBlocker)
+	*p = x
+	return p
+}
+
+func (x EnrollmentBlocker) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnrollmentBlocker) Descriptor() protoreflect.EnumDescriptor {
+	return file_courses_microservice_proto_enumTypes[2].Descriptor()
+}
+
+func (EnrollmentBlocker) Type() protoreflect.EnumType {
+	return &file_courses_microservice_proto_enumTypes[2]
+}
+
+func (x EnrollmentBlocker) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnrollmentBlocker.Descriptor instead.
+func (EnrollmentBlocker) EnumDescriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{2}
+}
+
+// Enrollment status of a course, resolved from its enrollment window and the current time.
+type EnrollmentStatus int32
+
+const (
+	EnrollmentStatus_ENROLLMENT_STATUS_UNSPECIFIED EnrollmentStatus = 0
+	EnrollmentStatus_NOT_YET_OPEN                  EnrollmentStatus = 1
+	EnrollmentStatus_OPEN                          EnrollmentStatus = 2
+	EnrollmentStatus_CLOSED                        EnrollmentStatus = 3
+)
+
+// Enum value maps for EnrollmentStatus.
+var (
+	EnrollmentStatus_name = map[int32]string{
+		0: "ENROLLMENT_STATUS_UNSPECIFIED",
+		1: "NOT_YET_OPEN",
+		2: "OPEN",
+		3: "CLOSED",
+	}
+	EnrollmentStatus_value = map[string]int32{
+		"ENROLLMENT_STATUS_UNSPECIFIED": 0,
+		"NOT_YET_OPEN":                  1,
+		"OPEN":                          2,
+		"CLOSED":                        3,
+	}
+)
+
+func (x EnrollmentStatus) Enum() *EnrollmentStatus {
+	p := new(EnrollmentStatus)
+	*p = x
+	return p
+}
+
+func (x EnrollmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnrollmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_courses_microservice_proto_enumTypes[3].Descriptor()
+}
+
+func (EnrollmentStatus) Type() protoreflect.EnumType {
+	return &file_courses_microservice_proto_enumTypes[3]
+}
+
+func (x EnrollmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnrollmentStatus.Descriptor instead.
+func (EnrollmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{3}
+}
+
+// Who can read an announcement. Staff-only notes are hidden from everyone but course staff and admins.
+type AnnouncementVisibility int32
+
+const (
+	AnnouncementVisibility_EVERYONE   AnnouncementVisibility = 0
+	AnnouncementVisibility_STAFF_ONLY AnnouncementVisibility = 1
+)
+
+// Enum value maps for AnnouncementVisibility.
+var (
+	AnnouncementVisibility_name = map[int32]string{
+		0: "EVERYONE",
+		1: "STAFF_ONLY",
+	}
+	AnnouncementVisibility_value = map[string]int32{
+		"EVERYONE":   0,
+		"STAFF_ONLY": 1,
+	}
+)
+
+func (x AnnouncementVisibility) Enum() *AnnouncementVisibility {
+	p := new(AnnouncementVisibility)
+	*p = x
+	return p
+}
+
+func (x AnnouncementVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnnouncementVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_courses_microservice_proto_enumTypes[4].Descriptor()
+}
+
+func (AnnouncementVisibility) Type() protoreflect.EnumType {
+	return &file_courses_microservice_proto_enumTypes[4]
+}
+
+func (x AnnouncementVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnnouncementVisibility.Descriptor instead.
+func (AnnouncementVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{4}
+}
+
+// How often an announcement is reposted. Each repost is a fresh, dated copy of the announcement.
+type AnnouncementRecurrence int32
+
+const (
+	AnnouncementRecurrence_NONE   AnnouncementRecurrence = 0
+	AnnouncementRecurrence_WEEKLY AnnouncementRecurrence = 1
+)
+
+// Enum value maps for AnnouncementRecurrence.
+var (
+	AnnouncementRecurrence_name = map[int32]string{
+		0: "NONE",
+		1: "WEEKLY",
+	}
+	AnnouncementRecurrence_value = map[string]int32{
+		"NONE":   0,
+		"WEEKLY": 1,
+	}
+)
+
+func (x AnnouncementRecurrence) Enum() *AnnouncementRecurrence {
+	p := new(AnnouncementRecurrence)
+	*p = x
+	return p
+}
+
+func (x AnnouncementRecurrence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnnouncementRecurrence) Descriptor() protoreflect.EnumDescriptor {
+	return file_courses_microservice_proto_enumTypes[5].Descriptor()
+}
+
+func (AnnouncementRecurrence) Type() protoreflect.EnumType {
+	return &file_courses_microservice_proto_enumTypes[5]
+}
+
+func (x AnnouncementRecurrence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnnouncementRecurrence.Descriptor instead.
+func (AnnouncementRecurrence) EnumDescriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{5}
+}
+
+// How a course overrides a feature. FEATURE_DEFAULT clears the override, so the rollout applies.
+type FeatureOverride int32
+
+const (
+	FeatureOverride_FEATURE_DEFAULT FeatureOverride = 0
+	FeatureOverride_FEATURE_ON      FeatureOverride = 1
+	FeatureOverride_FEATURE_OFF     FeatureOverride = 2
+)
+
+// Enum value maps for FeatureOverride.
+var (
+	FeatureOverride_name = map[int32]string{
+		0: "FEATURE_DEFAULT",
+		1: "FEATURE_ON",
+		2: "FEATURE_OFF",
+	}
+	FeatureOverride_value = map[string]int32{
+		"FEATURE_DEFAULT": 0,
+		"FEATURE_ON":      1,
+		"FEATURE_OFF":     2,
+	}
+)
+
+func (x FeatureOverride) Enum() *FeatureOverride {
+	p := new(FeatureOverride)
+	*p = x
+	return p
+}
+
+func (x FeatureOverride) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeatureOverride) Descriptor() protoreflect.EnumDescriptor {
+	return file_courses_microservice_proto_enumTypes[6].Descriptor()
+}
+
+func (FeatureOverride) Type() protoreflect.EnumType {
+	return &file_courses_microservice_proto_enumTypes[6]
+}
+
+func (x FeatureOverride) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeatureOverride.Descriptor instead.
+func (FeatureOverride) EnumDescriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{6}
+}
+
 // Request message for getting a course.
+// Set includeCorequisites to also get the co-requisites of the course,
+// and includeActivity to also get a summary of its recent activity.
 type GetCourseRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Token               string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID            string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	IncludeCorequisites bool                   `protobuf:"varint,3,opt,name=includeCorequisites,proto3" json:"includeCorequisites,omitempty"`
+	IncludeActivity     bool                   `protobuf:"varint,4,opt,name=includeActivity,proto3" json:"includeActivity,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetCourseRequest) Reset() {
+	*x = GetCourseRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseRequest) ProtoMessage() {}
+
+func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCourseRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCourseRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetCourseRequest) GetIncludeCorequisites() bool {
+	if x != nil {
+		return x.IncludeCorequisites
+	}
+	return false
+}
+
+func (x *GetCourseRequest) GetIncludeActivity() bool {
+	if x != nil {
+		return x.IncludeActivity
+	}
+	return false
+}
+
+// Response message for getting a course.
+// contentHash changes exactly when the content of the course, as the caller sees it, changes.
+type GetCourseResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Course         *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	ContentHash    string                 `protobuf:"bytes,2,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	CorequisiteIDs []string               `protobuf:"bytes,3,rep,name=corequisiteIDs,proto3" json:"corequisiteIDs,omitempty"`
+	Activity       *ActivitySummary       `protobuf:"bytes,4,opt,name=activity,proto3" json:"activity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCourseResponse) Reset() {
+	*x = GetCourseResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseResponse) ProtoMessage() {}
+
+func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GetCourseResponse) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *GetCourseResponse) GetCorequisiteIDs() []string {
+	if x != nil {
+		return x.CorequisiteIDs
+	}
+	return nil
+}
+
+func (x *GetCourseResponse) GetActivity() *ActivitySummary {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+// How active a course has been over the last 7 days.
+type ActivitySummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Announcements posted that the caller can read.
+	RecentAnnouncements int64 `protobuf:"varint,1,opt,name=recentAnnouncements,proto3" json:"recentAnnouncements,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ActivitySummary) Reset() {
+	*x = ActivitySummary{}
+	mi := &file_courses_microservice_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySummary) ProtoMessage() {}
+
+func (x *ActivitySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySummary.ProtoReflect.Descriptor instead.
+func (*ActivitySummary) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivitySummary) GetRecentAnnouncements() int64 {
+	if x != nil {
+		return x.RecentAnnouncements
+	}
+	return 0
+}
+
+// Request message for creating a new course.
+// The course is created together with initialStaff; if any of them is invalid, nothing is created.
+// A caller holding the staff role is added to the course staff as well.
+type CreateCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Course        *Course                `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
+	InitialStaff  []*StaffAssignment     `protobuf:"bytes,3,rep,name=initialStaff,proto3" json:"initialStaff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCourseRequest) Reset() {
+	*x = CreateCourseRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourseRequest) ProtoMessage() {}
+
+func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourseRequest.ProtoReflect.Descriptor instead.
+func (*CreateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateCourseRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *CreateCourseRequest) GetInitialStaff() []*StaffAssignment {
+	if x != nil {
+		return x.InitialStaff
+	}
+	return nil
+}
+
+// A staff member assigned to a course, optionally for a limited period as in AddStaffRequest.
+type StaffAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaffID       string                 `protobuf:"bytes,1,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=validUntil,proto3" json:"validUntil,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffAssignment) Reset() {
+	*x = StaffAssignment{}
+	mi := &file_courses_microservice_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffAssignment) ProtoMessage() {}
+
+func (x *StaffAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffAssignment.ProtoReflect.Descriptor instead.
+func (*StaffAssignment) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StaffAssignment) GetStaffID() string {
+	if x != nil {
+		return x.StaffID
+	}
+	return ""
+}
+
+func (x *StaffAssignment) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *StaffAssignment) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+// Response message for creating a new course.
+type CreateCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	StaffIDs      []string               `protobuf:"bytes,2,rep,name=staffIDs,proto3" json:"staffIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCourseResponse) Reset() {
+	*x = CreateCourseResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourseResponse) ProtoMessage() {}
+
+func (x *CreateCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourseResponse.ProtoReflect.Descriptor instead.
+func (*CreateCourseResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *CreateCourseResponse) GetStaffIDs() []string {
+	if x != nil {
+		return x.StaffIDs
+	}
+	return nil
+}
+
+// Request message for updating a course.
+type UpdateCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Course        *Course                `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCourseRequest) Reset() {
+	*x = UpdateCourseRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseRequest) ProtoMessage() {}
+
+func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateCourseRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+// Response message for updating a course.
+type UpdateCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCourseResponse) Reset() {
+	*x = UpdateCourseResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseResponse) ProtoMessage() {}
+
+func (x *UpdateCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCourseResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+// Request message for deleting a course.
+type DeleteCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCourseRequest) Reset() {
+	*x = DeleteCourseRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCourseRequest) ProtoMessage() {}
+
+func (x *DeleteCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCourseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCourseRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteCourseRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteCourseRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+// Response message for deleting a course.
+type DeleteCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCourseResponse) Reset() {
+	*x = DeleteCourseResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCourseResponse) ProtoMessage() {}
+
+func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCourseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCourseResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{9}
+}
+
+// Request message for changing the ID of a course. Its students, staff, announcements and
+// other data move to newCourseID, which must not be taken by another course.
+type ChangeCourseIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	NewCourseID   string                 `protobuf:"bytes,3,opt,name=newCourseID,proto3" json:"newCourseID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeCourseIDRequest) Reset() {
+	*x = ChangeCourseIDRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeCourseIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeCourseIDRequest) ProtoMessage() {}
+
+func (x *ChangeCourseIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeCourseIDRequest.ProtoReflect.Descriptor instead.
+func (*ChangeCourseIDRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChangeCourseIDRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ChangeCourseIDRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *ChangeCourseIDRequest) GetNewCourseID() string {
+	if x != nil {
+		return x.NewCourseID
+	}
+	return ""
+}
+
+// Response message for changing the ID of a course.
+type ChangeCourseIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeCourseIDResponse) Reset() {
+	*x = ChangeCourseIDResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeCourseIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeCourseIDResponse) ProtoMessage() {}
+
+func (x *ChangeCourseIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeCourseIDResponse.ProtoReflect.Descriptor instead.
+func (*ChangeCourseIDResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{11}
+}
+
+// Request message for adding a student to a course.
+// Students are enrolled only if CheckEnrollmentEligibility finds nothing blocking them; enrolling a
+// student who is already enrolled succeeds. Course staff may set overrideLimit to enroll beyond the
+// capacity of the course, outside its enrollment window and beyond the per-semester course and credit
+// limits, and allowConflictingRole to enroll a member of the course staff.
+type AddStudentRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Token                string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID             string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	StudentID            string                 `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	OverrideLimit        bool                   `protobuf:"varint,4,opt,name=overrideLimit,proto3" json:"overrideLimit,omitempty"`
+	AllowConflictingRole bool                   `protobuf:"varint,5,opt,name=allowConflictingRole,proto3" json:"allowConflictingRole,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AddStudentRequest) Reset() {
+	*x = AddStudentRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStudentRequest) ProtoMessage() {}
+
+func (x *AddStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStudentRequest.ProtoReflect.Descriptor instead.
+func (*AddStudentRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddStudentRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AddStudentRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *AddStudentRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *AddStudentRequest) GetOverrideLimit() bool {
+	if x != nil {
+		return x.OverrideLimit
+	}
+	return false
+}
+
+func (x *AddStudentRequest) GetAllowConflictingRole() bool {
+	if x != nil {
+		return x.AllowConflictingRole
+	}
+	return false
+}
+
+// Response message for adding a student to a course.
+// missingCorequisites lists the co-requisites of the course the student is not enrolled in.
+// They do not block the enrollment.
+type AddStudentResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MissingCorequisites []string               `protobuf:"bytes,1,rep,name=missingCorequisites,proto3" json:"missingCorequisites,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AddStudentResponse) Reset() {
+	*x = AddStudentResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStudentResponse) ProtoMessage() {}
+
+func (x *AddStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStudentResponse.ProtoReflect.Descriptor instead.
+func (*AddStudentResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddStudentResponse) GetMissingCorequisites() []string {
+	if x != nil {
+		return x.MissingCorequisites
+	}
+	return nil
+}
+
+// Request message for removing a student from a course.
+type RemoveStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	StudentID     string                 `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStudentRequest) Reset() {
+	*x = RemoveStudentRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStudentRequest) ProtoMessage() {}
+
+func (x *RemoveStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStudentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStudentRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveStudentRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RemoveStudentRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *RemoveStudentRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+// Response message for removing a student from a course.
+type RemoveStudentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStudentResponse) Reset() {
+	*x = RemoveStudentResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStudentResponse) ProtoMessage() {}
+
+func (x *RemoveStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStudentResponse.ProtoReflect.Descriptor instead.
+func (*RemoveStudentResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{15}
+}
+
+// Request message for setting the status of a student in a course.
+type SetStudentStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	StudentID     string                 `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStudentStatusRequest) Reset() {
+	*x = SetStudentStatusRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStudentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStudentStatusRequest) ProtoMessage() {}
+
+func (x *SetStudentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStudentStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetStudentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetStudentStatusRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetStudentStatusRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *SetStudentStatusRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *SetStudentStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Response message for setting the status of a student in a course.
+type SetStudentStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStudentStatusResponse) Reset() {
+	*x = SetStudentStatusResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStudentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStudentStatusResponse) ProtoMessage() {}
+
+func (x *SetStudentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStudentStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetStudentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{17}
+}
+
+// Request message for getting the allowed changes of student statuses.
+type GetStudentStatusTransitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentStatusTransitionsRequest) Reset() {
+	*x = GetStudentStatusTransitionsRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentStatusTransitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentStatusTransitionsRequest) ProtoMessage() {}
+
+func (x *GetStudentStatusTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentStatusTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentStatusTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetStudentStatusTransitionsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Response message for getting the allowed changes of student statuses, ordered by status.
+type GetStudentStatusTransitionsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Transitions   []*StudentStatusTransitions `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentStatusTransitionsResponse) Reset() {
+	*x = GetStudentStatusTransitionsResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentStatusTransitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentStatusTransitionsResponse) ProtoMessage() {}
+
+func (x *GetStudentStatusTransitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentStatusTransitionsResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentStatusTransitionsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetStudentStatusTransitionsResponse) GetTransitions() []*StudentStatusTransitions {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+// The statuses a student may move to from status. Keeping the current status is always allowed.
+type StudentStatusTransitions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Allowed       []string               `protobuf:"bytes,2,rep,name=allowed,proto3" json:"allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentStatusTransitions) Reset() {
+	*x = StudentStatusTransitions{}
+	mi := &file_courses_microservice_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentStatusTransitions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentStatusTransitions) ProtoMessage() {}
+
+func (x *StudentStatusTransitions) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentStatusTransitions.ProtoReflect.Descriptor instead.
+func (*StudentStatusTransitions) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StudentStatusTransitions) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StudentStatusTransitions) GetAllowed() []string {
+	if x != nil {
+		return x.Allowed
+	}
+	return nil
+}
+
+// Request message for enrolling many students in a course at once.
+// Every student is checked like in AddStudentToCourse. overrideLimit lifts the enrollment window, the
+// capacity and the semester limits and allowConflictingRole allows enrolling members of the course
+// staff, for every student.
+type BatchAddStudentsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Token                string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID             string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	StudentIDs           []string               `protobuf:"bytes,3,rep,name=studentIDs,proto3" json:"studentIDs,omitempty"`
+	OverrideLimit        bool                   `protobuf:"varint,4,opt,name=overrideLimit,proto3" json:"overrideLimit,omitempty"`
+	AllowConflictingRole bool                   `protobuf:"varint,5,opt,name=allowConflictingRole,proto3" json:"allowConflictingRole,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BatchAddStudentsRequest) Reset() {
+	*x = BatchAddStudentsRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAddStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAddStudentsRequest) ProtoMessage() {}
+
+func (x *BatchAddStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAddStudentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchAddStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchAddStudentsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BatchAddStudentsRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *BatchAddStudentsRequest) GetStudentIDs() []string {
+	if x != nil {
+		return x.StudentIDs
+	}
+	return nil
+}
+
+func (x *BatchAddStudentsRequest) GetOverrideLimit() bool {
+	if x != nil {
+		return x.OverrideLimit
+	}
+	return false
+}
+
+func (x *BatchAddStudentsRequest) GetAllowConflictingRole() bool {
+	if x != nil {
+		return x.AllowConflictingRole
+	}
+	return false
+}
+
+// Response message for enrolling many students in a course at once.
+// results holds the outcome of each requested student, in the order requested.
+type BatchAddStudentsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Results       []*BatchEnrollmentResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	AddedCount    int64                    `protobuf:"varint,2,opt,name=addedCount,proto3" json:"addedCount,omitempty"`
+	SkippedCount  int64                    `protobuf:"varint,3,opt,name=skippedCount,proto3" json:"skippedCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAddStudentsResponse) Reset() {
+	*x = BatchAddStudentsResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAddStudentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAddStudentsResponse) ProtoMessage() {}
+
+func (x *BatchAddStudentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAddStudentsResponse.ProtoReflect.Descriptor instead.
+func (*BatchAddStudentsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchAddStudentsResponse) GetResults() []*BatchEnrollmentResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchAddStudentsResponse) GetAddedCount() int64 {
+	if x != nil {
+		return x.AddedCount
+	}
+	return 0
+}
+
+func (x *BatchAddStudentsResponse) GetSkippedCount() int64 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+// The outcome of enrolling one student of a batch.
+type BatchEnrollmentResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentID     string                 `protobuf:"bytes,1,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	Outcome       BatchEnrollmentOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=courses.BatchEnrollmentOutcome" json:"outcome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchEnrollmentResult) Reset() {
+	*x = BatchEnrollmentResult{}
+	mi := &file_courses_microservice_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchEnrollmentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchEnrollmentResult) ProtoMessage() {}
+
+func (x *BatchEnrollmentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchEnrollmentResult.ProtoReflect.Descriptor instead.
+func (*BatchEnrollmentResult) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BatchEnrollmentResult) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *BatchEnrollmentResult) GetOutcome() BatchEnrollmentOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return BatchEnrollmentOutcome_STUDENT_ADDED
+}
+
+// Request message for transferring the enrolled students of a course to another course.
+type TransferEnrollmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	FromCourseID  string                 `protobuf:"bytes,2,opt,name=fromCourseID,proto3" json:"fromCourseID,omitempty"`
+	ToCourseID    string                 `protobuf:"bytes,3,opt,name=toCourseID,proto3" json:"toCourseID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferEnrollmentsRequest) Reset() {
+	*x = TransferEnrollmentsRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferEnrollmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferEnrollmentsRequest) ProtoMessage() {}
+
+func (x *TransferEnrollmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferEnrollmentsRequest.ProtoReflect.Descriptor instead.
+func (*TransferEnrollmentsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TransferEnrollmentsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TransferEnrollmentsRequest) GetFromCourseID() string {
+	if x != nil {
+		return x.FromCourseID
+	}
+	return ""
+}
+
+func (x *TransferEnrollmentsRequest) GetToCourseID() string {
+	if x != nil {
+		return x.ToCourseID
+	}
+	return ""
+}
+
+// Response message for transferring the enrolled students of a course to another course.
+// skippedCount counts the students who already were in the destination course.
+type TransferEnrollmentsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TransferredCount int64                  `protobuf:"varint,1,opt,name=transferredCount,proto3" json:"transferredCount,omitempty"`
+	SkippedCount     int64                  `protobuf:"varint,2,opt,name=skippedCount,proto3" json:"skippedCount,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TransferEnrollmentsResponse) Reset() {
+	*x = TransferEnrollmentsResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferEnrollmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferEnrollmentsResponse) ProtoMessage() {}
+
+func (x *TransferEnrollmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferEnrollmentsResponse.ProtoReflect.Descriptor instead.
+func (*TransferEnrollmentsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TransferEnrollmentsResponse) GetTransferredCount() int64 {
+	if x != nil {
+		return x.TransferredCount
+	}
+	return 0
+}
+
+func (x *TransferEnrollmentsResponse) GetSkippedCount() int64 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+// Request message for listing duplicate enrollments.
+type FindDuplicateEnrollmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateEnrollmentsRequest) Reset() {
+	*x = FindDuplicateEnrollmentsRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateEnrollmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateEnrollmentsRequest) ProtoMessage() {}
+
+func (x *FindDuplicateEnrollmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateEnrollmentsRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicateEnrollmentsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FindDuplicateEnrollmentsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Response message for listing duplicate enrollments.
+type FindDuplicateEnrollmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duplicates    []*DuplicateEnrollment `protobuf:"bytes,1,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateEnrollmentsResponse) Reset() {
+	*x = FindDuplicateEnrollmentsResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateEnrollmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateEnrollmentsResponse) ProtoMessage() {}
+
+func (x *FindDuplicateEnrollmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateEnrollmentsResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicateEnrollmentsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FindDuplicateEnrollmentsResponse) GetDuplicates() []*DuplicateEnrollment {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+// A student enrolled in a course count times.
+type DuplicateEnrollment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseID      string                 `protobuf:"bytes,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	StudentID     string                 `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateEnrollment) Reset() {
+	*x = DuplicateEnrollment{}
+	mi := &file_courses_microservice_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateEnrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateEnrollment) ProtoMessage() {}
+
+func (x *DuplicateEnrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateEnrollment.ProtoReflect.Descriptor instead.
+func (*DuplicateEnrollment) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DuplicateEnrollment) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *DuplicateEnrollment) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *DuplicateEnrollment) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Request message for removing duplicate enrollments.
+type DeduplicateEnrollmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeduplicateEnrollmentsRequest) Reset() {
+	*x = DeduplicateEnrollmentsRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeduplicateEnrollmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeduplicateEnrollmentsRequest) ProtoMessage() {}
+
+func (x *DeduplicateEnrollmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeduplicateEnrollmentsRequest.ProtoReflect.Descriptor instead.
+func (*DeduplicateEnrollmentsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeduplicateEnrollmentsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Response message for removing duplicate enrollments.
+type DeduplicateEnrollmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RemovedCount  int64                  `protobuf:"varint,1,opt,name=removedCount,proto3" json:"removedCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeduplicateEnrollmentsResponse) Reset() {
+	*x = DeduplicateEnrollmentsResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeduplicateEnrollmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeduplicateEnrollmentsResponse) ProtoMessage() {}
+
+func (x *DeduplicateEnrollmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeduplicateEnrollmentsResponse.ProtoReflect.Descriptor instead.
+func (*DeduplicateEnrollmentsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeduplicateEnrollmentsResponse) GetRemovedCount() int64 {
+	if x != nil {
+		return x.RemovedCount
+	}
+	return 0
+}
+
+// Request message for adding a staff member to a course.
+// Unset validFrom or validUntil leave the assignment unbounded on that side,
+// so guest lecturers can be given access for a limited period only.
+// Course staff may set allowConflictingRole to add a student of the course, such as a TA
+// who also takes it.
+type AddStaffRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Token                string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID             string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	StaffID              string                 `protobuf:"bytes,3,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	ValidFrom            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	ValidUntil           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=validUntil,proto3" json:"validUntil,omitempty"`
+	AllowConflictingRole bool                   `protobuf:"varint,6,opt,name=allowConflictingRole,proto3" json:"allowConflictingRole,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AddStaffRequest) Reset() {
+	*x = AddStaffRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStaffRequest) ProtoMessage() {}
+
+func (x *AddStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStaffRequest.ProtoReflect.Descriptor instead.
+func (*AddStaffRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddStaffRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AddStaffRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *AddStaffRequest) GetStaffID() string {
+	if x != nil {
+		return x.StaffID
+	}
+	return ""
+}
+
+func (x *AddStaffRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *AddStaffRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *AddStaffRequest) GetAllowConflictingRole() bool {
+	if x != nil {
+		return x.AllowConflictingRole
+	}
+	return false
+}
+
+// Response message for adding a staff member to a course.
+type AddStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddStaffResponse) Reset() {
+	*x = AddStaffResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStaffResponse) ProtoMessage() {}
+
+func (x *AddStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStaffResponse.ProtoReflect.Descriptor instead.
+func (*AddStaffResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{32}
+}
+
+// Request message for removing a staff member from a course.
+type RemoveStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	StaffID       string                 `protobuf:"bytes,3,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStaffRequest) Reset() {
+	*x = RemoveStaffRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStaffRequest) ProtoMessage() {}
+
+func (x *RemoveStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStaffRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStaffRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RemoveStaffRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RemoveStaffRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *RemoveStaffRequest) GetStaffID() string {
+	if x != nil {
+		return x.StaffID
+	}
+	return ""
+}
+
+// Response message for removing a staff member from a course.
+type RemoveStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStaffResponse) Reset() {
+	*x = RemoveStaffResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStaffResponse) ProtoMessage() {}
+
+func (x *RemoveStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStaffResponse.ProtoReflect.Descriptor instead.
+func (*RemoveStaffResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{34}
+}
+
+// Request message for getting all students in a course.
+// Students who are no longer enrolled, e.g. because they dropped the course,
+// are only listed when includeInactive is set.
+type GetCourseStudentsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Token           string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID        string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	IncludeInactive bool                   `protobuf:"varint,3,opt,name=includeInactive,proto3" json:"includeInactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCourseStudentsRequest) Reset() {
+	*x = GetCourseStudentsRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseStudentsRequest) ProtoMessage() {}
+
+func (x *GetCourseStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseStudentsRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetCourseStudentsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCourseStudentsRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetCourseStudentsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+// Response message for getting all students in a course.
+type GetCourseStudentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentsIDs   []string               `protobuf:"bytes,1,rep,name=studentsIDs,proto3" json:"studentsIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseStudentsResponse) Reset() {
+	*x = GetCourseStudentsResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseStudentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseStudentsResponse) ProtoMessage() {}
+
+func (x *GetCourseStudentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseStudentsResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseStudentsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetCourseStudentsResponse) GetStudentsIDs() []string {
+	if x != nil {
+		return x.StudentsIDs
+	}
+	return nil
+}
+
+// Request message for counting the students enrolled in a course.
+type GetCourseStudentCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseStudentCountRequest) Reset() {
+	*x = GetCourseStudentCountRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseStudentCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseStudentCountRequest) ProtoMessage() {}
+
+func (x *GetCourseStudentCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseStudentCountRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseStudentCountRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetCourseStudentCountRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCourseStudentCountRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+// Response message for counting the students enrolled in a course.
+type GetCourseStudentCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseStudentCountResponse) Reset() {
+	*x = GetCourseStudentCountResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseStudentCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseStudentCountResponse) ProtoMessage() {}
+
+func (x *GetCourseStudentCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseStudentCountResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseStudentCountResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCourseStudentCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Request message for getting the staff members in a course, ordered by staff ID.
+// Expired staff assignments are only returned when includeExpired is set.
+type GetCourseStaffRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID       string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	IncludeExpired bool                   `protobuf:"varint,3,opt,name=includeExpired,proto3" json:"includeExpired,omitempty"`
+	PageSize       int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken      string                 `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	// Also look up the name and email of each staff member in the staff directory.
+	IncludeContacts bool `protobuf:"varint,6,opt,name=includeContacts,proto3" json:"includeContacts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCourseStaffRequest) Reset() {
+	*x = GetCourseStaffRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseStaffRequest) ProtoMessage() {}
+
+func (x *GetCourseStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseStaffRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseStaffRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetCourseStaffRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCourseStaffRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetCourseStaffRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+func (x *GetCourseStaffRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCourseStaffRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetCourseStaffRequest) GetIncludeContacts() bool {
+	if x != nil {
+		return x.IncludeContacts
+	}
+	return false
+}
+
+// Response message for getting the staff members in a course.
+// nextPageToken is empty on the last page. pageSize is the page size actually used.
+// totalCount is the number of staff members on all pages.
+// contacts are set if includeContacts was, in the order of staffIDs. If the staff directory could
+// not be reached, contactsIncomplete is set and the missing contacts have only a staffID.
+type GetCourseStaffResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	StaffIDs           []string               `protobuf:"bytes,1,rep,name=staffIDs,proto3" json:"staffIDs,omitempty"`
+	NextPageToken      string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	PageSize           int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	TotalCount         int64                  `protobuf:"varint,4,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	Contacts           []*StaffContact        `protobuf:"bytes,5,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	ContactsIncomplete bool                   `protobuf:"varint,6,opt,name=contactsIncomplete,proto3" json:"contactsIncomplete,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetCourseStaffResponse) Reset() {
+	*x = GetCourseStaffResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseStaffResponse) ProtoMessage() {}
+
+func (x *GetCourseStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseStaffResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseStaffResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetCourseStaffResponse) GetStaffIDs() []string {
+	if x != nil {
+		return x.StaffIDs
+	}
+	return nil
+}
+
+func (x *GetCourseStaffResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetCourseStaffResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCourseStaffResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetCourseStaffResponse) GetContacts() []*StaffContact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *GetCourseStaffResponse) GetContactsIncomplete() bool {
+	if x != nil {
+		return x.ContactsIncomplete
+	}
+	return false
+}
+
+// How to reach a staff member, as listed in the staff directory.
+type StaffContact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaffID       string                 `protobuf:"bytes,1,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffContact) Reset() {
+	*x = StaffContact{}
+	mi := &file_courses_microservice_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffContact) ProtoMessage() {}
+
+func (x *StaffContact) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffContact.ProtoReflect.Descriptor instead.
+func (*StaffContact) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StaffContact) GetStaffID() string {
+	if x != nil {
+		return x.StaffID
+	}
+	return ""
+}
+
+func (x *StaffContact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StaffContact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// Request message for getting a student's courses, only those of semester if it is set.
+type GetStudentCoursesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Token     string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudentID string                 `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	Semester  string                 `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// With strict set, a student the service has never seen, neither in a current nor in a past
+	// enrollment, is NotFound instead of having no courses. Unset uses the STRICT_MEMBER_READS default.
+	Strict        *bool `protobuf:"varint,4,opt,name=strict,proto3,oneof" json:"strict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentCoursesRequest) Reset() {
+	*x = GetStudentCoursesRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentCoursesRequest) ProtoMessage() {}
+
+func (x *GetStudentCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentCoursesRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetStudentCoursesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetStudentCoursesRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *GetStudentCoursesRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetStudentCoursesRequest) GetStrict() bool {
+	if x != nil && x.Strict != nil {
+		return *x.Strict
+	}
+	return false
+}
+
+// Response message for getting a student's courses.
+type GetStudentCoursesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoursesIDs    []string               `protobuf:"bytes,1,rep,name=coursesIDs,proto3" json:"coursesIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentCoursesResponse) Reset() {
+	*x = GetStudentCoursesResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentCoursesResponse) ProtoMessage() {}
+
+func (x *GetStudentCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentCoursesResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetStudentCoursesResponse) GetCoursesIDs() []string {
+	if x != nil {
+		return x.CoursesIDs
+	}
+	return nil
+}
+
+// Request message for getting every enrollment of a student.
+type GetEnrollmentsForStudentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Token     string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudentID string                 `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// Strict as in GetStudentCoursesRequest: an unknown student is NotFound instead of having no enrollments.
+	Strict        *bool `protobuf:"varint,3,opt,name=strict,proto3,oneof" json:"strict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentsForStudentRequest) Reset() {
+	*x = GetEnrollmentsForStudentRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentsForStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentsForStudentRequest) ProtoMessage() {}
+
+func (x *GetEnrollmentsForStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentsForStudentRequest.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentsForStudentRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetEnrollmentsForStudentRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetEnrollmentsForStudentRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *GetEnrollmentsForStudentRequest) GetStrict() bool {
+	if x != nil && x.Strict != nil {
+		return *x.Strict
+	}
+	return false
+}
+
+// Response message for getting every enrollment of a student.
+// Enrollments are ordered by semester, oldest first.
+type GetEnrollmentsForStudentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollments   []*Enrollment          `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentsForStudentResponse) Reset() {
+	*x = GetEnrollmentsForStudentResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentsForStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentsForStudentResponse) ProtoMessage() {}
+
+func (x *GetEnrollmentsForStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentsForStudentResponse.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentsForStudentResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetEnrollmentsForStudentResponse) GetEnrollments() []*Enrollment {
+	if x != nil {
+		return x.Enrollments
+	}
+	return nil
+}
+
+// Request message for getting the credits a student is enrolled in during a semester.
+type GetStudentCreditsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Token     string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudentID string                 `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	Semester  string                 `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Strict as in GetStudentCoursesRequest: an unknown student is NotFound instead of having no credits.
+	Strict        *bool `protobuf:"varint,4,opt,name=strict,proto3,oneof" json:"strict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentCreditsRequest) Reset() {
+	*x = GetStudentCreditsRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentCreditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentCreditsRequest) ProtoMessage() {}
+
+func (x *GetStudentCreditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentCreditsRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentCreditsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetStudentCreditsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetStudentCreditsRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *GetStudentCreditsRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetStudentCreditsRequest) GetStrict() bool {
+	if x != nil && x.Strict != nil {
+		return *x.Strict
+	}
+	return false
+}
+
+// Response message for getting the credits a student is enrolled in during a semester.
+// Courses the student dropped or withdrew from do not count.
+type GetStudentCreditsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credits       int64                  `protobuf:"varint,1,opt,name=credits,proto3" json:"credits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentCreditsResponse) Reset() {
+	*x = GetStudentCreditsResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentCreditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentCreditsResponse) ProtoMessage() {}
+
+func (x *GetStudentCreditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentCreditsResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentCreditsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetStudentCreditsResponse) GetCredits() int64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+// An enrollment of a student in a course. status is enrolled, dropped, withdrawn or completed.
+type Enrollment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Enrollment) Reset() {
+	*x = Enrollment{}
+	mi := &file_courses_microservice_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollment) ProtoMessage() {}
+
+func (x *Enrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
+func (*Enrollment) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Enrollment) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *Enrollment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Request message for getting a staff's courses, only those of semester if it is set,
+// e.g. to tell the courses someone currently teaches from their teaching history.
+type GetStaffCoursesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Token    string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StaffID  string                 `protobuf:"bytes,2,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	Semester string                 `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// With strict set, a staff member the service has never seen, neither in a current nor in an
+	// expired assignment, is NotFound instead of having no courses, unless the staff directory knows
+	// them. Unset uses the STRICT_MEMBER_READS default.
+	Strict        *bool `protobuf:"varint,4,opt,name=strict,proto3,oneof" json:"strict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStaffCoursesRequest) Reset() {
+	*x = GetStaffCoursesRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStaffCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStaffCoursesRequest) ProtoMessage() {}
+
+func (x *GetStaffCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStaffCoursesRequest.ProtoReflect.Descriptor instead.
+func (*GetStaffCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetStaffCoursesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetStaffCoursesRequest) GetStaffID() string {
+	if x != nil {
+		return x.StaffID
+	}
+	return ""
+}
+
+func (x *GetStaffCoursesRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetStaffCoursesRequest) GetStrict() bool {
+	if x != nil && x.Strict != nil {
+		return *x.Strict
+	}
+	return false
+}
+
+// Response message for getting a staff's courses.
+type GetStaffCoursesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoursesIDs    []string               `protobuf:"bytes,1,rep,name=coursesIDs,proto3" json:"coursesIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStaffCoursesResponse) Reset() {
+	*x = GetStaffCoursesResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStaffCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStaffCoursesResponse) ProtoMessage() {}
+
+func (x *GetStaffCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStaffCoursesResponse.ProtoReflect.Descriptor instead.
+func (*GetStaffCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetStaffCoursesResponse) GetCoursesIDs() []string {
+	if x != nil {
+		return x.CoursesIDs
+	}
+	return nil
+}
+
+// Request message for listing the courses in a semester that have no staff assigned.
+type ListCoursesWithoutStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoursesWithoutStaffRequest) Reset() {
+	*x = ListCoursesWithoutStaffRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoursesWithoutStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoursesWithoutStaffRequest) ProtoMessage() {}
+
+func (x *ListCoursesWithoutStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoursesWithoutStaffRequest.ProtoReflect.Descriptor instead.
+func (*ListCoursesWithoutStaffRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListCoursesWithoutStaffRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListCoursesWithoutStaffRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// Response message for listing the courses in a semester that have no staff assigned.
+// Courses are ordered by ID.
+type ListCoursesWithoutStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoursesWithoutStaffResponse) Reset() {
+	*x = ListCoursesWithoutStaffResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoursesWithoutStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoursesWithoutStaffResponse) ProtoMessage() {}
+
+func (x *ListCoursesWithoutStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoursesWithoutStaffResponse.ProtoReflect.Descriptor instead.
+func (*ListCoursesWithoutStaffResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListCoursesWithoutStaffResponse) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+// Request message for listing the courses of the catalog. Unset filters select every course.
+// nameContains matches course names ignoring case; createdAfter selects courses created after it.
+type ListCoursesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	NameContains  string                 `protobuf:"bytes,3,opt,name=nameContains,proto3" json:"nameContains,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAfter,proto3" json:"createdAfter,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoursesRequest) Reset() {
+	*x = ListCoursesRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoursesRequest) ProtoMessage() {}
+
+func (x *ListCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoursesRequest.ProtoReflect.Descriptor instead.
+func (*ListCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListCoursesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListCoursesRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *ListCoursesRequest) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *ListCoursesRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListCoursesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCoursesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response message for listing the courses of the catalog. Courses are ordered by ID.
+// nextPageToken is empty on the last page. pageSize is the page size actually used.
+type ListCoursesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoursesResponse) Reset() {
+	*x = ListCoursesResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoursesResponse) ProtoMessage() {}
+
+func (x *ListCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoursesResponse.ProtoReflect.Descriptor instead.
+func (*ListCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListCoursesResponse) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *ListCoursesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCoursesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Request message for listing the courses with a custom field set to a value.
+type GetCoursesByMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoursesByMetadataRequest) Reset() {
+	*x = GetCoursesByMetadataRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursesByMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursesByMetadataRequest) ProtoMessage() {}
+
+func (x *GetCoursesByMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursesByMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetCoursesByMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetCoursesByMetadataRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCoursesByMetadataRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetCoursesByMetadataRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *GetCoursesByMetadataRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCoursesByMetadataRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response message for listing the courses with a custom field set to a value. Courses are ordered by ID.
+// nextPageToken is empty on the last page. pageSize is the page size actually used.
+type GetCoursesByMetadataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoursesByMetadataResponse) Reset() {
+	*x = GetCoursesByMetadataResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursesByMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursesByMetadataResponse) ProtoMessage() {}
+
+func (x *GetCoursesByMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursesByMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetCoursesByMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetCoursesByMetadataResponse) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *GetCoursesByMetadataResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetCoursesByMetadataResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Request message for getting all courses in a semester.
+type GetSemesterCoursesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSemesterCoursesRequest) Reset() {
+	*x = GetSemesterCoursesRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemesterCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemesterCoursesRequest) ProtoMessage() {}
+
+func (x *GetSemesterCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSemesterCoursesRequest.ProtoReflect.Descriptor instead.
+func (*GetSemesterCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetSemesterCoursesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetSemesterCoursesRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// Response message for getting all courses in a semester.
+// truncated is set when the semester holds more courses than the server returns at once.
+type GetSemesterCoursesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	Truncated     bool                   `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSemesterCoursesResponse) Reset() {
+	*x = GetSemesterCoursesResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemesterCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemesterCoursesResponse) ProtoMessage() {}
+
+func (x *GetSemesterCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSemesterCoursesResponse.ProtoReflect.Descriptor instead.
+func (*GetSemesterCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetSemesterCoursesResponse) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *GetSemesterCoursesResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Request message for adding an announcement to a course.
+// Urgent announcements are posted even during a quiet period of the course.
+type AddAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`
+	Announcement  *Announcement          `protobuf:"bytes,3,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	Urgent        bool                   `protobuf:"varint,4,opt,name=urgent,proto3" json:"urgent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAnnouncementRequest) Reset() {
+	*x = AddAnnouncementRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAnnouncementRequest) ProtoMessage() {}
+
+func (x *AddAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*AddAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AddAnnouncementRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AddAnnouncementRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *AddAnnouncementRequest) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *AddAnnouncementRequest) GetUrgent() bool {
+	if x != nil {
+		return x.Urgent
+	}
+	return false
+}
+
+// Response message for adding an announcement to a course.
+type AddAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcement  *Announcement          `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAnnouncementResponse) Reset() {
+	*x = AddAnnouncementResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAnnouncementResponse) ProtoMessage() {}
+
+func (x *AddAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*AddAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AddAnnouncementResponse) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+// Request message for getting all announcements in a course.
+// createdFrom and createdBefore optionally restrict the announcements to those posted in
+// [createdFrom, createdBefore), e.g. to expand a group of GetCourseAnnouncementsGrouped.
+// knownVersionToken is the versionToken of a previous response, if the client has one cached.
+type GetCourseAnnouncementsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Token             string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID          string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	CreatedFrom       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdFrom,proto3" json:"createdFrom,omitempty"`
+	CreatedBefore     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdBefore,proto3" json:"createdBefore,omitempty"`
+	KnownVersionToken string                 `protobuf:"bytes,5,opt,name=knownVersionToken,proto3" json:"knownVersionToken,omitempty"`
+	PageSize          int32                  `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken         string                 `protobuf:"bytes,7,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetCourseAnnouncementsRequest) Reset() {
+	*x = GetCourseAnnouncementsRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseAnnouncementsRequest) ProtoMessage() {}
+
+func (x *GetCourseAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetCourseAnnouncementsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCourseAnnouncementsRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetCourseAnnouncementsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetCourseAnnouncementsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *GetCourseAnnouncementsRequest) GetKnownVersionToken() string {
+	if x != nil {
+		return x.KnownVersionToken
+	}
+	return ""
+}
+
+func (x *GetCourseAnnouncementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCourseAnnouncementsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response message for getting all announcements in a course.
+// versionToken changes whenever the returned announcements change. When it still equals the
+// knownVersionToken of the request, notModified is set and no announcements are returned.
+// Announcements are ordered newest first. pageSize is the page size actually used.
+type GetCourseAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	VersionToken  string                 `protobuf:"bytes,2,opt,name=versionToken,proto3" json:"versionToken,omitempty"`
+	NotModified   bool                   `protobuf:"varint,3,opt,name=notModified,proto3" json:"notModified,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseAnnouncementsResponse) Reset() {
+	*x = GetCourseAnnouncementsResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseAnnouncementsResponse) ProtoMessage() {}
+
+func (x *GetCourseAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetCourseAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *GetCourseAnnouncementsResponse) GetVersionToken() string {
+	if x != nil {
+		return x.VersionToken
+	}
+	return ""
+}
+
+func (x *GetCourseAnnouncementsResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+func (x *GetCourseAnnouncementsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetCourseAnnouncementsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Request message for getting the announcements in a course grouped by period.
+// pageToken is taken from the nextPageToken of a previous response.
+// An unset pageSize takes the server default; larger ones are capped at the server maximum.
+type GetCourseAnnouncementsGroupedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	GroupBy       AnnouncementGrouping   `protobuf:"varint,3,opt,name=groupBy,proto3,enum=courses.AnnouncementGrouping" json:"groupBy,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseAnnouncementsGroupedRequest) Reset() {
+	*x = GetCourseAnnouncementsGroupedRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseAnnouncementsGroupedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseAnnouncementsGroupedRequest) ProtoMessage() {}
+
+func (x *GetCourseAnnouncementsGroupedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseAnnouncementsGroupedRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseAnnouncementsGroupedRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetCourseAnnouncementsGroupedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCourseAnnouncementsGroupedRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetCourseAnnouncementsGroupedRequest) GetGroupBy() AnnouncementGrouping {
+	if x != nil {
+		return x.GroupBy
+	}
+	return AnnouncementGrouping_WEEK
+}
+
+func (x *GetCourseAnnouncementsGroupedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCourseAnnouncementsGroupedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response message for getting the announcements in a course grouped by period.
+// Groups are ordered newest first. pageSize is the page size actually used.
+type GetCourseAnnouncementsGroupedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*AnnouncementGroup   `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseAnnouncementsGroupedResponse) Reset() {
+	*x = GetCourseAnnouncementsGroupedResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseAnnouncementsGroupedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseAnnouncementsGroupedResponse) ProtoMessage() {}
+
+func (x *GetCourseAnnouncementsGroupedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseAnnouncementsGroupedResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseAnnouncementsGroupedResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetCourseAnnouncementsGroupedResponse) GetGroups() []*AnnouncementGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetCourseAnnouncementsGroupedResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetCourseAnnouncementsGroupedResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// The announcements posted in one week or month, in the configured time zone.
+// Pass startsAt and endsAt as createdFrom and createdBefore to GetCourseAnnouncements to expand it.
+type AnnouncementGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Newest        *Announcement          `protobuf:"bytes,4,opt,name=newest,proto3" json:"newest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnouncementGroup) Reset() {
+	*x = AnnouncementGroup{}
+	mi := &file_courses_microservice_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnouncementGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementGroup) ProtoMessage() {}
+
+func (x *AnnouncementGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementGroup.ProtoReflect.Descriptor instead.
+func (*AnnouncementGroup) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AnnouncementGroup) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *AnnouncementGroup) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *AnnouncementGroup) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AnnouncementGroup) GetNewest() *Announcement {
+	if x != nil {
+		return x.Newest
+	}
+	return nil
+}
+
+// Request message for getting an announcement of a course.
+type GetAnnouncementRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID       string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AnnouncementID string                 `protobuf:"bytes,3,opt,name=announcementID,proto3" json:"announcementID,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAnnouncementRequest) Reset() {
+	*x = GetAnnouncementRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnouncementRequest) ProtoMessage() {}
+
+func (x *GetAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetAnnouncementRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetAnnouncementRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetAnnouncementRequest) GetAnnouncementID() string {
+	if x != nil {
+		return x.AnnouncementID
+	}
+	return ""
+}
+
+// Response message for getting an announcement of a course.
+// Unlike in lists, the content of the announcement is never cut to an excerpt.
+type GetAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcement  *Announcement          `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnnouncementResponse) Reset() {
+	*x = GetAnnouncementResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnouncementResponse) ProtoMessage() {}
+
+func (x *GetAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetAnnouncementResponse) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+// Request message for removing an announcement from a course.
+type RemoveAnnouncementRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID       string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AnnouncementID string                 `protobuf:"bytes,3,opt,name=announcementID,proto3" json:"announcementID,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveAnnouncementRequest) Reset() {
+	*x = RemoveAnnouncementRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAnnouncementRequest) ProtoMessage() {}
+
+func (x *RemoveAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveAnnouncementRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RemoveAnnouncementRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *RemoveAnnouncementRequest) GetAnnouncementID() string {
+	if x != nil {
+		return x.AnnouncementID
+	}
+	return ""
+}
+
+// Response message for removing an announcement from a course.
+type RemoveAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAnnouncementResponse) Reset() {
+	*x = RemoveAnnouncementResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAnnouncementResponse) ProtoMessage() {}
+
+func (x *RemoveAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{69}
+}
+
+// Request message for updating an announcement of a course.
+// An empty announcementTitle or announcementContent, or an unset visibility, leaves the field unchanged.
+type UpdateAnnouncementRequest struct {
+	state               protoimpl.MessageState  `protogen:"open.v1"`
+	Token               string                  `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID            string                  `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AnnouncementID      string                  `protobuf:"bytes,3,opt,name=announcementID,proto3" json:"announcementID,omitempty"`
+	AnnouncementTitle   string                  `protobuf:"bytes,4,opt,name=announcementTitle,proto3" json:"announcementTitle,omitempty"`
+	AnnouncementContent string                  `protobuf:"bytes,5,opt,name=announcementContent,proto3" json:"announcementContent,omitempty"`
+	Visibility          *AnnouncementVisibility `protobuf:"varint,6,opt,name=visibility,proto3,enum=courses.AnnouncementVisibility,oneof" json:"visibility,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateAnnouncementRequest) Reset() {
+	*x = UpdateAnnouncementRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAnnouncementRequest) ProtoMessage() {}
+
+func (x *UpdateAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateAnnouncementRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateAnnouncementRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *UpdateAnnouncementRequest) GetAnnouncementID() string {
+	if x != nil {
+		return x.AnnouncementID
+	}
+	return ""
+}
+
+func (x *UpdateAnnouncementRequest) GetAnnouncementTitle() string {
+	if x != nil {
+		return x.AnnouncementTitle
+	}
+	return ""
+}
+
+func (x *UpdateAnnouncementRequest) GetAnnouncementContent() string {
+	if x != nil {
+		return x.AnnouncementContent
+	}
+	return ""
+}
+
+func (x *UpdateAnnouncementRequest) GetVisibility() AnnouncementVisibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return AnnouncementVisibility_EVERYONE
+}
+
+// Response message for updating an announcement of a course.
+type UpdateAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcement  *Announcement          `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAnnouncementResponse) Reset() {
+	*x = UpdateAnnouncementResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAnnouncementResponse) ProtoMessage() {}
+
+func (x *UpdateAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateAnnouncementResponse) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+// Request message for creating or updating the announcement of a course with the slug of announcement.
+// An existing announcement gets the title and content of announcement and keeps its other fields.
+type UpsertAnnouncementBySlugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Announcement  *Announcement          `protobuf:"bytes,3,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertAnnouncementBySlugRequest) Reset() {
+	*x = UpsertAnnouncementBySlugRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertAnnouncementBySlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertAnnouncementBySlugRequest) ProtoMessage() {}
+
+func (x *UpsertAnnouncementBySlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertAnnouncementBySlugRequest.ProtoReflect.Descriptor instead.
+func (*UpsertAnnouncementBySlugRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpsertAnnouncementBySlugRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpsertAnnouncementBySlugRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *UpsertAnnouncementBySlugRequest) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+// Response message for creating or updating the announcement of a course with a given slug.
+type UpsertAnnouncementBySlugResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Announcement *Announcement          `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	// Whether the announcement was created rather than updated.
+	Created       bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertAnnouncementBySlugResponse) Reset() {
+	*x = UpsertAnnouncementBySlugResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertAnnouncementBySlugResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertAnnouncementBySlugResponse) ProtoMessage() {}
+
+func (x *UpsertAnnouncementBySlugResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertAnnouncementBySlugResponse.ProtoReflect.Descriptor instead.
+func (*UpsertAnnouncementBySlugResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpsertAnnouncementBySlugResponse) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *UpsertAnnouncementBySlugResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// Request message for copying the announcements of a course to another course.
+// The copies get new IDs and are dated when they are copied.
+type CopyAnnouncementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	FromCourseID  string                 `protobuf:"bytes,2,opt,name=fromCourseID,proto3" json:"fromCourseID,omitempty"`
+	ToCourseID    string                 `protobuf:"bytes,3,opt,name=toCourseID,proto3" json:"toCourseID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyAnnouncementsRequest) Reset() {
+	*x = CopyAnnouncementsRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyAnnouncementsRequest) ProtoMessage() {}
+
+func (x *CopyAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*CopyAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CopyAnnouncementsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CopyAnnouncementsRequest) GetFromCourseID() string {
+	if x != nil {
+		return x.FromCourseID
+	}
+	return ""
+}
+
+func (x *CopyAnnouncementsRequest) GetToCourseID() string {
+	if x != nil {
+		return x.ToCourseID
+	}
+	return ""
+}
+
+// Response message for copying the announcements of a course to another course.
+type CopyAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CopiedCount   int64                  `protobuf:"varint,1,opt,name=copiedCount,proto3" json:"copiedCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyAnnouncementsResponse) Reset() {
+	*x = CopyAnnouncementsResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyAnnouncementsResponse) ProtoMessage() {}
+
+func (x *CopyAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*CopyAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CopyAnnouncementsResponse) GetCopiedCount() int64 {
+	if x != nil {
+		return x.CopiedCount
+	}
+	return 0
+}
+
+// Request message for removing several announcements from a course.
+type BatchRemoveAnnouncementsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Token           string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID        string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AnnouncementIDs []string               `protobuf:"bytes,3,rep,name=announcementIDs,proto3" json:"announcementIDs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchRemoveAnnouncementsRequest) Reset() {
+	*x = BatchRemoveAnnouncementsRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRemoveAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRemoveAnnouncementsRequest) ProtoMessage() {}
+
+func (x *BatchRemoveAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRemoveAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*BatchRemoveAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *BatchRemoveAnnouncementsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BatchRemoveAnnouncementsRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *BatchRemoveAnnouncementsRequest) GetAnnouncementIDs() []string {
+	if x != nil {
+		return x.AnnouncementIDs
+	}
+	return nil
+}
+
+// Response message for removing several announcements from a course.
+// IDs that did not match an announcement of the course are not counted and fail in result.
+type BatchRemoveAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RemovedCount  int64                  `protobuf:"varint,1,opt,name=removedCount,proto3" json:"removedCount,omitempty"`
+	Result        *BatchResult           `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRemoveAnnouncementsResponse) Reset() {
+	*x = BatchRemoveAnnouncementsResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRemoveAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRemoveAnnouncementsResponse) ProtoMessage() {}
+
+func (x *BatchRemoveAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRemoveAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*BatchRemoveAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *BatchRemoveAnnouncementsResponse) GetRemovedCount() int64 {
+	if x != nil {
+		return x.RemovedCount
+	}
+	return 0
+}
+
+func (x *BatchRemoveAnnouncementsResponse) GetResult() *BatchResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Outcome of each item of a batch request, in request order.
+// Items that fail do not fail the request; the request fails only if it cannot be processed at all.
+type BatchResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Items          []*BatchItemResult     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	SucceededCount int64                  `protobuf:"varint,2,opt,name=succeededCount,proto3" json:"succeededCount,omitempty"`
+	FailedCount    int64                  `protobuf:"varint,3,opt,name=failedCount,proto3" json:"failedCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	mi := &file_courses_microservice_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *BatchResult) GetItems() []*BatchItemResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchResult) GetSucceededCount() int64 {
+	if x != nil {
+		return x.SucceededCount
+	}
+	return 0
+}
+
+func (x *BatchResult) GetFailedCount() int64 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+// Outcome of a single item of a batch request.
+// errorCode is the gRPC status code the item would have failed with on its own, 0 (OK) on success.
+type BatchItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ok            bool                   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	ErrorCode     uint32                 `protobuf:"varint,3,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	mi := &file_courses_microservice_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BatchItemResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *BatchItemResult) GetErrorCode() uint32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// Request message for setting the enrollment window of a course.
+// An unset opensAt or closesAt leaves that side of the window unbounded.
+type SetEnrollmentWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=opensAt,proto3" json:"opensAt,omitempty"`
+	ClosesAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=closesAt,proto3" json:"closesAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEnrollmentWindowRequest) Reset() {
+	*x = SetEnrollmentWindowRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEnrollmentWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnrollmentWindowRequest) ProtoMessage() {}
+
+func (x *SetEnrollmentWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnrollmentWindowRequest.ProtoReflect.Descriptor instead.
+func (*SetEnrollmentWindowRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SetEnrollmentWindowRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetEnrollmentWindowRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *SetEnrollmentWindowRequest) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
+}
+
+func (x *SetEnrollmentWindowRequest) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+// Response message for setting the enrollment window of a course.
+type SetEnrollmentWindowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEnrollmentWindowResponse) Reset() {
+	*x = SetEnrollmentWindowResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEnrollmentWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnrollmentWindowResponse) ProtoMessage() {}
+
+func (x *SetEnrollmentWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnrollmentWindowResponse.ProtoReflect.Descriptor instead.
+func (*SetEnrollmentWindowResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{81}
+}
+
+// Request message for getting the enrollment status of a course.
+type GetEnrollmentStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentStatusRequest) Reset() {
+	*x = GetEnrollmentStatusRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentStatusRequest) ProtoMessage() {}
+
+func (x *GetEnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetEnrollmentStatusRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetEnrollmentStatusRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+// Request message for checking whether a student can enroll in a course.
+type CheckEnrollmentEligibilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	StudentID     string                 `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckEnrollmentEligibilityRequest) Reset() {
+	*x = CheckEnrollmentEligibilityRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckEnrollmentEligibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEnrollmentEligibilityRequest) ProtoMessage() {}
+
+func (x *CheckEnrollmentEligibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckEnrollmentEligibilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckEnrollmentEligibilityRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CheckEnrollmentEligibilityRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CheckEnrollmentEligibilityRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *CheckEnrollmentEligibilityRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+// Response message for checking whether a student can enroll in a course.
+// The checks are not a reservation: the course may change before the student is enrolled.
+type CheckEnrollmentEligibilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether nothing blocks the enrollment.
+	Eligible bool `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	// Every reason the student cannot enroll; empty if eligible.
+	Blockers []EnrollmentBlocker `protobuf:"varint,2,rep,packed,name=blockers,proto3,enum=courses.EnrollmentBlocker" json:"blockers,omitempty"`
+	// Co-requisites of the course the student is not enrolled in. They only warn and never block.
+	MissingCorequisites []string `protobuf:"bytes,3,rep,name=missingCorequisites,proto3" json:"missingCorequisites,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CheckEnrollmentEligibilityResponse) Reset() {
+	*x = CheckEnrollmentEligibilityResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckEnrollmentEligibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEnrollmentEligibilityResponse) ProtoMessage() {}
+
+func (x *CheckEnrollmentEligibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckEnrollmentEligibilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckEnrollmentEligibilityResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CheckEnrollmentEligibilityResponse) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *CheckEnrollmentEligibilityResponse) GetBlockers() []EnrollmentBlocker {
+	if x != nil {
+		return x.Blockers
+	}
+	return nil
+}
+
+func (x *CheckEnrollmentEligibilityResponse) GetMissingCorequisites() []string {
+	if x != nil {
+		return x.MissingCorequisites
+	}
+	return nil
+}
+
+// Response message for getting the enrollment status of a course.
+// secondsUntilOpen and secondsUntilClose count down to the bounds of the window by the server clock,
+// rounded up; zero or negative values mean the bound has passed. They are unset for an unbounded side.
+type GetEnrollmentStatusResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Status            EnrollmentStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=courses.EnrollmentStatus" json:"status,omitempty"`
+	OpensAt           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=opensAt,proto3" json:"opensAt,omitempty"`
+	ClosesAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=closesAt,proto3" json:"closesAt,omitempty"`
+	SecondsUntilOpen  *int64                 `protobuf:"varint,4,opt,name=secondsUntilOpen,proto3,oneof" json:"secondsUntilOpen,omitempty"`
+	SecondsUntilClose *int64                 `protobuf:"varint,5,opt,name=secondsUntilClose,proto3,oneof" json:"secondsUntilClose,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentStatusResponse) Reset() {
+	*x = GetEnrollmentStatusResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentStatusResponse) ProtoMessage() {}
+
+func (x *GetEnrollmentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetEnrollmentStatusResponse) GetStatus() EnrollmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnrollmentStatus_ENROLLMENT_STATUS_UNSPECIFIED
+}
+
+func (x *GetEnrollmentStatusResponse) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
+}
+
+func (x *GetEnrollmentStatusResponse) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *GetEnrollmentStatusResponse) GetSecondsUntilOpen() int64 {
+	if x != nil && x.SecondsUntilOpen != nil {
+		return *x.SecondsUntilOpen
+	}
+	return 0
+}
+
+func (x *GetEnrollmentStatusResponse) GetSecondsUntilClose() int64 {
+	if x != nil && x.SecondsUntilClose != nil {
+		return *x.SecondsUntilClose
+	}
+	return 0
+}
+
+// Request message for searching announcements across all courses.
+// pageToken is taken from the nextPageToken of a previous response.
+// An unset pageSize takes the server default; larger ones are capped at the server maximum.
+type SearchAllAnnouncementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAllAnnouncementsRequest) Reset() {
+	*x = SearchAllAnnouncementsRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAllAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAllAnnouncementsRequest) ProtoMessage() {}
+
+func (x *SearchAllAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAllAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAllAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SearchAllAnnouncementsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SearchAllAnnouncementsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchAllAnnouncementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchAllAnnouncementsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response message for searching announcements across all courses.
+// nextPageToken is empty on the last page. pageSize is the page size actually used.
+type SearchAllAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*AnnouncementMatch   `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAllAnnouncementsResponse) Reset() {
+	*x = SearchAllAnnouncementsResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAllAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAllAnnouncementsResponse) ProtoMessage() {}
+
+func (x *SearchAllAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAllAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAllAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SearchAllAnnouncementsResponse) GetMatches() []*AnnouncementMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *SearchAllAnnouncementsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchAllAnnouncementsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Request message for getting the announcements of all courses posted after since, oldest first.
+// Poll by passing the nextCursor of the previous response as after, which takes precedence over since.
+// Announcements posted at the same time are ordered by course and announcement ID, so the cursor
+// resumes between them where since would skip those not returned yet.
+// Unset since and after start from the beginning.
+// An unset pageSize takes the server default; larger ones are capped at the server maximum.
+type GetAnnouncementsSinceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	After         *AnnouncementCursor    `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnnouncementsSinceRequest) Reset() {
+	*x = GetAnnouncementsSinceRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnnouncementsSinceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnouncementsSinceRequest) ProtoMessage() {}
+
+func (x *GetAnnouncementsSinceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnouncementsSinceRequest.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementsSinceRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetAnnouncementsSinceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetAnnouncementsSinceRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetAnnouncementsSinceRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAnnouncementsSinceRequest) GetAfter() *AnnouncementCursor {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// Response message for getting the announcements of all courses posted after a point in time.
+// nextCursor is the position of the last announcement returned, or the position the request started
+// from if none were. nextSince is the creation time of that announcement.
+// pageSize is the page size actually used.
+type GetAnnouncementsSinceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*AnnouncementMatch   `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	NextSince     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=nextSince,proto3" json:"nextSince,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	NextCursor    *AnnouncementCursor    `protobuf:"bytes,4,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnnouncementsSinceResponse) Reset() {
+	*x = GetAnnouncementsSinceResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnnouncementsSinceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnouncementsSinceResponse) ProtoMessage() {}
+
+func (x *GetAnnouncementsSinceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnouncementsSinceResponse.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementsSinceResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetAnnouncementsSinceResponse) GetAnnouncements() []*AnnouncementMatch {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *GetAnnouncementsSinceResponse) GetNextSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextSince
+	}
+	return nil
+}
+
+func (x *GetAnnouncementsSinceResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAnnouncementsSinceResponse) GetNextCursor() *AnnouncementCursor {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
+// The position of an announcement in the order GetAnnouncementsSince returns announcements in.
+type AnnouncementCursor struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	CourseID       string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AnnouncementID string                 `protobuf:"bytes,3,opt,name=announcementID,proto3" json:"announcementID,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AnnouncementCursor) Reset() {
+	*x = AnnouncementCursor{}
+	mi := &file_courses_microservice_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnouncementCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementCursor) ProtoMessage() {}
+
+func (x *AnnouncementCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementCursor.ProtoReflect.Descriptor instead.
+func (*AnnouncementCursor) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AnnouncementCursor) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AnnouncementCursor) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *AnnouncementCursor) GetAnnouncementID() string {
+	if x != nil {
+		return x.AnnouncementID
+	}
+	return ""
+}
+
+// An announcement matched by a search, together with its course.
+type AnnouncementMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseID      string                 `protobuf:"bytes,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Announcement  *Announcement          `protobuf:"bytes,2,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnouncementMatch) Reset() {
+	*x = AnnouncementMatch{}
+	mi := &file_courses_microservice_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnouncementMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementMatch) ProtoMessage() {}
+
+func (x *AnnouncementMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementMatch.ProtoReflect.Descriptor instead.
+func (*AnnouncementMatch) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AnnouncementMatch) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *AnnouncementMatch) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+// Request message for comparing the students enrolled in two courses.
+// The page applies to each of the three lists of the response.
+// An unset pageSize takes the server default; larger ones are capped at the server maximum.
+type GetEnrollmentDifferenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseIDA     string                 `protobuf:"bytes,2,opt,name=courseIDA,proto3" json:"courseIDA,omitempty"`
+	CourseIDB     string                 `protobuf:"bytes,3,opt,name=courseIDB,proto3" json:"courseIDB,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentDifferenceRequest) Reset() {
+	*x = GetEnrollmentDifferenceRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentDifferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentDifferenceRequest) ProtoMessage() {}
+
+func (x *GetEnrollmentDifferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentDifferenceRequest.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentDifferenceRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetEnrollmentDifferenceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetEnrollmentDifferenceRequest) GetCourseIDA() string {
+	if x != nil {
+		return x.CourseIDA
+	}
+	return ""
+}
+
+func (x *GetEnrollmentDifferenceRequest) GetCourseIDB() string {
+	if x != nil {
+		return x.CourseIDB
+	}
+	return ""
+}
+
+func (x *GetEnrollmentDifferenceRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetEnrollmentDifferenceRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response message for comparing the students enrolled in two courses.
+// nextPageToken is empty once every list has been fully returned. pageSize is the page size actually used.
+type GetEnrollmentDifferenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OnlyInA       []string               `protobuf:"bytes,1,rep,name=onlyInA,proto3" json:"onlyInA,omitempty"`
+	OnlyInB       []string               `protobuf:"bytes,2,rep,name=onlyInB,proto3" json:"onlyInB,omitempty"`
+	InBoth        []string               `protobuf:"bytes,3,rep,name=inBoth,proto3" json:"inBoth,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentDifferenceResponse) Reset() {
+	*x = GetEnrollmentDifferenceResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentDifferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentDifferenceResponse) ProtoMessage() {}
+
+func (x *GetEnrollmentDifferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentDifferenceResponse.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentDifferenceResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetEnrollmentDifferenceResponse) GetOnlyInA() []string {
+	if x != nil {
+		return x.OnlyInA
+	}
+	return nil
+}
+
+func (x *GetEnrollmentDifferenceResponse) GetOnlyInB() []string {
+	if x != nil {
+		return x.OnlyInB
+	}
+	return nil
+}
+
+func (x *GetEnrollmentDifferenceResponse) GetInBoth() []string {
+	if x != nil {
+		return x.InBoth
+	}
+	return nil
+}
+
+func (x *GetEnrollmentDifferenceResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetEnrollmentDifferenceResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Request message for setting the quiet periods of a course.
+// The given periods replace any previously set; an empty list removes them all.
+type SetQuietPeriodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Periods       []*QuietPeriod         `protobuf:"bytes,3,rep,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuietPeriodsRequest) Reset() {
+	*x = SetQuietPeriodsRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuietPeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuietPeriodsRequest) ProtoMessage() {}
+
+func (x *SetQuietPeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuietPeriodsRequest.ProtoReflect.Descriptor instead.
+func (*SetQuietPeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SetQuietPeriodsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetQuietPeriodsRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *SetQuietPeriodsRequest) GetPeriods() []*QuietPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+// Response message for setting the quiet periods of a course.
+type SetQuietPeriodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuietPeriodsResponse) Reset() {
+	*x = SetQuietPeriodsResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuietPeriodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuietPeriodsResponse) ProtoMessage() {}
+
+func (x *SetQuietPeriodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuietPeriodsResponse.ProtoReflect.Descriptor instead.
+func (*SetQuietPeriodsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{95}
+}
+
+// Request message for setting whether new announcements may be posted to a course.
+// Disabling only blocks new posts; the existing announcements of the course stay readable.
+type SetAnnouncementsEnabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAnnouncementsEnabledRequest) Reset() {
+	*x = SetAnnouncementsEnabledRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnnouncementsEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnnouncementsEnabledRequest) ProtoMessage() {}
+
+func (x *SetAnnouncementsEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnnouncementsEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetAnnouncementsEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SetAnnouncementsEnabledRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetAnnouncementsEnabledRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *SetAnnouncementsEnabledRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// Response message for setting whether new announcements may be posted to a course.
+type SetAnnouncementsEnabledResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAnnouncementsEnabledResponse) Reset() {
+	*x = SetAnnouncementsEnabledResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnnouncementsEnabledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnnouncementsEnabledResponse) ProtoMessage() {}
+
+func (x *SetAnnouncementsEnabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnnouncementsEnabledResponse.ProtoReflect.Descriptor instead.
+func (*SetAnnouncementsEnabledResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{97}
+}
+
+// A period during which non-urgent announcements may not be posted to a course.
+// A weekly period recurs every week from startsAt to endsAt, and must be shorter than a week.
+type QuietPeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+	Weekly        bool                   `protobuf:"varint,3,opt,name=weekly,proto3" json:"weekly,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuietPeriod) Reset() {
+	*x = QuietPeriod{}
+	mi := &file_courses_microservice_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuietPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietPeriod) ProtoMessage() {}
+
+func (x *QuietPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietPeriod.ProtoReflect.Descriptor instead.
+func (*QuietPeriod) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *QuietPeriod) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *QuietPeriod) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *QuietPeriod) GetWeekly() bool {
+	if x != nil {
+		return x.Weekly
+	}
+	return false
+}
+
+// Request message for creating an API key of a course.
+// scopes lists the names of the RPCs the key may call, e.g. "AddAnnouncementToCourse".
+// An unset expiresAt creates a key that does not expire.
+type CreateCourseAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCourseAPIKeyRequest) Reset() {
+	*x = CreateCourseAPIKeyRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCourseAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourseAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateCourseAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourseAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCourseAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CreateCourseAPIKeyRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateCourseAPIKeyRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *CreateCourseAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateCourseAPIKeyRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Response message for creating an API key of a course.
+// The secret is sent as "x-api-key" metadata and is not retrievable later.
+type CreateCourseAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *CourseAPIKey          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCourseAPIKeyResponse) Reset() {
+	*x = CreateCourseAPIKeyResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCourseAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourseAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateCourseAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourseAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateCourseAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CreateCourseAPIKeyResponse) GetKey() *CourseAPIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CreateCourseAPIKeyResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// Request message for listing the API keys of a course.
+type ListCourseAPIKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCourseAPIKeysRequest) Reset() {
+	*x = ListCourseAPIKeysRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCourseAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCourseAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListCourseAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCourseAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListCourseAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListCourseAPIKeysRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListCourseAPIKeysRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+// Response message for listing the API keys of a course.
+type ListCourseAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*CourseAPIKey        `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCourseAPIKeysResponse) Reset() {
+	*x = ListCourseAPIKeysResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCourseAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCourseAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListCourseAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCourseAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListCourseAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListCourseAPIKeysResponse) GetKeys() []*CourseAPIKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// Request message for revoking an API key of a course.
+type RevokeCourseAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	KeyID         string                 `protobuf:"bytes,3,opt,name=keyID,proto3" json:"keyID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCourseAPIKeyRequest) Reset() {
+	*x = RevokeCourseAPIKeyRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCourseAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCourseAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeCourseAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCourseAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCourseAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *RevokeCourseAPIKeyRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RevokeCourseAPIKeyRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *RevokeCourseAPIKeyRequest) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+// Response message for revoking an API key of a course.
+type RevokeCourseAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCourseAPIKeyResponse) Reset() {
+	*x = RevokeCourseAPIKeyResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCourseAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCourseAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeCourseAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCourseAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCourseAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{104}
+}
+
+// An API key of a course, without its secret.
+type CourseAPIKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyID         string                 `protobuf:"bytes,1,opt,name=keyID,proto3" json:"keyID,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseAPIKey) Reset() {
+	*x = CourseAPIKey{}
+	mi := &file_courses_microservice_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseAPIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseAPIKey) ProtoMessage() {}
+
+func (x *CourseAPIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseAPIKey.ProtoReflect.Descriptor instead.
+func (*CourseAPIKey) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CourseAPIKey) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *CourseAPIKey) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *CourseAPIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CourseAPIKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CourseAPIKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Request message for adding a co-requisite to a course.
+type AddCorequisiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	CorequisiteID string                 `protobuf:"bytes,3,opt,name=corequisiteID,proto3" json:"corequisiteID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCorequisiteRequest) Reset() {
+	*x = AddCorequisiteRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCorequisiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCorequisiteRequest) ProtoMessage() {}
+
+func (x *AddCorequisiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCorequisiteRequest.ProtoReflect.Descriptor instead.
+func (*AddCorequisiteRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AddCorequisiteRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AddCorequisiteRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *AddCorequisiteRequest) GetCorequisiteID() string {
+	if x != nil {
+		return x.CorequisiteID
+	}
+	return ""
+}
+
+// Response message for adding a co-requisite to a course.
+type AddCorequisiteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCorequisiteResponse) Reset() {
+	*x = AddCorequisiteResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCorequisiteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCorequisiteResponse) ProtoMessage() {}
+
+func (x *AddCorequisiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCorequisiteResponse.ProtoReflect.Descriptor instead.
+func (*AddCorequisiteResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{107}
+}
+
+// Request message for getting the co-requisites of a course.
+type GetCorequisitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCorequisitesRequest) Reset() {
+	*x = GetCorequisitesRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCorequisitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCorequisitesRequest) ProtoMessage() {}
+
+func (x *GetCorequisitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCorequisitesRequest.ProtoReflect.Descriptor instead.
+func (*GetCorequisitesRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetCorequisitesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCorequisitesRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+// Response message for getting the co-requisites of a course.
+type GetCorequisitesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CorequisiteIDs []string               `protobuf:"bytes,1,rep,name=corequisiteIDs,proto3" json:"corequisiteIDs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCorequisitesResponse) Reset() {
+	*x = GetCorequisitesResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCorequisitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCorequisitesResponse) ProtoMessage() {}
+
+func (x *GetCorequisitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCorequisitesResponse.ProtoReflect.Descriptor instead.
+func (*GetCorequisitesResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetCorequisitesResponse) GetCorequisiteIDs() []string {
+	if x != nil {
+		return x.CorequisiteIDs
+	}
+	return nil
+}
+
+// Request message for getting the courses a staff member and a student share.
+type GetSharedCoursesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	StaffID       string                 `protobuf:"bytes,2,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	StudentID     string                 `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseRequest) Reset() {
-	*x = GetCourseRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[0]
+func (x *GetSharedCoursesRequest) Reset() {
+	*x = GetSharedCoursesRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseRequest) String() string {
+func (x *GetSharedCoursesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseRequest) ProtoMessage() {}
+func (*GetSharedCoursesRequest) ProtoMessage() {}
 
-func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[0]
+func (x *GetSharedCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -54,48 +6870,55 @@ func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use GetSharedCoursesRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *GetCourseRequest) GetToken() string {
+func (x *GetSharedCoursesRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *GetCourseRequest) GetCourseID() string {
+func (x *GetSharedCoursesRequest) GetStaffID() string {
 	if x != nil {
-		return x.CourseID
+		return x.StaffID
 	}
 	return ""
 }
 
-// Response message for getting a course.
-type GetCourseResponse struct {
+func (x *GetSharedCoursesRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+// Response message for getting the courses a staff member and a student share.
+type GetSharedCoursesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	CourseIDs     []string               `protobuf:"bytes,1,rep,name=courseIDs,proto3" json:"courseIDs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseResponse) Reset() {
-	*x = GetCourseResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[1]
+func (x *GetSharedCoursesResponse) Reset() {
+	*x = GetSharedCoursesResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseResponse) String() string {
+func (x *GetSharedCoursesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseResponse) ProtoMessage() {}
+func (*GetSharedCoursesResponse) ProtoMessage() {}
 
-func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[1]
+func (x *GetSharedCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -106,42 +6929,45 @@ func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetSharedCoursesResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetCourseResponse) GetCourse() *Course {
+func (x *GetSharedCoursesResponse) GetCourseIDs() []string {
 	if x != nil {
-		return x.Course
+		return x.CourseIDs
 	}
 	return nil
 }
 
-// Request message for creating a new course.
-type CreateCourseRequest struct {
+// A part of the final grade of a course, such as the exam or the homework.
+// weight is the percentage of the final grade it makes up.
+// kind optionally names the kind of assignment or exam it is linked to in the grades service.
+type GradingComponent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Course        *Course                `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight        float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCourseRequest) Reset() {
-	*x = CreateCourseRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[2]
+func (x *GradingComponent) Reset() {
+	*x = GradingComponent{}
+	mi := &file_courses_microservice_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCourseRequest) String() string {
+func (x *GradingComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCourseRequest) ProtoMessage() {}
+func (*GradingComponent) ProtoMessage() {}
 
-func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[2]
+func (x *GradingComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,48 +6978,58 @@ func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCourseRequest.ProtoReflect.Descriptor instead.
-func (*CreateCourseRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GradingComponent.ProtoReflect.Descriptor instead.
+func (*GradingComponent) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *CreateCourseRequest) GetToken() string {
+func (x *GradingComponent) GetName() string {
 	if x != nil {
-		return x.Token
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateCourseRequest) GetCourse() *Course {
+func (x *GradingComponent) GetWeight() float64 {
 	if x != nil {
-		return x.Course
+		return x.Weight
 	}
-	return nil
+	return 0
 }
 
-// Response message for creating a new course.
-type CreateCourseResponse struct {
+func (x *GradingComponent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+// Request message for adding or replacing a component of the grading scheme of a course.
+// A component with the same name is replaced.
+type SetGradingComponentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Component     *GradingComponent      `protobuf:"bytes,3,opt,name=component,proto3" json:"component,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCourseResponse) Reset() {
-	*x = CreateCourseResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[3]
+func (x *SetGradingComponentRequest) Reset() {
+	*x = SetGradingComponentRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCourseResponse) String() string {
+func (x *SetGradingComponentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCourseResponse) ProtoMessage() {}
+func (*SetGradingComponentRequest) ProtoMessage() {}
 
-func (x *CreateCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[3]
+func (x *SetGradingComponentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,42 +7040,94 @@ func (x *CreateCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCourseResponse.ProtoReflect.Descriptor instead.
-func (*CreateCourseResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SetGradingComponentRequest.ProtoReflect.Descriptor instead.
+func (*SetGradingComponentRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *CreateCourseResponse) GetCourse() *Course {
+func (x *SetGradingComponentRequest) GetToken() string {
 	if x != nil {
-		return x.Course
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetGradingComponentRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *SetGradingComponentRequest) GetComponent() *GradingComponent {
+	if x != nil {
+		return x.Component
 	}
 	return nil
 }
 
-// Request message for updating a course.
-type UpdateCourseRequest struct {
+// Response message for adding or replacing a component of the grading scheme of a course.
+type SetGradingComponentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGradingComponentResponse) Reset() {
+	*x = SetGradingComponentResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGradingComponentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGradingComponentResponse) ProtoMessage() {}
+
+func (x *SetGradingComponentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGradingComponentResponse.ProtoReflect.Descriptor instead.
+func (*SetGradingComponentResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{114}
+}
+
+// Request message for removing a component from the grading scheme of a course.
+type RemoveGradingComponentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Course        *Course                `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCourseRequest) Reset() {
-	*x = UpdateCourseRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[4]
+func (x *RemoveGradingComponentRequest) Reset() {
+	*x = RemoveGradingComponentRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCourseRequest) String() string {
+func (x *RemoveGradingComponentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCourseRequest) ProtoMessage() {}
+func (*RemoveGradingComponentRequest) ProtoMessage() {}
 
-func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[4]
+func (x *RemoveGradingComponentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,48 +7138,54 @@ func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCourseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCourseRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use RemoveGradingComponentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGradingComponentRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *UpdateCourseRequest) GetToken() string {
+func (x *RemoveGradingComponentRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *UpdateCourseRequest) GetCourse() *Course {
+func (x *RemoveGradingComponentRequest) GetCourseID() string {
 	if x != nil {
-		return x.Course
+		return x.CourseID
 	}
-	return nil
+	return ""
 }
 
-// Response message for updating a course.
-type UpdateCourseResponse struct {
+func (x *RemoveGradingComponentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Response message for removing a component from the grading scheme of a course.
+type RemoveGradingComponentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCourseResponse) Reset() {
-	*x = UpdateCourseResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[5]
+func (x *RemoveGradingComponentResponse) Reset() {
+	*x = RemoveGradingComponentResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCourseResponse) String() string {
+func (x *RemoveGradingComponentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCourseResponse) ProtoMessage() {}
+func (*RemoveGradingComponentResponse) ProtoMessage() {}
 
-func (x *UpdateCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[5]
+func (x *RemoveGradingComponentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,20 +7196,13 @@ func (x *UpdateCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCourseResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCourseResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *UpdateCourseResponse) GetCourse() *Course {
-	if x != nil {
-		return x.Course
-	}
-	return nil
+// Deprecated: Use RemoveGradingComponentResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGradingComponentResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{116}
 }
 
-// Request message for deleting a course.
-type DeleteCourseRequest struct {
+// Request message for getting the grading scheme of a course.
+type GetGradingSchemeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
@@ -323,21 +7210,21 @@ type DeleteCourseRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCourseRequest) Reset() {
-	*x = DeleteCourseRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[6]
+func (x *GetGradingSchemeRequest) Reset() {
+	*x = GetGradingSchemeRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCourseRequest) String() string {
+func (x *GetGradingSchemeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCourseRequest) ProtoMessage() {}
+func (*GetGradingSchemeRequest) ProtoMessage() {}
 
-func (x *DeleteCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[6]
+func (x *GetGradingSchemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,47 +7235,50 @@ func (x *DeleteCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCourseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCourseRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetGradingSchemeRequest.ProtoReflect.Descriptor instead.
+func (*GetGradingSchemeRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *DeleteCourseRequest) GetToken() string {
+func (x *GetGradingSchemeRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *DeleteCourseRequest) GetCourseID() string {
+func (x *GetGradingSchemeRequest) GetCourseID() string {
 	if x != nil {
 		return x.CourseID
 	}
 	return ""
 }
 
-// Response message for deleting a course.
-type DeleteCourseResponse struct {
+// Response message for getting the grading scheme of a course. Components are ordered by name.
+// finalizedAt is unset while the scheme is a draft.
+type GetGradingSchemeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Components    []*GradingComponent    `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	FinalizedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=finalizedAt,proto3" json:"finalizedAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCourseResponse) Reset() {
-	*x = DeleteCourseResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[7]
+func (x *GetGradingSchemeResponse) Reset() {
+	*x = GetGradingSchemeResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCourseResponse) String() string {
+func (x *GetGradingSchemeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCourseResponse) ProtoMessage() {}
+func (*GetGradingSchemeResponse) ProtoMessage() {}
 
-func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[7]
+func (x *GetGradingSchemeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,36 +7289,49 @@ func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCourseResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCourseResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetGradingSchemeResponse.ProtoReflect.Descriptor instead.
+func (*GetGradingSchemeResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{118}
 }
 
-// Request message for adding a student to a course.
-type AddStudentRequest struct {
+func (x *GetGradingSchemeResponse) GetComponents() []*GradingComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *GetGradingSchemeResponse) GetFinalizedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinalizedAt
+	}
+	return nil
+}
+
+// Request message for finalizing the grading scheme of a course.
+type FinalizeGradingSchemeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	StudentID     string                 `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddStudentRequest) Reset() {
-	*x = AddStudentRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[8]
+func (x *FinalizeGradingSchemeRequest) Reset() {
+	*x = FinalizeGradingSchemeRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddStudentRequest) String() string {
+func (x *FinalizeGradingSchemeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddStudentRequest) ProtoMessage() {}
+func (*FinalizeGradingSchemeRequest) ProtoMessage() {}
 
-func (x *AddStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[8]
+func (x *FinalizeGradingSchemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,54 +7342,50 @@ func (x *AddStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddStudentRequest.ProtoReflect.Descriptor instead.
-func (*AddStudentRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use FinalizeGradingSchemeRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeGradingSchemeRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *AddStudentRequest) GetToken() string {
+func (x *FinalizeGradingSchemeRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *AddStudentRequest) GetCourseID() string {
-	if x != nil {
-		return x.CourseID
-	}
-	return ""
-}
-
-func (x *AddStudentRequest) GetStudentID() string {
+func (x *FinalizeGradingSchemeRequest) GetCourseID() string {
 	if x != nil {
-		return x.StudentID
+		return x.CourseID
 	}
 	return ""
 }
 
-// Response message for adding a student to a course.
-type AddStudentResponse struct {
+// Response message for finalizing the grading scheme of a course.
+// Finalizing a finalized scheme returns it unchanged.
+type FinalizeGradingSchemeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Components    []*GradingComponent    `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	FinalizedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=finalizedAt,proto3" json:"finalizedAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddStudentResponse) Reset() {
-	*x = AddStudentResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[9]
+func (x *FinalizeGradingSchemeResponse) Reset() {
+	*x = FinalizeGradingSchemeResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddStudentResponse) String() string {
+func (x *FinalizeGradingSchemeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddStudentResponse) ProtoMessage() {}
+func (*FinalizeGradingSchemeResponse) ProtoMessage() {}
 
-func (x *AddStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[9]
+func (x *FinalizeGradingSchemeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,36 +7396,67 @@ func (x *AddStudentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddStudentResponse.ProtoReflect.Descriptor instead.
-func (*AddStudentResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use FinalizeGradingSchemeResponse.ProtoReflect.Descriptor instead.
+func (*FinalizeGradingSchemeResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{120}
 }
 
-// Request message for removing a student from a course.
-type RemoveStudentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	StudentID     string                 `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
+func (x *FinalizeGradingSchemeResponse) GetComponents() []*GradingComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *FinalizeGradingSchemeResponse) GetFinalizedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinalizedAt
+	}
+	return nil
+}
+
+// Message representing a course.
+type Course struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CourseID   string                 `protobuf:"bytes,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	CourseName string                 `protobuf:"bytes,2,opt,name=courseName,proto3" json:"courseName,omitempty"`
+	Semester   string                 `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// At most 16 KiB by default; CreateCourse and UpdateCourse reject longer descriptions.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Maximum number of enrolled students; 0 means unlimited.
+	// GetCourse, ListCourses and GetCourseByShareToken return it to course staff only.
+	Capacity int32 `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// Whether new announcements may be posted to the course.
+	// Set with SetAnnouncementsEnabled; ignored by CreateCourse and UpdateCourse.
+	AnnouncementsEnabled bool  `protobuf:"varint,6,opt,name=announcementsEnabled,proto3" json:"announcementsEnabled,omitempty"`
+	Credits              int32 `protobuf:"varint,7,opt,name=credits,proto3" json:"credits,omitempty"`
+	// Set by GetCourse and ListCourses for course staff only; ignored by CreateCourse and UpdateCourse.
+	StaffDetails *CourseStaffDetails `protobuf:"bytes,8,opt,name=staffDetails,proto3" json:"staffDetails,omitempty"`
+	// When the course was created and last changed. Set by the server; ignored by CreateCourse and UpdateCourse.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// Custom fields departments attach to the course, such as its room or syllabus URL.
+	// UpdateCourse merges the fields it is given into the existing ones; an empty value removes a field.
+	Metadata      map[string]string `protobuf:"bytes,11,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveStudentRequest) Reset() {
-	*x = RemoveStudentRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[10]
+func (x *Course) Reset() {
+	*x = Course{}
+	mi := &file_courses_microservice_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveStudentRequest) String() string {
+func (x *Course) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveStudentRequest) ProtoMessage() {}
+func (*Course) ProtoMessage() {}
 
-func (x *RemoveStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[10]
+func (x *Course) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,54 +7467,117 @@ func (x *RemoveStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveStudentRequest.ProtoReflect.Descriptor instead.
-func (*RemoveStudentRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Course.ProtoReflect.Descriptor instead.
+func (*Course) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *RemoveStudentRequest) GetToken() string {
+func (x *Course) GetCourseID() string {
 	if x != nil {
-		return x.Token
+		return x.CourseID
 	}
 	return ""
 }
 
-func (x *RemoveStudentRequest) GetCourseID() string {
+func (x *Course) GetCourseName() string {
 	if x != nil {
-		return x.CourseID
+		return x.CourseName
 	}
 	return ""
 }
 
-func (x *RemoveStudentRequest) GetStudentID() string {
+func (x *Course) GetSemester() string {
 	if x != nil {
-		return x.StudentID
+		return x.Semester
 	}
 	return ""
 }
 
-// Response message for removing a student from a course.
-type RemoveStudentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Course) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *RemoveStudentResponse) Reset() {
-	*x = RemoveStudentResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[11]
+func (x *Course) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Course) GetAnnouncementsEnabled() bool {
+	if x != nil {
+		return x.AnnouncementsEnabled
+	}
+	return false
+}
+
+func (x *Course) GetCredits() int32 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *Course) GetStaffDetails() *CourseStaffDetails {
+	if x != nil {
+		return x.StaffDetails
+	}
+	return nil
+}
+
+func (x *Course) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Course) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Course) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Internal state of a course that only its staff see.
+type CourseStaffDetails struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When the grading scheme was finalized; unset while it is still a draft.
+	GradingFinalizedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=gradingFinalizedAt,proto3" json:"gradingFinalizedAt,omitempty"`
+	// The enrollment window of the course; unset bounds leave it open.
+	EnrollmentOpensAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=enrollmentOpensAt,proto3" json:"enrollmentOpensAt,omitempty"`
+	EnrollmentClosesAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=enrollmentClosesAt,proto3" json:"enrollmentClosesAt,omitempty"`
+	// The number of components of the grading scheme while it is still a draft; 0 once finalized.
+	DraftGradingComponents int64 `protobuf:"varint,4,opt,name=draftGradingComponents,proto3" json:"draftGradingComponents,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CourseStaffDetails) Reset() {
+	*x = CourseStaffDetails{}
+	mi := &file_courses_microservice_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveStudentResponse) String() string {
+func (x *CourseStaffDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveStudentResponse) ProtoMessage() {}
+func (*CourseStaffDetails) ProtoMessage() {}
 
-func (x *RemoveStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[11]
+func (x *CourseStaffDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,36 +7588,78 @@ func (x *RemoveStudentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveStudentResponse.ProtoReflect.Descriptor instead.
-func (*RemoveStudentResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CourseStaffDetails.ProtoReflect.Descriptor instead.
+func (*CourseStaffDetails) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{122}
 }
 
-// Request message for adding a staff member to a course.
-type AddStaffRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	StaffID       string                 `protobuf:"bytes,3,opt,name=staffID,proto3" json:"staffID,omitempty"`
+func (x *CourseStaffDetails) GetGradingFinalizedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GradingFinalizedAt
+	}
+	return nil
+}
+
+func (x *CourseStaffDetails) GetEnrollmentOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnrollmentOpensAt
+	}
+	return nil
+}
+
+func (x *CourseStaffDetails) GetEnrollmentClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnrollmentClosesAt
+	}
+	return nil
+}
+
+func (x *CourseStaffDetails) GetDraftGradingComponents() int64 {
+	if x != nil {
+		return x.DraftGradingComponents
+	}
+	return 0
+}
+
+type Announcement struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AnnouncementID      string                 `protobuf:"bytes,1,opt,name=AnnouncementID,proto3" json:"AnnouncementID,omitempty"`
+	AnnouncementTitle   string                 `protobuf:"bytes,2,opt,name=AnnouncementTitle,proto3" json:"AnnouncementTitle,omitempty"`
+	AnnouncementContent string                 `protobuf:"bytes,3,opt,name=AnnouncementContent,proto3" json:"AnnouncementContent,omitempty"`
+	Visibility          AnnouncementVisibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=courses.AnnouncementVisibility" json:"visibility,omitempty"`
+	Recurrence          AnnouncementRecurrence `protobuf:"varint,5,opt,name=recurrence,proto3,enum=courses.AnnouncementRecurrence" json:"recurrence,omitempty"`
+	// When a recurring announcement is next reposted. Set by the server.
+	NextPostAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=nextPostAt,proto3" json:"nextPostAt,omitempty"`
+	// Set by the server on long announcements, whose AnnouncementContent lists cut to an excerpt.
+	// GetAnnouncement returns the full content.
+	HasFullBody bool `protobuf:"varint,7,opt,name=hasFullBody,proto3" json:"hasFullBody,omitempty"`
+	// The ID of whoever posted the announcement. Set by the server.
+	Author string `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
+	// When the announcement was posted and last changed. Set by the server.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// Optional stable name of an announcement, unique within its course, e.g. the name of the file
+	// it is kept in. Copies and reposts of an announcement have none.
+	Slug          string `protobuf:"bytes,11,opt,name=slug,proto3" json:"slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddStaffRequest) Reset() {
-	*x = AddStaffRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[12]
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_courses_microservice_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddStaffRequest) String() string {
+func (x *Announcement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddStaffRequest) ProtoMessage() {}
+func (*Announcement) ProtoMessage() {}
 
-func (x *AddStaffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[12]
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,94 +7670,113 @@ func (x *AddStaffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddStaffRequest.ProtoReflect.Descriptor instead.
-func (*AddStaffRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *AddStaffRequest) GetToken() string {
+func (x *Announcement) GetAnnouncementID() string {
 	if x != nil {
-		return x.Token
+		return x.AnnouncementID
 	}
 	return ""
 }
 
-func (x *AddStaffRequest) GetCourseID() string {
+func (x *Announcement) GetAnnouncementTitle() string {
 	if x != nil {
-		return x.CourseID
+		return x.AnnouncementTitle
 	}
 	return ""
 }
 
-func (x *AddStaffRequest) GetStaffID() string {
+func (x *Announcement) GetAnnouncementContent() string {
 	if x != nil {
-		return x.StaffID
+		return x.AnnouncementContent
 	}
 	return ""
 }
 
-// Response message for adding a staff member to a course.
-type AddStaffResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Announcement) GetVisibility() AnnouncementVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return AnnouncementVisibility_EVERYONE
 }
 
-func (x *AddStaffResponse) Reset() {
-	*x = AddStaffResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Announcement) GetRecurrence() AnnouncementRecurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return AnnouncementRecurrence_NONE
 }
 
-func (x *AddStaffResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Announcement) GetNextPostAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextPostAt
+	}
+	return nil
 }
 
-func (*AddStaffResponse) ProtoMessage() {}
+func (x *Announcement) GetHasFullBody() bool {
+	if x != nil {
+		return x.HasFullBody
+	}
+	return false
+}
 
-func (x *AddStaffResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[13]
+func (x *Announcement) GetAuthor() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Author
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AddStaffResponse.ProtoReflect.Descriptor instead.
-func (*AddStaffResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{13}
+func (x *Announcement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-// Request message for removing a staff member from a course.
-type RemoveStaffRequest struct {
+func (x *Announcement) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// Request message for creating a share token of a course, valid for ttlSeconds, at most 30 days.
+type GenerateCourseShareTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	StaffID       string                 `protobuf:"bytes,3,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	TtlSeconds    uint32                 `protobuf:"varint,3,opt,name=ttlSeconds,proto3" json:"ttlSeconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveStaffRequest) Reset() {
-	*x = RemoveStaffRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[14]
+func (x *GenerateCourseShareTokenRequest) Reset() {
+	*x = GenerateCourseShareTokenRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveStaffRequest) String() string {
+func (x *GenerateCourseShareTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveStaffRequest) ProtoMessage() {}
+func (*GenerateCourseShareTokenRequest) ProtoMessage() {}
 
-func (x *RemoveStaffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[14]
+func (x *GenerateCourseShareTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,54 +7787,56 @@ func (x *RemoveStaffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveStaffRequest.ProtoReflect.Descriptor instead.
-func (*RemoveStaffRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GenerateCourseShareTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateCourseShareTokenRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *RemoveStaffRequest) GetToken() string {
+func (x *GenerateCourseShareTokenRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *RemoveStaffRequest) GetCourseID() string {
+func (x *GenerateCourseShareTokenRequest) GetCourseID() string {
 	if x != nil {
 		return x.CourseID
 	}
 	return ""
 }
 
-func (x *RemoveStaffRequest) GetStaffID() string {
+func (x *GenerateCourseShareTokenRequest) GetTtlSeconds() uint32 {
 	if x != nil {
-		return x.StaffID
+		return x.TtlSeconds
 	}
-	return ""
+	return 0
 }
 
-// Response message for removing a staff member from a course.
-type RemoveStaffResponse struct {
+// Response message for creating a share token of a course.
+type GenerateCourseShareTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=shareToken,proto3" json:"shareToken,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveStaffResponse) Reset() {
-	*x = RemoveStaffResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[15]
+func (x *GenerateCourseShareTokenResponse) Reset() {
+	*x = GenerateCourseShareTokenResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveStaffResponse) String() string {
+func (x *GenerateCourseShareTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveStaffResponse) ProtoMessage() {}
+func (*GenerateCourseShareTokenResponse) ProtoMessage() {}
 
-func (x *RemoveStaffResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[15]
+func (x *GenerateCourseShareTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,38 +7844,51 @@ func (x *RemoveStaffResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateCourseShareTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateCourseShareTokenResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GenerateCourseShareTokenResponse) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
 }
 
-// Deprecated: Use RemoveStaffResponse.ProtoReflect.Descriptor instead.
-func (*RemoveStaffResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{15}
+func (x *GenerateCourseShareTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
 }
 
-// Request message for getting all students in a course.
-type GetCourseStudentsRequest struct {
+// Request message for getting a course with a share token.
+type GetCourseByShareTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=shareToken,proto3" json:"shareToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseStudentsRequest) Reset() {
-	*x = GetCourseStudentsRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[16]
+func (x *GetCourseByShareTokenRequest) Reset() {
+	*x = GetCourseByShareTokenRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseStudentsRequest) String() string {
+func (x *GetCourseByShareTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseStudentsRequest) ProtoMessage() {}
+func (*GetCourseByShareTokenRequest) ProtoMessage() {}
 
-func (x *GetCourseStudentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[16]
+func (x *GetCourseByShareTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,48 +7899,41 @@ func (x *GetCourseStudentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseStudentsRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseStudentsRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *GetCourseStudentsRequest) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+// Deprecated: Use GetCourseByShareTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseByShareTokenRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *GetCourseStudentsRequest) GetCourseID() string {
+func (x *GetCourseByShareTokenRequest) GetShareToken() string {
 	if x != nil {
-		return x.CourseID
+		return x.ShareToken
 	}
 	return ""
 }
 
-// Response message for getting all students in a course.
-type GetCourseStudentsResponse struct {
+// Response message for getting a course with a share token.
+type GetCourseByShareTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentsIDs   []string               `protobuf:"bytes,1,rep,name=studentsIDs,proto3" json:"studentsIDs,omitempty"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseStudentsResponse) Reset() {
-	*x = GetCourseStudentsResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[17]
+func (x *GetCourseByShareTokenResponse) Reset() {
+	*x = GetCourseByShareTokenResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseStudentsResponse) String() string {
+func (x *GetCourseByShareTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseStudentsResponse) ProtoMessage() {}
+func (*GetCourseByShareTokenResponse) ProtoMessage() {}
 
-func (x *GetCourseStudentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[17]
+func (x *GetCourseByShareTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,20 +7944,20 @@ func (x *GetCourseStudentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseStudentsResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseStudentsResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetCourseByShareTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseByShareTokenResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GetCourseStudentsResponse) GetStudentsIDs() []string {
+func (x *GetCourseByShareTokenResponse) GetCourse() *Course {
 	if x != nil {
-		return x.StudentsIDs
+		return x.Course
 	}
 	return nil
 }
 
-// Request message for getting all staff members in a course.
-type GetCourseStaffRequest struct {
+// Request message for getting the features of a course.
+type GetCourseFeaturesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
@@ -903,21 +7965,21 @@ type GetCourseStaffRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseStaffRequest) Reset() {
-	*x = GetCourseStaffRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[18]
+func (x *GetCourseFeaturesRequest) Reset() {
+	*x = GetCourseFeaturesRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseStaffRequest) String() string {
+func (x *GetCourseFeaturesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseStaffRequest) ProtoMessage() {}
+func (*GetCourseFeaturesRequest) ProtoMessage() {}
 
-func (x *GetCourseStaffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[18]
+func (x *GetCourseFeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,48 +7990,48 @@ func (x *GetCourseStaffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseStaffRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseStaffRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetCourseFeaturesRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseFeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GetCourseStaffRequest) GetToken() string {
+func (x *GetCourseFeaturesRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *GetCourseStaffRequest) GetCourseID() string {
+func (x *GetCourseFeaturesRequest) GetCourseID() string {
 	if x != nil {
 		return x.CourseID
 	}
 	return ""
 }
 
-// Response message for getting all staff members in a course.
-type GetCourseStaffResponse struct {
+// Response message for getting the features of a course. Features are ordered by name.
+type GetCourseFeaturesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StaffIDs      []string               `protobuf:"bytes,1,rep,name=staffIDs,proto3" json:"staffIDs,omitempty"`
+	Features      []*CourseFeature       `protobuf:"bytes,1,rep,name=features,proto3" json:"features,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseStaffResponse) Reset() {
-	*x = GetCourseStaffResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[19]
+func (x *GetCourseFeaturesResponse) Reset() {
+	*x = GetCourseFeaturesResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseStaffResponse) String() string {
+func (x *GetCourseFeaturesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseStaffResponse) ProtoMessage() {}
+func (*GetCourseFeaturesResponse) ProtoMessage() {}
 
-func (x *GetCourseStaffResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[19]
+func (x *GetCourseFeaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -980,42 +8042,44 @@ func (x *GetCourseStaffResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseStaffResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseStaffResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetCourseFeaturesResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseFeaturesResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *GetCourseStaffResponse) GetStaffIDs() []string {
+func (x *GetCourseFeaturesResponse) GetFeatures() []*CourseFeature {
 	if x != nil {
-		return x.StaffIDs
+		return x.Features
 	}
 	return nil
 }
 
-// Request message for getting a student's courses.
-type GetStudentCoursesRequest struct {
+// Whether a feature is enabled for a course.
+// overridden is set when the course overrides the feature rather than following its rollout.
+type CourseFeature struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	StudentID     string                 `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Overridden    bool                   `protobuf:"varint,3,opt,name=overridden,proto3" json:"overridden,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStudentCoursesRequest) Reset() {
-	*x = GetStudentCoursesRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[20]
+func (x *CourseFeature) Reset() {
+	*x = CourseFeature{}
+	mi := &file_courses_microservice_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentCoursesRequest) String() string {
+func (x *CourseFeature) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentCoursesRequest) ProtoMessage() {}
+func (*CourseFeature) ProtoMessage() {}
 
-func (x *GetStudentCoursesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[20]
+func (x *CourseFeature) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,48 +8090,58 @@ func (x *GetStudentCoursesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentCoursesRequest.ProtoReflect.Descriptor instead.
-func (*GetStudentCoursesRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use CourseFeature.ProtoReflect.Descriptor instead.
+func (*CourseFeature) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *GetStudentCoursesRequest) GetToken() string {
+func (x *CourseFeature) GetName() string {
 	if x != nil {
-		return x.Token
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetStudentCoursesRequest) GetStudentID() string {
+func (x *CourseFeature) GetEnabled() bool {
 	if x != nil {
-		return x.StudentID
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-// Response message for getting a student's courses.
-type GetStudentCoursesResponse struct {
+func (x *CourseFeature) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+// Request message for overriding a feature for a course.
+type SetCourseFeatureRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CoursesIDs    []string               `protobuf:"bytes,1,rep,name=coursesIDs,proto3" json:"coursesIDs,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Feature       string                 `protobuf:"bytes,3,opt,name=feature,proto3" json:"feature,omitempty"`
+	Override      FeatureOverride        `protobuf:"varint,4,opt,name=override,proto3,enum=courses.FeatureOverride" json:"override,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStudentCoursesResponse) Reset() {
-	*x = GetStudentCoursesResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[21]
+func (x *SetCourseFeatureRequest) Reset() {
+	*x = SetCourseFeatureRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentCoursesResponse) String() string {
+func (x *SetCourseFeatureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentCoursesResponse) ProtoMessage() {}
+func (*SetCourseFeatureRequest) ProtoMessage() {}
 
-func (x *GetStudentCoursesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[21]
+func (x *SetCourseFeatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,42 +8152,61 @@ func (x *GetStudentCoursesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentCoursesResponse.ProtoReflect.Descriptor instead.
-func (*GetStudentCoursesResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SetCourseFeatureRequest.ProtoReflect.Descriptor instead.
+func (*SetCourseFeatureRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *GetStudentCoursesResponse) GetCoursesIDs() []string {
+func (x *SetCourseFeatureRequest) GetToken() string {
 	if x != nil {
-		return x.CoursesIDs
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-// Request message for getting a staff's courses.
-type GetStaffCoursesRequest struct {
+func (x *SetCourseFeatureRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *SetCourseFeatureRequest) GetFeature() string {
+	if x != nil {
+		return x.Feature
+	}
+	return ""
+}
+
+func (x *SetCourseFeatureRequest) GetOverride() FeatureOverride {
+	if x != nil {
+		return x.Override
+	}
+	return FeatureOverride_FEATURE_DEFAULT
+}
+
+// Response message for overriding a feature for a course.
+type SetCourseFeatureResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	StaffID       string                 `protobuf:"bytes,2,opt,name=staffID,proto3" json:"staffID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStaffCoursesRequest) Reset() {
-	*x = GetStaffCoursesRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[22]
+func (x *SetCourseFeatureResponse) Reset() {
+	*x = SetCourseFeatureResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStaffCoursesRequest) String() string {
+func (x *SetCourseFeatureResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStaffCoursesRequest) ProtoMessage() {}
+func (*SetCourseFeatureResponse) ProtoMessage() {}
 
-func (x *GetStaffCoursesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[22]
+func (x *SetCourseFeatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,48 +8217,35 @@ func (x *GetStaffCoursesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStaffCoursesRequest.ProtoReflect.Descriptor instead.
-func (*GetStaffCoursesRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *GetStaffCoursesRequest) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
-}
-
-func (x *GetStaffCoursesRequest) GetStaffID() string {
-	if x != nil {
-		return x.StaffID
-	}
-	return ""
+// Deprecated: Use SetCourseFeatureResponse.ProtoReflect.Descriptor instead.
+func (*SetCourseFeatureResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{132}
 }
 
-// Response message for getting a staff's courses.
-type GetStaffCoursesResponse struct {
+// Request message for counting the announcements each staff member posted to a course.
+type GetAnnouncementCountByAuthorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CoursesIDs    []string               `protobuf:"bytes,1,rep,name=coursesIDs,proto3" json:"coursesIDs,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStaffCoursesResponse) Reset() {
-	*x = GetStaffCoursesResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[23]
+func (x *GetAnnouncementCountByAuthorRequest) Reset() {
+	*x = GetAnnouncementCountByAuthorRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStaffCoursesResponse) String() string {
+func (x *GetAnnouncementCountByAuthorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStaffCoursesResponse) ProtoMessage() {}
+func (*GetAnnouncementCountByAuthorRequest) ProtoMessage() {}
 
-func (x *GetStaffCoursesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[23]
+func (x *GetAnnouncementCountByAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1176,42 +8256,50 @@ func (x *GetStaffCoursesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStaffCoursesResponse.ProtoReflect.Descriptor instead.
-func (*GetStaffCoursesResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetAnnouncementCountByAuthorRequest.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementCountByAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *GetStaffCoursesResponse) GetCoursesIDs() []string {
+func (x *GetAnnouncementCountByAuthorRequest) GetToken() string {
 	if x != nil {
-		return x.CoursesIDs
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-// Request message for getting all courses in a semester.
-type GetSemesterCoursesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+func (x *GetAnnouncementCountByAuthorRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+// Response message for counting the announcements each staff member posted to a course.
+// Authors are ordered by their count, highest first. Announcements posted before authors were
+// recorded are left out.
+type GetAnnouncementCountByAuthorResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Counts        []*AuthorAnnouncementCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSemesterCoursesRequest) Reset() {
-	*x = GetSemesterCoursesRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[24]
+func (x *GetAnnouncementCountByAuthorResponse) Reset() {
+	*x = GetAnnouncementCountByAuthorResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSemesterCoursesRequest) String() string {
+func (x *GetAnnouncementCountByAuthorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSemesterCoursesRequest) ProtoMessage() {}
+func (*GetAnnouncementCountByAuthorResponse) ProtoMessage() {}
 
-func (x *GetSemesterCoursesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[24]
+func (x *GetAnnouncementCountByAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,48 +8310,42 @@ func (x *GetSemesterCoursesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSemesterCoursesRequest.ProtoReflect.Descriptor instead.
-func (*GetSemesterCoursesRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *GetSemesterCoursesRequest) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+// Deprecated: Use GetAnnouncementCountByAuthorResponse.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementCountByAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *GetSemesterCoursesRequest) GetSemester() string {
+func (x *GetAnnouncementCountByAuthorResponse) GetCounts() []*AuthorAnnouncementCount {
 	if x != nil {
-		return x.Semester
+		return x.Counts
 	}
-	return ""
+	return nil
 }
 
-// Response message for getting all courses in a semester.
-type GetSemesterCoursesResponse struct {
+// The number of announcements an author posted to a course.
+type AuthorAnnouncementCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	Author        string                 `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSemesterCoursesResponse) Reset() {
-	*x = GetSemesterCoursesResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[25]
+func (x *AuthorAnnouncementCount) Reset() {
+	*x = AuthorAnnouncementCount{}
+	mi := &file_courses_microservice_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSemesterCoursesResponse) String() string {
+func (x *AuthorAnnouncementCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSemesterCoursesResponse) ProtoMessage() {}
+func (*AuthorAnnouncementCount) ProtoMessage() {}
 
-func (x *GetSemesterCoursesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[25]
+func (x *AuthorAnnouncementCount) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,43 +8356,49 @@ func (x *GetSemesterCoursesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSemesterCoursesResponse.ProtoReflect.Descriptor instead.
-func (*GetSemesterCoursesResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AuthorAnnouncementCount.ProtoReflect.Descriptor instead.
+func (*AuthorAnnouncementCount) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *GetSemesterCoursesResponse) GetCourses() []*Course {
+func (x *AuthorAnnouncementCount) GetAuthor() string {
 	if x != nil {
-		return x.Courses
+		return x.Author
 	}
-	return nil
+	return ""
 }
 
-// Request message for adding an announcement to a course.
-type AddAnnouncementRequest struct {
+func (x *AuthorAnnouncementCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Request message for getting a snapshot of a course.
+type GetCourseSnapshotRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	CourseID      string                 `protobuf:"bytes,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`
-	Announcement  *Announcement          `protobuf:"bytes,3,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddAnnouncementRequest) Reset() {
-	*x = AddAnnouncementRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[26]
+func (x *GetCourseSnapshotRequest) Reset() {
+	*x = GetCourseSnapshotRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddAnnouncementRequest) String() string {
+func (x *GetCourseSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAnnouncementRequest) ProtoMessage() {}
+func (*GetCourseSnapshotRequest) ProtoMessage() {}
 
-func (x *AddAnnouncementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[26]
+func (x *GetCourseSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,55 +8409,58 @@ func (x *AddAnnouncementRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAnnouncementRequest.ProtoReflect.Descriptor instead.
-func (*AddAnnouncementRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetCourseSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *AddAnnouncementRequest) GetToken() string {
+func (x *GetCourseSnapshotRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *AddAnnouncementRequest) GetCourseID() string {
+func (x *GetCourseSnapshotRequest) GetCourseID() string {
 	if x != nil {
 		return x.CourseID
 	}
 	return ""
 }
 
-func (x *AddAnnouncementRequest) GetAnnouncement() *Announcement {
-	if x != nil {
-		return x.Announcement
-	}
-	return nil
-}
-
-// Response message for adding an announcement to a course.
-type AddAnnouncementResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Announcement  *Announcement          `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+// Response message for getting a snapshot of a course. Every part was read together, so they agree
+// with each other even while the course is being changed.
+type GetCourseSnapshotResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TakenAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=takenAt,proto3" json:"takenAt,omitempty"`
+	Course  *Course                `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
+	// Students of any status, ordered by student ID.
+	Students []*CourseStudent `protobuf:"bytes,3,rep,name=students,proto3" json:"students,omitempty"`
+	// Staff, including those whose access expired, ordered by staff ID.
+	Staff []*StaffAssignment `protobuf:"bytes,4,rep,name=staff,proto3" json:"staff,omitempty"`
+	// Announcements of any visibility with their full content, newest first.
+	Announcements []*Announcement `protobuf:"bytes,5,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	// Quiet periods ordered by start.
+	QuietPeriods  []*QuietPeriod `protobuf:"bytes,6,rep,name=quietPeriods,proto3" json:"quietPeriods,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddAnnouncementResponse) Reset() {
-	*x = AddAnnouncementResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[27]
+func (x *GetCourseSnapshotResponse) Reset() {
+	*x = GetCourseSnapshotResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddAnnouncementResponse) String() string {
+func (x *GetCourseSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAnnouncementResponse) ProtoMessage() {}
+func (*GetCourseSnapshotResponse) ProtoMessage() {}
 
-func (x *AddAnnouncementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[27]
+func (x *GetCourseSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,42 +8471,77 @@ func (x *AddAnnouncementResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAnnouncementResponse.ProtoReflect.Descriptor instead.
-func (*AddAnnouncementResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetCourseSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *AddAnnouncementResponse) GetAnnouncement() *Announcement {
+func (x *GetCourseSnapshotResponse) GetTakenAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Announcement
+		return x.TakenAt
 	}
 	return nil
 }
 
-// Request message for getting all announcements in a course.
-type GetCourseAnnouncementsRequest struct {
+func (x *GetCourseSnapshotResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GetCourseSnapshotResponse) GetStudents() []*CourseStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+func (x *GetCourseSnapshotResponse) GetStaff() []*StaffAssignment {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+func (x *GetCourseSnapshotResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *GetCourseSnapshotResponse) GetQuietPeriods() []*QuietPeriod {
+	if x != nil {
+		return x.QuietPeriods
+	}
+	return nil
+}
+
+// A student of a course and their status in it.
+type CourseStudent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	StudentID     string                 `protobuf:"bytes,1,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseAnnouncementsRequest) Reset() {
-	*x = GetCourseAnnouncementsRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[28]
+func (x *CourseStudent) Reset() {
+	*x = CourseStudent{}
+	mi := &file_courses_microservice_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseAnnouncementsRequest) String() string {
+func (x *CourseStudent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseAnnouncementsRequest) ProtoMessage() {}
+func (*CourseStudent) ProtoMessage() {}
 
-func (x *GetCourseAnnouncementsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[28]
+func (x *CourseStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1426,48 +8552,48 @@ func (x *GetCourseAnnouncementsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseAnnouncementsRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseAnnouncementsRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CourseStudent.ProtoReflect.Descriptor instead.
+func (*CourseStudent) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *GetCourseAnnouncementsRequest) GetToken() string {
+func (x *CourseStudent) GetStudentID() string {
 	if x != nil {
-		return x.Token
+		return x.StudentID
 	}
 	return ""
 }
 
-func (x *GetCourseAnnouncementsRequest) GetCourseID() string {
+func (x *CourseStudent) GetStatus() string {
 	if x != nil {
-		return x.CourseID
+		return x.Status
 	}
 	return ""
 }
 
-// Response message for getting all announcements in a course.
-type GetCourseAnnouncementsResponse struct {
+// Request message for reporting the shape of the data.
+type GetDataShapeReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseAnnouncementsResponse) Reset() {
-	*x = GetCourseAnnouncementsResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[29]
+func (x *GetDataShapeReportRequest) Reset() {
+	*x = GetDataShapeReportRequest{}
+	mi := &file_courses_microservice_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseAnnouncementsResponse) String() string {
+func (x *GetDataShapeReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseAnnouncementsResponse) ProtoMessage() {}
+func (*GetDataShapeReportRequest) ProtoMessage() {}
 
-func (x *GetCourseAnnouncementsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[29]
+func (x *GetDataShapeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1478,43 +8604,47 @@ func (x *GetCourseAnnouncementsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseAnnouncementsResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseAnnouncementsResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetDataShapeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataShapeReportRequest) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *GetCourseAnnouncementsResponse) GetAnnouncements() []*Announcement {
+func (x *GetDataShapeReportRequest) GetToken() string {
 	if x != nil {
-		return x.Announcements
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-// Request message for removing an announcement from a course.
-type RemoveAnnouncementRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	CourseID       string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	AnnouncementID string                 `protobuf:"bytes,3,opt,name=announcementID,proto3" json:"announcementID,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Response message for reporting the shape of the data.
+type GetDataShapeReportResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GeneratedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=generatedAt,proto3" json:"generatedAt,omitempty"`
+	// Semesters are ordered by name.
+	CoursesPerSemester     []*SemesterCourseCount `protobuf:"bytes,2,rep,name=coursesPerSemester,proto3" json:"coursesPerSemester,omitempty"`
+	EnrollmentsPerCourse   *CountDistribution     `protobuf:"bytes,3,opt,name=enrollmentsPerCourse,proto3" json:"enrollmentsPerCourse,omitempty"`
+	AnnouncementsPerCourse *CountDistribution     `protobuf:"bytes,4,opt,name=announcementsPerCourse,proto3" json:"announcementsPerCourse,omitempty"`
+	// Tables are ordered by name.
+	TableRows     []*TableRowCount `protobuf:"bytes,5,rep,name=tableRows,proto3" json:"tableRows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveAnnouncementRequest) Reset() {
-	*x = RemoveAnnouncementRequest{}
-	mi := &file_courses_microservice_proto_msgTypes[30]
+func (x *GetDataShapeReportResponse) Reset() {
+	*x = GetDataShapeReportResponse{}
+	mi := &file_courses_microservice_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveAnnouncementRequest) String() string {
+func (x *GetDataShapeReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveAnnouncementRequest) ProtoMessage() {}
+func (*GetDataShapeReportResponse) ProtoMessage() {}
 
-func (x *RemoveAnnouncementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[30]
+func (x *GetDataShapeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1525,54 +8655,70 @@ func (x *RemoveAnnouncementRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveAnnouncementRequest.ProtoReflect.Descriptor instead.
-func (*RemoveAnnouncementRequest) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetDataShapeReportResponse.ProtoReflect.Descriptor instead.
+func (*GetDataShapeReportResponse) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *RemoveAnnouncementRequest) GetToken() string {
+func (x *GetDataShapeReportResponse) GetGeneratedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Token
+		return x.GeneratedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *RemoveAnnouncementRequest) GetCourseID() string {
+func (x *GetDataShapeReportResponse) GetCoursesPerSemester() []*SemesterCourseCount {
 	if x != nil {
-		return x.CourseID
+		return x.CoursesPerSemester
 	}
-	return ""
+	return nil
 }
 
-func (x *RemoveAnnouncementRequest) GetAnnouncementID() string {
+func (x *GetDataShapeReportResponse) GetEnrollmentsPerCourse() *CountDistribution {
 	if x != nil {
-		return x.AnnouncementID
+		return x.EnrollmentsPerCourse
 	}
-	return ""
+	return nil
 }
 
-// Response message for removing an announcement from a course.
-type RemoveAnnouncementResponse struct {
+func (x *GetDataShapeReportResponse) GetAnnouncementsPerCourse() *CountDistribution {
+	if x != nil {
+		return x.AnnouncementsPerCourse
+	}
+	return nil
+}
+
+func (x *GetDataShapeReportResponse) GetTableRows() []*TableRowCount {
+	if x != nil {
+		return x.TableRows
+	}
+	return nil
+}
+
+// The number of courses in a semester.
+type SemesterCourseCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semester      string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveAnnouncementResponse) Reset() {
-	*x = RemoveAnnouncementResponse{}
-	mi := &file_courses_microservice_proto_msgTypes[31]
+func (x *SemesterCourseCount) Reset() {
+	*x = SemesterCourseCount{}
+	mi := &file_courses_microservice_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveAnnouncementResponse) String() string {
+func (x *SemesterCourseCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveAnnouncementResponse) ProtoMessage() {}
+func (*SemesterCourseCount) ProtoMessage() {}
 
-func (x *RemoveAnnouncementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[31]
+func (x *SemesterCourseCount) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,37 +8729,51 @@ func (x *RemoveAnnouncementResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveAnnouncementResponse.ProtoReflect.Descriptor instead.
-func (*RemoveAnnouncementResponse) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SemesterCourseCount.ProtoReflect.Descriptor instead.
+func (*SemesterCourseCount) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{141}
 }
 
-// Message representing a course.
-type Course struct {
+func (x *SemesterCourseCount) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *SemesterCourseCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// How a count spreads over courses. Percentiles are of the counts of all courses, including
+// courses with none, and are always one of those counts.
+type CountDistribution struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseID      string                 `protobuf:"bytes,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	CourseName    string                 `protobuf:"bytes,2,opt,name=courseName,proto3" json:"courseName,omitempty"`
-	Semester      string                 `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	P50           int64                  `protobuf:"varint,1,opt,name=p50,proto3" json:"p50,omitempty"`
+	P95           int64                  `protobuf:"varint,2,opt,name=p95,proto3" json:"p95,omitempty"`
+	Max           int64                  `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Course) Reset() {
-	*x = Course{}
-	mi := &file_courses_microservice_proto_msgTypes[32]
+func (x *CountDistribution) Reset() {
+	*x = CountDistribution{}
+	mi := &file_courses_microservice_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Course) String() string {
+func (x *CountDistribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Course) ProtoMessage() {}
+func (*CountDistribution) ProtoMessage() {}
 
-func (x *Course) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[32]
+func (x *CountDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1624,63 +8784,56 @@ func (x *Course) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Course.ProtoReflect.Descriptor instead.
-func (*Course) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *Course) GetCourseID() string {
-	if x != nil {
-		return x.CourseID
-	}
-	return ""
+// Deprecated: Use CountDistribution.ProtoReflect.Descriptor instead.
+func (*CountDistribution) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *Course) GetCourseName() string {
+func (x *CountDistribution) GetP50() int64 {
 	if x != nil {
-		return x.CourseName
+		return x.P50
 	}
-	return ""
+	return 0
 }
 
-func (x *Course) GetSemester() string {
+func (x *CountDistribution) GetP95() int64 {
 	if x != nil {
-		return x.Semester
+		return x.P95
 	}
-	return ""
+	return 0
 }
 
-func (x *Course) GetDescription() string {
+func (x *CountDistribution) GetMax() int64 {
 	if x != nil {
-		return x.Description
+		return x.Max
 	}
-	return ""
+	return 0
 }
 
-type Announcement struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	AnnouncementID      string                 `protobuf:"bytes,1,opt,name=AnnouncementID,proto3" json:"AnnouncementID,omitempty"`
-	AnnouncementTitle   string                 `protobuf:"bytes,2,opt,name=AnnouncementTitle,proto3" json:"AnnouncementTitle,omitempty"`
-	AnnouncementContent string                 `protobuf:"bytes,3,opt,name=AnnouncementContent,proto3" json:"AnnouncementContent,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+// The number of rows of a database table.
+type TableRowCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         string                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Rows          int64                  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Announcement) Reset() {
-	*x = Announcement{}
-	mi := &file_courses_microservice_proto_msgTypes[33]
+func (x *TableRowCount) Reset() {
+	*x = TableRowCount{}
+	mi := &file_courses_microservice_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Announcement) String() string {
+func (x *TableRowCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Announcement) ProtoMessage() {}
+func (*TableRowCount) ProtoMessage() {}
 
-func (x *Announcement) ProtoReflect() protoreflect.Message {
-	mi := &file_courses_microservice_proto_msgTypes[33]
+func (x *TableRowCount) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_microservice_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1691,30 +8844,23 @@ func (x *Announcement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
-func (*Announcement) Descriptor() ([]byte, []int) {
-	return file_courses_microservice_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *Announcement) GetAnnouncementID() string {
-	if x != nil {
-		return x.AnnouncementID
-	}
-	return ""
+// Deprecated: Use TableRowCount.ProtoReflect.Descriptor instead.
+func (*TableRowCount) Descriptor() ([]byte, []int) {
+	return file_courses_microservice_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *Announcement) GetAnnouncementTitle() string {
+func (x *TableRowCount) GetTable() string {
 	if x != nil {
-		return x.AnnouncementTitle
+		return x.Table
 	}
 	return ""
 }
 
-func (x *Announcement) GetAnnouncementContent() string {
+func (x *TableRowCount) GetRows() int64 {
 	if x != nil {
-		return x.AnnouncementContent
+		return x.Rows
 	}
-	return ""
+	return 0
 }
 
 var File_courses_microservice_proto protoreflect.FileDescriptor
@@ -1722,166 +8868,1252 @@ var File_courses_microservice_proto protoreflect.FileDescriptor
 var file_courses_microservice_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x3c, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xa9, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x30, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x22, 0xbc, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x49, 0x44, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74,
+	0x65, 0x49, 0x44, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x22, 0x43, 0x0a, 0x0f, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x30, 0x0a,
+	0x13, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x63, 0x65,
+	0x6e, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x9c, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x31, 0x0a,
+	0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x66, 0x66, 0x22, 0xaa,
+	0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x66, 0x66, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x3a, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x5b, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x73, 0x22, 0x5e, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x13, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x13, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22,
-	0x3f, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x22, 0x54, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a,
-	0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
-	0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
-	0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x16, 0x0a, 0x14, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcf, 0x01, 0x0a,
+	0x11, 0x41, 0x64, 0x64, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x25, 0x0a,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x46,
+	0x0a, 0x12, 0x41, 0x64, 0x64, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x13, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x17, 0x53, 0x65,
+	0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x25, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xfa, 0x42, 0x2b, 0x72, 0x29, 0x52, 0x08,
+	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x22, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6a, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x4c, 0x0a, 0x18, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x22, 0xe1, 0x01, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0xfa, 0x42,
+	0x0e, 0x92, 0x01, 0x0b, 0x08, 0x01, 0x10, 0x88, 0x27, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41,
+	0x64, 0x64, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x70, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x39, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x0a, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x6d, 0x0a,
+	0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x37, 0x0a, 0x1f,
+	0x46, 0x69, 0x6e, 0x64, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x60, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x44, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35,
+	0x0a, 0x1d, 0x44, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x44, 0x0a, 0x1e, 0x44, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x99, 0x02, 0x0a, 0x0f,
+	0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x12, 0x38, 0x0a,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53, 0x74,
+	0x61, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x0a, 0x12, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x07,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x22,
+	0x15, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x28, 0x0a,
+	0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49,
+	0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x3d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x49, 0x44, 0x73, 0x22, 0x59, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x22, 0x35, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe7, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x73, 0x22, 0xf9, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x2e,
+	0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x52,
+	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x22, 0xcb, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x4a, 0x0a, 0x08,
+	0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e,
+	0xfa, 0x42, 0x2b, 0x72, 0x29, 0x32, 0x24, 0x5e, 0x28, 0x57, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x7c,
+	0x53, 0x70, 0x72, 0x69, 0x6e, 0x67, 0x7c, 0x53, 0x75, 0x6d, 0x6d, 0x65, 0x72, 0x29, 0x5b, 0x20,
+	0x5f, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x08,
+	0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x22, 0x3b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x49, 0x44, 0x73, 0x22, 0x86, 0x01,
+	0x0a, 0x1f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1b,
+	0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x59, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x65, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xc8, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
 	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
-	0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x47, 0x0a, 0x08, 0x73,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xfa,
+	0x42, 0x28, 0x72, 0x26, 0x32, 0x24, 0x5e, 0x28, 0x57, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x7c, 0x53,
+	0x70, 0x72, 0x69, 0x6e, 0x67, 0x7c, 0x53, 0x75, 0x6d, 0x6d, 0x65, 0x72, 0x29, 0x5b, 0x20, 0x5f,
+	0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x24, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x35, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
 	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x14, 0x0a,
-	0x12, 0x41, 0x64, 0x64, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x66, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x17, 0x0a, 0x15, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xfa, 0x42, 0x2b, 0x72, 0x29, 0x32,
+	0x24, 0x5e, 0x28, 0x57, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x7c, 0x53, 0x70, 0x72, 0x69, 0x6e, 0x67,
+	0x7c, 0x53, 0x75, 0x6d, 0x6d, 0x65, 0x72, 0x29, 0x5b, 0x20, 0x5f, 0x5d, 0x5b, 0x30, 0x2d, 0x39,
+	0x5d, 0x7b, 0x34, 0x7d, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x39, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x49, 0x44, 0x73, 0x22, 0x7f, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
-	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61,
-	0x66, 0x66, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66,
-	0x66, 0x49, 0x44, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x4c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x47, 0x0a,
+	0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2b, 0xfa, 0x42, 0x28, 0x72, 0x26, 0x32, 0x24, 0x5e, 0x28, 0x57, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x7c, 0x53, 0x70, 0x72, 0x69, 0x6e, 0x67, 0x7c, 0x53, 0x75, 0x6d, 0x6d, 0x65, 0x72, 0x29, 0x5b,
+	0x20, 0x5f, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x24, 0x52, 0x08, 0x73, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22, 0x4c, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x22, 0x9d, 0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2e, 0xfa, 0x42, 0x2b, 0x72, 0x29, 0x32, 0x24, 0x5e, 0x28, 0x57, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x7c, 0x53, 0x70, 0x72, 0x69, 0x6e, 0x67, 0x7c, 0x53, 0x75, 0x6d, 0x6d,
+	0x65, 0x72, 0x29, 0x5b, 0x20, 0x5f, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x24,
+	0xd0, 0x01, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a,
+	0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1b, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42,
+	0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23,
+	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x42, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x7a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x3d,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x44, 0x73, 0x22, 0x49, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x73, 0x22, 0x4e,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x65, 0x6e, 0x12, 0x47, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xfa, 0x42, 0x28, 0x72, 0x26, 0x32, 0x24, 0x5e, 0x28, 0x57,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x7c, 0x53, 0x70, 0x72, 0x69, 0x6e, 0x67, 0x7c, 0x53, 0x75, 0x6d,
+	0x6d, 0x65, 0x72, 0x29, 0x5b, 0x20, 0x5f, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d,
+	0x24, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22, 0x65, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75,
+	0x72, 0x67, 0x65, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xcb, 0x02, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28,
+	0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe5, 0x01, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0d,
+	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12,
+	0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0xe7, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
 	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x3b,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x49, 0x44, 0x73, 0x22, 0x48, 0x0a, 0x16, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x41, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x69, 0x6e, 0x67, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9d, 0x01, 0x0a, 0x25,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x11,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x6e, 0x64,
+	0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x65,
+	0x73, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x0e, 0x61, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x61, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x54, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x87, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x0e, 0x61, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x61, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc6, 0x02, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74,
-	0x61, 0x66, 0x66, 0x49, 0x44, 0x22, 0x39, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66,
-	0x66, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x49, 0x44, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x49, 0x44, 0x73,
-	0x22, 0x4d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x2f, 0x0a, 0x0e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x0e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x30, 0x0a, 0x13, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x4e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0x48, 0x00, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01,
+	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x22, 0x57, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x1f, 0x55, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
 	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22,
-	0x47, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
-	0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
-	0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x64,
-	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x77, 0x0a,
+	0x20, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x39, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c,
+	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x70, 0x79, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x39, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x22, 0x54, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x61,
-	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
-	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
-	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x0c, 0x66, 0x72, 0x6f,
+	0x6d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22,
+	0x3d, 0x0a, 0x19, 0x43, 0x6f, 0x70, 0x79, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x90,
+	0x01, 0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x32, 0x0a,
+	0x0f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01,
+	0x52, 0x0f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x73, 0x22, 0x74, 0x0a, 0x20, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x73, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x02, 0x6f, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x34, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x6f,
+	0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73,
+	0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x22, 0x1d,
+	0x0a, 0x1b, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x85, 0x01, 0x0a, 0x21, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0xaa,
+	0x01, 0x0a, 0x22, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c,
+	0x65, 0x12, 0x36, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x22, 0xcd, 0x02, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34,
+	0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x6f, 0x70, 0x65,
+	0x6e, 0x73, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x10,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x4f, 0x70, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a,
+	0x11, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x11, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x1d,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x41, 0x6c, 0x6c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0xbe, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x31,
+	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x22, 0xf4, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x6a, 0x0a,
+	0x11, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x39,
+	0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x25, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x41, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x41, 0x12, 0x25, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x42,
+	0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x6c, 0x79, 0x49,
+	0x6e, 0x41, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6e, 0x6c, 0x79, 0x49, 0x6e,
+	0x41, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x6c, 0x79, 0x49, 0x6e, 0x42, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x6e, 0x6c, 0x79, 0x49, 0x6e, 0x42, 0x12, 0x16, 0x0a, 0x06, 0x69,
+	0x6e, 0x42, 0x6f, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x42,
+	0x6f, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x51, 0x75, 0x69,
+	0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x07, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x69, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x53,
+	0x65, 0x74, 0x51, 0x75, 0x69, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x21, 0x0a,
+	0x1f, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xa5, 0x01, 0x0a, 0x0b, 0x51, 0x75, 0x69, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x40, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x41, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x22, 0xb2, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x20, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x06, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x5d, 0x0a,
+	0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x55, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x22, 0x46, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x75, 0x0a, 0x19, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x6b, 0x65, 0x79,
+	0x49, 0x44, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xcc, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x81, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73,
+	0x69, 0x74, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74,
+	0x65, 0x49, 0x44, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
-	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x5d, 0x0a, 0x1e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x61,
-	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75,
-	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x75, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x22, 0x41, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x73, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x49, 0x44, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69,
+	0x74, 0x65, 0x49, 0x44, 0x73, 0x22, 0x79, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x22, 0x38, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x73, 0x22, 0x74, 0x0a, 0x10, 0x47, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14,
+	0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x21, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x22, 0x9a, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x41, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x1d, 0x0a,
+	0x1b, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a, 0x1d,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x93, 0x01,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x59, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x98,
+	0x01, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x47, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x74, 0x22, 0xfd, 0x04, 0x0a, 0x06, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x65, 0x6d,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xfa, 0x42, 0x2b,
+	0x72, 0x29, 0x32, 0x24, 0x5e, 0x28, 0x57, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x7c, 0x53, 0x70, 0x72,
+	0x69, 0x6e, 0x67, 0x7c, 0x53, 0x75, 0x6d, 0x6d, 0x65, 0x72, 0x29, 0x5b, 0x20, 0x5f, 0x5d, 0x5b,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6d,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02,
+	0x28, 0x00, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x14,
+	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x21, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x66, 0x66, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x66, 0x66, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x52, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x9a, 0x01,
+	0x11, 0x10, 0x20, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x2a, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x08, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xae, 0x02, 0x0a, 0x12, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x4a, 0x0a, 0x12, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x74, 0x12, 0x48, 0x0a, 0x11,
+	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x41,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x11, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
+	0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x12, 0x4a, 0x0a, 0x12, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12,
+	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x36, 0x0a, 0x16, 0x64, 0x72, 0x61, 0x66, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x16, 0x64, 0x72, 0x61, 0x66, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd7, 0x04, 0x0a, 0x0c, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x39, 0x0a, 0x13, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x13, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x68, 0x61, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xfa, 0x42, 0x1f, 0x72,
+	0x1d, 0x32, 0x18, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x28, 0x2d, 0x5b,
+	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x29, 0x2a, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x22, 0x8a, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x2a, 0x07, 0x18, 0x80,
+	0x9a, 0x9e, 0x01, 0x20, 0x00, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x7c, 0x0a, 0x20, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22,
+	0x47, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x42, 0x79, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x22, 0x55, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x46,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x4f, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x0d, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x17, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6e, 0x6e, 0x6f, 0x75,
-	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22,
-	0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x01,
-	0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x41, 0x6e, 0x6e,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x41,
-	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x41, 0x6e, 0x6e,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x32, 0xf3, 0x0a, 0x0a, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x21, 0x0a, 0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x60, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x22, 0x60, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x22, 0x47, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x22, 0xd5, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x74, 0x61, 0x6b, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x66, 0x66, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x12, 0x3b, 0x0a, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x38, 0x0a, 0x0c, 0x71, 0x75, 0x69, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x69, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0c, 0x71,
+	0x75, 0x69, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0x45, 0x0a, 0x0d, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x31, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x68, 0x61,
+	0x70, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x82, 0x03, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x4c, 0x0a, 0x12, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x12, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x4e, 0x0a, 0x14, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x16, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x61, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x22, 0x47, 0x0a, 0x13, 0x53, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x35, 0x30, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x35, 0x30, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x39,
+	0x35, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x39, 0x35, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x39,
+	0x0a, 0x0d, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x2a, 0xb5, 0x01, 0x0a, 0x16, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f,
+	0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x5f, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x10, 0x01, 0x12, 0x13,
+	0x0a, 0x0f, 0x53, 0x54, 0x41, 0x46, 0x46, 0x5f, 0x4f, 0x46, 0x5f, 0x43, 0x4f, 0x55, 0x52, 0x53,
+	0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45, 0x4d, 0x45, 0x53, 0x54, 0x45, 0x52, 0x5f,
+	0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x04,
+	0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x52, 0x4f, 0x4c, 0x4c, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x52, 0x45, 0x44, 0x49,
+	0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10,
+	0x06, 0x2a, 0x2b, 0x0a, 0x14, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x45, 0x45,
+	0x4b, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x01, 0x2a, 0x9a,
+	0x02, 0x0a, 0x11, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
+	0x14, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x59, 0x45, 0x54,
+	0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4c, 0x4f, 0x43, 0x4b,
+	0x45, 0x52, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x46, 0x55,
+	0x4c, 0x4c, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f,
+	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x4e, 0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54,
+	0x41, 0x46, 0x46, 0x5f, 0x4f, 0x46, 0x5f, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x10, 0x05, 0x12,
+	0x22, 0x0a, 0x1e, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x4d, 0x45, 0x53,
+	0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x45,
+	0x44, 0x10, 0x06, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x43,
+	0x52, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43,
+	0x48, 0x45, 0x44, 0x10, 0x07, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x52,
+	0x5f, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x45, 0x4e, 0x52, 0x4f, 0x4c, 0x4c, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x08, 0x2a, 0x5d, 0x0a, 0x10, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x21, 0x0a, 0x1d, 0x45, 0x4e, 0x52, 0x4f, 0x4c, 0x4c, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x59, 0x45, 0x54, 0x5f, 0x4f, 0x50,
+	0x45, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x0a,
+	0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x36, 0x0a, 0x16, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x56, 0x45, 0x52, 0x59, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x46, 0x46, 0x5f, 0x4f, 0x4e, 0x4c, 0x59,
+	0x10, 0x01, 0x2a, 0x2e, 0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59,
+	0x10, 0x01, 0x2a, 0x47, 0x0a, 0x0f, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45,
+	0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x45,
+	0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x45,
+	0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x02, 0x32, 0xa5, 0x2d, 0x0a, 0x0e,
 	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42,
 	0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x6f,
 	0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
@@ -1912,67 +10144,342 @@ var file_courses_microservice_proto_rawDesc = []byte{
 	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d,
 	0x6f, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x66, 0x66, 0x54, 0x6f,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74,
-	0x61, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x15, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x12, 0x1e, 0x2e,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x2e,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66,
-	0x66, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5c, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
-	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
-	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72,
-	0x6f, 0x6d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e,
+	0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64,
+	0x64, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
+	0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6f, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x69, 0x0a, 0x16, 0x44, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x44, 0x65,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x10,
+	0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x66, 0x66, 0x54, 0x6f, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x12, 0x18, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74,
+	0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x74, 0x61, 0x66, 0x66, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1b,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x21, 0x2e,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x12, 0x28, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x22, 0x2e,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x42, 0x79,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x42, 0x79, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x12, 0x27, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x57,
+	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1f,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e,
 	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x42, 0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x52, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x69, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7e, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x64, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x67, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11,
+	0x43, 0x6f, 0x70, 0x79, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x70, 0x79,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x70, 0x79, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x23, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x53,
+	0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x16,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41,
+	0x6c, 0x6c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x25, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
+	0x0f, 0x53, 0x65, 0x74, 0x51, 0x75, 0x69, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x51, 0x75,
+	0x69, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x51,
+	0x75, 0x69, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x41,
+	0x64, 0x64, 0x43, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x2e,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65,
+	0x73, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a,
+	0x13, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x53,
+	0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x69, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x20,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x25, 0x2e, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x57, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x53,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x2e, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x68,
+	0x61, 0x70, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x22, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x55,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79,
+	0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x1a,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x45,
+	0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x42, 0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x52, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1987,90 +10494,384 @@ func file_courses_microservice_proto_rawDescGZIP() []byte {
 	return file_courses_microservice_proto_rawDescData
 }
 
-var file_courses_microservice_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_courses_microservice_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_courses_microservice_proto_msgTypes = make([]protoimpl.MessageInfo, 145)
 var file_courses_microservice_proto_goTypes = []any{
-	(*GetCourseRequest)(nil),               // 0: courses.GetCourseRequest
-	(*GetCourseResponse)(nil),              // 1: courses.GetCourseResponse
-	(*CreateCourseRequest)(nil),            // 2: courses.CreateCourseRequest
-	(*CreateCourseResponse)(nil),           // 3: courses.CreateCourseResponse
-	(*UpdateCourseRequest)(nil),            // 4: courses.UpdateCourseRequest
-	(*UpdateCourseResponse)(nil),           // 5: courses.UpdateCourseResponse
-	(*DeleteCourseRequest)(nil),            // 6: courses.DeleteCourseRequest
-	(*DeleteCourseResponse)(nil),           // 7: courses.DeleteCourseResponse
-	(*AddStudentRequest)(nil),              // 8: courses.AddStudentRequest
-	(*AddStudentResponse)(nil),             // 9: courses.AddStudentResponse
-	(*RemoveStudentRequest)(nil),           // 10: courses.RemoveStudentRequest
-	(*RemoveStudentResponse)(nil),          // 11: courses.RemoveStudentResponse
-	(*AddStaffRequest)(nil),                // 12: courses.AddStaffRequest
-	(*AddStaffResponse)(nil),               // 13: courses.AddStaffResponse
-	(*RemoveStaffRequest)(nil),             // 14: courses.RemoveStaffRequest
-	(*RemoveStaffResponse)(nil),            // 15: courses.RemoveStaffResponse
-	(*GetCourseStudentsRequest)(nil),       // 16: courses.GetCourseStudentsRequest
-	(*GetCourseStudentsResponse)(nil),      // 17: courses.GetCourseStudentsResponse
-	(*GetCourseStaffRequest)(nil),          // 18: courses.GetCourseStaffRequest
-	(*GetCourseStaffResponse)(nil),         // 19: courses.GetCourseStaffResponse
-	(*GetStudentCoursesRequest)(nil),       // 20: courses.GetStudentCoursesRequest
-	(*GetStudentCoursesResponse)(nil),      // 21: courses.GetStudentCoursesResponse
-	(*GetStaffCoursesRequest)(nil),         // 22: courses.GetStaffCoursesRequest
-	(*GetStaffCoursesResponse)(nil),        // 23: courses.GetStaffCoursesResponse
-	(*GetSemesterCoursesRequest)(nil),      // 24: courses.GetSemesterCoursesRequest
-	(*GetSemesterCoursesResponse)(nil),     // 25: courses.GetSemesterCoursesResponse
-	(*AddAnnouncementRequest)(nil),         // 26: courses.AddAnnouncementRequest
-	(*AddAnnouncementResponse)(nil),        // 27: courses.AddAnnouncementResponse
-	(*GetCourseAnnouncementsRequest)(nil),  // 28: courses.GetCourseAnnouncementsRequest
-	(*GetCourseAnnouncementsResponse)(nil), // 29: courses.GetCourseAnnouncementsResponse
-	(*RemoveAnnouncementRequest)(nil),      // 30: courses.RemoveAnnouncementRequest
-	(*RemoveAnnouncementResponse)(nil),     // 31: courses.RemoveAnnouncementResponse
-	(*Course)(nil),                         // 32: courses.Course
-	(*Announcement)(nil),                   // 33: courses.Announcement
+	(BatchEnrollmentOutcome)(0),                   // 0: courses.BatchEnrollmentOutcome
+	(AnnouncementGrouping)(0),                     // 1: courses.AnnouncementGrouping
+	(EnrollmentBlocker)(0),                        // 2: courses.EnrollmentBlocker
+	(EnrollmentStatus)(0),                         // 3: courses.EnrollmentStatus
+	(AnnouncementVisibility)(0),                   // 4: courses.AnnouncementVisibility
+	(AnnouncementRecurrence)(0),                   // 5: courses.AnnouncementRecurrence
+	(FeatureOverride)(0),                          // 6: courses.FeatureOverride
+	(*GetCourseRequest)(nil),                      // 7: courses.GetCourseRequest
+	(*GetCourseResponse)(nil),                     // 8: courses.GetCourseResponse
+	(*ActivitySummary)(nil),                       // 9: courses.ActivitySummary
+	(*CreateCourseRequest)(nil),                   // 10: courses.CreateCourseRequest
+	(*StaffAssignment)(nil),                       // 11: courses.StaffAssignment
+	(*CreateCourseResponse)(nil),                  // 12: courses.CreateCourseResponse
+	(*UpdateCourseRequest)(nil),                   // 13: courses.UpdateCourseRequest
+	(*UpdateCourseResponse)(nil),                  // 14: courses.UpdateCourseResponse
+	(*DeleteCourseRequest)(nil),                   // 15: courses.DeleteCourseRequest
+	(*DeleteCourseResponse)(nil),                  // 16: courses.DeleteCourseResponse
+	(*ChangeCourseIDRequest)(nil),                 // 17: courses.ChangeCourseIDRequest
+	(*ChangeCourseIDResponse)(nil),                // 18: courses.ChangeCourseIDResponse
+	(*AddStudentRequest)(nil),                     // 19: courses.AddStudentRequest
+	(*AddStudentResponse)(nil),                    // 20: courses.AddStudentResponse
+	(*RemoveStudentRequest)(nil),                  // 21: courses.RemoveStudentRequest
+	(*RemoveStudentResponse)(nil),                 // 22: courses.RemoveStudentResponse
+	(*SetStudentStatusRequest)(nil),               // 23: courses.SetStudentStatusRequest
+	(*SetStudentStatusResponse)(nil),              // 24: courses.SetStudentStatusResponse
+	(*GetStudentStatusTransitionsRequest)(nil),    // 25: courses.GetStudentStatusTransitionsRequest
+	(*GetStudentStatusTransitionsResponse)(nil),   // 26: courses.GetStudentStatusTransitionsResponse
+	(*StudentStatusTransitions)(nil),              // 27: courses.StudentStatusTransitions
+	(*BatchAddStudentsRequest)(nil),               // 28: courses.BatchAddStudentsRequest
+	(*BatchAddStudentsResponse)(nil),              // 29: courses.BatchAddStudentsResponse
+	(*BatchEnrollmentResult)(nil),                 // 30: courses.BatchEnrollmentResult
+	(*TransferEnrollmentsRequest)(nil),            // 31: courses.TransferEnrollmentsRequest
+	(*TransferEnrollmentsResponse)(nil),           // 32: courses.TransferEnrollmentsResponse
+	(*FindDuplicateEnrollmentsRequest)(nil),       // 33: courses.FindDuplicateEnrollmentsRequest
+	(*FindDuplicateEnrollmentsResponse)(nil),      // 34: courses.FindDuplicateEnrollmentsResponse
+	(*DuplicateEnrollment)(nil),                   // 35: courses.DuplicateEnrollment
+	(*DeduplicateEnrollmentsRequest)(nil),         // 36: courses.DeduplicateEnrollmentsRequest
+	(*DeduplicateEnrollmentsResponse)(nil),        // 37: courses.DeduplicateEnrollmentsResponse
+	(*AddStaffRequest)(nil),                       // 38: courses.AddStaffRequest
+	(*AddStaffResponse)(nil),                      // 39: courses.AddStaffResponse
+	(*RemoveStaffRequest)(nil),                    // 40: courses.RemoveStaffRequest
+	(*RemoveStaffResponse)(nil),                   // 41: courses.RemoveStaffResponse
+	(*GetCourseStudentsRequest)(nil),              // 42: courses.GetCourseStudentsRequest
+	(*GetCourseStudentsResponse)(nil),             // 43: courses.GetCourseStudentsResponse
+	(*GetCourseStudentCountRequest)(nil),          // 44: courses.GetCourseStudentCountRequest
+	(*GetCourseStudentCountResponse)(nil),         // 45: courses.GetCourseStudentCountResponse
+	(*GetCourseStaffRequest)(nil),                 // 46: courses.GetCourseStaffRequest
+	(*GetCourseStaffResponse)(nil),                // 47: courses.GetCourseStaffResponse
+	(*StaffContact)(nil),                          // 48: courses.StaffContact
+	(*GetStudentCoursesRequest)(nil),              // 49: courses.GetStudentCoursesRequest
+	(*GetStudentCoursesResponse)(nil),             // 50: courses.GetStudentCoursesResponse
+	(*GetEnrollmentsForStudentRequest)(nil),       // 51: courses.GetEnrollmentsForStudentRequest
+	(*GetEnrollmentsForStudentResponse)(nil),      // 52: courses.GetEnrollmentsForStudentResponse
+	(*GetStudentCreditsRequest)(nil),              // 53: courses.GetStudentCreditsRequest
+	(*GetStudentCreditsResponse)(nil),             // 54: courses.GetStudentCreditsResponse
+	(*Enrollment)(nil),                            // 55: courses.Enrollment
+	(*GetStaffCoursesRequest)(nil),                // 56: courses.GetStaffCoursesRequest
+	(*GetStaffCoursesResponse)(nil),               // 57: courses.GetStaffCoursesResponse
+	(*ListCoursesWithoutStaffRequest)(nil),        // 58: courses.ListCoursesWithoutStaffRequest
+	(*ListCoursesWithoutStaffResponse)(nil),       // 59: courses.ListCoursesWithoutStaffResponse
+	(*ListCoursesRequest)(nil),                    // 60: courses.ListCoursesRequest
+	(*ListCoursesResponse)(nil),                   // 61: courses.ListCoursesResponse
+	(*GetCoursesByMetadataRequest)(nil),           // 62: courses.GetCoursesByMetadataRequest
+	(*GetCoursesByMetadataResponse)(nil),          // 63: courses.GetCoursesByMetadataResponse
+	(*GetSemesterCoursesRequest)(nil),             // 64: courses.GetSemesterCoursesRequest
+	(*GetSemesterCoursesResponse)(nil),            // 65: courses.GetSemesterCoursesResponse
+	(*AddAnnouncementRequest)(nil),                // 66: courses.AddAnnouncementRequest
+	(*AddAnnouncementResponse)(nil),               // 67: courses.AddAnnouncementResponse
+	(*GetCourseAnnouncementsRequest)(nil),         // 68: courses.GetCourseAnnouncementsRequest
+	(*GetCourseAnnouncementsResponse)(nil),        // 69: courses.GetCourseAnnouncementsResponse
+	(*GetCourseAnnouncementsGroupedRequest)(nil),  // 70: courses.GetCourseAnnouncementsGroupedRequest
+	(*GetCourseAnnouncementsGroupedResponse)(nil), // 71: courses.GetCourseAnnouncementsGroupedResponse
+	(*AnnouncementGroup)(nil),                     // 72: courses.AnnouncementGroup
+	(*GetAnnouncementRequest)(nil),                // 73: courses.GetAnnouncementRequest
+	(*GetAnnouncementResponse)(nil),               // 74: courses.GetAnnouncementResponse
+	(*RemoveAnnouncementRequest)(nil),             // 75: courses.RemoveAnnouncementRequest
+	(*RemoveAnnouncementResponse)(nil),            // 76: courses.RemoveAnnouncementResponse
+	(*UpdateAnnouncementRequest)(nil),             // 77: courses.UpdateAnnouncementRequest
+	(*UpdateAnnouncementResponse)(nil),            // 78: courses.UpdateAnnouncementResponse
+	(*UpsertAnnouncementBySlugRequest)(nil),       // 79: courses.UpsertAnnouncementBySlugRequest
+	(*UpsertAnnouncementBySlugResponse)(nil),      // 80: courses.UpsertAnnouncementBySlugResponse
+	(*CopyAnnouncementsRequest)(nil),              // 81: courses.CopyAnnouncementsRequest
+	(*CopyAnnouncementsResponse)(nil),             // 82: courses.CopyAnnouncementsResponse
+	(*BatchRemoveAnnouncementsRequest)(nil),       // 83: courses.BatchRemoveAnnouncementsRequest
+	(*BatchRemoveAnnouncementsResponse)(nil),      // 84: courses.BatchRemoveAnnouncementsResponse
+	(*BatchResult)(nil),                           // 85: courses.BatchResult
+	(*BatchItemResult)(nil),                       // 86: courses.BatchItemResult
+	(*SetEnrollmentWindowRequest)(nil),            // 87: courses.SetEnrollmentWindowRequest
+	(*SetEnrollmentWindowResponse)(nil),           // 88: courses.SetEnrollmentWindowResponse
+	(*GetEnrollmentStatusRequest)(nil),            // 89: courses.GetEnrollmentStatusRequest
+	(*CheckEnrollmentEligibilityRequest)(nil),     // 90: courses.CheckEnrollmentEligibilityRequest
+	(*CheckEnrollmentEligibilityResponse)(nil),    // 91: courses.CheckEnrollmentEligibilityResponse
+	(*GetEnrollmentStatusResponse)(nil),           // 92: courses.GetEnrollmentStatusResponse
+	(*SearchAllAnnouncementsRequest)(nil),         // 93: courses.SearchAllAnnouncementsRequest
+	(*SearchAllAnnouncementsResponse)(nil),        // 94: courses.SearchAllAnnouncementsResponse
+	(*GetAnnouncementsSinceRequest)(nil),          // 95: courses.GetAnnouncementsSinceRequest
+	(*GetAnnouncementsSinceResponse)(nil),         // 96: courses.GetAnnouncementsSinceResponse
+	(*AnnouncementCursor)(nil),                    // 97: courses.AnnouncementCursor
+	(*AnnouncementMatch)(nil),                     // 98: courses.AnnouncementMatch
+	(*GetEnrollmentDifferenceRequest)(nil),        // 99: courses.GetEnrollmentDifferenceRequest
+	(*GetEnrollmentDifferenceResponse)(nil),       // 100: courses.GetEnrollmentDifferenceResponse
+	(*SetQuietPeriodsRequest)(nil),                // 101: courses.SetQuietPeriodsRequest
+	(*SetQuietPeriodsResponse)(nil),               // 102: courses.SetQuietPeriodsResponse
+	(*SetAnnouncementsEnabledRequest)(nil),        // 103: courses.SetAnnouncementsEnabledRequest
+	(*SetAnnouncementsEnabledResponse)(nil),       // 104: courses.SetAnnouncementsEnabledResponse
+	(*QuietPeriod)(nil),                           // 105: courses.QuietPeriod
+	(*CreateCourseAPIKeyRequest)(nil),             // 106: courses.CreateCourseAPIKeyRequest
+	(*CreateCourseAPIKeyResponse)(nil),            // 107: courses.CreateCourseAPIKeyResponse
+	(*ListCourseAPIKeysRequest)(nil),              // 108: courses.ListCourseAPIKeysRequest
+	(*ListCourseAPIKeysResponse)(nil),             // 109: courses.ListCourseAPIKeysResponse
+	(*RevokeCourseAPIKeyRequest)(nil),             // 110: courses.RevokeCourseAPIKeyRequest
+	(*RevokeCourseAPIKeyResponse)(nil),            // 111: courses.RevokeCourseAPIKeyResponse
+	(*CourseAPIKey)(nil),                          // 112: courses.CourseAPIKey
+	(*AddCorequisiteRequest)(nil),                 // 113: courses.AddCorequisiteRequest
+	(*AddCorequisiteResponse)(nil),                // 114: courses.AddCorequisiteResponse
+	(*GetCorequisitesRequest)(nil),                // 115: courses.GetCorequisitesRequest
+	(*GetCorequisitesResponse)(nil),               // 116: courses.GetCorequisitesResponse
+	(*GetSharedCoursesRequest)(nil),               // 117: courses.GetSharedCoursesRequest
+	(*GetSharedCoursesResponse)(nil),              // 118: courses.GetSharedCoursesResponse
+	(*GradingComponent)(nil),                      // 119: courses.GradingComponent
+	(*SetGradingComponentRequest)(nil),            // 120: courses.SetGradingComponentRequest
+	(*SetGradingComponentResponse)(nil),           // 121: courses.SetGradingComponentResponse
+	(*RemoveGradingComponentRequest)(nil),         // 122: courses.RemoveGradingComponentRequest
+	(*RemoveGradingComponentResponse)(nil),        // 123: courses.RemoveGradingComponentResponse
+	(*GetGradingSchemeRequest)(nil),               // 124: courses.GetGradingSchemeRequest
+	(*GetGradingSchemeResponse)(nil),              // 125: courses.GetGradingSchemeResponse
+	(*FinalizeGradingSchemeRequest)(nil),          // 126: courses.FinalizeGradingSchemeRequest
+	(*FinalizeGradingSchemeResponse)(nil),         // 127: courses.FinalizeGradingSchemeResponse
+	(*Course)(nil),                                // 128: courses.Course
+	(*CourseStaffDetails)(nil),                    // 129: courses.CourseStaffDetails
+	(*Announcement)(nil),                          // 130: courses.Announcement
+	(*GenerateCourseShareTokenRequest)(nil),       // 131: courses.GenerateCourseShareTokenRequest
+	(*GenerateCourseShareTokenResponse)(nil),      // 132: courses.GenerateCourseShareTokenResponse
+	(*GetCourseByShareTokenRequest)(nil),          // 133: courses.GetCourseByShareTokenRequest
+	(*GetCourseByShareTokenResponse)(nil),         // 134: courses.GetCourseByShareTokenResponse
+	(*GetCourseFeaturesRequest)(nil),              // 135: courses.GetCourseFeaturesRequest
+	(*GetCourseFeaturesResponse)(nil),             // 136: courses.GetCourseFeaturesResponse
+	(*CourseFeature)(nil),                         // 137: courses.CourseFeature
+	(*SetCourseFeatureRequest)(nil),               // 138: courses.SetCourseFeatureRequest
+	(*SetCourseFeatureResponse)(nil),              // 139: courses.SetCourseFeatureResponse
+	(*GetAnnouncementCountByAuthorRequest)(nil),   // 140: courses.GetAnnouncementCountByAuthorRequest
+	(*GetAnnouncementCountByAuthorResponse)(nil),  // 141: courses.GetAnnouncementCountByAuthorResponse
+	(*AuthorAnnouncementCount)(nil),               // 142: courses.AuthorAnnouncementCount
+	(*GetCourseSnapshotRequest)(nil),              // 143: courses.GetCourseSnapshotRequest
+	(*GetCourseSnapshotResponse)(nil),             // 144: courses.GetCourseSnapshotResponse
+	(*CourseStudent)(nil),                         // 145: courses.CourseStudent
+	(*GetDataShapeReportRequest)(nil),             // 146: courses.GetDataShapeReportRequest
+	(*GetDataShapeReportResponse)(nil),            // 147: courses.GetDataShapeReportResponse
+	(*SemesterCourseCount)(nil),                   // 148: courses.SemesterCourseCount
+	(*CountDistribution)(nil),                     // 149: courses.CountDistribution
+	(*TableRowCount)(nil),                         // 150: courses.TableRowCount
+	nil,                                           // 151: courses.Course.MetadataEntry
+	(*timestamppb.Timestamp)(nil),                 // 152: google.protobuf.Timestamp
 }
 var file_courses_microservice_proto_depIdxs = []int32{
-	32, // 0: courses.GetCourseResponse.course:type_name -> courses.Course
-	32, // 1: courses.CreateCourseRequest.course:type_name -> courses.Course
-	32, // 2: courses.CreateCourseResponse.course:type_name -> courses.Course
-	32, // 3: courses.UpdateCourseRequest.course:type_name -> courses.Course
-	32, // 4: courses.UpdateCourseResponse.course:type_name -> courses.Course
-	32, // 5: courses.GetSemesterCoursesResponse.courses:type_name -> courses.Course
-	33, // 6: courses.AddAnnouncementRequest.announcement:type_name -> courses.Announcement
-	33, // 7: courses.AddAnnouncementResponse.announcement:type_name -> courses.Announcement
-	33, // 8: courses.GetCourseAnnouncementsResponse.announcements:type_name -> courses.Announcement
-	0,  // 9: courses.CoursesService.GetCourse:input_type -> courses.GetCourseRequest
-	2,  // 10: courses.CoursesService.CreateCourse:input_type -> courses.CreateCourseRequest
-	4,  // 11: courses.CoursesService.UpdateCourse:input_type -> courses.UpdateCourseRequest
-	6,  // 12: courses.CoursesService.DeleteCourse:input_type -> courses.DeleteCourseRequest
-	8,  // 13: courses.CoursesService.AddStudentToCourse:input_type -> courses.AddStudentRequest
-	10, // 14: courses.CoursesService.RemoveStudentFromCourse:input_type -> courses.RemoveStudentRequest
-	12, // 15: courses.CoursesService.AddStaffToCourse:input_type -> courses.AddStaffRequest
-	14, // 16: courses.CoursesService.RemoveStaffFromCourse:input_type -> courses.RemoveStaffRequest
-	16, // 17: courses.CoursesService.GetCourseStudents:input_type -> courses.GetCourseStudentsRequest
-	18, // 18: courses.CoursesService.GetCourseStaff:input_type -> courses.GetCourseStaffRequest
-	20, // 19: courses.CoursesService.GetStudentCourses:input_type -> courses.GetStudentCoursesRequest
-	22, // 20: courses.CoursesService.GetStaffCourses:input_type -> courses.GetStaffCoursesRequest
-	24, // 21: courses.CoursesService.GetSemesterCourses:input_type -> courses.GetSemesterCoursesRequest
-	26, // 22: courses.CoursesService.AddAnnouncementToCourse:input_type -> courses.AddAnnouncementRequest
-	28, // 23: courses.CoursesService.GetCourseAnnouncements:input_type -> courses.GetCourseAnnouncementsRequest
-	30, // 24: courses.CoursesService.RemoveAnnouncementFromCourse:input_type -> courses.RemoveAnnouncementRequest
-	1,  // 25: courses.CoursesService.GetCourse:output_type -> courses.GetCourseResponse
-	3,  // 26: courses.CoursesService.CreateCourse:output_type -> courses.CreateCourseResponse
-	5,  // 27: courses.CoursesService.UpdateCourse:output_type -> courses.UpdateCourseResponse
-	7,  // 28: courses.CoursesService.DeleteCourse:output_type -> courses.DeleteCourseResponse
-	9,  // 29: courses.CoursesService.AddStudentToCourse:output_type -> courses.AddStudentResponse
-	11, // 30: courses.CoursesService.RemoveStudentFromCourse:output_type -> courses.RemoveStudentResponse
-	13, // 31: courses.CoursesService.AddStaffToCourse:output_type -> courses.AddStaffResponse
-	15, // 32: courses.CoursesService.RemoveStaffFromCourse:output_type -> courses.RemoveStaffResponse
-	17, // 33: courses.CoursesService.GetCourseStudents:output_type -> courses.GetCourseStudentsResponse
-	19, // 34: courses.CoursesService.GetCourseStaff:output_type -> courses.GetCourseStaffResponse
-	21, // 35: courses.CoursesService.GetStudentCourses:output_type -> courses.GetStudentCoursesResponse
-	23, // 36: courses.CoursesService.GetStaffCourses:output_type -> courses.GetStaffCoursesResponse
-	25, // 37: courses.CoursesService.GetSemesterCourses:output_type -> courses.GetSemesterCoursesResponse
-	27, // 38: courses.CoursesService.AddAnnouncementToCourse:output_type -> courses.AddAnnouncementResponse
-	29, // 39: courses.CoursesService.GetCourseAnnouncements:output_type -> courses.GetCourseAnnouncementsResponse
-	31, // 40: courses.CoursesService.RemoveAnnouncementFromCourse:output_type -> courses.RemoveAnnouncementResponse
-	25, // [25:41] is the sub-list for method output_type
-	9,  // [9:25] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	128, // 0: courses.GetCourseResponse.course:type_name -> courses.Course
+	9,   // 1: courses.GetCourseResponse.activity:type_name -> courses.ActivitySummary
+	128, // 2: courses.CreateCourseRequest.course:type_name -> courses.Course
+	11,  // 3: courses.CreateCourseRequest.initialStaff:type_name -> courses.StaffAssignment
+	152, // 4: courses.StaffAssignment.validFrom:type_name -> google.protobuf.Timestamp
+	152, // 5: courses.StaffAssignment.validUntil:type_name -> google.protobuf.Timestamp
+	128, // 6: courses.CreateCourseResponse.course:type_name -> courses.Course
+	128, // 7: courses.UpdateCourseRequest.course:type_name -> courses.Course
+	128, // 8: courses.UpdateCourseResponse.course:type_name -> courses.Course
+	27,  // 9: courses.GetStudentStatusTransitionsResponse.transitions:type_name -> courses.StudentStatusTransitions
+	30,  // 10: courses.BatchAddStudentsResponse.results:type_name -> courses.BatchEnrollmentResult
+	0,   // 11: courses.BatchEnrollmentResult.outcome:type_name -> courses.BatchEnrollmentOutcome
+	35,  // 12: courses.FindDuplicateEnrollmentsResponse.duplicates:type_name -> courses.DuplicateEnrollment
+	152, // 13: courses.AddStaffRequest.validFrom:type_name -> google.protobuf.Timestamp
+	152, // 14: courses.AddStaffRequest.validUntil:type_name -> google.protobuf.Timestamp
+	48,  // 15: courses.GetCourseStaffResponse.contacts:type_name -> courses.StaffContact
+	55,  // 16: courses.GetEnrollmentsForStudentResponse.enrollments:type_name -> courses.Enrollment
+	128, // 17: courses.Enrollment.course:type_name -> courses.Course
+	128, // 18: courses.ListCoursesWithoutStaffResponse.courses:type_name -> courses.Course
+	152, // 19: courses.ListCoursesRequest.createdAfter:type_name -> google.protobuf.Timestamp
+	128, // 20: courses.ListCoursesResponse.courses:type_name -> courses.Course
+	128, // 21: courses.GetCoursesByMetadataResponse.courses:type_name -> courses.Course
+	128, // 22: courses.GetSemesterCoursesResponse.courses:type_name -> courses.Course
+	130, // 23: courses.AddAnnouncementRequest.announcement:type_name -> courses.Announcement
+	130, // 24: courses.AddAnnouncementResponse.announcement:type_name -> courses.Announcement
+	152, // 25: courses.GetCourseAnnouncementsRequest.createdFrom:type_name -> google.protobuf.Timestamp
+	152, // 26: courses.GetCourseAnnouncementsRequest.createdBefore:type_name -> google.protobuf.Timestamp
+	130, // 27: courses.GetCourseAnnouncementsResponse.announcements:type_name -> courses.Announcement
+	1,   // 28: courses.GetCourseAnnouncementsGroupedRequest.groupBy:type_name -> courses.AnnouncementGrouping
+	72,  // 29: courses.GetCourseAnnouncementsGroupedResponse.groups:type_name -> courses.AnnouncementGroup
+	152, // 30: courses.AnnouncementGroup.startsAt:type_name -> google.protobuf.Timestamp
+	152, // 31: courses.AnnouncementGroup.endsAt:type_name -> google.protobuf.Timestamp
+	130, // 32: courses.AnnouncementGroup.newest:type_name -> courses.Announcement
+	130, // 33: courses.GetAnnouncementResponse.announcement:type_name -> courses.Announcement
+	4,   // 34: courses.UpdateAnnouncementRequest.visibility:type_name -> courses.AnnouncementVisibility
+	130, // 35: courses.UpdateAnnouncementResponse.announcement:type_name -> courses.Announcement
+	130, // 36: courses.UpsertAnnouncementBySlugRequest.announcement:type_name -> courses.Announcement
+	130, // 37: courses.UpsertAnnouncementBySlugResponse.announcement:type_name -> courses.Announcement
+	85,  // 38: courses.BatchRemoveAnnouncementsResponse.result:type_name -> courses.BatchResult
+	86,  // 39: courses.BatchResult.items:type_name -> courses.BatchItemResult
+	152, // 40: courses.SetEnrollmentWindowRequest.opensAt:type_name -> google.protobuf.Timestamp
+	152, // 41: courses.SetEnrollmentWindowRequest.closesAt:type_name -> google.protobuf.Timestamp
+	2,   // 42: courses.CheckEnrollmentEligibilityResponse.blockers:type_name -> courses.EnrollmentBlocker
+	3,   // 43: courses.GetEnrollmentStatusResponse.status:type_name -> courses.EnrollmentStatus
+	152, // 44: courses.GetEnrollmentStatusResponse.opensAt:type_name -> google.protobuf.Timestamp
+	152, // 45: courses.GetEnrollmentStatusResponse.closesAt:type_name -> google.protobuf.Timestamp
+	98,  // 46: courses.SearchAllAnnouncementsResponse.matches:type_name -> courses.AnnouncementMatch
+	152, // 47: courses.GetAnnouncementsSinceRequest.since:type_name -> google.protobuf.Timestamp
+	97,  // 48: courses.GetAnnouncementsSinceRequest.after:type_name -> courses.AnnouncementCursor
+	98,  // 49: courses.GetAnnouncementsSinceResponse.announcements:type_name -> courses.AnnouncementMatch
+	152, // 50: courses.GetAnnouncementsSinceResponse.nextSince:type_name -> google.protobuf.Timestamp
+	97,  // 51: courses.GetAnnouncementsSinceResponse.nextCursor:type_name -> courses.AnnouncementCursor
+	152, // 52: courses.AnnouncementCursor.createdAt:type_name -> google.protobuf.Timestamp
+	130, // 53: courses.AnnouncementMatch.announcement:type_name -> courses.Announcement
+	105, // 54: courses.SetQuietPeriodsRequest.periods:type_name -> courses.QuietPeriod
+	152, // 55: courses.QuietPeriod.startsAt:type_name -> google.protobuf.Timestamp
+	152, // 56: courses.QuietPeriod.endsAt:type_name -> google.protobuf.Timestamp
+	152, // 57: courses.CreateCourseAPIKeyRequest.expiresAt:type_name -> google.protobuf.Timestamp
+	112, // 58: courses.CreateCourseAPIKeyResponse.key:type_name -> courses.CourseAPIKey
+	112, // 59: courses.ListCourseAPIKeysResponse.keys:type_name -> courses.CourseAPIKey
+	152, // 60: courses.CourseAPIKey.expiresAt:type_name -> google.protobuf.Timestamp
+	152, // 61: courses.CourseAPIKey.createdAt:type_name -> google.protobuf.Timestamp
+	119, // 62: courses.SetGradingComponentRequest.component:type_name -> courses.GradingComponent
+	119, // 63: courses.GetGradingSchemeResponse.components:type_name -> courses.GradingComponent
+	152, // 64: courses.GetGradingSchemeResponse.finalizedAt:type_name -> google.protobuf.Timestamp
+	119, // 65: courses.FinalizeGradingSchemeResponse.components:type_name -> courses.GradingComponent
+	152, // 66: courses.FinalizeGradingSchemeResponse.finalizedAt:type_name -> google.protobuf.Timestamp
+	129, // 67: courses.Course.staffDetails:type_name -> courses.CourseStaffDetails
+	152, // 68: courses.Course.createdAt:type_name -> google.protobuf.Timestamp
+	152, // 69: courses.Course.updatedAt:type_name -> google.protobuf.Timestamp
+	151, // 70: courses.Course.metadata:type_name -> courses.Course.MetadataEntry
+	152, // 71: courses.CourseStaffDetails.gradingFinalizedAt:type_name -> google.protobuf.Timestamp
+	152, // 72: courses.CourseStaffDetails.enrollmentOpensAt:type_name -> google.protobuf.Timestamp
+	152, // 73: courses.CourseStaffDetails.enrollmentClosesAt:type_name -> google.protobuf.Timestamp
+	4,   // 74: courses.Announcement.visibility:type_name -> courses.AnnouncementVisibility
+	5,   // 75: courses.Announcement.recurrence:type_name -> courses.AnnouncementRecurrence
+	152, // 76: courses.Announcement.nextPostAt:type_name -> google.protobuf.Timestamp
+	152, // 77: courses.Announcement.createdAt:type_name -> google.protobuf.Timestamp
+	152, // 78: courses.Announcement.updatedAt:type_name -> google.protobuf.Timestamp
+	152, // 79: courses.GenerateCourseShareTokenResponse.expiresAt:type_name -> google.protobuf.Timestamp
+	128, // 80: courses.GetCourseByShareTokenResponse.course:type_name -> courses.Course
+	137, // 81: courses.GetCourseFeaturesResponse.features:type_name -> courses.CourseFeature
+	6,   // 82: courses.SetCourseFeatureRequest.override:type_name -> courses.FeatureOverride
+	142, // 83: courses.GetAnnouncementCountByAuthorResponse.counts:type_name -> courses.AuthorAnnouncementCount
+	152, // 84: courses.GetCourseSnapshotResponse.takenAt:type_name -> google.protobuf.Timestamp
+	128, // 85: courses.GetCourseSnapshotResponse.course:type_name -> courses.Course
+	145, // 86: courses.GetCourseSnapshotResponse.students:type_name -> courses.CourseStudent
+	11,  // 87: courses.GetCourseSnapshotResponse.staff:type_name -> courses.StaffAssignment
+	130, // 88: courses.GetCourseSnapshotResponse.announcements:type_name -> courses.Announcement
+	105, // 89: courses.GetCourseSnapshotResponse.quietPeriods:type_name -> courses.QuietPeriod
+	152, // 90: courses.GetDataShapeReportResponse.generatedAt:type_name -> google.protobuf.Timestamp
+	148, // 91: courses.GetDataShapeReportResponse.coursesPerSemester:type_name -> courses.SemesterCourseCount
+	149, // 92: courses.GetDataShapeReportResponse.enrollmentsPerCourse:type_name -> courses.CountDistribution
+	149, // 93: courses.GetDataShapeReportResponse.announcementsPerCourse:type_name -> courses.CountDistribution
+	150, // 94: courses.GetDataShapeReportResponse.tableRows:type_name -> courses.TableRowCount
+	7,   // 95: courses.CoursesService.GetCourse:input_type -> courses.GetCourseRequest
+	10,  // 96: courses.CoursesService.CreateCourse:input_type -> courses.CreateCourseRequest
+	13,  // 97: courses.CoursesService.UpdateCourse:input_type -> courses.UpdateCourseRequest
+	15,  // 98: courses.CoursesService.DeleteCourse:input_type -> courses.DeleteCourseRequest
+	19,  // 99: courses.CoursesService.AddStudentToCourse:input_type -> courses.AddStudentRequest
+	21,  // 100: courses.CoursesService.RemoveStudentFromCourse:input_type -> courses.RemoveStudentRequest
+	23,  // 101: courses.CoursesService.SetStudentStatus:input_type -> courses.SetStudentStatusRequest
+	25,  // 102: courses.CoursesService.GetStudentStatusTransitions:input_type -> courses.GetStudentStatusTransitionsRequest
+	28,  // 103: courses.CoursesService.BatchAddStudents:input_type -> courses.BatchAddStudentsRequest
+	31,  // 104: courses.CoursesService.TransferEnrollments:input_type -> courses.TransferEnrollmentsRequest
+	33,  // 105: courses.CoursesService.FindDuplicateEnrollments:input_type -> courses.FindDuplicateEnrollmentsRequest
+	36,  // 106: courses.CoursesService.DeduplicateEnrollments:input_type -> courses.DeduplicateEnrollmentsRequest
+	38,  // 107: courses.CoursesService.AddStaffToCourse:input_type -> courses.AddStaffRequest
+	40,  // 108: courses.CoursesService.RemoveStaffFromCourse:input_type -> courses.RemoveStaffRequest
+	42,  // 109: courses.CoursesService.GetCourseStudents:input_type -> courses.GetCourseStudentsRequest
+	46,  // 110: courses.CoursesService.GetCourseStaff:input_type -> courses.GetCourseStaffRequest
+	49,  // 111: courses.CoursesService.GetStudentCourses:input_type -> courses.GetStudentCoursesRequest
+	51,  // 112: courses.CoursesService.GetEnrollmentsForStudent:input_type -> courses.GetEnrollmentsForStudentRequest
+	53,  // 113: courses.CoursesService.GetStudentCredits:input_type -> courses.GetStudentCreditsRequest
+	56,  // 114: courses.CoursesService.GetStaffCourses:input_type -> courses.GetStaffCoursesRequest
+	64,  // 115: courses.CoursesService.GetSemesterCourses:input_type -> courses.GetSemesterCoursesRequest
+	60,  // 116: courses.CoursesService.ListCourses:input_type -> courses.ListCoursesRequest
+	62,  // 117: courses.CoursesService.GetCoursesByMetadata:input_type -> courses.GetCoursesByMetadataRequest
+	58,  // 118: courses.CoursesService.ListCoursesWithoutStaff:input_type -> courses.ListCoursesWithoutStaffRequest
+	66,  // 119: courses.CoursesService.AddAnnouncementToCourse:input_type -> courses.AddAnnouncementRequest
+	68,  // 120: courses.CoursesService.GetCourseAnnouncements:input_type -> courses.GetCourseAnnouncementsRequest
+	73,  // 121: courses.CoursesService.GetAnnouncement:input_type -> courses.GetAnnouncementRequest
+	70,  // 122: courses.CoursesService.GetCourseAnnouncementsGrouped:input_type -> courses.GetCourseAnnouncementsGroupedRequest
+	75,  // 123: courses.CoursesService.RemoveAnnouncementFromCourse:input_type -> courses.RemoveAnnouncementRequest
+	83,  // 124: courses.CoursesService.BatchRemoveAnnouncements:input_type -> courses.BatchRemoveAnnouncementsRequest
+	81,  // 125: courses.CoursesService.CopyAnnouncements:input_type -> courses.CopyAnnouncementsRequest
+	87,  // 126: courses.CoursesService.SetEnrollmentWindow:input_type -> courses.SetEnrollmentWindowRequest
+	89,  // 127: courses.CoursesService.GetEnrollmentStatus:input_type -> courses.GetEnrollmentStatusRequest
+	93,  // 128: courses.CoursesService.SearchAllAnnouncements:input_type -> courses.SearchAllAnnouncementsRequest
+	95,  // 129: courses.CoursesService.GetAnnouncementsSince:input_type -> courses.GetAnnouncementsSinceRequest
+	99,  // 130: courses.CoursesService.GetEnrollmentDifference:input_type -> courses.GetEnrollmentDifferenceRequest
+	101, // 131: courses.CoursesService.SetQuietPeriods:input_type -> courses.SetQuietPeriodsRequest
+	103, // 132: courses.CoursesService.SetAnnouncementsEnabled:input_type -> courses.SetAnnouncementsEnabledRequest
+	106, // 133: courses.CoursesService.CreateCourseAPIKey:input_type -> courses.CreateCourseAPIKeyRequest
+	108, // 134: courses.CoursesService.ListCourseAPIKeys:input_type -> courses.ListCourseAPIKeysRequest
+	110, // 135: courses.CoursesService.RevokeCourseAPIKey:input_type -> courses.RevokeCourseAPIKeyRequest
+	113, // 136: courses.CoursesService.AddCorequisite:input_type -> courses.AddCorequisiteRequest
+	115, // 137: courses.CoursesService.GetCorequisites:input_type -> courses.GetCorequisitesRequest
+	117, // 138: courses.CoursesService.GetSharedCourses:input_type -> courses.GetSharedCoursesRequest
+	120, // 139: courses.CoursesService.SetGradingComponent:input_type -> courses.SetGradingComponentRequest
+	122, // 140: courses.CoursesService.RemoveGradingComponent:input_type -> courses.RemoveGradingComponentRequest
+	124, // 141: courses.CoursesService.GetGradingScheme:input_type -> courses.GetGradingSchemeRequest
+	126, // 142: courses.CoursesService.FinalizeGradingScheme:input_type -> courses.FinalizeGradingSchemeRequest
+	131, // 143: courses.CoursesService.GenerateCourseShareToken:input_type -> courses.GenerateCourseShareTokenRequest
+	133, // 144: courses.CoursesService.GetCourseByShareToken:input_type -> courses.GetCourseByShareTokenRequest
+	135, // 145: courses.CoursesService.GetCourseFeatures:input_type -> courses.GetCourseFeaturesRequest
+	138, // 146: courses.CoursesService.SetCourseFeature:input_type -> courses.SetCourseFeatureRequest
+	140, // 147: courses.CoursesService.GetAnnouncementCountByAuthor:input_type -> courses.GetAnnouncementCountByAuthorRequest
+	146, // 148: courses.CoursesService.GetDataShapeReport:input_type -> courses.GetDataShapeReportRequest
+	44,  // 149: courses.CoursesService.GetCourseStudentCount:input_type -> courses.GetCourseStudentCountRequest
+	143, // 150: courses.CoursesService.GetCourseSnapshot:input_type -> courses.GetCourseSnapshotRequest
+	77,  // 151: courses.CoursesService.UpdateAnnouncement:input_type -> courses.UpdateAnnouncementRequest
+	17,  // 152: courses.CoursesService.ChangeCourseID:input_type -> courses.ChangeCourseIDRequest
+	79,  // 153: courses.CoursesService.UpsertAnnouncementBySlug:input_type -> courses.UpsertAnnouncementBySlugRequest
+	90,  // 154: courses.CoursesService.CheckEnrollmentEligibility:input_type -> courses.CheckEnrollmentEligibilityRequest
+	8,   // 155: courses.CoursesService.GetCourse:output_type -> courses.GetCourseResponse
+	12,  // 156: courses.CoursesService.CreateCourse:output_type -> courses.CreateCourseResponse
+	14,  // 157: courses.CoursesService.UpdateCourse:output_type -> courses.UpdateCourseResponse
+	16,  // 158: courses.CoursesService.DeleteCourse:output_type -> courses.DeleteCourseResponse
+	20,  // 159: courses.CoursesService.AddStudentToCourse:output_type -> courses.AddStudentResponse
+	22,  // 160: courses.CoursesService.RemoveStudentFromCourse:output_type -> courses.RemoveStudentResponse
+	24,  // 161: courses.CoursesService.SetStudentStatus:output_type -> courses.SetStudentStatusResponse
+	26,  // 162: courses.CoursesService.GetStudentStatusTransitions:output_type -> courses.GetStudentStatusTransitionsResponse
+	29,  // 163: courses.CoursesService.BatchAddStudents:output_type -> courses.BatchAddStudentsResponse
+	32,  // 164: courses.CoursesService.TransferEnrollments:output_type -> courses.TransferEnrollmentsResponse
+	34,  // 165: courses.CoursesService.FindDuplicateEnrollments:output_type -> courses.FindDuplicateEnrollmentsResponse
+	37,  // 166: courses.CoursesService.DeduplicateEnrollments:output_type -> courses.DeduplicateEnrollmentsResponse
+	39,  // 167: courses.CoursesService.AddStaffToCourse:output_type -> courses.AddStaffResponse
+	41,  // 168: courses.CoursesService.RemoveStaffFromCourse:output_type -> courses.RemoveStaffResponse
+	43,  // 169: courses.CoursesService.GetCourseStudents:output_type -> courses.GetCourseStudentsResponse
+	47,  // 170: courses.CoursesService.GetCourseStaff:output_type -> courses.GetCourseStaffResponse
+	50,  // 171: courses.CoursesService.GetStudentCourses:output_type -> courses.GetStudentCoursesResponse
+	52,  // 172: courses.CoursesService.GetEnrollmentsForStudent:output_type -> courses.GetEnrollmentsForStudentResponse
+	54,  // 173: courses.CoursesService.GetStudentCredits:output_type -> courses.GetStudentCreditsResponse
+	57,  // 174: courses.CoursesService.GetStaffCourses:output_type -> courses.GetStaffCoursesResponse
+	65,  // 175: courses.CoursesService.GetSemesterCourses:output_type -> courses.GetSemesterCoursesResponse
+	61,  // 176: courses.CoursesService.ListCourses:output_type -> courses.ListCoursesResponse
+	63,  // 177: courses.CoursesService.GetCoursesByMetadata:output_type -> courses.GetCoursesByMetadataResponse
+	59,  // 178: courses.CoursesService.ListCoursesWithoutStaff:output_type -> courses.ListCoursesWithoutStaffResponse
+	67,  // 179: courses.CoursesService.AddAnnouncementToCourse:output_type -> courses.AddAnnouncementResponse
+	69,  // 180: courses.CoursesService.GetCourseAnnouncements:output_type -> courses.GetCourseAnnouncementsResponse
+	74,  // 181: courses.CoursesService.GetAnnouncement:output_type -> courses.GetAnnouncementResponse
+	71,  // 182: courses.CoursesService.GetCourseAnnouncementsGrouped:output_type -> courses.GetCourseAnnouncementsGroupedResponse
+	76,  // 183: courses.CoursesService.RemoveAnnouncementFromCourse:output_type -> courses.RemoveAnnouncementResponse
+	84,  // 184: courses.CoursesService.BatchRemoveAnnouncements:output_type -> courses.BatchRemoveAnnouncementsResponse
+	82,  // 185: courses.CoursesService.CopyAnnouncements:output_type -> courses.CopyAnnouncementsResponse
+	88,  // 186: courses.CoursesService.SetEnrollmentWindow:output_type -> courses.SetEnrollmentWindowResponse
+	92,  // 187: courses.CoursesService.GetEnrollmentStatus:output_type -> courses.GetEnrollmentStatusResponse
+	94,  // 188: courses.CoursesService.SearchAllAnnouncements:output_type -> courses.SearchAllAnnouncementsResponse
+	96,  // 189: courses.CoursesService.GetAnnouncementsSince:output_type -> courses.GetAnnouncementsSinceResponse
+	100, // 190: courses.CoursesService.GetEnrollmentDifference:output_type -> courses.GetEnrollmentDifferenceResponse
+	102, // 191: courses.CoursesService.SetQuietPeriods:output_type -> courses.SetQuietPeriodsResponse
+	104, // 192: courses.CoursesService.SetAnnouncementsEnabled:output_type -> courses.SetAnnouncementsEnabledResponse
+	107, // 193: courses.CoursesService.CreateCourseAPIKey:output_type -> courses.CreateCourseAPIKeyResponse
+	109, // 194: courses.CoursesService.ListCourseAPIKeys:output_type -> courses.ListCourseAPIKeysResponse
+	111, // 195: courses.CoursesService.RevokeCourseAPIKey:output_type -> courses.RevokeCourseAPIKeyResponse
+	114, // 196: courses.CoursesService.AddCorequisite:output_type -> courses.AddCorequisiteResponse
+	116, // 197: courses.CoursesService.GetCorequisites:output_type -> courses.GetCorequisitesResponse
+	118, // 198: courses.CoursesService.GetSharedCourses:output_type -> courses.GetSharedCoursesResponse
+	121, // 199: courses.CoursesService.SetGradingComponent:output_type -> courses.SetGradingComponentResponse
+	123, // 200: courses.CoursesService.RemoveGradingComponent:output_type -> courses.RemoveGradingComponentResponse
+	125, // 201: courses.CoursesService.GetGradingScheme:output_type -> courses.GetGradingSchemeResponse
+	127, // 202: courses.CoursesService.FinalizeGradingScheme:output_type -> courses.FinalizeGradingSchemeResponse
+	132, // 203: courses.CoursesService.GenerateCourseShareToken:output_type -> courses.GenerateCourseShareTokenResponse
+	134, // 204: courses.CoursesService.GetCourseByShareToken:output_type -> courses.GetCourseByShareTokenResponse
+	136, // 205: courses.CoursesService.GetCourseFeatures:output_type -> courses.GetCourseFeaturesResponse
+	139, // 206: courses.CoursesService.SetCourseFeature:output_type -> courses.SetCourseFeatureResponse
+	141, // 207: courses.CoursesService.GetAnnouncementCountByAuthor:output_type -> courses.GetAnnouncementCountByAuthorResponse
+	147, // 208: courses.CoursesService.GetDataShapeReport:output_type -> courses.GetDataShapeReportResponse
+	45,  // 209: courses.CoursesService.GetCourseStudentCount:output_type -> courses.GetCourseStudentCountResponse
+	144, // 210: courses.CoursesService.GetCourseSnapshot:output_type -> courses.GetCourseSnapshotResponse
+	78,  // 211: courses.CoursesService.UpdateAnnouncement:output_type -> courses.UpdateAnnouncementResponse
+	18,  // 212: courses.CoursesService.ChangeCourseID:output_type -> courses.ChangeCourseIDResponse
+	80,  // 213: courses.CoursesService.UpsertAnnouncementBySlug:output_type -> courses.UpsertAnnouncementBySlugResponse
+	91,  // 214: courses.CoursesService.CheckEnrollmentEligibility:output_type -> courses.CheckEnrollmentEligibilityResponse
+	155, // [155:215] is the sub-list for method output_type
+	95,  // [95:155] is the sub-list for method input_type
+	95,  // [95:95] is the sub-list for extension type_name
+	95,  // [95:95] is the sub-list for extension extendee
+	0,   // [0:95] is the sub-list for field type_name
 }
 
 func init() { file_courses_microservice_proto_init() }
@@ -2078,18 +10879,25 @@ func file_courses_microservice_proto_init() {
 	if File_courses_microservice_proto != nil {
 		return
 	}
+	file_courses_microservice_proto_msgTypes[42].OneofWrappers = []any{}
+	file_courses_microservice_proto_msgTypes[44].OneofWrappers = []any{}
+	file_courses_microservice_proto_msgTypes[46].OneofWrappers = []any{}
+	file_courses_microservice_proto_msgTypes[49].OneofWrappers = []any{}
+	file_courses_microservice_proto_msgTypes[70].OneofWrappers = []any{}
+	file_courses_microservice_proto_msgTypes[85].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_courses_microservice_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   34,
+			NumEnums:      7,
+			NumMessages:   145,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_courses_microservice_proto_goTypes,
 		DependencyIndexes: file_courses_microservice_proto_depIdxs,
+		EnumInfos:         file_courses_microservice_proto_enumTypes,
 		MessageInfos:      file_courses_microservice_proto_msgTypes,
 	}.Build()
 	File_courses_microservice_proto = out.File
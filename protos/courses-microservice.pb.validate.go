@@ -0,0 +1,18987 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: courses-microservice.proto
+
+package protos
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetCourseRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseRequestMultiError, or nil if none found.
+func (m *GetCourseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetCourseRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeCorequisites
+
+	// no validation rules for IncludeActivity
+
+	if len(errors) > 0 {
+		return GetCourseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCourseRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCourseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseRequestMultiError) AllErrors() []error { return m }
+
+// GetCourseRequestValidationError is the validation error returned by
+// GetCourseRequest.Validate if the designated constraints aren't met.
+type GetCourseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseRequestValidationError) ErrorName() string { return "GetCourseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCourseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseRequestValidationError{}
+
+// Validate checks the field values on GetCourseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseResponseMultiError, or nil if none found.
+func (m *GetCourseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCourse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCourseResponseValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCourseResponseValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCourse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCourseResponseValidationError{
+				field:  "Course",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ContentHash
+
+	if all {
+		switch v := interface{}(m.GetActivity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCourseResponseValidationError{
+					field:  "Activity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCourseResponseValidationError{
+					field:  "Activity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActivity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCourseResponseValidationError{
+				field:  "Activity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCourseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseResponseMultiError is an error wrapping multiple validation errors
+// returned by GetCourseResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetCourseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseResponseMultiError) AllErrors() []error { return m }
+
+// GetCourseResponseValidationError is the validation error returned by
+// GetCourseResponse.Validate if the designated constraints aren't met.
+type GetCourseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseResponseValidationError) ErrorName() string {
+	return "GetCourseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseResponseValidationError{}
+
+// Validate checks the field values on ActivitySummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ActivitySummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActivitySummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActivitySummaryMultiError, or nil if none found.
+func (m *ActivitySummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActivitySummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RecentAnnouncements
+
+	if len(errors) > 0 {
+		return ActivitySummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActivitySummaryMultiError is an error wrapping multiple validation errors
+// returned by ActivitySummary.ValidateAll() if the designated constraints
+// aren't met.
+type ActivitySummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActivitySummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActivitySummaryMultiError) AllErrors() []error { return m }
+
+// ActivitySummaryValidationError is the validation error returned by
+// ActivitySummary.Validate if the designated constraints aren't met.
+type ActivitySummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivitySummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivitySummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivitySummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivitySummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivitySummaryValidationError) ErrorName() string { return "ActivitySummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ActivitySummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivitySummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivitySummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivitySummaryValidationError{}
+
+// Validate checks the field values on CreateCourseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCourseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCourseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCourseRequestMultiError, or nil if none found.
+func (m *CreateCourseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCourseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if m.GetCourse() == nil {
+		err := CreateCourseRequestValidationError{
+			field:  "Course",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCourse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCourseRequestValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCourseRequestValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCourse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCourseRequestValidationError{
+				field:  "Course",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetInitialStaff() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateCourseRequestValidationError{
+						field:  fmt.Sprintf("InitialStaff[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateCourseRequestValidationError{
+						field:  fmt.Sprintf("InitialStaff[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateCourseRequestValidationError{
+					field:  fmt.Sprintf("InitialStaff[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateCourseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCourseRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCourseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCourseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCourseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCourseRequestMultiError) AllErrors() []error { return m }
+
+// CreateCourseRequestValidationError is the validation error returned by
+// CreateCourseRequest.Validate if the designated constraints aren't met.
+type CreateCourseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCourseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCourseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCourseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCourseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCourseRequestValidationError) ErrorName() string {
+	return "CreateCourseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCourseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCourseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCourseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCourseRequestValidationError{}
+
+// Validate checks the field values on StaffAssignment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StaffAssignment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StaffAssignment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StaffAssignmentMultiError, or nil if none found.
+func (m *StaffAssignment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StaffAssignment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStaffID()) < 1 {
+		err := StaffAssignmentValidationError{
+			field:  "StaffID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StaffAssignmentValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StaffAssignmentValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StaffAssignmentValidationError{
+				field:  "ValidFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StaffAssignmentValidationError{
+					field:  "ValidUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StaffAssignmentValidationError{
+					field:  "ValidUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StaffAssignmentValidationError{
+				field:  "ValidUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StaffAssignmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// StaffAssignmentMultiError is an error wrapping multiple validation errors
+// returned by StaffAssignment.ValidateAll() if the designated constraints
+// aren't met.
+type StaffAssignmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StaffAssignmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StaffAssignmentMultiError) AllErrors() []error { return m }
+
+// StaffAssignmentValidationError is the validation error returned by
+// StaffAssignment.Validate if the designated constraints aren't met.
+type StaffAssignmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StaffAssignmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StaffAssignmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StaffAssignmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StaffAssignmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StaffAssignmentValidationError) ErrorName() string { return "StaffAssignmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StaffAssignmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStaffAssignment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StaffAssignmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StaffAssignmentValidationError{}
+
+// Validate checks the field values on CreateCourseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCourseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCourseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCourseResponseMultiError, or nil if none found.
+func (m *CreateCourseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCourseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCourse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCourseResponseValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCourseResponseValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCourse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCourseResponseValidationError{
+				field:  "Course",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCourseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCourseResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateCourseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCourseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCourseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCourseResponseMultiError) AllErrors() []error { return m }
+
+// CreateCourseResponseValidationError is the validation error returned by
+// CreateCourseResponse.Validate if the designated constraints aren't met.
+type CreateCourseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCourseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCourseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCourseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCourseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCourseResponseValidationError) ErrorName() string {
+	return "CreateCourseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCourseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCourseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCourseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCourseResponseValidationError{}
+
+// Validate checks the field values on UpdateCourseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCourseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCourseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCourseRequestMultiError, or nil if none found.
+func (m *UpdateCourseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCourseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if m.GetCourse() == nil {
+		err := UpdateCourseRequestValidationError{
+			field:  "Course",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCourse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCourseRequestValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCourseRequestValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCourse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCourseRequestValidationError{
+				field:  "Course",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCourseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCourseRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCourseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCourseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCourseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCourseRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCourseRequestValidationError is the validation error returned by
+// UpdateCourseRequest.Validate if the designated constraints aren't met.
+type UpdateCourseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCourseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCourseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCourseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCourseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCourseRequestValidationError) ErrorName() string {
+	return "UpdateCourseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCourseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCourseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCourseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCourseRequestValidationError{}
+
+// Validate checks the field values on UpdateCourseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCourseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCourseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCourseResponseMultiError, or nil if none found.
+func (m *UpdateCourseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCourseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCourse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCourseResponseValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCourseResponseValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCourse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCourseResponseValidationError{
+				field:  "Course",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCourseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCourseResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateCourseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCourseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCourseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCourseResponseMultiError) AllErrors() []error { return m }
+
+// UpdateCourseResponseValidationError is the validation error returned by
+// UpdateCourseResponse.Validate if the designated constraints aren't met.
+type UpdateCourseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCourseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCourseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCourseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCourseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCourseResponseValidationError) ErrorName() string {
+	return "UpdateCourseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCourseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCourseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCourseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCourseResponseValidationError{}
+
+// Validate checks the field values on DeleteCourseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCourseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCourseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCourseRequestMultiError, or nil if none found.
+func (m *DeleteCourseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCourseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := DeleteCourseRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCourseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCourseRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCourseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCourseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCourseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCourseRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCourseRequestValidationError is the validation error returned by
+// DeleteCourseRequest.Validate if the designated constraints aren't met.
+type DeleteCourseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCourseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCourseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCourseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCourseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCourseRequestValidationError) ErrorName() string {
+	return "DeleteCourseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCourseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCourseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCourseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCourseRequestValidationError{}
+
+// Validate checks the field values on DeleteCourseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCourseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCourseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCourseResponseMultiError, or nil if none found.
+func (m *DeleteCourseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCourseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteCourseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCourseResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteCourseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCourseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCourseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCourseResponseMultiError) AllErrors() []error { return m }
+
+// DeleteCourseResponseValidationError is the validation error returned by
+// DeleteCourseResponse.Validate if the designated constraints aren't met.
+type DeleteCourseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCourseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCourseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCourseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCourseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCourseResponseValidationError) ErrorName() string {
+	return "DeleteCourseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCourseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCourseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCourseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCourseResponseValidationError{}
+
+// Validate checks the field values on ChangeCourseIDRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeCourseIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeCourseIDRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeCourseIDRequestMultiError, or nil if none found.
+func (m *ChangeCourseIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeCourseIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := ChangeCourseIDRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNewCourseID()) < 1 {
+		err := ChangeCourseIDRequestValidationError{
+			field:  "NewCourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChangeCourseIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeCourseIDRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangeCourseIDRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeCourseIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeCourseIDRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeCourseIDRequestMultiError) AllErrors() []error { return m }
+
+// ChangeCourseIDRequestValidationError is the validation error returned by
+// ChangeCourseIDRequest.Validate if the designated constraints aren't met.
+type ChangeCourseIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeCourseIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeCourseIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeCourseIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeCourseIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeCourseIDRequestValidationError) ErrorName() string {
+	return "ChangeCourseIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeCourseIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeCourseIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeCourseIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeCourseIDRequestValidationError{}
+
+// Validate checks the field values on ChangeCourseIDResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeCourseIDResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeCourseIDResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeCourseIDResponseMultiError, or nil if none found.
+func (m *ChangeCourseIDResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeCourseIDResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ChangeCourseIDResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeCourseIDResponseMultiError is an error wrapping multiple validation
+// errors returned by ChangeCourseIDResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeCourseIDResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeCourseIDResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeCourseIDResponseMultiError) AllErrors() []error { return m }
+
+// ChangeCourseIDResponseValidationError is the validation error returned by
+// ChangeCourseIDResponse.Validate if the designated constraints aren't met.
+type ChangeCourseIDResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeCourseIDResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeCourseIDResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeCourseIDResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeCourseIDResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeCourseIDResponseValidationError) ErrorName() string {
+	return "ChangeCourseIDResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeCourseIDResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeCourseIDResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeCourseIDResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeCourseIDResponseValidationError{}
+
+// Validate checks the field values on AddStudentRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddStudentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddStudentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddStudentRequestMultiError, or nil if none found.
+func (m *AddStudentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddStudentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := AddStudentRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStudentID()) < 1 {
+		err := AddStudentRequestValidationError{
+			field:  "StudentID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OverrideLimit
+
+	// no validation rules for AllowConflictingRole
+
+	if len(errors) > 0 {
+		return AddStudentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddStudentRequestMultiError is an error wrapping multiple validation errors
+// returned by AddStudentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddStudentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddStudentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddStudentRequestMultiError) AllErrors() []error { return m }
+
+// AddStudentRequestValidationError is the validation error returned by
+// AddStudentRequest.Validate if the designated constraints aren't met.
+type AddStudentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddStudentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddStudentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddStudentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddStudentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddStudentRequestValidationError) ErrorName() string {
+	return "AddStudentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddStudentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddStudentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddStudentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddStudentRequestValidationError{}
+
+// Validate checks the field values on AddStudentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddStudentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddStudentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddStudentResponseMultiError, or nil if none found.
+func (m *AddStudentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddStudentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddStudentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddStudentResponseMultiError is an error wrapping multiple validation errors
+// returned by AddStudentResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AddStudentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddStudentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddStudentResponseMultiError) AllErrors() []error { return m }
+
+// AddStudentResponseValidationError is the validation error returned by
+// AddStudentResponse.Validate if the designated constraints aren't met.
+type AddStudentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddStudentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddStudentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddStudentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddStudentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddStudentResponseValidationError) ErrorName() string {
+	return "AddStudentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddStudentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddStudentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddStudentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddStudentResponseValidationError{}
+
+// Validate checks the field values on RemoveStudentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveStudentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveStudentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveStudentRequestMultiError, or nil if none found.
+func (m *RemoveStudentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveStudentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := RemoveStudentRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStudentID()) < 1 {
+		err := RemoveStudentRequestValidationError{
+			field:  "StudentID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveStudentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveStudentRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveStudentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveStudentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveStudentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveStudentRequestMultiError) AllErrors() []error { return m }
+
+// RemoveStudentRequestValidationError is the validation error returned by
+// RemoveStudentRequest.Validate if the designated constraints aren't met.
+type RemoveStudentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveStudentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveStudentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveStudentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveStudentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveStudentRequestValidationError) ErrorName() string {
+	return "RemoveStudentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveStudentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveStudentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveStudentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveStudentRequestValidationError{}
+
+// Validate checks the field values on RemoveStudentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveStudentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveStudentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveStudentResponseMultiError, or nil if none found.
+func (m *RemoveStudentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveStudentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveStudentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveStudentResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveStudentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveStudentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveStudentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveStudentResponseMultiError) AllErrors() []error { return m }
+
+// RemoveStudentResponseValidationError is the validation error returned by
+// RemoveStudentResponse.Validate if the designated constraints aren't met.
+type RemoveStudentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveStudentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveStudentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveStudentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveStudentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveStudentResponseValidationError) ErrorName() string {
+	return "RemoveStudentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveStudentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveStudentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveStudentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveStudentResponseValidationError{}
+
+// Validate checks the field values on SetStudentStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetStudentStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetStudentStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetStudentStatusRequestMultiError, or nil if none found.
+func (m *SetStudentStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetStudentStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := SetStudentStatusRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStudentID()) < 1 {
+		err := SetStudentStatusRequestValidationError{
+			field:  "StudentID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetStudentStatusRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := SetStudentStatusRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [enrolled dropped withdrawn completed]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetStudentStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetStudentStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by SetStudentStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetStudentStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetStudentStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetStudentStatusRequestMultiError) AllErrors() []error { return m }
+
+// SetStudentStatusRequestValidationError is the validation error returned by
+// SetStudentStatusRequest.Validate if the designated constraints aren't met.
+type SetStudentStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetStudentStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetStudentStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetStudentStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetStudentStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetStudentStatusRequestValidationError) ErrorName() string {
+	return "SetStudentStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetStudentStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetStudentStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetStudentStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetStudentStatusRequestValidationError{}
+
+var _SetStudentStatusRequest_Status_InLookup = map[string]struct{}{
+	"enrolled":  {},
+	"dropped":   {},
+	"withdrawn": {},
+	"completed": {},
+}
+
+// Validate checks the field values on SetStudentStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetStudentStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetStudentStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetStudentStatusResponseMultiError, or nil if none found.
+func (m *SetStudentStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetStudentStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetStudentStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetStudentStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by SetStudentStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetStudentStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetStudentStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetStudentStatusResponseMultiError) AllErrors() []error { return m }
+
+// SetStudentStatusResponseValidationError is the validation error returned by
+// SetStudentStatusResponse.Validate if the designated constraints aren't met.
+type SetStudentStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetStudentStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetStudentStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetStudentStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetStudentStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetStudentStatusResponseValidationError) ErrorName() string {
+	return "SetStudentStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetStudentStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetStudentStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetStudentStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetStudentStatusResponseValidationError{}
+
+// Validate checks the field values on GetStudentStatusTransitionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetStudentStatusTransitionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStudentStatusTransitionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetStudentStatusTransitionsRequestMultiError, or nil if none found.
+func (m *GetStudentStatusTransitionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStudentStatusTransitionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return GetStudentStatusTransitionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStudentStatusTransitionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetStudentStatusTransitionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStudentStatusTransitionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStudentStatusTransitionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStudentStatusTransitionsRequestMultiError) AllErrors() []error { return m }
+
+// GetStudentStatusTransitionsRequestValidationError is the validation error
+// returned by GetStudentStatusTransitionsRequest.Validate if the designated
+// constraints aren't met.
+type GetStudentStatusTransitionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStudentStatusTransitionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStudentStatusTransitionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStudentStatusTransitionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStudentStatusTransitionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStudentStatusTransitionsRequestValidationError) ErrorName() string {
+	return "GetStudentStatusTransitionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStudentStatusTransitionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStudentStatusTransitionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStudentStatusTransitionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStudentStatusTransitionsRequestValidationError{}
+
+// Validate checks the field values on GetStudentStatusTransitionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetStudentStatusTransitionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStudentStatusTransitionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetStudentStatusTransitionsResponseMultiError, or nil if none found.
+func (m *GetStudentStatusTransitionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStudentStatusTransitionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTransitions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetStudentStatusTransitionsResponseValidationError{
+						field:  fmt.Sprintf("Transitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetStudentStatusTransitionsResponseValidationError{
+						field:  fmt.Sprintf("Transitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetStudentStatusTransitionsResponseValidationError{
+					field:  fmt.Sprintf("Transitions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetStudentStatusTransitionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStudentStatusTransitionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetStudentStatusTransitionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetStudentStatusTransitionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStudentStatusTransitionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStudentStatusTransitionsResponseMultiError) AllErrors() []error { return m }
+
+// GetStudentStatusTransitionsResponseValidationError is the validation error
+// returned by GetStudentStatusTransitionsResponse.Validate if the designated
+// constraints aren't met.
+type GetStudentStatusTransitionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStudentStatusTransitionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStudentStatusTransitionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStudentStatusTransitionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStudentStatusTransitionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStudentStatusTransitionsResponseValidationError) ErrorName() string {
+	return "GetStudentStatusTransitionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStudentStatusTransitionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStudentStatusTransitionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStudentStatusTransitionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStudentStatusTransitionsResponseValidationError{}
+
+// Validate checks the field values on StudentStatusTransitions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StudentStatusTransitions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StudentStatusTransitions with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StudentStatusTransitionsMultiError, or nil if none found.
+func (m *StudentStatusTransitions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StudentStatusTransitions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return StudentStatusTransitionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// StudentStatusTransitionsMultiError is an error wrapping multiple validation
+// errors returned by StudentStatusTransitions.ValidateAll() if the designated
+// constraints aren't met.
+type StudentStatusTransitionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StudentStatusTransitionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StudentStatusTransitionsMultiError) AllErrors() []error { return m }
+
+// StudentStatusTransitionsValidationError is the validation error returned by
+// StudentStatusTransitions.Validate if the designated constraints aren't met.
+type StudentStatusTransitionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StudentStatusTransitionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StudentStatusTransitionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StudentStatusTransitionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StudentStatusTransitionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StudentStatusTransitionsValidationError) ErrorName() string {
+	return "StudentStatusTransitionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StudentStatusTransitionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStudentStatusTransitions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StudentStatusTransitionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StudentStatusTransitionsValidationError{}
+
+// Validate checks the field values on BatchAddStudentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchAddStudentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchAddStudentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchAddStudentsRequestMultiError, or nil if none found.
+func (m *BatchAddStudentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchAddStudentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := BatchAddStudentsRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetStudentIDs()); l < 1 || l > 5000 {
+		err := BatchAddStudentsRequestValidationError{
+			field:  "StudentIDs",
+			reason: "value must contain between 1 and 5000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetStudentIDs() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := BatchAddStudentsRequestValidationError{
+				field:  fmt.Sprintf("StudentIDs[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for OverrideLimit
+
+	// no validation rules for AllowConflictingRole
+
+	if len(errors) > 0 {
+		return BatchAddStudentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchAddStudentsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchAddStudentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchAddStudentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchAddStudentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchAddStudentsRequestMultiError) AllErrors() []error { return m }
+
+// BatchAddStudentsRequestValidationError is the validation error returned by
+// BatchAddStudentsRequest.Validate if the designated constraints aren't met.
+type BatchAddStudentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchAddStudentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchAddStudentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchAddStudentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchAddStudentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchAddStudentsRequestValidationError) ErrorName() string {
+	return "BatchAddStudentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchAddStudentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchAddStudentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchAddStudentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchAddStudentsRequestValidationError{}
+
+// Validate checks the field values on BatchAddStudentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchAddStudentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchAddStudentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchAddStudentsResponseMultiError, or nil if none found.
+func (m *BatchAddStudentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchAddStudentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchAddStudentsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchAddStudentsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchAddStudentsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AddedCount
+
+	// no validation rules for SkippedCount
+
+	if len(errors) > 0 {
+		return BatchAddStudentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchAddStudentsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchAddStudentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchAddStudentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchAddStudentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchAddStudentsResponseMultiError) AllErrors() []error { return m }
+
+// BatchAddStudentsResponseValidationError is the validation error returned by
+// BatchAddStudentsResponse.Validate if the designated constraints aren't met.
+type BatchAddStudentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchAddStudentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchAddStudentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchAddStudentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchAddStudentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchAddStudentsResponseValidationError) ErrorName() string {
+	return "BatchAddStudentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchAddStudentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchAddStudentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchAddStudentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchAddStudentsResponseValidationError{}
+
+// Validate checks the field values on BatchEnrollmentResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchEnrollmentResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchEnrollmentResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchEnrollmentResultMultiError, or nil if none found.
+func (m *BatchEnrollmentResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchEnrollmentResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StudentID
+
+	// no validation rules for Outcome
+
+	if len(errors) > 0 {
+		return BatchEnrollmentResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchEnrollmentResultMultiError is an error wrapping multiple validation
+// errors returned by BatchEnrollmentResult.ValidateAll() if the designated
+// constraints aren't met.
+type BatchEnrollmentResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchEnrollmentResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchEnrollmentResultMultiError) AllErrors() []error { return m }
+
+// BatchEnrollmentResultValidationError is the validation error returned by
+// BatchEnrollmentResult.Validate if the designated constraints aren't met.
+type BatchEnrollmentResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchEnrollmentResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchEnrollmentResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchEnrollmentResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchEnrollmentResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchEnrollmentResultValidationError) ErrorName() string {
+	return "BatchEnrollmentResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchEnrollmentResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchEnrollmentResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchEnrollmentResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchEnrollmentResultValidationError{}
+
+// Validate checks the field values on TransferEnrollmentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferEnrollmentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferEnrollmentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferEnrollmentsRequestMultiError, or nil if none found.
+func (m *TransferEnrollmentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferEnrollmentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetFromCourseID()) < 1 {
+		err := TransferEnrollmentsRequestValidationError{
+			field:  "FromCourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetToCourseID()) < 1 {
+		err := TransferEnrollmentsRequestValidationError{
+			field:  "ToCourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TransferEnrollmentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferEnrollmentsRequestMultiError is an error wrapping multiple
+// validation errors returned by TransferEnrollmentsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type TransferEnrollmentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferEnrollmentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferEnrollmentsRequestMultiError) AllErrors() []error { return m }
+
+// TransferEnrollmentsRequestValidationError is the validation error returned
+// by TransferEnrollmentsRequest.Validate if the designated constraints aren't met.
+type TransferEnrollmentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferEnrollmentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferEnrollmentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferEnrollmentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferEnrollmentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferEnrollmentsRequestValidationError) ErrorName() string {
+	return "TransferEnrollmentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferEnrollmentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferEnrollmentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferEnrollmentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferEnrollmentsRequestValidationError{}
+
+// Validate checks the field values on TransferEnrollmentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferEnrollmentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferEnrollmentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferEnrollmentsResponseMultiError, or nil if none found.
+func (m *TransferEnrollmentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferEnrollmentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransferredCount
+
+	// no validation rules for SkippedCount
+
+	if len(errors) > 0 {
+		return TransferEnrollmentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferEnrollmentsResponseMultiError is an error wrapping multiple
+// validation errors returned by TransferEnrollmentsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type TransferEnrollmentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferEnrollmentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferEnrollmentsResponseMultiError) AllErrors() []error { return m }
+
+// TransferEnrollmentsResponseValidationError is the validation error returned
+// by TransferEnrollmentsResponse.Validate if the designated constraints
+// aren't met.
+type TransferEnrollmentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferEnrollmentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferEnrollmentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferEnrollmentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferEnrollmentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferEnrollmentsResponseValidationError) ErrorName() string {
+	return "TransferEnrollmentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferEnrollmentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferEnrollmentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferEnrollmentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferEnrollmentsResponseValidationError{}
+
+// Validate checks the field values on FindDuplicateEnrollmentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FindDuplicateEnrollmentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindDuplicateEnrollmentsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// FindDuplicateEnrollmentsRequestMultiError, or nil if none found.
+func (m *FindDuplicateEnrollmentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindDuplicateEnrollmentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return FindDuplicateEnrollmentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindDuplicateEnrollmentsRequestMultiError is an error wrapping multiple
+// validation errors returned by FindDuplicateEnrollmentsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type FindDuplicateEnrollmentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindDuplicateEnrollmentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindDuplicateEnrollmentsRequestMultiError) AllErrors() []error { return m }
+
+// FindDuplicateEnrollmentsRequestValidationError is the validation error
+// returned by FindDuplicateEnrollmentsRequest.Validate if the designated
+// constraints aren't met.
+type FindDuplicateEnrollmentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindDuplicateEnrollmentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindDuplicateEnrollmentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindDuplicateEnrollmentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindDuplicateEnrollmentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindDuplicateEnrollmentsRequestValidationError) ErrorName() string {
+	return "FindDuplicateEnrollmentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindDuplicateEnrollmentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindDuplicateEnrollmentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindDuplicateEnrollmentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindDuplicateEnrollmentsRequestValidationError{}
+
+// Validate checks the field values on FindDuplicateEnrollmentsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *FindDuplicateEnrollmentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindDuplicateEnrollmentsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// FindDuplicateEnrollmentsResponseMultiError, or nil if none found.
+func (m *FindDuplicateEnrollmentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindDuplicateEnrollmentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDuplicates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FindDuplicateEnrollmentsResponseValidationError{
+						field:  fmt.Sprintf("Duplicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FindDuplicateEnrollmentsResponseValidationError{
+						field:  fmt.Sprintf("Duplicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FindDuplicateEnrollmentsResponseValidationError{
+					field:  fmt.Sprintf("Duplicates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FindDuplicateEnrollmentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindDuplicateEnrollmentsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// FindDuplicateEnrollmentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type FindDuplicateEnrollmentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindDuplicateEnrollmentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindDuplicateEnrollmentsResponseMultiError) AllErrors() []error { return m }
+
+// FindDuplicateEnrollmentsResponseValidationError is the validation error
+// returned by FindDuplicateEnrollmentsResponse.Validate if the designated
+// constraints aren't met.
+type FindDuplicateEnrollmentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindDuplicateEnrollmentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindDuplicateEnrollmentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindDuplicateEnrollmentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindDuplicateEnrollmentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindDuplicateEnrollmentsResponseValidationError) ErrorName() string {
+	return "FindDuplicateEnrollmentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindDuplicateEnrollmentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindDuplicateEnrollmentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindDuplicateEnrollmentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindDuplicateEnrollmentsResponseValidationError{}
+
+// Validate checks the field values on DuplicateEnrollment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DuplicateEnrollment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DuplicateEnrollment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DuplicateEnrollmentMultiError, or nil if none found.
+func (m *DuplicateEnrollment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DuplicateEnrollment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CourseID
+
+	// no validation rules for StudentID
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return DuplicateEnrollmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// DuplicateEnrollmentMultiError is an error wrapping multiple validation
+// errors returned by DuplicateEnrollment.ValidateAll() if the designated
+// constraints aren't met.
+type DuplicateEnrollmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DuplicateEnrollmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DuplicateEnrollmentMultiError) AllErrors() []error { return m }
+
+// DuplicateEnrollmentValidationError is the validation error returned by
+// DuplicateEnrollment.Validate if the designated constraints aren't met.
+type DuplicateEnrollmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DuplicateEnrollmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DuplicateEnrollmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DuplicateEnrollmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DuplicateEnrollmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DuplicateEnrollmentValidationError) ErrorName() string {
+	return "DuplicateEnrollmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DuplicateEnrollmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDuplicateEnrollment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DuplicateEnrollmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DuplicateEnrollmentValidationError{}
+
+// Validate checks the field values on DeduplicateEnrollmentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeduplicateEnrollmentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeduplicateEnrollmentsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeduplicateEnrollmentsRequestMultiError, or nil if none found.
+func (m *DeduplicateEnrollmentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeduplicateEnrollmentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return DeduplicateEnrollmentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeduplicateEnrollmentsRequestMultiError is an error wrapping multiple
+// validation errors returned by DeduplicateEnrollmentsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeduplicateEnrollmentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeduplicateEnrollmentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeduplicateEnrollmentsRequestMultiError) AllErrors() []error { return m }
+
+// DeduplicateEnrollmentsRequestValidationError is the validation error
+// returned by DeduplicateEnrollmentsRequest.Validate if the designated
+// constraints aren't met.
+type DeduplicateEnrollmentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeduplicateEnrollmentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeduplicateEnrollmentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeduplicateEnrollmentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeduplicateEnrollmentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeduplicateEnrollmentsRequestValidationError) ErrorName() string {
+	return "DeduplicateEnrollmentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeduplicateEnrollmentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeduplicateEnrollmentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeduplicateEnrollmentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeduplicateEnrollmentsRequestValidationError{}
+
+// Validate checks the field values on DeduplicateEnrollmentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeduplicateEnrollmentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeduplicateEnrollmentsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeduplicateEnrollmentsResponseMultiError, or nil if none found.
+func (m *DeduplicateEnrollmentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeduplicateEnrollmentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RemovedCount
+
+	if len(errors) > 0 {
+		return DeduplicateEnrollmentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeduplicateEnrollmentsResponseMultiError is an error wrapping multiple
+// validation errors returned by DeduplicateEnrollmentsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeduplicateEnrollmentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeduplicateEnrollmentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeduplicateEnrollmentsResponseMultiError) AllErrors() []error { return m }
+
+// DeduplicateEnrollmentsResponseValidationError is the validation error
+// returned by DeduplicateEnrollmentsResponse.Validate if the designated
+// constraints aren't met.
+type DeduplicateEnrollmentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeduplicateEnrollmentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeduplicateEnrollmentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeduplicateEnrollmentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeduplicateEnrollmentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeduplicateEnrollmentsResponseValidationError) ErrorName() string {
+	return "DeduplicateEnrollmentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeduplicateEnrollmentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeduplicateEnrollmentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeduplicateEnrollmentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeduplicateEnrollmentsResponseValidationError{}
+
+// Validate checks the field values on AddStaffRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddStaffRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddStaffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddStaffRequestMultiError, or nil if none found.
+func (m *AddStaffRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddStaffRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := AddStaffRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStaffID()) < 1 {
+		err := AddStaffRequestValidationError{
+			field:  "StaffID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddStaffRequestValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddStaffRequestValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddStaffRequestValidationError{
+				field:  "ValidFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddStaffRequestValidationError{
+					field:  "ValidUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddStaffRequestValidationError{
+					field:  "ValidUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddStaffRequestValidationError{
+				field:  "ValidUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AllowConflictingRole
+
+	if len(errors) > 0 {
+		return AddStaffRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddStaffRequestMultiError is an error wrapping multiple validation errors
+// returned by AddStaffRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddStaffRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddStaffRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddStaffRequestMultiError) AllErrors() []error { return m }
+
+// AddStaffRequestValidationError is the validation error returned by
+// AddStaffRequest.Validate if the designated constraints aren't met.
+type AddStaffRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddStaffRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddStaffRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddStaffRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddStaffRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddStaffRequestValidationError) ErrorName() string { return "AddStaffRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddStaffRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddStaffRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddStaffRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddStaffRequestValidationError{}
+
+// Validate checks the field values on AddStaffResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddStaffResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddStaffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddStaffResponseMultiError, or nil if none found.
+func (m *AddStaffResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddStaffResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddStaffResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddStaffResponseMultiError is an error wrapping multiple validation errors
+// returned by AddStaffResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AddStaffResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddStaffResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddStaffResponseMultiError) AllErrors() []error { return m }
+
+// AddStaffResponseValidationError is the validation error returned by
+// AddStaffResponse.Validate if the designated constraints aren't met.
+type AddStaffResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddStaffResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddStaffResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddStaffResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddStaffResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddStaffResponseValidationError) ErrorName() string { return "AddStaffResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddStaffResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddStaffResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddStaffResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddStaffResponseValidationError{}
+
+// Validate checks the field values on RemoveStaffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveStaffRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveStaffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveStaffRequestMultiError, or nil if none found.
+func (m *RemoveStaffRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveStaffRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := RemoveStaffRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStaffID()) < 1 {
+		err := RemoveStaffRequestValidationError{
+			field:  "StaffID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveStaffRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveStaffRequestMultiError is an error wrapping multiple validation errors
+// returned by RemoveStaffRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RemoveStaffRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveStaffRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveStaffRequestMultiError) AllErrors() []error { return m }
+
+// RemoveStaffRequestValidationError is the validation error returned by
+// RemoveStaffRequest.Validate if the designated constraints aren't met.
+type RemoveStaffRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveStaffRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveStaffRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveStaffRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveStaffRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveStaffRequestValidationError) ErrorName() string {
+	return "RemoveStaffRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveStaffRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveStaffRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveStaffRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveStaffRequestValidationError{}
+
+// Validate checks the field values on RemoveStaffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveStaffResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveStaffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveStaffResponseMultiError, or nil if none found.
+func (m *RemoveStaffResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveStaffResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveStaffResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveStaffResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveStaffResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveStaffResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveStaffResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveStaffResponseMultiError) AllErrors() []error { return m }
+
+// RemoveStaffResponseValidationError is the validation error returned by
+// RemoveStaffResponse.Validate if the designated constraints aren't met.
+type RemoveStaffResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveStaffResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveStaffResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveStaffResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveStaffResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveStaffResponseValidationError) ErrorName() string {
+	return "RemoveStaffResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveStaffResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveStaffResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveStaffResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveStaffResponseValidationError{}
+
+// Validate checks the field values on GetCourseStudentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseStudentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseStudentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseStudentsRequestMultiError, or nil if none found.
+func (m *GetCourseStudentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseStudentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetCourseStudentsRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeInactive
+
+	if len(errors) > 0 {
+		return GetCourseStudentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseStudentsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCourseStudentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCourseStudentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseStudentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseStudentsRequestMultiError) AllErrors() []error { return m }
+
+// GetCourseStudentsRequestValidationError is the validation error returned by
+// GetCourseStudentsRequest.Validate if the designated constraints aren't met.
+type GetCourseStudentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseStudentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseStudentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseStudentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseStudentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseStudentsRequestValidationError) ErrorName() string {
+	return "GetCourseStudentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseStudentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseStudentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseStudentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseStudentsRequestValidationError{}
+
+// Validate checks the field values on GetCourseStudentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseStudentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseStudentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseStudentsResponseMultiError, or nil if none found.
+func (m *GetCourseStudentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseStudentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetCourseStudentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseStudentsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCourseStudentsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetCourseStudentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseStudentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseStudentsResponseMultiError) AllErrors() []error { return m }
+
+// GetCourseStudentsResponseValidationError is the validation error returned by
+// GetCourseStudentsResponse.Validate if the designated constraints aren't met.
+type GetCourseStudentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseStudentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseStudentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseStudentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseStudentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseStudentsResponseValidationError) ErrorName() string {
+	return "GetCourseStudentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseStudentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseStudentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseStudentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseStudentsResponseValidationError{}
+
+// Validate checks the field values on GetCourseStudentCountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseStudentCountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseStudentCountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseStudentCountRequestMultiError, or nil if none found.
+func (m *GetCourseStudentCountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseStudentCountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetCourseStudentCountRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCourseStudentCountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseStudentCountRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCourseStudentCountRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetCourseStudentCountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseStudentCountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseStudentCountRequestMultiError) AllErrors() []error { return m }
+
+// GetCourseStudentCountRequestValidationError is the validation error returned
+// by GetCourseStudentCountRequest.Validate if the designated constraints
+// aren't met.
+type GetCourseStudentCountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseStudentCountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseStudentCountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseStudentCountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseStudentCountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseStudentCountRequestValidationError) ErrorName() string {
+	return "GetCourseStudentCountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseStudentCountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseStudentCountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseStudentCountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseStudentCountRequestValidationError{}
+
+// Validate checks the field values on GetCourseStudentCountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseStudentCountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseStudentCountResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetCourseStudentCountResponseMultiError, or nil if none found.
+func (m *GetCourseStudentCountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseStudentCountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return GetCourseStudentCountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseStudentCountResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCourseStudentCountResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetCourseStudentCountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseStudentCountResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseStudentCountResponseMultiError) AllErrors() []error { return m }
+
+// GetCourseStudentCountResponseValidationError is the validation error
+// returned by GetCourseStudentCountResponse.Validate if the designated
+// constraints aren't met.
+type GetCourseStudentCountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseStudentCountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseStudentCountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseStudentCountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseStudentCountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseStudentCountResponseValidationError) ErrorName() string {
+	return "GetCourseStudentCountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseStudentCountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseStudentCountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseStudentCountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseStudentCountResponseValidationError{}
+
+// Validate checks the field values on GetCourseStaffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseStaffRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseStaffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseStaffRequestMultiError, or nil if none found.
+func (m *GetCourseStaffRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseStaffRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetCourseStaffRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeExpired
+
+	if m.GetPageSize() < 0 {
+		err := GetCourseStaffRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeContacts
+
+	if len(errors) > 0 {
+		return GetCourseStaffRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseStaffRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCourseStaffRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCourseStaffRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseStaffRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseStaffRequestMultiError) AllErrors() []error { return m }
+
+// GetCourseStaffRequestValidationError is the validation error returned by
+// GetCourseStaffRequest.Validate if the designated constraints aren't met.
+type GetCourseStaffRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseStaffRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseStaffRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseStaffRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseStaffRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseStaffRequestValidationError) ErrorName() string {
+	return "GetCourseStaffRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseStaffRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseStaffRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseStaffRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseStaffRequestValidationError{}
+
+// Validate checks the field values on GetCourseStaffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseStaffResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseStaffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseStaffResponseMultiError, or nil if none found.
+func (m *GetCourseStaffResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseStaffResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for PageSize
+
+	// no validation rules for TotalCount
+
+	for idx, item := range m.GetContacts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCourseStaffResponseValidationError{
+						field:  fmt.Sprintf("Contacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCourseStaffResponseValidationError{
+						field:  fmt.Sprintf("Contacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCourseStaffResponseValidationError{
+					field:  fmt.Sprintf("Contacts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContactsIncomplete
+
+	if len(errors) > 0 {
+		return GetCourseStaffResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseStaffResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCourseStaffResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCourseStaffResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseStaffResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseStaffResponseMultiError) AllErrors() []error { return m }
+
+// GetCourseStaffResponseValidationError is the validation error returned by
+// GetCourseStaffResponse.Validate if the designated constraints aren't met.
+type GetCourseStaffResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseStaffResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseStaffResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseStaffResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseStaffResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseStaffResponseValidationError) ErrorName() string {
+	return "GetCourseStaffResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseStaffResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseStaffResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseStaffResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseStaffResponseValidationError{}
+
+// Validate checks the field values on StaffContact with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StaffContact) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StaffContact with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StaffContactMultiError, or
+// nil if none found.
+func (m *StaffContact) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StaffContact) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StaffID
+
+	// no validation rules for Name
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return StaffContactMultiError(errors)
+	}
+
+	return nil
+}
+
+// StaffContactMultiError is an error wrapping multiple validation errors
+// returned by StaffContact.ValidateAll() if the designated constraints aren't met.
+type StaffContactMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StaffContactMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StaffContactMultiError) AllErrors() []error { return m }
+
+// StaffContactValidationError is the validation error returned by
+// StaffContact.Validate if the designated constraints aren't met.
+type StaffContactValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StaffContactValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StaffContactValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StaffContactValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StaffContactValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StaffContactValidationError) ErrorName() string { return "StaffContactValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StaffContactValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStaffContact.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StaffContactValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StaffContactValidationError{}
+
+// Validate checks the field values on GetStudentCoursesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStudentCoursesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStudentCoursesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStudentCoursesRequestMultiError, or nil if none found.
+func (m *GetStudentCoursesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStudentCoursesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetStudentID()) < 1 {
+		err := GetStudentCoursesRequestValidationError{
+			field:  "StudentID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSemester() != "" {
+
+		if !_GetStudentCoursesRequest_Semester_Pattern.MatchString(m.GetSemester()) {
+			err := GetStudentCoursesRequestValidationError{
+				field:  "Semester",
+				reason: "value does not match regex pattern \"^(Winter|Spring|Summer)[ _][0-9]{4}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Strict != nil {
+		// no validation rules for Strict
+	}
+
+	if len(errors) > 0 {
+		return GetStudentCoursesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStudentCoursesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStudentCoursesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStudentCoursesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStudentCoursesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStudentCoursesRequestMultiError) AllErrors() []error { return m }
+
+// GetStudentCoursesRequestValidationError is the validation error returned by
+// GetStudentCoursesRequest.Validate if the designated constraints aren't met.
+type GetStudentCoursesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStudentCoursesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStudentCoursesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStudentCoursesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStudentCoursesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStudentCoursesRequestValidationError) ErrorName() string {
+	return "GetStudentCoursesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStudentCoursesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStudentCoursesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStudentCoursesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStudentCoursesRequestValidationError{}
+
+var _GetStudentCoursesRequest_Semester_Pattern = regexp.MustCompile("^(Winter|Spring|Summer)[ _][0-9]{4}$")
+
+// Validate checks the field values on GetStudentCoursesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStudentCoursesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStudentCoursesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStudentCoursesResponseMultiError, or nil if none found.
+func (m *GetStudentCoursesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStudentCoursesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetStudentCoursesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStudentCoursesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetStudentCoursesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetStudentCoursesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStudentCoursesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStudentCoursesResponseMultiError) AllErrors() []error { return m }
+
+// GetStudentCoursesResponseValidationError is the validation error returned by
+// GetStudentCoursesResponse.Validate if the designated constraints aren't met.
+type GetStudentCoursesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStudentCoursesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStudentCoursesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStudentCoursesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStudentCoursesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStudentCoursesResponseValidationError) ErrorName() string {
+	return "GetStudentCoursesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStudentCoursesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStudentCoursesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStudentCoursesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStudentCoursesResponseValidationError{}
+
+// Validate checks the field values on GetEnrollmentsForStudentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEnrollmentsForStudentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEnrollmentsForStudentRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetEnrollmentsForStudentRequestMultiError, or nil if none found.
+func (m *GetEnrollmentsForStudentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEnrollmentsForStudentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetStudentID()) < 1 {
+		err := GetEnrollmentsForStudentRequestValidationError{
+			field:  "StudentID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Strict != nil {
+		// no validation rules for Strict
+	}
+
+	if len(errors) > 0 {
+		return GetEnrollmentsForStudentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEnrollmentsForStudentRequestMultiError is an error wrapping multiple
+// validation errors returned by GetEnrollmentsForStudentRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetEnrollmentsForStudentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEnrollmentsForStudentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEnrollmentsForStudentRequestMultiError) AllErrors() []error { return m }
+
+// GetEnrollmentsForStudentRequestValidationError is the validation error
+// returned by GetEnrollmentsForStudentRequest.Validate if the designated
+// constraints aren't met.
+type GetEnrollmentsForStudentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEnrollmentsForStudentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEnrollmentsForStudentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEnrollmentsForStudentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEnrollmentsForStudentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEnrollmentsForStudentRequestValidationError) ErrorName() string {
+	return "GetEnrollmentsForStudentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEnrollmentsForStudentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEnrollmentsForStudentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEnrollmentsForStudentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEnrollmentsForStudentRequestValidationError{}
+
+// Validate checks the field values on GetEnrollmentsForStudentResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetEnrollmentsForStudentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEnrollmentsForStudentResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetEnrollmentsForStudentResponseMultiError, or nil if none found.
+func (m *GetEnrollmentsForStudentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEnrollmentsForStudentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEnrollments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetEnrollmentsForStudentResponseValidationError{
+						field:  fmt.Sprintf("Enrollments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetEnrollmentsForStudentResponseValidationError{
+						field:  fmt.Sprintf("Enrollments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetEnrollmentsForStudentResponseValidationError{
+					field:  fmt.Sprintf("Enrollments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetEnrollmentsForStudentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEnrollmentsForStudentResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetEnrollmentsForStudentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetEnrollmentsForStudentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEnrollmentsForStudentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEnrollmentsForStudentResponseMultiError) AllErrors() []error { return m }
+
+// GetEnrollmentsForStudentResponseValidationError is the validation error
+// returned by GetEnrollmentsForStudentResponse.Validate if the designated
+// constraints aren't met.
+type GetEnrollmentsForStudentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEnrollmentsForStudentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEnrollmentsForStudentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEnrollmentsForStudentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEnrollmentsForStudentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEnrollmentsForStudentResponseValidationError) ErrorName() string {
+	return "GetEnrollmentsForStudentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEnrollmentsForStudentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEnrollmentsForStudentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEnrollmentsForStudentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEnrollmentsForStudentResponseValidationError{}
+
+// Validate checks the field values on GetStudentCreditsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStudentCreditsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStudentCreditsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStudentCreditsRequestMultiError, or nil if none found.
+func (m *GetStudentCreditsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStudentCreditsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetStudentID()) < 1 {
+		err := GetStudentCreditsRequestValidationError{
+			field:  "StudentID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetStudentCreditsRequest_Semester_Pattern.MatchString(m.GetSemester()) {
+		err := GetStudentCreditsRequestValidationError{
+			field:  "Semester",
+			reason: "value does not match regex pattern \"^(Winter|Spring|Summer)[ _][0-9]{4}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Strict != nil {
+		// no validation rules for Strict
+	}
+
+	if len(errors) > 0 {
+		return GetStudentCreditsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStudentCreditsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStudentCreditsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStudentCreditsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStudentCreditsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStudentCreditsRequestMultiError) AllErrors() []error { return m }
+
+// GetStudentCreditsRequestValidationError is the validation error returned by
+// GetStudentCreditsRequest.Validate if the designated constraints aren't met.
+type GetStudentCreditsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStudentCreditsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStudentCreditsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStudentCreditsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStudentCreditsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStudentCreditsRequestValidationError) ErrorName() string {
+	return "GetStudentCreditsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStudentCreditsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStudentCreditsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStudentCreditsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStudentCreditsRequestValidationError{}
+
+var _GetStudentCreditsRequest_Semester_Pattern = regexp.MustCompile("^(Winter|Spring|Summer)[ _][0-9]{4}$")
+
+// Validate checks the field values on GetStudentCreditsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStudentCreditsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStudentCreditsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStudentCreditsResponseMultiError, or nil if none found.
+func (m *GetStudentCreditsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStudentCreditsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Credits
+
+	if len(errors) > 0 {
+		return GetStudentCreditsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStudentCreditsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetStudentCreditsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetStudentCreditsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStudentCreditsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStudentCreditsResponseMultiError) AllErrors() []error { return m }
+
+// GetStudentCreditsResponseValidationError is the validation error returned by
+// GetStudentCreditsResponse.Validate if the designated constraints aren't met.
+type GetStudentCreditsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStudentCreditsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStudentCreditsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStudentCreditsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStudentCreditsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStudentCreditsResponseValidationError) ErrorName() string {
+	return "GetStudentCreditsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStudentCreditsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStudentCreditsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStudentCreditsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStudentCreditsResponseValidationError{}
+
+// Validate checks the field values on Enrollment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Enrollment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Enrollment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EnrollmentMultiError, or
+// nil if none found.
+func (m *Enrollment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Enrollment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCourse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EnrollmentValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EnrollmentValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCourse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EnrollmentValidationError{
+				field:  "Course",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return EnrollmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollmentMultiError is an error wrapping multiple validation errors
+// returned by Enrollment.ValidateAll() if the designated constraints aren't met.
+type EnrollmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollmentMultiError) AllErrors() []error { return m }
+
+// EnrollmentValidationError is the validation error returned by
+// Enrollment.Validate if the designated constraints aren't met.
+type EnrollmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollmentValidationError) ErrorName() string { return "EnrollmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnrollmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollmentValidationError{}
+
+// Validate checks the field values on GetStaffCoursesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStaffCoursesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStaffCoursesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStaffCoursesRequestMultiError, or nil if none found.
+func (m *GetStaffCoursesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStaffCoursesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetStaffID()) < 1 {
+		err := GetStaffCoursesRequestValidationError{
+			field:  "StaffID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSemester() != "" {
+
+		if !_GetStaffCoursesRequest_Semester_Pattern.MatchString(m.GetSemester()) {
+			err := GetStaffCoursesRequestValidationError{
+				field:  "Semester",
+				reason: "value does not match regex pattern \"^(Winter|Spring|Summer)[ _][0-9]{4}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Strict != nil {
+		// no validation rules for Strict
+	}
+
+	if len(errors) > 0 {
+		return GetStaffCoursesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStaffCoursesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStaffCoursesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStaffCoursesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStaffCoursesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStaffCoursesRequestMultiError) AllErrors() []error { return m }
+
+// GetStaffCoursesRequestValidationError is the validation error returned by
+// GetStaffCoursesRequest.Validate if the designated constraints aren't met.
+type GetStaffCoursesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStaffCoursesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStaffCoursesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStaffCoursesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStaffCoursesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStaffCoursesRequestValidationError) ErrorName() string {
+	return "GetStaffCoursesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStaffCoursesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStaffCoursesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStaffCoursesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStaffCoursesRequestValidationError{}
+
+var _GetStaffCoursesRequest_Semester_Pattern = regexp.MustCompile("^(Winter|Spring|Summer)[ _][0-9]{4}$")
+
+// Validate checks the field values on GetStaffCoursesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStaffCoursesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStaffCoursesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStaffCoursesResponseMultiError, or nil if none found.
+func (m *GetStaffCoursesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStaffCoursesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetStaffCoursesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStaffCoursesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetStaffCoursesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetStaffCoursesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStaffCoursesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStaffCoursesResponseMultiError) AllErrors() []error { return m }
+
+// GetStaffCoursesResponseValidationError is the validation error returned by
+// GetStaffCoursesResponse.Validate if the designated constraints aren't met.
+type GetStaffCoursesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStaffCoursesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStaffCoursesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStaffCoursesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStaffCoursesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStaffCoursesResponseValidationError) ErrorName() string {
+	return "GetStaffCoursesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStaffCoursesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStaffCoursesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStaffCoursesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStaffCoursesResponseValidationError{}
+
+// Validate checks the field values on ListCoursesWithoutStaffRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCoursesWithoutStaffRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCoursesWithoutStaffRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListCoursesWithoutStaffRequestMultiError, or nil if none found.
+func (m *ListCoursesWithoutStaffRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCoursesWithoutStaffRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if !_ListCoursesWithoutStaffRequest_Semester_Pattern.MatchString(m.GetSemester()) {
+		err := ListCoursesWithoutStaffRequestValidationError{
+			field:  "Semester",
+			reason: "value does not match regex pattern \"^(Winter|Spring|Summer)[ _][0-9]{4}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCoursesWithoutStaffRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCoursesWithoutStaffRequestMultiError is an error wrapping multiple
+// validation errors returned by ListCoursesWithoutStaffRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListCoursesWithoutStaffRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCoursesWithoutStaffRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCoursesWithoutStaffRequestMultiError) AllErrors() []error { return m }
+
+// ListCoursesWithoutStaffRequestValidationError is the validation error
+// returned by ListCoursesWithoutStaffRequest.Validate if the designated
+// constraints aren't met.
+type ListCoursesWithoutStaffRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCoursesWithoutStaffRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCoursesWithoutStaffRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCoursesWithoutStaffRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCoursesWithoutStaffRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCoursesWithoutStaffRequestValidationError) ErrorName() string {
+	return "ListCoursesWithoutStaffRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCoursesWithoutStaffRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCoursesWithoutStaffRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCoursesWithoutStaffRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCoursesWithoutStaffRequestValidationError{}
+
+var _ListCoursesWithoutStaffRequest_Semester_Pattern = regexp.MustCompile("^(Winter|Spring|Summer)[ _][0-9]{4}$")
+
+// Validate checks the field values on ListCoursesWithoutStaffResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCoursesWithoutStaffResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCoursesWithoutStaffResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListCoursesWithoutStaffResponseMultiError, or nil if none found.
+func (m *ListCoursesWithoutStaffResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCoursesWithoutStaffResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCourses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCoursesWithoutStaffResponseValidationError{
+						field:  fmt.Sprintf("Courses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCoursesWithoutStaffResponseValidationError{
+						field:  fmt.Sprintf("Courses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCoursesWithoutStaffResponseValidationError{
+					field:  fmt.Sprintf("Courses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCoursesWithoutStaffResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCoursesWithoutStaffResponseMultiError is an error wrapping multiple
+// validation errors returned by ListCoursesWithoutStaffResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListCoursesWithoutStaffResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCoursesWithoutStaffResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCoursesWithoutStaffResponseMultiError) AllErrors() []error { return m }
+
+// ListCoursesWithoutStaffResponseValidationError is the validation error
+// returned by ListCoursesWithoutStaffResponse.Validate if the designated
+// constraints aren't met.
+type ListCoursesWithoutStaffResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCoursesWithoutStaffResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCoursesWithoutStaffResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCoursesWithoutStaffResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCoursesWithoutStaffResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCoursesWithoutStaffResponseValidationError) ErrorName() string {
+	return "ListCoursesWithoutStaffResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCoursesWithoutStaffResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCoursesWithoutStaffResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCoursesWithoutStaffResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCoursesWithoutStaffResponseValidationError{}
+
+// Validate checks the field values on ListCoursesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCoursesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCoursesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCoursesRequestMultiError, or nil if none found.
+func (m *ListCoursesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCoursesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if m.GetSemester() != "" {
+
+		if !_ListCoursesRequest_Semester_Pattern.MatchString(m.GetSemester()) {
+			err := ListCoursesRequestValidationError{
+				field:  "Semester",
+				reason: "value does not match regex pattern \"^(Winter|Spring|Summer)[ _][0-9]{4}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for NameContains
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCoursesRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCoursesRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCoursesRequestValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() < 0 {
+		err := ListCoursesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListCoursesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCoursesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListCoursesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListCoursesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCoursesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCoursesRequestMultiError) AllErrors() []error { return m }
+
+// ListCoursesRequestValidationError is the validation error returned by
+// ListCoursesRequest.Validate if the designated constraints aren't met.
+type ListCoursesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCoursesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCoursesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCoursesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCoursesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCoursesRequestValidationError) ErrorName() string {
+	return "ListCoursesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCoursesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCoursesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCoursesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCoursesRequestValidationError{}
+
+var _ListCoursesRequest_Semester_Pattern = regexp.MustCompile("^(Winter|Spring|Summer)[ _][0-9]{4}$")
+
+// Validate checks the field values on ListCoursesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCoursesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCoursesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCoursesResponseMultiError, or nil if none found.
+func (m *ListCoursesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCoursesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCourses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCoursesResponseValidationError{
+						field:  fmt.Sprintf("Courses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCoursesResponseValidationError{
+						field:  fmt.Sprintf("Courses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCoursesResponseValidationError{
+					field:  fmt.Sprintf("Courses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListCoursesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCoursesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCoursesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCoursesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCoursesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCoursesResponseMultiError) AllErrors() []error { return m }
+
+// ListCoursesResponseValidationError is the validation error returned by
+// ListCoursesResponse.Validate if the designated constraints aren't met.
+type ListCoursesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCoursesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCoursesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCoursesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCoursesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCoursesResponseValidationError) ErrorName() string {
+	return "ListCoursesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCoursesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCoursesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCoursesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCoursesResponseValidationError{}
+
+// Validate checks the field values on GetCoursesByMetadataRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCoursesByMetadataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCoursesByMetadataRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCoursesByMetadataRequestMultiError, or nil if none found.
+func (m *GetCoursesByMetadataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCoursesByMetadataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 64 {
+		err := GetCoursesByMetadataRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetValue()); l < 1 || l > 1024 {
+		err := GetCoursesByMetadataRequestValidationError{
+			field:  "Value",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() < 0 {
+		err := GetCoursesByMetadataRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return GetCoursesByMetadataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCoursesByMetadataRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCoursesByMetadataRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetCoursesByMetadataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCoursesByMetadataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCoursesByMetadataRequestMultiError) AllErrors() []error { return m }
+
+// GetCoursesByMetadataRequestValidationError is the validation error returned
+// by GetCoursesByMetadataRequest.Validate if the designated constraints
+// aren't met.
+type GetCoursesByMetadataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCoursesByMetadataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCoursesByMetadataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCoursesByMetadataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCoursesByMetadataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCoursesByMetadataRequestValidationError) ErrorName() string {
+	return "GetCoursesByMetadataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCoursesByMetadataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCoursesByMetadataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCoursesByMetadataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCoursesByMetadataRequestValidationError{}
+
+// Validate checks the field values on GetCoursesByMetadataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCoursesByMetadataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCoursesByMetadataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCoursesByMetadataResponseMultiError, or nil if none found.
+func (m *GetCoursesByMetadataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCoursesByMetadataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCourses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCoursesByMetadataResponseValidationError{
+						field:  fmt.Sprintf("Courses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCoursesByMetadataResponseValidationError{
+						field:  fmt.Sprintf("Courses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCoursesByMetadataResponseValidationError{
+					field:  fmt.Sprintf("Courses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return GetCoursesByMetadataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCoursesByMetadataResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCoursesByMetadataResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetCoursesByMetadataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCoursesByMetadataResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCoursesByMetadataResponseMultiError) AllErrors() []error { return m }
+
+// GetCoursesByMetadataResponseValidationError is the validation error returned
+// by GetCoursesByMetadataResponse.Validate if the designated constraints
+// aren't met.
+type GetCoursesByMetadataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCoursesByMetadataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCoursesByMetadataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCoursesByMetadataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCoursesByMetadataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCoursesByMetadataResponseValidationError) ErrorName() string {
+	return "GetCoursesByMetadataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCoursesByMetadataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCoursesByMetadataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCoursesByMetadataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCoursesByMetadataResponseValidationError{}
+
+// Validate checks the field values on GetSemesterCoursesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSemesterCoursesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSemesterCoursesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSemesterCoursesRequestMultiError, or nil if none found.
+func (m *GetSemesterCoursesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSemesterCoursesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if !_GetSemesterCoursesRequest_Semester_Pattern.MatchString(m.GetSemester()) {
+		err := GetSemesterCoursesRequestValidationError{
+			field:  "Semester",
+			reason: "value does not match regex pattern \"^(Winter|Spring|Summer)[ _][0-9]{4}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSemesterCoursesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSemesterCoursesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSemesterCoursesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetSemesterCoursesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSemesterCoursesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSemesterCoursesRequestMultiError) AllErrors() []error { return m }
+
+// GetSemesterCoursesRequestValidationError is the validation error returned by
+// GetSemesterCoursesRequest.Validate if the designated constraints aren't met.
+type GetSemesterCoursesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSemesterCoursesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSemesterCoursesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSemesterCoursesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSemesterCoursesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSemesterCoursesRequestValidationError) ErrorName() string {
+	return "GetSemesterCoursesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSemesterCoursesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSemesterCoursesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSemesterCoursesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSemesterCoursesRequestValidationError{}
+
+var _GetSemesterCoursesRequest_Semester_Pattern = regexp.MustCompile("^(Winter|Spring|Summer)[ _][0-9]{4}$")
+
+// Validate checks the field values on GetSemesterCoursesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSemesterCoursesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSemesterCoursesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSemesterCoursesResponseMultiError, or nil if none found.
+func (m *GetSemesterCoursesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSemesterCoursesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCourses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSemesterCoursesResponseValidationError{
+						field:  fmt.Sprintf("Courses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSemesterCoursesResponseValidationError{
+						field:  fmt.Sprintf("Courses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSemesterCoursesResponseValidationError{
+					field:  fmt.Sprintf("Courses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return GetSemesterCoursesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSemesterCoursesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSemesterCoursesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetSemesterCoursesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSemesterCoursesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSemesterCoursesResponseMultiError) AllErrors() []error { return m }
+
+// GetSemesterCoursesResponseValidationError is the validation error returned
+// by GetSemesterCoursesResponse.Validate if the designated constraints aren't met.
+type GetSemesterCoursesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSemesterCoursesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSemesterCoursesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSemesterCoursesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSemesterCoursesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSemesterCoursesResponseValidationError) ErrorName() string {
+	return "GetSemesterCoursesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSemesterCoursesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSemesterCoursesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSemesterCoursesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSemesterCoursesResponseValidationError{}
+
+// Validate checks the field values on AddAnnouncementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddAnnouncementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddAnnouncementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddAnnouncementRequestMultiError, or nil if none found.
+func (m *AddAnnouncementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAnnouncementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := AddAnnouncementRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAnnouncement() == nil {
+		err := AddAnnouncementRequestValidationError{
+			field:  "Announcement",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddAnnouncementRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddAnnouncementRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddAnnouncementRequestValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Urgent
+
+	if len(errors) > 0 {
+		return AddAnnouncementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAnnouncementRequestMultiError is an error wrapping multiple validation
+// errors returned by AddAnnouncementRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddAnnouncementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAnnouncementRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAnnouncementRequestMultiError) AllErrors() []error { return m }
+
+// AddAnnouncementRequestValidationError is the validation error returned by
+// AddAnnouncementRequest.Validate if the designated constraints aren't met.
+type AddAnnouncementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAnnouncementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAnnouncementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAnnouncementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAnnouncementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAnnouncementRequestValidationError) ErrorName() string {
+	return "AddAnnouncementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddAnnouncementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAnnouncementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddAnnouncementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAnnouncementRequestValidationError{}
+
+// Validate checks the field values on AddAnnouncementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddAnnouncementResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddAnnouncementResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddAnnouncementResponseMultiError, or nil if none found.
+func (m *AddAnnouncementResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAnnouncementResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddAnnouncementResponseValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddAnnouncementResponseValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddAnnouncementResponseValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddAnnouncementResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAnnouncementResponseMultiError is an error wrapping multiple validation
+// errors returned by AddAnnouncementResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddAnnouncementResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAnnouncementResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAnnouncementResponseMultiError) AllErrors() []error { return m }
+
+// AddAnnouncementResponseValidationError is the validation error returned by
+// AddAnnouncementResponse.Validate if the designated constraints aren't met.
+type AddAnnouncementResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAnnouncementResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAnnouncementResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAnnouncementResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAnnouncementResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAnnouncementResponseValidationError) ErrorName() string {
+	return "AddAnnouncementResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddAnnouncementResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAnnouncementResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddAnnouncementResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAnnouncementResponseValidationError{}
+
+// Validate checks the field values on GetCourseAnnouncementsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseAnnouncementsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseAnnouncementsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetCourseAnnouncementsRequestMultiError, or nil if none found.
+func (m *GetCourseAnnouncementsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseAnnouncementsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetCourseAnnouncementsRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCourseAnnouncementsRequestValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCourseAnnouncementsRequestValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCourseAnnouncementsRequestValidationError{
+				field:  "CreatedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCourseAnnouncementsRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCourseAnnouncementsRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCourseAnnouncementsRequestValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for KnownVersionToken
+
+	if m.GetPageSize() < 0 {
+		err := GetCourseAnnouncementsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return GetCourseAnnouncementsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseAnnouncementsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCourseAnnouncementsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetCourseAnnouncementsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseAnnouncementsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseAnnouncementsRequestMultiError) AllErrors() []error { return m }
+
+// GetCourseAnnouncementsRequestValidationError is the validation error
+// returned by GetCourseAnnouncementsRequest.Validate if the designated
+// constraints aren't met.
+type GetCourseAnnouncementsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseAnnouncementsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseAnnouncementsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseAnnouncementsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseAnnouncementsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseAnnouncementsRequestValidationError) ErrorName() string {
+	return "GetCourseAnnouncementsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseAnnouncementsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseAnnouncementsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseAnnouncementsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseAnnouncementsRequestValidationError{}
+
+// Validate checks the field values on GetCourseAnnouncementsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseAnnouncementsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseAnnouncementsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetCourseAnnouncementsResponseMultiError, or nil if none found.
+func (m *GetCourseAnnouncementsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseAnnouncementsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAnnouncements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCourseAnnouncementsResponseValidationError{
+						field:  fmt.Sprintf("Announcements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCourseAnnouncementsResponseValidationError{
+						field:  fmt.Sprintf("Announcements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCourseAnnouncementsResponseValidationError{
+					field:  fmt.Sprintf("Announcements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for VersionToken
+
+	// no validation rules for NotModified
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return GetCourseAnnouncementsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseAnnouncementsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCourseAnnouncementsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetCourseAnnouncementsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseAnnouncementsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseAnnouncementsResponseMultiError) AllErrors() []error { return m }
+
+// GetCourseAnnouncementsResponseValidationError is the validation error
+// returned by GetCourseAnnouncementsResponse.Validate if the designated
+// constraints aren't met.
+type GetCourseAnnouncementsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseAnnouncementsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseAnnouncementsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseAnnouncementsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseAnnouncementsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseAnnouncementsResponseValidationError) ErrorName() string {
+	return "GetCourseAnnouncementsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseAnnouncementsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseAnnouncementsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseAnnouncementsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseAnnouncementsResponseValidationError{}
+
+// Validate checks the field values on GetCourseAnnouncementsGroupedRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetCourseAnnouncementsGroupedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseAnnouncementsGroupedRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetCourseAnnouncementsGroupedRequestMultiError, or nil if none found.
+func (m *GetCourseAnnouncementsGroupedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseAnnouncementsGroupedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetCourseAnnouncementsGroupedRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AnnouncementGrouping_name[int32(m.GetGroupBy())]; !ok {
+		err := GetCourseAnnouncementsGroupedRequestValidationError{
+			field:  "GroupBy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() < 0 {
+		err := GetCourseAnnouncementsGroupedRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return GetCourseAnnouncementsGroupedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseAnnouncementsGroupedRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetCourseAnnouncementsGroupedRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCourseAnnouncementsGroupedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseAnnouncementsGroupedRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseAnnouncementsGroupedRequestMultiError) AllErrors() []error { return m }
+
+// GetCourseAnnouncementsGroupedRequestValidationError is the validation error
+// returned by GetCourseAnnouncementsGroupedRequest.Validate if the designated
+// constraints aren't met.
+type GetCourseAnnouncementsGroupedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseAnnouncementsGroupedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseAnnouncementsGroupedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseAnnouncementsGroupedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseAnnouncementsGroupedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseAnnouncementsGroupedRequestValidationError) ErrorName() string {
+	return "GetCourseAnnouncementsGroupedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseAnnouncementsGroupedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseAnnouncementsGroupedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseAnnouncementsGroupedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseAnnouncementsGroupedRequestValidationError{}
+
+// Validate checks the field values on GetCourseAnnouncementsGroupedResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetCourseAnnouncementsGroupedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseAnnouncementsGroupedResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetCourseAnnouncementsGroupedResponseMultiError, or nil if none found.
+func (m *GetCourseAnnouncementsGroupedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseAnnouncementsGroupedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCourseAnnouncementsGroupedResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCourseAnnouncementsGroupedResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCourseAnnouncementsGroupedResponseValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return GetCourseAnnouncementsGroupedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseAnnouncementsGroupedResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// GetCourseAnnouncementsGroupedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCourseAnnouncementsGroupedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseAnnouncementsGroupedResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseAnnouncementsGroupedResponseMultiError) AllErrors() []error { return m }
+
+// GetCourseAnnouncementsGroupedResponseValidationError is the validation error
+// returned by GetCourseAnnouncementsGroupedResponse.Validate if the
+// designated constraints aren't met.
+type GetCourseAnnouncementsGroupedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseAnnouncementsGroupedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseAnnouncementsGroupedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseAnnouncementsGroupedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseAnnouncementsGroupedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseAnnouncementsGroupedResponseValidationError) ErrorName() string {
+	return "GetCourseAnnouncementsGroupedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseAnnouncementsGroupedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseAnnouncementsGroupedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseAnnouncementsGroupedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseAnnouncementsGroupedResponseValidationError{}
+
+// Validate checks the field values on AnnouncementGroup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AnnouncementGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnnouncementGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnnouncementGroupMultiError, or nil if none found.
+func (m *AnnouncementGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnnouncementGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnnouncementGroupValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnnouncementGroupValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnnouncementGroupValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnnouncementGroupValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnnouncementGroupValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnnouncementGroupValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Count
+
+	if all {
+		switch v := interface{}(m.GetNewest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnnouncementGroupValidationError{
+					field:  "Newest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnnouncementGroupValidationError{
+					field:  "Newest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNewest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnnouncementGroupValidationError{
+				field:  "Newest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AnnouncementGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnnouncementGroupMultiError is an error wrapping multiple validation errors
+// returned by AnnouncementGroup.ValidateAll() if the designated constraints
+// aren't met.
+type AnnouncementGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnnouncementGroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnnouncementGroupMultiError) AllErrors() []error { return m }
+
+// AnnouncementGroupValidationError is the validation error returned by
+// AnnouncementGroup.Validate if the designated constraints aren't met.
+type AnnouncementGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnouncementGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnouncementGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnouncementGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnouncementGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnouncementGroupValidationError) ErrorName() string {
+	return "AnnouncementGroupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnnouncementGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnouncementGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnouncementGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnouncementGroupValidationError{}
+
+// Validate checks the field values on GetAnnouncementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAnnouncementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnnouncementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAnnouncementRequestMultiError, or nil if none found.
+func (m *GetAnnouncementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnnouncementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetAnnouncementRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAnnouncementID()) < 1 {
+		err := GetAnnouncementRequestValidationError{
+			field:  "AnnouncementID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAnnouncementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnnouncementRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAnnouncementRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAnnouncementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnnouncementRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnnouncementRequestMultiError) AllErrors() []error { return m }
+
+// GetAnnouncementRequestValidationError is the validation error returned by
+// GetAnnouncementRequest.Validate if the designated constraints aren't met.
+type GetAnnouncementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnnouncementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnnouncementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnnouncementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnnouncementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnnouncementRequestValidationError) ErrorName() string {
+	return "GetAnnouncementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnnouncementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnnouncementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnnouncementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnnouncementRequestValidationError{}
+
+// Validate checks the field values on GetAnnouncementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAnnouncementResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnnouncementResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAnnouncementResponseMultiError, or nil if none found.
+func (m *GetAnnouncementResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnnouncementResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAnnouncementResponseValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAnnouncementResponseValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAnnouncementResponseValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAnnouncementResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnnouncementResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAnnouncementResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAnnouncementResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnnouncementResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnnouncementResponseMultiError) AllErrors() []error { return m }
+
+// GetAnnouncementResponseValidationError is the validation error returned by
+// GetAnnouncementResponse.Validate if the designated constraints aren't met.
+type GetAnnouncementResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnnouncementResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnnouncementResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnnouncementResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnnouncementResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnnouncementResponseValidationError) ErrorName() string {
+	return "GetAnnouncementResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnnouncementResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnnouncementResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnnouncementResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnnouncementResponseValidationError{}
+
+// Validate checks the field values on RemoveAnnouncementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveAnnouncementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveAnnouncementRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveAnnouncementRequestMultiError, or nil if none found.
+func (m *RemoveAnnouncementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveAnnouncementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := RemoveAnnouncementRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAnnouncementID()) < 1 {
+		err := RemoveAnnouncementRequestValidationError{
+			field:  "AnnouncementID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveAnnouncementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveAnnouncementRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveAnnouncementRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RemoveAnnouncementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveAnnouncementRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveAnnouncementRequestMultiError) AllErrors() []error { return m }
+
+// RemoveAnnouncementRequestValidationError is the validation error returned by
+// RemoveAnnouncementRequest.Validate if the designated constraints aren't met.
+type RemoveAnnouncementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveAnnouncementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveAnnouncementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveAnnouncementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveAnnouncementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveAnnouncementRequestValidationError) ErrorName() string {
+	return "RemoveAnnouncementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveAnnouncementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveAnnouncementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveAnnouncementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveAnnouncementRequestValidationError{}
+
+// Validate checks the field values on RemoveAnnouncementResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveAnnouncementResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveAnnouncementResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveAnnouncementResponseMultiError, or nil if none found.
+func (m *RemoveAnnouncementResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveAnnouncementResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveAnnouncementResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveAnnouncementResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveAnnouncementResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveAnnouncementResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveAnnouncementResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveAnnouncementResponseMultiError) AllErrors() []error { return m }
+
+// RemoveAnnouncementResponseValidationError is the validation error returned
+// by RemoveAnnouncementResponse.Validate if the designated constraints aren't met.
+type RemoveAnnouncementResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveAnnouncementResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveAnnouncementResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveAnnouncementResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveAnnouncementResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveAnnouncementResponseValidationError) ErrorName() string {
+	return "RemoveAnnouncementResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveAnnouncementResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveAnnouncementResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveAnnouncementResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveAnnouncementResponseValidationError{}
+
+// Validate checks the field values on UpdateAnnouncementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAnnouncementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAnnouncementRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAnnouncementRequestMultiError, or nil if none found.
+func (m *UpdateAnnouncementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAnnouncementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := UpdateAnnouncementRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAnnouncementID()) < 1 {
+		err := UpdateAnnouncementRequestValidationError{
+			field:  "AnnouncementID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AnnouncementTitle
+
+	// no validation rules for AnnouncementContent
+
+	if m.Visibility != nil {
+
+		if _, ok := AnnouncementVisibility_name[int32(m.GetVisibility())]; !ok {
+			err := UpdateAnnouncementRequestValidationError{
+				field:  "Visibility",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateAnnouncementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAnnouncementRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAnnouncementRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateAnnouncementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAnnouncementRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAnnouncementRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAnnouncementRequestValidationError is the validation error returned by
+// UpdateAnnouncementRequest.Validate if the designated constraints aren't met.
+type UpdateAnnouncementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAnnouncementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAnnouncementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAnnouncementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAnnouncementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAnnouncementRequestValidationError) ErrorName() string {
+	return "UpdateAnnouncementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAnnouncementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAnnouncementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAnnouncementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAnnouncementRequestValidationError{}
+
+// Validate checks the field values on UpdateAnnouncementResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAnnouncementResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAnnouncementResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAnnouncementResponseMultiError, or nil if none found.
+func (m *UpdateAnnouncementResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAnnouncementResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAnnouncementResponseValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAnnouncementResponseValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAnnouncementResponseValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAnnouncementResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAnnouncementResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateAnnouncementResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateAnnouncementResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAnnouncementResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAnnouncementResponseMultiError) AllErrors() []error { return m }
+
+// UpdateAnnouncementResponseValidationError is the validation error returned
+// by UpdateAnnouncementResponse.Validate if the designated constraints aren't met.
+type UpdateAnnouncementResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAnnouncementResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAnnouncementResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAnnouncementResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAnnouncementResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAnnouncementResponseValidationError) ErrorName() string {
+	return "UpdateAnnouncementResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAnnouncementResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAnnouncementResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAnnouncementResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAnnouncementResponseValidationError{}
+
+// Validate checks the field values on UpsertAnnouncementBySlugRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertAnnouncementBySlugRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertAnnouncementBySlugRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpsertAnnouncementBySlugRequestMultiError, or nil if none found.
+func (m *UpsertAnnouncementBySlugRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertAnnouncementBySlugRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := UpsertAnnouncementBySlugRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAnnouncement() == nil {
+		err := UpsertAnnouncementBySlugRequestValidationError{
+			field:  "Announcement",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertAnnouncementBySlugRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertAnnouncementBySlugRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertAnnouncementBySlugRequestValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpsertAnnouncementBySlugRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertAnnouncementBySlugRequestMultiError is an error wrapping multiple
+// validation errors returned by UpsertAnnouncementBySlugRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpsertAnnouncementBySlugRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertAnnouncementBySlugRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertAnnouncementBySlugRequestMultiError) AllErrors() []error { return m }
+
+// UpsertAnnouncementBySlugRequestValidationError is the validation error
+// returned by UpsertAnnouncementBySlugRequest.Validate if the designated
+// constraints aren't met.
+type UpsertAnnouncementBySlugRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertAnnouncementBySlugRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertAnnouncementBySlugRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertAnnouncementBySlugRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertAnnouncementBySlugRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertAnnouncementBySlugRequestValidationError) ErrorName() string {
+	return "UpsertAnnouncementBySlugRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertAnnouncementBySlugRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertAnnouncementBySlugRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertAnnouncementBySlugRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertAnnouncementBySlugRequestValidationError{}
+
+// Validate checks the field values on UpsertAnnouncementBySlugResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpsertAnnouncementBySlugResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertAnnouncementBySlugResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpsertAnnouncementBySlugResponseMultiError, or nil if none found.
+func (m *UpsertAnnouncementBySlugResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertAnnouncementBySlugResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertAnnouncementBySlugResponseValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertAnnouncementBySlugResponseValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertAnnouncementBySlugResponseValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Created
+
+	if len(errors) > 0 {
+		return UpsertAnnouncementBySlugResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertAnnouncementBySlugResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpsertAnnouncementBySlugResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertAnnouncementBySlugResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertAnnouncementBySlugResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertAnnouncementBySlugResponseMultiError) AllErrors() []error { return m }
+
+// UpsertAnnouncementBySlugResponseValidationError is the validation error
+// returned by UpsertAnnouncementBySlugResponse.Validate if the designated
+// constraints aren't met.
+type UpsertAnnouncementBySlugResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertAnnouncementBySlugResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertAnnouncementBySlugResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertAnnouncementBySlugResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertAnnouncementBySlugResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertAnnouncementBySlugResponseValidationError) ErrorName() string {
+	return "UpsertAnnouncementBySlugResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertAnnouncementBySlugResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertAnnouncementBySlugResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertAnnouncementBySlugResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertAnnouncementBySlugResponseValidationError{}
+
+// Validate checks the field values on CopyAnnouncementsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CopyAnnouncementsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyAnnouncementsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyAnnouncementsRequestMultiError, or nil if none found.
+func (m *CopyAnnouncementsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyAnnouncementsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetFromCourseID()) < 1 {
+		err := CopyAnnouncementsRequestValidationError{
+			field:  "FromCourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetToCourseID()) < 1 {
+		err := CopyAnnouncementsRequestValidationError{
+			field:  "ToCourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CopyAnnouncementsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyAnnouncementsRequestMultiError is an error wrapping multiple validation
+// errors returned by CopyAnnouncementsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CopyAnnouncementsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyAnnouncementsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyAnnouncementsRequestMultiError) AllErrors() []error { return m }
+
+// CopyAnnouncementsRequestValidationError is the validation error returned by
+// CopyAnnouncementsRequest.Validate if the designated constraints aren't met.
+type CopyAnnouncementsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyAnnouncementsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyAnnouncementsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyAnnouncementsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyAnnouncementsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyAnnouncementsRequestValidationError) ErrorName() string {
+	return "CopyAnnouncementsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopyAnnouncementsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyAnnouncementsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyAnnouncementsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyAnnouncementsRequestValidationError{}
+
+// Validate checks the field values on CopyAnnouncementsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CopyAnnouncementsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyAnnouncementsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyAnnouncementsResponseMultiError, or nil if none found.
+func (m *CopyAnnouncementsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyAnnouncementsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CopiedCount
+
+	if len(errors) > 0 {
+		return CopyAnnouncementsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyAnnouncementsResponseMultiError is an error wrapping multiple validation
+// errors returned by CopyAnnouncementsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CopyAnnouncementsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyAnnouncementsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyAnnouncementsResponseMultiError) AllErrors() []error { return m }
+
+// CopyAnnouncementsResponseValidationError is the validation error returned by
+// CopyAnnouncementsResponse.Validate if the designated constraints aren't met.
+type CopyAnnouncementsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyAnnouncementsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyAnnouncementsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyAnnouncementsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyAnnouncementsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyAnnouncementsResponseValidationError) ErrorName() string {
+	return "CopyAnnouncementsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopyAnnouncementsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyAnnouncementsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyAnnouncementsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyAnnouncementsResponseValidationError{}
+
+// Validate checks the field values on BatchRemoveAnnouncementsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchRemoveAnnouncementsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchRemoveAnnouncementsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BatchRemoveAnnouncementsRequestMultiError, or nil if none found.
+func (m *BatchRemoveAnnouncementsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchRemoveAnnouncementsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := BatchRemoveAnnouncementsRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAnnouncementIDs()) < 1 {
+		err := BatchRemoveAnnouncementsRequestValidationError{
+			field:  "AnnouncementIDs",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchRemoveAnnouncementsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchRemoveAnnouncementsRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchRemoveAnnouncementsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BatchRemoveAnnouncementsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchRemoveAnnouncementsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchRemoveAnnouncementsRequestMultiError) AllErrors() []error { return m }
+
+// BatchRemoveAnnouncementsRequestValidationError is the validation error
+// returned by BatchRemoveAnnouncementsRequest.Validate if the designated
+// constraints aren't met.
+type BatchRemoveAnnouncementsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchRemoveAnnouncementsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchRemoveAnnouncementsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchRemoveAnnouncementsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchRemoveAnnouncementsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchRemoveAnnouncementsRequestValidationError) ErrorName() string {
+	return "BatchRemoveAnnouncementsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchRemoveAnnouncementsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchRemoveAnnouncementsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchRemoveAnnouncementsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchRemoveAnnouncementsRequestValidationError{}
+
+// Validate checks the field values on BatchRemoveAnnouncementsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *BatchRemoveAnnouncementsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchRemoveAnnouncementsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BatchRemoveAnnouncementsResponseMultiError, or nil if none found.
+func (m *BatchRemoveAnnouncementsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchRemoveAnnouncementsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RemovedCount
+
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchRemoveAnnouncementsResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchRemoveAnnouncementsResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchRemoveAnnouncementsResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchRemoveAnnouncementsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchRemoveAnnouncementsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// BatchRemoveAnnouncementsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchRemoveAnnouncementsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchRemoveAnnouncementsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchRemoveAnnouncementsResponseMultiError) AllErrors() []error { return m }
+
+// BatchRemoveAnnouncementsResponseValidationError is the validation error
+// returned by BatchRemoveAnnouncementsResponse.Validate if the designated
+// constraints aren't met.
+type BatchRemoveAnnouncementsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchRemoveAnnouncementsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchRemoveAnnouncementsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchRemoveAnnouncementsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchRemoveAnnouncementsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchRemoveAnnouncementsResponseValidationError) ErrorName() string {
+	return "BatchRemoveAnnouncementsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchRemoveAnnouncementsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchRemoveAnnouncementsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchRemoveAnnouncementsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchRemoveAnnouncementsResponseValidationError{}
+
+// Validate checks the field values on BatchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchResultMultiError, or
+// nil if none found.
+func (m *BatchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResultValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SucceededCount
+
+	// no validation rules for FailedCount
+
+	if len(errors) > 0 {
+		return BatchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchResultMultiError is an error wrapping multiple validation errors
+// returned by BatchResult.ValidateAll() if the designated constraints aren't met.
+type BatchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchResultMultiError) AllErrors() []error { return m }
+
+// BatchResultValidationError is the validation error returned by
+// BatchResult.Validate if the designated constraints aren't met.
+type BatchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchResultValidationError) ErrorName() string { return "BatchResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchResultValidationError{}
+
+// Validate checks the field values on BatchItemResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchItemResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchItemResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchItemResultMultiError, or nil if none found.
+func (m *BatchItemResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchItemResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Ok
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return BatchItemResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchItemResultMultiError is an error wrapping multiple validation errors
+// returned by BatchItemResult.ValidateAll() if the designated constraints
+// aren't met.
+type BatchItemResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchItemResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchItemResultMultiError) AllErrors() []error { return m }
+
+// BatchItemResultValidationError is the validation error returned by
+// BatchItemResult.Validate if the designated constraints aren't met.
+type BatchItemResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchItemResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchItemResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchItemResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchItemResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchItemResultValidationError) ErrorName() string { return "BatchItemResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchItemResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchItemResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchItemResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchItemResultValidationError{}
+
+// Validate checks the field values on SetEnrollmentWindowRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetEnrollmentWindowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetEnrollmentWindowRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetEnrollmentWindowRequestMultiError, or nil if none found.
+func (m *SetEnrollmentWindowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetEnrollmentWindowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := SetEnrollmentWindowRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOpensAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetEnrollmentWindowRequestValidationError{
+					field:  "OpensAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetEnrollmentWindowRequestValidationError{
+					field:  "OpensAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOpensAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetEnrollmentWindowRequestValidationError{
+				field:  "OpensAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetClosesAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetEnrollmentWindowRequestValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetEnrollmentWindowRequestValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClosesAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetEnrollmentWindowRequestValidationError{
+				field:  "ClosesAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetEnrollmentWindowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetEnrollmentWindowRequestMultiError is an error wrapping multiple
+// validation errors returned by SetEnrollmentWindowRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetEnrollmentWindowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetEnrollmentWindowRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetEnrollmentWindowRequestMultiError) AllErrors() []error { return m }
+
+// SetEnrollmentWindowRequestValidationError is the validation error returned
+// by SetEnrollmentWindowRequest.Validate if the designated constraints aren't met.
+type SetEnrollmentWindowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetEnrollmentWindowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetEnrollmentWindowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetEnrollmentWindowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetEnrollmentWindowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetEnrollmentWindowRequestValidationError) ErrorName() string {
+	return "SetEnrollmentWindowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetEnrollmentWindowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetEnrollmentWindowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetEnrollmentWindowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetEnrollmentWindowRequestValidationError{}
+
+// Validate checks the field values on SetEnrollmentWindowResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetEnrollmentWindowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetEnrollmentWindowResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetEnrollmentWindowResponseMultiError, or nil if none found.
+func (m *SetEnrollmentWindowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetEnrollmentWindowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetEnrollmentWindowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetEnrollmentWindowResponseMultiError is an error wrapping multiple
+// validation errors returned by SetEnrollmentWindowResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetEnrollmentWindowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetEnrollmentWindowResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetEnrollmentWindowResponseMultiError) AllErrors() []error { return m }
+
+// SetEnrollmentWindowResponseValidationError is the validation error returned
+// by SetEnrollmentWindowResponse.Validate if the designated constraints
+// aren't met.
+type SetEnrollmentWindowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetEnrollmentWindowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetEnrollmentWindowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetEnrollmentWindowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetEnrollmentWindowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetEnrollmentWindowResponseValidationError) ErrorName() string {
+	return "SetEnrollmentWindowResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetEnrollmentWindowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetEnrollmentWindowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetEnrollmentWindowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetEnrollmentWindowResponseValidationError{}
+
+// Validate checks the field values on GetEnrollmentStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEnrollmentStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEnrollmentStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEnrollmentStatusRequestMultiError, or nil if none found.
+func (m *GetEnrollmentStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEnrollmentStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetEnrollmentStatusRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetEnrollmentStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEnrollmentStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetEnrollmentStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetEnrollmentStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEnrollmentStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEnrollmentStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetEnrollmentStatusRequestValidationError is the validation error returned
+// by GetEnrollmentStatusRequest.Validate if the designated constraints aren't met.
+type GetEnrollmentStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEnrollmentStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEnrollmentStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEnrollmentStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEnrollmentStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEnrollmentStatusRequestValidationError) ErrorName() string {
+	return "GetEnrollmentStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEnrollmentStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEnrollmentStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEnrollmentStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEnrollmentStatusRequestValidationError{}
+
+// Validate checks the field values on CheckEnrollmentEligibilityRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckEnrollmentEligibilityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckEnrollmentEligibilityRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CheckEnrollmentEligibilityRequestMultiError, or nil if none found.
+func (m *CheckEnrollmentEligibilityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckEnrollmentEligibilityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := CheckEnrollmentEligibilityRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStudentID()) < 1 {
+		err := CheckEnrollmentEligibilityRequestValidationError{
+			field:  "StudentID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckEnrollmentEligibilityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckEnrollmentEligibilityRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckEnrollmentEligibilityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckEnrollmentEligibilityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckEnrollmentEligibilityRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckEnrollmentEligibilityRequestMultiError) AllErrors() []error { return m }
+
+// CheckEnrollmentEligibilityRequestValidationError is the validation error
+// returned by CheckEnrollmentEligibilityRequest.Validate if the designated
+// constraints aren't met.
+type CheckEnrollmentEligibilityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckEnrollmentEligibilityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckEnrollmentEligibilityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckEnrollmentEligibilityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckEnrollmentEligibilityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckEnrollmentEligibilityRequestValidationError) ErrorName() string {
+	return "CheckEnrollmentEligibilityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckEnrollmentEligibilityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckEnrollmentEligibilityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckEnrollmentEligibilityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckEnrollmentEligibilityRequestValidationError{}
+
+// Validate checks the field values on CheckEnrollmentEligibilityResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckEnrollmentEligibilityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckEnrollmentEligibilityResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CheckEnrollmentEligibilityResponseMultiError, or nil if none found.
+func (m *CheckEnrollmentEligibilityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckEnrollmentEligibilityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Eligible
+
+	if len(errors) > 0 {
+		return CheckEnrollmentEligibilityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckEnrollmentEligibilityResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckEnrollmentEligibilityResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckEnrollmentEligibilityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckEnrollmentEligibilityResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckEnrollmentEligibilityResponseMultiError) AllErrors() []error { return m }
+
+// CheckEnrollmentEligibilityResponseValidationError is the validation error
+// returned by CheckEnrollmentEligibilityResponse.Validate if the designated
+// constraints aren't met.
+type CheckEnrollmentEligibilityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckEnrollmentEligibilityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckEnrollmentEligibilityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckEnrollmentEligibilityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckEnrollmentEligibilityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckEnrollmentEligibilityResponseValidationError) ErrorName() string {
+	return "CheckEnrollmentEligibilityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckEnrollmentEligibilityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckEnrollmentEligibilityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckEnrollmentEligibilityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckEnrollmentEligibilityResponseValidationError{}
+
+// Validate checks the field values on GetEnrollmentStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEnrollmentStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEnrollmentStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEnrollmentStatusResponseMultiError, or nil if none found.
+func (m *GetEnrollmentStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEnrollmentStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetOpensAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetEnrollmentStatusResponseValidationError{
+					field:  "OpensAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetEnrollmentStatusResponseValidationError{
+					field:  "OpensAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOpensAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetEnrollmentStatusResponseValidationError{
+				field:  "OpensAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetClosesAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetEnrollmentStatusResponseValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetEnrollmentStatusResponseValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClosesAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetEnrollmentStatusResponseValidationError{
+				field:  "ClosesAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.SecondsUntilOpen != nil {
+		// no validation rules for SecondsUntilOpen
+	}
+
+	if m.SecondsUntilClose != nil {
+		// no validation rules for SecondsUntilClose
+	}
+
+	if len(errors) > 0 {
+		return GetEnrollmentStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEnrollmentStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetEnrollmentStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetEnrollmentStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEnrollmentStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEnrollmentStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetEnrollmentStatusResponseValidationError is the validation error returned
+// by GetEnrollmentStatusResponse.Validate if the designated constraints
+// aren't met.
+type GetEnrollmentStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEnrollmentStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEnrollmentStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEnrollmentStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEnrollmentStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEnrollmentStatusResponseValidationError) ErrorName() string {
+	return "GetEnrollmentStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEnrollmentStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEnrollmentStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEnrollmentStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEnrollmentStatusResponseValidationError{}
+
+// Validate checks the field values on SearchAllAnnouncementsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchAllAnnouncementsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchAllAnnouncementsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SearchAllAnnouncementsRequestMultiError, or nil if none found.
+func (m *SearchAllAnnouncementsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchAllAnnouncementsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetQuery()) < 1 {
+		err := SearchAllAnnouncementsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() < 0 {
+		err := SearchAllAnnouncementsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return SearchAllAnnouncementsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchAllAnnouncementsRequestMultiError is an error wrapping multiple
+// validation errors returned by SearchAllAnnouncementsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SearchAllAnnouncementsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchAllAnnouncementsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchAllAnnouncementsRequestMultiError) AllErrors() []error { return m }
+
+// SearchAllAnnouncementsRequestValidationError is the validation error
+// returned by SearchAllAnnouncementsRequest.Validate if the designated
+// constraints aren't met.
+type SearchAllAnnouncementsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchAllAnnouncementsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchAllAnnouncementsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchAllAnnouncementsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchAllAnnouncementsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchAllAnnouncementsRequestValidationError) ErrorName() string {
+	return "SearchAllAnnouncementsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchAllAnnouncementsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchAllAnnouncementsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchAllAnnouncementsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchAllAnnouncementsRequestValidationError{}
+
+// Validate checks the field values on SearchAllAnnouncementsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchAllAnnouncementsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchAllAnnouncementsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SearchAllAnnouncementsResponseMultiError, or nil if none found.
+func (m *SearchAllAnnouncementsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchAllAnnouncementsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchAllAnnouncementsResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchAllAnnouncementsResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchAllAnnouncementsResponseValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return SearchAllAnnouncementsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchAllAnnouncementsResponseMultiError is an error wrapping multiple
+// validation errors returned by SearchAllAnnouncementsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SearchAllAnnouncementsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchAllAnnouncementsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchAllAnnouncementsResponseMultiError) AllErrors() []error { return m }
+
+// SearchAllAnnouncementsResponseValidationError is the validation error
+// returned by SearchAllAnnouncementsResponse.Validate if the designated
+// constraints aren't met.
+type SearchAllAnnouncementsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchAllAnnouncementsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchAllAnnouncementsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchAllAnnouncementsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchAllAnnouncementsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchAllAnnouncementsResponseValidationError) ErrorName() string {
+	return "SearchAllAnnouncementsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchAllAnnouncementsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchAllAnnouncementsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchAllAnnouncementsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchAllAnnouncementsResponseValidationError{}
+
+// Validate checks the field values on GetAnnouncementsSinceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAnnouncementsSinceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnnouncementsSinceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAnnouncementsSinceRequestMultiError, or nil if none found.
+func (m *GetAnnouncementsSinceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnnouncementsSinceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAnnouncementsSinceRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAnnouncementsSinceRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAnnouncementsSinceRequestValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() < 0 {
+		err := GetAnnouncementsSinceRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAnnouncementsSinceRequestValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAnnouncementsSinceRequestValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAnnouncementsSinceRequestValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAnnouncementsSinceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnnouncementsSinceRequestMultiError is an error wrapping multiple
+// validation errors returned by GetAnnouncementsSinceRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetAnnouncementsSinceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnnouncementsSinceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnnouncementsSinceRequestMultiError) AllErrors() []error { return m }
+
+// GetAnnouncementsSinceRequestValidationError is the validation error returned
+// by GetAnnouncementsSinceRequest.Validate if the designated constraints
+// aren't met.
+type GetAnnouncementsSinceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnnouncementsSinceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnnouncementsSinceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnnouncementsSinceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnnouncementsSinceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnnouncementsSinceRequestValidationError) ErrorName() string {
+	return "GetAnnouncementsSinceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnnouncementsSinceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnnouncementsSinceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnnouncementsSinceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnnouncementsSinceRequestValidationError{}
+
+// Validate checks the field values on GetAnnouncementsSinceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAnnouncementsSinceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnnouncementsSinceResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetAnnouncementsSinceResponseMultiError, or nil if none found.
+func (m *GetAnnouncementsSinceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnnouncementsSinceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAnnouncements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAnnouncementsSinceResponseValidationError{
+						field:  fmt.Sprintf("Announcements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAnnouncementsSinceResponseValidationError{
+						field:  fmt.Sprintf("Announcements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAnnouncementsSinceResponseValidationError{
+					field:  fmt.Sprintf("Announcements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAnnouncementsSinceResponseValidationError{
+					field:  "NextSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAnnouncementsSinceResponseValidationError{
+					field:  "NextSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAnnouncementsSinceResponseValidationError{
+				field:  "NextSince",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageSize
+
+	if all {
+		switch v := interface{}(m.GetNextCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAnnouncementsSinceResponseValidationError{
+					field:  "NextCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAnnouncementsSinceResponseValidationError{
+					field:  "NextCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAnnouncementsSinceResponseValidationError{
+				field:  "NextCursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAnnouncementsSinceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnnouncementsSinceResponseMultiError is an error wrapping multiple
+// validation errors returned by GetAnnouncementsSinceResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetAnnouncementsSinceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnnouncementsSinceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnnouncementsSinceResponseMultiError) AllErrors() []error { return m }
+
+// GetAnnouncementsSinceResponseValidationError is the validation error
+// returned by GetAnnouncementsSinceResponse.Validate if the designated
+// constraints aren't met.
+type GetAnnouncementsSinceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnnouncementsSinceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnnouncementsSinceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnnouncementsSinceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnnouncementsSinceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnnouncementsSinceResponseValidationError) ErrorName() string {
+	return "GetAnnouncementsSinceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnnouncementsSinceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnnouncementsSinceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnnouncementsSinceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnnouncementsSinceResponseValidationError{}
+
+// Validate checks the field values on AnnouncementCursor with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnnouncementCursor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnnouncementCursor with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnnouncementCursorMultiError, or nil if none found.
+func (m *AnnouncementCursor) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnnouncementCursor) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnnouncementCursorValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnnouncementCursorValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnnouncementCursorValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CourseID
+
+	// no validation rules for AnnouncementID
+
+	if len(errors) > 0 {
+		return AnnouncementCursorMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnnouncementCursorMultiError is an error wrapping multiple validation errors
+// returned by AnnouncementCursor.ValidateAll() if the designated constraints
+// aren't met.
+type AnnouncementCursorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnnouncementCursorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnnouncementCursorMultiError) AllErrors() []error { return m }
+
+// AnnouncementCursorValidationError is the validation error returned by
+// AnnouncementCursor.Validate if the designated constraints aren't met.
+type AnnouncementCursorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnouncementCursorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnouncementCursorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnouncementCursorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnouncementCursorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnouncementCursorValidationError) ErrorName() string {
+	return "AnnouncementCursorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnnouncementCursorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnouncementCursor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnouncementCursorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnouncementCursorValidationError{}
+
+// Validate checks the field values on AnnouncementMatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AnnouncementMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnnouncementMatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnnouncementMatchMultiError, or nil if none found.
+func (m *AnnouncementMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnnouncementMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CourseID
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnnouncementMatchValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnnouncementMatchValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnnouncementMatchValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AnnouncementMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnnouncementMatchMultiError is an error wrapping multiple validation errors
+// returned by AnnouncementMatch.ValidateAll() if the designated constraints
+// aren't met.
+type AnnouncementMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnnouncementMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnnouncementMatchMultiError) AllErrors() []error { return m }
+
+// AnnouncementMatchValidationError is the validation error returned by
+// AnnouncementMatch.Validate if the designated constraints aren't met.
+type AnnouncementMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnouncementMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnouncementMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnouncementMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnouncementMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnouncementMatchValidationError) ErrorName() string {
+	return "AnnouncementMatchValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnnouncementMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnouncementMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnouncementMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnouncementMatchValidationError{}
+
+// Validate checks the field values on GetEnrollmentDifferenceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEnrollmentDifferenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEnrollmentDifferenceRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetEnrollmentDifferenceRequestMultiError, or nil if none found.
+func (m *GetEnrollmentDifferenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEnrollmentDifferenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseIDA()) < 1 {
+		err := GetEnrollmentDifferenceRequestValidationError{
+			field:  "CourseIDA",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCourseIDB()) < 1 {
+		err := GetEnrollmentDifferenceRequestValidationError{
+			field:  "CourseIDB",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() < 0 {
+		err := GetEnrollmentDifferenceRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return GetEnrollmentDifferenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEnrollmentDifferenceRequestMultiError is an error wrapping multiple
+// validation errors returned by GetEnrollmentDifferenceRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetEnrollmentDifferenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEnrollmentDifferenceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEnrollmentDifferenceRequestMultiError) AllErrors() []error { return m }
+
+// GetEnrollmentDifferenceRequestValidationError is the validation error
+// returned by GetEnrollmentDifferenceRequest.Validate if the designated
+// constraints aren't met.
+type GetEnrollmentDifferenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEnrollmentDifferenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEnrollmentDifferenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEnrollmentDifferenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEnrollmentDifferenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEnrollmentDifferenceRequestValidationError) ErrorName() string {
+	return "GetEnrollmentDifferenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEnrollmentDifferenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEnrollmentDifferenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEnrollmentDifferenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEnrollmentDifferenceRequestValidationError{}
+
+// Validate checks the field values on GetEnrollmentDifferenceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEnrollmentDifferenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEnrollmentDifferenceResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetEnrollmentDifferenceResponseMultiError, or nil if none found.
+func (m *GetEnrollmentDifferenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEnrollmentDifferenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return GetEnrollmentDifferenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEnrollmentDifferenceResponseMultiError is an error wrapping multiple
+// validation errors returned by GetEnrollmentDifferenceResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetEnrollmentDifferenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEnrollmentDifferenceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEnrollmentDifferenceResponseMultiError) AllErrors() []error { return m }
+
+// GetEnrollmentDifferenceResponseValidationError is the validation error
+// returned by GetEnrollmentDifferenceResponse.Validate if the designated
+// constraints aren't met.
+type GetEnrollmentDifferenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEnrollmentDifferenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEnrollmentDifferenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEnrollmentDifferenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEnrollmentDifferenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEnrollmentDifferenceResponseValidationError) ErrorName() string {
+	return "GetEnrollmentDifferenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEnrollmentDifferenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEnrollmentDifferenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEnrollmentDifferenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEnrollmentDifferenceResponseValidationError{}
+
+// Validate checks the field values on SetQuietPeriodsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetQuietPeriodsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetQuietPeriodsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetQuietPeriodsRequestMultiError, or nil if none found.
+func (m *SetQuietPeriodsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetQuietPeriodsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := SetQuietPeriodsRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPeriods() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetQuietPeriodsRequestValidationError{
+						field:  fmt.Sprintf("Periods[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetQuietPeriodsRequestValidationError{
+						field:  fmt.Sprintf("Periods[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetQuietPeriodsRequestValidationError{
+					field:  fmt.Sprintf("Periods[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetQuietPeriodsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetQuietPeriodsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetQuietPeriodsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetQuietPeriodsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetQuietPeriodsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetQuietPeriodsRequestMultiError) AllErrors() []error { return m }
+
+// SetQuietPeriodsRequestValidationError is the validation error returned by
+// SetQuietPeriodsRequest.Validate if the designated constraints aren't met.
+type SetQuietPeriodsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetQuietPeriodsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetQuietPeriodsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetQuietPeriodsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetQuietPeriodsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetQuietPeriodsRequestValidationError) ErrorName() string {
+	return "SetQuietPeriodsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetQuietPeriodsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetQuietPeriodsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetQuietPeriodsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetQuietPeriodsRequestValidationError{}
+
+// Validate checks the field values on SetQuietPeriodsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetQuietPeriodsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetQuietPeriodsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetQuietPeriodsResponseMultiError, or nil if none found.
+func (m *SetQuietPeriodsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetQuietPeriodsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetQuietPeriodsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetQuietPeriodsResponseMultiError is an error wrapping multiple validation
+// errors returned by SetQuietPeriodsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetQuietPeriodsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetQuietPeriodsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetQuietPeriodsResponseMultiError) AllErrors() []error { return m }
+
+// SetQuietPeriodsResponseValidationError is the validation error returned by
+// SetQuietPeriodsResponse.Validate if the designated constraints aren't met.
+type SetQuietPeriodsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetQuietPeriodsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetQuietPeriodsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetQuietPeriodsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetQuietPeriodsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetQuietPeriodsResponseValidationError) ErrorName() string {
+	return "SetQuietPeriodsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetQuietPeriodsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetQuietPeriodsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetQuietPeriodsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetQuietPeriodsResponseValidationError{}
+
+// Validate checks the field values on SetAnnouncementsEnabledRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetAnnouncementsEnabledRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetAnnouncementsEnabledRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetAnnouncementsEnabledRequestMultiError, or nil if none found.
+func (m *SetAnnouncementsEnabledRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetAnnouncementsEnabledRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := SetAnnouncementsEnabledRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return SetAnnouncementsEnabledRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetAnnouncementsEnabledRequestMultiError is an error wrapping multiple
+// validation errors returned by SetAnnouncementsEnabledRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SetAnnouncementsEnabledRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetAnnouncementsEnabledRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetAnnouncementsEnabledRequestMultiError) AllErrors() []error { return m }
+
+// SetAnnouncementsEnabledRequestValidationError is the validation error
+// returned by SetAnnouncementsEnabledRequest.Validate if the designated
+// constraints aren't met.
+type SetAnnouncementsEnabledRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetAnnouncementsEnabledRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetAnnouncementsEnabledRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetAnnouncementsEnabledRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetAnnouncementsEnabledRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetAnnouncementsEnabledRequestValidationError) ErrorName() string {
+	return "SetAnnouncementsEnabledRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetAnnouncementsEnabledRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetAnnouncementsEnabledRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetAnnouncementsEnabledRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetAnnouncementsEnabledRequestValidationError{}
+
+// Validate checks the field values on SetAnnouncementsEnabledResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetAnnouncementsEnabledResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetAnnouncementsEnabledResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetAnnouncementsEnabledResponseMultiError, or nil if none found.
+func (m *SetAnnouncementsEnabledResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetAnnouncementsEnabledResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetAnnouncementsEnabledResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetAnnouncementsEnabledResponseMultiError is an error wrapping multiple
+// validation errors returned by SetAnnouncementsEnabledResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetAnnouncementsEnabledResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetAnnouncementsEnabledResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetAnnouncementsEnabledResponseMultiError) AllErrors() []error { return m }
+
+// SetAnnouncementsEnabledResponseValidationError is the validation error
+// returned by SetAnnouncementsEnabledResponse.Validate if the designated
+// constraints aren't met.
+type SetAnnouncementsEnabledResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetAnnouncementsEnabledResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetAnnouncementsEnabledResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetAnnouncementsEnabledResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetAnnouncementsEnabledResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetAnnouncementsEnabledResponseValidationError) ErrorName() string {
+	return "SetAnnouncementsEnabledResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetAnnouncementsEnabledResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetAnnouncementsEnabledResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetAnnouncementsEnabledResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetAnnouncementsEnabledResponseValidationError{}
+
+// Validate checks the field values on QuietPeriod with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuietPeriod) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuietPeriod with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuietPeriodMultiError, or
+// nil if none found.
+func (m *QuietPeriod) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuietPeriod) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStartsAt() == nil {
+		err := QuietPeriodValidationError{
+			field:  "StartsAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndsAt() == nil {
+		err := QuietPeriodValidationError{
+			field:  "EndsAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Weekly
+
+	if len(errors) > 0 {
+		return QuietPeriodMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuietPeriodMultiError is an error wrapping multiple validation errors
+// returned by QuietPeriod.ValidateAll() if the designated constraints aren't met.
+type QuietPeriodMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuietPeriodMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuietPeriodMultiError) AllErrors() []error { return m }
+
+// QuietPeriodValidationError is the validation error returned by
+// QuietPeriod.Validate if the designated constraints aren't met.
+type QuietPeriodValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuietPeriodValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuietPeriodValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuietPeriodValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuietPeriodValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuietPeriodValidationError) ErrorName() string { return "QuietPeriodValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuietPeriodValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuietPeriod.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuietPeriodValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuietPeriodValidationError{}
+
+// Validate checks the field values on CreateCourseAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCourseAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCourseAPIKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCourseAPIKeyRequestMultiError, or nil if none found.
+func (m *CreateCourseAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCourseAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := CreateCourseAPIKeyRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetScopes()) < 1 {
+		err := CreateCourseAPIKeyRequestValidationError{
+			field:  "Scopes",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCourseAPIKeyRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCourseAPIKeyRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCourseAPIKeyRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCourseAPIKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCourseAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCourseAPIKeyRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateCourseAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCourseAPIKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCourseAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// CreateCourseAPIKeyRequestValidationError is the validation error returned by
+// CreateCourseAPIKeyRequest.Validate if the designated constraints aren't met.
+type CreateCourseAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCourseAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCourseAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCourseAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCourseAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCourseAPIKeyRequestValidationError) ErrorName() string {
+	return "CreateCourseAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCourseAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCourseAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCourseAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCourseAPIKeyRequestValidationError{}
+
+// Validate checks the field values on CreateCourseAPIKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCourseAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCourseAPIKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCourseAPIKeyResponseMultiError, or nil if none found.
+func (m *CreateCourseAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCourseAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCourseAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCourseAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCourseAPIKeyResponseValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return CreateCourseAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCourseAPIKeyResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateCourseAPIKeyResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateCourseAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCourseAPIKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCourseAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// CreateCourseAPIKeyResponseValidationError is the validation error returned
+// by CreateCourseAPIKeyResponse.Validate if the designated constraints aren't met.
+type CreateCourseAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCourseAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCourseAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCourseAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCourseAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCourseAPIKeyResponseValidationError) ErrorName() string {
+	return "CreateCourseAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCourseAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCourseAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCourseAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCourseAPIKeyResponseValidationError{}
+
+// Validate checks the field values on ListCourseAPIKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCourseAPIKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCourseAPIKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCourseAPIKeysRequestMultiError, or nil if none found.
+func (m *ListCourseAPIKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCourseAPIKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := ListCourseAPIKeysRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCourseAPIKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCourseAPIKeysRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCourseAPIKeysRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCourseAPIKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCourseAPIKeysRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCourseAPIKeysRequestMultiError) AllErrors() []error { return m }
+
+// ListCourseAPIKeysRequestValidationError is the validation error returned by
+// ListCourseAPIKeysRequest.Validate if the designated constraints aren't met.
+type ListCourseAPIKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCourseAPIKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCourseAPIKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCourseAPIKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCourseAPIKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCourseAPIKeysRequestValidationError) ErrorName() string {
+	return "ListCourseAPIKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCourseAPIKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCourseAPIKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCourseAPIKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCourseAPIKeysRequestValidationError{}
+
+// Validate checks the field values on ListCourseAPIKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCourseAPIKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCourseAPIKeysResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCourseAPIKeysResponseMultiError, or nil if none found.
+func (m *ListCourseAPIKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCourseAPIKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCourseAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCourseAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCourseAPIKeysResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCourseAPIKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCourseAPIKeysResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCourseAPIKeysResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListCourseAPIKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCourseAPIKeysResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCourseAPIKeysResponseMultiError) AllErrors() []error { return m }
+
+// ListCourseAPIKeysResponseValidationError is the validation error returned by
+// ListCourseAPIKeysResponse.Validate if the designated constraints aren't met.
+type ListCourseAPIKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCourseAPIKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCourseAPIKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCourseAPIKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCourseAPIKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCourseAPIKeysResponseValidationError) ErrorName() string {
+	return "ListCourseAPIKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCourseAPIKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCourseAPIKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCourseAPIKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCourseAPIKeysResponseValidationError{}
+
+// Validate checks the field values on RevokeCourseAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeCourseAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeCourseAPIKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeCourseAPIKeyRequestMultiError, or nil if none found.
+func (m *RevokeCourseAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeCourseAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := RevokeCourseAPIKeyRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKeyID()) < 1 {
+		err := RevokeCourseAPIKeyRequestValidationError{
+			field:  "KeyID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeCourseAPIKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeCourseAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeCourseAPIKeyRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RevokeCourseAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeCourseAPIKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeCourseAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// RevokeCourseAPIKeyRequestValidationError is the validation error returned by
+// RevokeCourseAPIKeyRequest.Validate if the designated constraints aren't met.
+type RevokeCourseAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeCourseAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeCourseAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeCourseAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeCourseAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeCourseAPIKeyRequestValidationError) ErrorName() string {
+	return "RevokeCourseAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeCourseAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeCourseAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeCourseAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeCourseAPIKeyRequestValidationError{}
+
+// Validate checks the field values on RevokeCourseAPIKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeCourseAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeCourseAPIKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeCourseAPIKeyResponseMultiError, or nil if none found.
+func (m *RevokeCourseAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeCourseAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeCourseAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeCourseAPIKeyResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeCourseAPIKeyResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeCourseAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeCourseAPIKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeCourseAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// RevokeCourseAPIKeyResponseValidationError is the validation error returned
+// by RevokeCourseAPIKeyResponse.Validate if the designated constraints aren't met.
+type RevokeCourseAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeCourseAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeCourseAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeCourseAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeCourseAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeCourseAPIKeyResponseValidationError) ErrorName() string {
+	return "RevokeCourseAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeCourseAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeCourseAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeCourseAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeCourseAPIKeyResponseValidationError{}
+
+// Validate checks the field values on CourseAPIKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CourseAPIKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CourseAPIKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CourseAPIKeyMultiError, or
+// nil if none found.
+func (m *CourseAPIKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CourseAPIKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeyID
+
+	// no validation rules for CourseID
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CourseAPIKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CourseAPIKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CourseAPIKeyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CourseAPIKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CourseAPIKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CourseAPIKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CourseAPIKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CourseAPIKeyMultiError is an error wrapping multiple validation errors
+// returned by CourseAPIKey.ValidateAll() if the designated constraints aren't met.
+type CourseAPIKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CourseAPIKeyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CourseAPIKeyMultiError) AllErrors() []error { return m }
+
+// CourseAPIKeyValidationError is the validation error returned by
+// CourseAPIKey.Validate if the designated constraints aren't met.
+type CourseAPIKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CourseAPIKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CourseAPIKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CourseAPIKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CourseAPIKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CourseAPIKeyValidationError) ErrorName() string { return "CourseAPIKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CourseAPIKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCourseAPIKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CourseAPIKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CourseAPIKeyValidationError{}
+
+// Validate checks the field values on AddCorequisiteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddCorequisiteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCorequisiteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddCorequisiteRequestMultiError, or nil if none found.
+func (m *AddCorequisiteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCorequisiteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := AddCorequisiteRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCorequisiteID()) < 1 {
+		err := AddCorequisiteRequestValidationError{
+			field:  "CorequisiteID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddCorequisiteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCorequisiteRequestMultiError is an error wrapping multiple validation
+// errors returned by AddCorequisiteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddCorequisiteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCorequisiteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCorequisiteRequestMultiError) AllErrors() []error { return m }
+
+// AddCorequisiteRequestValidationError is the validation error returned by
+// AddCorequisiteRequest.Validate if the designated constraints aren't met.
+type AddCorequisiteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCorequisiteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCorequisiteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCorequisiteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCorequisiteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCorequisiteRequestValidationError) ErrorName() string {
+	return "AddCorequisiteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCorequisiteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCorequisiteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCorequisiteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCorequisiteRequestValidationError{}
+
+// Validate checks the field values on AddCorequisiteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddCorequisiteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCorequisiteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddCorequisiteResponseMultiError, or nil if none found.
+func (m *AddCorequisiteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCorequisiteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddCorequisiteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCorequisiteResponseMultiError is an error wrapping multiple validation
+// errors returned by AddCorequisiteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddCorequisiteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCorequisiteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCorequisiteResponseMultiError) AllErrors() []error { return m }
+
+// AddCorequisiteResponseValidationError is the validation error returned by
+// AddCorequisiteResponse.Validate if the designated constraints aren't met.
+type AddCorequisiteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCorequisiteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCorequisiteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCorequisiteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCorequisiteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCorequisiteResponseValidationError) ErrorName() string {
+	return "AddCorequisiteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCorequisiteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCorequisiteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCorequisiteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCorequisiteResponseValidationError{}
+
+// Validate checks the field values on GetCorequisitesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCorequisitesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCorequisitesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCorequisitesRequestMultiError, or nil if none found.
+func (m *GetCorequisitesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCorequisitesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetCorequisitesRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCorequisitesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCorequisitesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCorequisitesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCorequisitesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCorequisitesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCorequisitesRequestMultiError) AllErrors() []error { return m }
+
+// GetCorequisitesRequestValidationError is the validation error returned by
+// GetCorequisitesRequest.Validate if the designated constraints aren't met.
+type GetCorequisitesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCorequisitesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCorequisitesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCorequisitesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCorequisitesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCorequisitesRequestValidationError) ErrorName() string {
+	return "GetCorequisitesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCorequisitesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCorequisitesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCorequisitesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCorequisitesRequestValidationError{}
+
+// Validate checks the field values on GetCorequisitesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCorequisitesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCorequisitesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCorequisitesResponseMultiError, or nil if none found.
+func (m *GetCorequisitesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCorequisitesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetCorequisitesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCorequisitesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCorequisitesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCorequisitesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCorequisitesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCorequisitesResponseMultiError) AllErrors() []error { return m }
+
+// GetCorequisitesResponseValidationError is the validation error returned by
+// GetCorequisitesResponse.Validate if the designated constraints aren't met.
+type GetCorequisitesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCorequisitesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCorequisitesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCorequisitesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCorequisitesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCorequisitesResponseValidationError) ErrorName() string {
+	return "GetCorequisitesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCorequisitesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCorequisitesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCorequisitesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCorequisitesResponseValidationError{}
+
+// Validate checks the field values on GetSharedCoursesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSharedCoursesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSharedCoursesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSharedCoursesRequestMultiError, or nil if none found.
+func (m *GetSharedCoursesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSharedCoursesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetStaffID()) < 1 {
+		err := GetSharedCoursesRequestValidationError{
+			field:  "StaffID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStudentID()) < 1 {
+		err := GetSharedCoursesRequestValidationError{
+			field:  "StudentID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSharedCoursesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSharedCoursesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSharedCoursesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSharedCoursesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSharedCoursesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSharedCoursesRequestMultiError) AllErrors() []error { return m }
+
+// GetSharedCoursesRequestValidationError is the validation error returned by
+// GetSharedCoursesRequest.Validate if the designated constraints aren't met.
+type GetSharedCoursesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSharedCoursesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSharedCoursesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSharedCoursesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSharedCoursesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSharedCoursesRequestValidationError) ErrorName() string {
+	return "GetSharedCoursesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSharedCoursesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSharedCoursesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSharedCoursesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSharedCoursesRequestValidationError{}
+
+// Validate checks the field values on GetSharedCoursesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSharedCoursesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSharedCoursesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSharedCoursesResponseMultiError, or nil if none found.
+func (m *GetSharedCoursesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSharedCoursesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetSharedCoursesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSharedCoursesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSharedCoursesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSharedCoursesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSharedCoursesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSharedCoursesResponseMultiError) AllErrors() []error { return m }
+
+// GetSharedCoursesResponseValidationError is the validation error returned by
+// GetSharedCoursesResponse.Validate if the designated constraints aren't met.
+type GetSharedCoursesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSharedCoursesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSharedCoursesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSharedCoursesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSharedCoursesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSharedCoursesResponseValidationError) ErrorName() string {
+	return "GetSharedCoursesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSharedCoursesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSharedCoursesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSharedCoursesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSharedCoursesResponseValidationError{}
+
+// Validate checks the field values on GradingComponent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GradingComponent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GradingComponent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GradingComponentMultiError, or nil if none found.
+func (m *GradingComponent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GradingComponent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GradingComponentValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetWeight(); val <= 0 || val > 100 {
+		err := GradingComponentValidationError{
+			field:  "Weight",
+			reason: "value must be inside range (0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return GradingComponentMultiError(errors)
+	}
+
+	return nil
+}
+
+// GradingComponentMultiError is an error wrapping multiple validation errors
+// returned by GradingComponent.ValidateAll() if the designated constraints
+// aren't met.
+type GradingComponentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GradingComponentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GradingComponentMultiError) AllErrors() []error { return m }
+
+// GradingComponentValidationError is the validation error returned by
+// GradingComponent.Validate if the designated constraints aren't met.
+type GradingComponentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GradingComponentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GradingComponentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GradingComponentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GradingComponentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GradingComponentValidationError) ErrorName() string { return "GradingComponentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GradingComponentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGradingComponent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GradingComponentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GradingComponentValidationError{}
+
+// Validate checks the field values on SetGradingComponentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetGradingComponentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetGradingComponentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetGradingComponentRequestMultiError, or nil if none found.
+func (m *SetGradingComponentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetGradingComponentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := SetGradingComponentRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetComponent() == nil {
+		err := SetGradingComponentRequestValidationError{
+			field:  "Component",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetComponent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetGradingComponentRequestValidationError{
+					field:  "Component",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetGradingComponentRequestValidationError{
+					field:  "Component",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComponent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetGradingComponentRequestValidationError{
+				field:  "Component",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetGradingComponentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetGradingComponentRequestMultiError is an error wrapping multiple
+// validation errors returned by SetGradingComponentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetGradingComponentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetGradingComponentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetGradingComponentRequestMultiError) AllErrors() []error { return m }
+
+// SetGradingComponentRequestValidationError is the validation error returned
+// by SetGradingComponentRequest.Validate if the designated constraints aren't met.
+type SetGradingComponentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetGradingComponentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetGradingComponentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetGradingComponentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetGradingComponentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetGradingComponentRequestValidationError) ErrorName() string {
+	return "SetGradingComponentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetGradingComponentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetGradingComponentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetGradingComponentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetGradingComponentRequestValidationError{}
+
+// Validate checks the field values on SetGradingComponentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetGradingComponentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetGradingComponentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetGradingComponentResponseMultiError, or nil if none found.
+func (m *SetGradingComponentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetGradingComponentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetGradingComponentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetGradingComponentResponseMultiError is an error wrapping multiple
+// validation errors returned by SetGradingComponentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetGradingComponentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetGradingComponentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetGradingComponentResponseMultiError) AllErrors() []error { return m }
+
+// SetGradingComponentResponseValidationError is the validation error returned
+// by SetGradingComponentResponse.Validate if the designated constraints
+// aren't met.
+type SetGradingComponentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetGradingComponentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetGradingComponentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetGradingComponentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetGradingComponentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetGradingComponentResponseValidationError) ErrorName() string {
+	return "SetGradingComponentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetGradingComponentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetGradingComponentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetGradingComponentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetGradingComponentResponseValidationError{}
+
+// Validate checks the field values on RemoveGradingComponentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveGradingComponentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveGradingComponentRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveGradingComponentRequestMultiError, or nil if none found.
+func (m *RemoveGradingComponentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveGradingComponentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := RemoveGradingComponentRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := RemoveGradingComponentRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveGradingComponentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveGradingComponentRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveGradingComponentRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveGradingComponentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveGradingComponentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveGradingComponentRequestMultiError) AllErrors() []error { return m }
+
+// RemoveGradingComponentRequestValidationError is the validation error
+// returned by RemoveGradingComponentRequest.Validate if the designated
+// constraints aren't met.
+type RemoveGradingComponentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveGradingComponentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveGradingComponentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveGradingComponentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveGradingComponentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveGradingComponentRequestValidationError) ErrorName() string {
+	return "RemoveGradingComponentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveGradingComponentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveGradingComponentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveGradingComponentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveGradingComponentRequestValidationError{}
+
+// Validate checks the field values on RemoveGradingComponentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveGradingComponentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveGradingComponentResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveGradingComponentResponseMultiError, or nil if none found.
+func (m *RemoveGradingComponentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveGradingComponentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveGradingComponentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveGradingComponentResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveGradingComponentResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveGradingComponentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveGradingComponentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveGradingComponentResponseMultiError) AllErrors() []error { return m }
+
+// RemoveGradingComponentResponseValidationError is the validation error
+// returned by RemoveGradingComponentResponse.Validate if the designated
+// constraints aren't met.
+type RemoveGradingComponentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveGradingComponentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveGradingComponentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveGradingComponentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveGradingComponentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveGradingComponentResponseValidationError) ErrorName() string {
+	return "RemoveGradingComponentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveGradingComponentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveGradingComponentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveGradingComponentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveGradingComponentResponseValidationError{}
+
+// Validate checks the field values on GetGradingSchemeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGradingSchemeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetGradingSchemeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGradingSchemeRequestMultiError, or nil if none found.
+func (m *GetGradingSchemeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetGradingSchemeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetGradingSchemeRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetGradingSchemeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetGradingSchemeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetGradingSchemeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetGradingSchemeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetGradingSchemeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetGradingSchemeRequestMultiError) AllErrors() []error { return m }
+
+// GetGradingSchemeRequestValidationError is the validation error returned by
+// GetGradingSchemeRequest.Validate if the designated constraints aren't met.
+type GetGradingSchemeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGradingSchemeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGradingSchemeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGradingSchemeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGradingSchemeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGradingSchemeRequestValidationError) ErrorName() string {
+	return "GetGradingSchemeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGradingSchemeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGradingSchemeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGradingSchemeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGradingSchemeRequestValidationError{}
+
+// Validate checks the field values on GetGradingSchemeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGradingSchemeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetGradingSchemeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGradingSchemeResponseMultiError, or nil if none found.
+func (m *GetGradingSchemeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetGradingSchemeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComponents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetGradingSchemeResponseValidationError{
+						field:  fmt.Sprintf("Components[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetGradingSchemeResponseValidationError{
+						field:  fmt.Sprintf("Components[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetGradingSchemeResponseValidationError{
+					field:  fmt.Sprintf("Components[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinalizedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetGradingSchemeResponseValidationError{
+					field:  "FinalizedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetGradingSchemeResponseValidationError{
+					field:  "FinalizedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinalizedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetGradingSchemeResponseValidationError{
+				field:  "FinalizedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetGradingSchemeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetGradingSchemeResponseMultiError is an error wrapping multiple validation
+// errors returned by GetGradingSchemeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetGradingSchemeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetGradingSchemeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetGradingSchemeResponseMultiError) AllErrors() []error { return m }
+
+// GetGradingSchemeResponseValidationError is the validation error returned by
+// GetGradingSchemeResponse.Validate if the designated constraints aren't met.
+type GetGradingSchemeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGradingSchemeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGradingSchemeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGradingSchemeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGradingSchemeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGradingSchemeResponseValidationError) ErrorName() string {
+	return "GetGradingSchemeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGradingSchemeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGradingSchemeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGradingSchemeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGradingSchemeResponseValidationError{}
+
+// Validate checks the field values on FinalizeGradingSchemeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinalizeGradingSchemeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinalizeGradingSchemeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinalizeGradingSchemeRequestMultiError, or nil if none found.
+func (m *FinalizeGradingSchemeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinalizeGradingSchemeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := FinalizeGradingSchemeRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FinalizeGradingSchemeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinalizeGradingSchemeRequestMultiError is an error wrapping multiple
+// validation errors returned by FinalizeGradingSchemeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type FinalizeGradingSchemeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinalizeGradingSchemeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinalizeGradingSchemeRequestMultiError) AllErrors() []error { return m }
+
+// FinalizeGradingSchemeRequestValidationError is the validation error returned
+// by FinalizeGradingSchemeRequest.Validate if the designated constraints
+// aren't met.
+type FinalizeGradingSchemeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinalizeGradingSchemeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinalizeGradingSchemeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinalizeGradingSchemeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinalizeGradingSchemeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinalizeGradingSchemeRequestValidationError) ErrorName() string {
+	return "FinalizeGradingSchemeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinalizeGradingSchemeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinalizeGradingSchemeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinalizeGradingSchemeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinalizeGradingSchemeRequestValidationError{}
+
+// Validate checks the field values on FinalizeGradingSchemeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinalizeGradingSchemeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinalizeGradingSchemeResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// FinalizeGradingSchemeResponseMultiError, or nil if none found.
+func (m *FinalizeGradingSchemeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinalizeGradingSchemeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComponents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FinalizeGradingSchemeResponseValidationError{
+						field:  fmt.Sprintf("Components[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FinalizeGradingSchemeResponseValidationError{
+						field:  fmt.Sprintf("Components[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FinalizeGradingSchemeResponseValidationError{
+					field:  fmt.Sprintf("Components[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinalizedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FinalizeGradingSchemeResponseValidationError{
+					field:  "FinalizedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FinalizeGradingSchemeResponseValidationError{
+					field:  "FinalizedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinalizedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FinalizeGradingSchemeResponseValidationError{
+				field:  "FinalizedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FinalizeGradingSchemeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinalizeGradingSchemeResponseMultiError is an error wrapping multiple
+// validation errors returned by FinalizeGradingSchemeResponse.ValidateAll()
+// if the designated constraints aren't met.
+type FinalizeGradingSchemeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinalizeGradingSchemeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinalizeGradingSchemeResponseMultiError) AllErrors() []error { return m }
+
+// FinalizeGradingSchemeResponseValidationError is the validation error
+// returned by FinalizeGradingSchemeResponse.Validate if the designated
+// constraints aren't met.
+type FinalizeGradingSchemeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinalizeGradingSchemeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinalizeGradingSchemeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinalizeGradingSchemeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinalizeGradingSchemeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinalizeGradingSchemeResponseValidationError) ErrorName() string {
+	return "FinalizeGradingSchemeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinalizeGradingSchemeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinalizeGradingSchemeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinalizeGradingSchemeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinalizeGradingSchemeResponseValidationError{}
+
+// Validate checks the field values on Course with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Course) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Course with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CourseMultiError, or nil if none found.
+func (m *Course) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Course) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := CourseValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CourseName
+
+	if m.GetSemester() != "" {
+
+		if !_Course_Semester_Pattern.MatchString(m.GetSemester()) {
+			err := CourseValidationError{
+				field:  "Semester",
+				reason: "value does not match regex pattern \"^(Winter|Spring|Summer)[ _][0-9]{4}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Description
+
+	if m.GetCapacity() < 0 {
+		err := CourseValidationError{
+			field:  "Capacity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AnnouncementsEnabled
+
+	if m.GetCredits() < 0 {
+		err := CourseValidationError{
+			field:  "Credits",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStaffDetails()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CourseValidationError{
+					field:  "StaffDetails",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CourseValidationError{
+					field:  "StaffDetails",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStaffDetails()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CourseValidationError{
+				field:  "StaffDetails",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CourseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CourseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CourseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CourseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CourseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CourseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetMetadata()) > 32 {
+		err := CourseValidationError{
+			field:  "Metadata",
+			reason: "value must contain no more than 32 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetMetadata()))
+		i := 0
+		for key := range m.GetMetadata() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetMetadata()[key]
+			_ = val
+
+			if l := utf8.RuneCountInString(key); l < 1 || l > 64 {
+				err := CourseValidationError{
+					field:  fmt.Sprintf("Metadata[%v]", key),
+					reason: "value length must be between 1 and 64 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 1024 {
+				err := CourseValidationError{
+					field:  fmt.Sprintf("Metadata[%v]", key),
+					reason: "value length must be at most 1024 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return CourseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CourseMultiError is an error wrapping multiple validation errors returned by
+// Course.ValidateAll() if the designated constraints aren't met.
+type CourseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CourseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CourseMultiError) AllErrors() []error { return m }
+
+// CourseValidationError is the validation error returned by Course.Validate if
+// the designated constraints aren't met.
+type CourseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CourseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CourseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CourseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CourseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CourseValidationError) ErrorName() string { return "CourseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CourseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCourse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CourseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CourseValidationError{}
+
+var _Course_Semester_Pattern = regexp.MustCompile("^(Winter|Spring|Summer)[ _][0-9]{4}$")
+
+// Validate checks the field values on CourseStaffDetails with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CourseStaffDetails) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CourseStaffDetails with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CourseStaffDetailsMultiError, or nil if none found.
+func (m *CourseStaffDetails) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CourseStaffDetails) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGradingFinalizedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CourseStaffDetailsValidationError{
+					field:  "GradingFinalizedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CourseStaffDetailsValidationError{
+					field:  "GradingFinalizedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGradingFinalizedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CourseStaffDetailsValidationError{
+				field:  "GradingFinalizedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnrollmentOpensAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CourseStaffDetailsValidationError{
+					field:  "EnrollmentOpensAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CourseStaffDetailsValidationError{
+					field:  "EnrollmentOpensAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnrollmentOpensAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CourseStaffDetailsValidationError{
+				field:  "EnrollmentOpensAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnrollmentClosesAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CourseStaffDetailsValidationError{
+					field:  "EnrollmentClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CourseStaffDetailsValidationError{
+					field:  "EnrollmentClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnrollmentClosesAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CourseStaffDetailsValidationError{
+				field:  "EnrollmentClosesAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DraftGradingComponents
+
+	if len(errors) > 0 {
+		return CourseStaffDetailsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CourseStaffDetailsMultiError is an error wrapping multiple validation errors
+// returned by CourseStaffDetails.ValidateAll() if the designated constraints
+// aren't met.
+type CourseStaffDetailsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CourseStaffDetailsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CourseStaffDetailsMultiError) AllErrors() []error { return m }
+
+// CourseStaffDetailsValidationError is the validation error returned by
+// CourseStaffDetails.Validate if the designated constraints aren't met.
+type CourseStaffDetailsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CourseStaffDetailsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CourseStaffDetailsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CourseStaffDetailsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CourseStaffDetailsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CourseStaffDetailsValidationError) ErrorName() string {
+	return "CourseStaffDetailsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CourseStaffDetailsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCourseStaffDetails.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CourseStaffDetailsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CourseStaffDetailsValidationError{}
+
+// Validate checks the field values on Announcement with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Announcement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Announcement with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AnnouncementMultiError, or
+// nil if none found.
+func (m *Announcement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Announcement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AnnouncementID
+
+	// no validation rules for AnnouncementTitle
+
+	if utf8.RuneCountInString(m.GetAnnouncementContent()) < 1 {
+		err := AnnouncementValidationError{
+			field:  "AnnouncementContent",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AnnouncementVisibility_name[int32(m.GetVisibility())]; !ok {
+		err := AnnouncementValidationError{
+			field:  "Visibility",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AnnouncementRecurrence_name[int32(m.GetRecurrence())]; !ok {
+		err := AnnouncementValidationError{
+			field:  "Recurrence",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextPostAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnnouncementValidationError{
+					field:  "NextPostAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnnouncementValidationError{
+					field:  "NextPostAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextPostAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnnouncementValidationError{
+				field:  "NextPostAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HasFullBody
+
+	// no validation rules for Author
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnnouncementValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnnouncementValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnnouncementValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnnouncementValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnnouncementValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnnouncementValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetSlug() != "" {
+
+		if !_Announcement_Slug_Pattern.MatchString(m.GetSlug()) {
+			err := AnnouncementValidationError{
+				field:  "Slug",
+				reason: "value does not match regex pattern \"^[a-z0-9]+(-[a-z0-9]+)*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AnnouncementMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnnouncementMultiError is an error wrapping multiple validation errors
+// returned by Announcement.ValidateAll() if the designated constraints aren't met.
+type AnnouncementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnnouncementMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnnouncementMultiError) AllErrors() []error { return m }
+
+// AnnouncementValidationError is the validation error returned by
+// Announcement.Validate if the designated constraints aren't met.
+type AnnouncementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnouncementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnouncementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnouncementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnouncementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnouncementValidationError) ErrorName() string { return "AnnouncementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnnouncementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnouncement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnouncementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnouncementValidationError{}
+
+var _Announcement_Slug_Pattern = regexp.MustCompile("^[a-z0-9]+(-[a-z0-9]+)*$")
+
+// Validate checks the field values on GenerateCourseShareTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateCourseShareTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateCourseShareTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateCourseShareTokenRequestMultiError, or nil if none found.
+func (m *GenerateCourseShareTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateCourseShareTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GenerateCourseShareTokenRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetTtlSeconds(); val <= 0 || val > 2592000 {
+		err := GenerateCourseShareTokenRequestValidationError{
+			field:  "TtlSeconds",
+			reason: "value must be inside range (0, 2592000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GenerateCourseShareTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateCourseShareTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by GenerateCourseShareTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GenerateCourseShareTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateCourseShareTokenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateCourseShareTokenRequestMultiError) AllErrors() []error { return m }
+
+// GenerateCourseShareTokenRequestValidationError is the validation error
+// returned by GenerateCourseShareTokenRequest.Validate if the designated
+// constraints aren't met.
+type GenerateCourseShareTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateCourseShareTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateCourseShareTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateCourseShareTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateCourseShareTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateCourseShareTokenRequestValidationError) ErrorName() string {
+	return "GenerateCourseShareTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateCourseShareTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateCourseShareTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateCourseShareTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateCourseShareTokenRequestValidationError{}
+
+// Validate checks the field values on GenerateCourseShareTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GenerateCourseShareTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateCourseShareTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateCourseShareTokenResponseMultiError, or nil if none found.
+func (m *GenerateCourseShareTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateCourseShareTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShareToken
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateCourseShareTokenResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateCourseShareTokenResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateCourseShareTokenResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenerateCourseShareTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateCourseShareTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GenerateCourseShareTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateCourseShareTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateCourseShareTokenResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateCourseShareTokenResponseMultiError) AllErrors() []error { return m }
+
+// GenerateCourseShareTokenResponseValidationError is the validation error
+// returned by GenerateCourseShareTokenResponse.Validate if the designated
+// constraints aren't met.
+type GenerateCourseShareTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateCourseShareTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateCourseShareTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateCourseShareTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateCourseShareTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateCourseShareTokenResponseValidationError) ErrorName() string {
+	return "GenerateCourseShareTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateCourseShareTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateCourseShareTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateCourseShareTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateCourseShareTokenResponseValidationError{}
+
+// Validate checks the field values on GetCourseByShareTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseByShareTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseByShareTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseByShareTokenRequestMultiError, or nil if none found.
+func (m *GetCourseByShareTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseByShareTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShareToken()) < 1 {
+		err := GetCourseByShareTokenRequestValidationError{
+			field:  "ShareToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCourseByShareTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseByShareTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCourseByShareTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetCourseByShareTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseByShareTokenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseByShareTokenRequestMultiError) AllErrors() []error { return m }
+
+// GetCourseByShareTokenRequestValidationError is the validation error returned
+// by GetCourseByShareTokenRequest.Validate if the designated constraints
+// aren't met.
+type GetCourseByShareTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseByShareTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseByShareTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseByShareTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseByShareTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseByShareTokenRequestValidationError) ErrorName() string {
+	return "GetCourseByShareTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseByShareTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseByShareTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseByShareTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseByShareTokenRequestValidationError{}
+
+// Validate checks the field values on GetCourseByShareTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseByShareTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseByShareTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetCourseByShareTokenResponseMultiError, or nil if none found.
+func (m *GetCourseByShareTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseByShareTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCourse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCourseByShareTokenResponseValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCourseByShareTokenResponseValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCourse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCourseByShareTokenResponseValidationError{
+				field:  "Course",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCourseByShareTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseByShareTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCourseByShareTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetCourseByShareTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseByShareTokenResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseByShareTokenResponseMultiError) AllErrors() []error { return m }
+
+// GetCourseByShareTokenResponseValidationError is the validation error
+// returned by GetCourseByShareTokenResponse.Validate if the designated
+// constraints aren't met.
+type GetCourseByShareTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseByShareTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseByShareTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseByShareTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseByShareTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseByShareTokenResponseValidationError) ErrorName() string {
+	return "GetCourseByShareTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseByShareTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseByShareTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseByShareTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseByShareTokenResponseValidationError{}
+
+// Validate checks the field values on GetCourseFeaturesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseFeaturesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseFeaturesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseFeaturesRequestMultiError, or nil if none found.
+func (m *GetCourseFeaturesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseFeaturesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetCourseFeaturesRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCourseFeaturesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseFeaturesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCourseFeaturesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCourseFeaturesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseFeaturesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseFeaturesRequestMultiError) AllErrors() []error { return m }
+
+// GetCourseFeaturesRequestValidationError is the validation error returned by
+// GetCourseFeaturesRequest.Validate if the designated constraints aren't met.
+type GetCourseFeaturesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseFeaturesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseFeaturesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseFeaturesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseFeaturesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseFeaturesRequestValidationError) ErrorName() string {
+	return "GetCourseFeaturesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseFeaturesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseFeaturesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseFeaturesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseFeaturesRequestValidationError{}
+
+// Validate checks the field values on GetCourseFeaturesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseFeaturesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseFeaturesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseFeaturesResponseMultiError, or nil if none found.
+func (m *GetCourseFeaturesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseFeaturesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFeatures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCourseFeaturesResponseValidationError{
+						field:  fmt.Sprintf("Features[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCourseFeaturesResponseValidationError{
+						field:  fmt.Sprintf("Features[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCourseFeaturesResponseValidationError{
+					field:  fmt.Sprintf("Features[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCourseFeaturesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseFeaturesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCourseFeaturesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetCourseFeaturesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseFeaturesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseFeaturesResponseMultiError) AllErrors() []error { return m }
+
+// GetCourseFeaturesResponseValidationError is the validation error returned by
+// GetCourseFeaturesResponse.Validate if the designated constraints aren't met.
+type GetCourseFeaturesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseFeaturesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseFeaturesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseFeaturesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseFeaturesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseFeaturesResponseValidationError) ErrorName() string {
+	return "GetCourseFeaturesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseFeaturesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseFeaturesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseFeaturesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseFeaturesResponseValidationError{}
+
+// Validate checks the field values on CourseFeature with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CourseFeature) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CourseFeature with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CourseFeatureMultiError, or
+// nil if none found.
+func (m *CourseFeature) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CourseFeature) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Enabled
+
+	// no validation rules for Overridden
+
+	if len(errors) > 0 {
+		return CourseFeatureMultiError(errors)
+	}
+
+	return nil
+}
+
+// CourseFeatureMultiError is an error wrapping multiple validation errors
+// returned by CourseFeature.ValidateAll() if the designated constraints
+// aren't met.
+type CourseFeatureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CourseFeatureMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CourseFeatureMultiError) AllErrors() []error { return m }
+
+// CourseFeatureValidationError is the validation error returned by
+// CourseFeature.Validate if the designated constraints aren't met.
+type CourseFeatureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CourseFeatureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CourseFeatureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CourseFeatureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CourseFeatureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CourseFeatureValidationError) ErrorName() string { return "CourseFeatureValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CourseFeatureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCourseFeature.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CourseFeatureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CourseFeatureValidationError{}
+
+// Validate checks the field values on SetCourseFeatureRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetCourseFeatureRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetCourseFeatureRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetCourseFeatureRequestMultiError, or nil if none found.
+func (m *SetCourseFeatureRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetCourseFeatureRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := SetCourseFeatureRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetFeature()) < 1 {
+		err := SetCourseFeatureRequestValidationError{
+			field:  "Feature",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := FeatureOverride_name[int32(m.GetOverride())]; !ok {
+		err := SetCourseFeatureRequestValidationError{
+			field:  "Override",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetCourseFeatureRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetCourseFeatureRequestMultiError is an error wrapping multiple validation
+// errors returned by SetCourseFeatureRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetCourseFeatureRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetCourseFeatureRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetCourseFeatureRequestMultiError) AllErrors() []error { return m }
+
+// SetCourseFeatureRequestValidationError is the validation error returned by
+// SetCourseFeatureRequest.Validate if the designated constraints aren't met.
+type SetCourseFeatureRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetCourseFeatureRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetCourseFeatureRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetCourseFeatureRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetCourseFeatureRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetCourseFeatureRequestValidationError) ErrorName() string {
+	return "SetCourseFeatureRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetCourseFeatureRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetCourseFeatureRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetCourseFeatureRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetCourseFeatureRequestValidationError{}
+
+// Validate checks the field values on SetCourseFeatureResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetCourseFeatureResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetCourseFeatureResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetCourseFeatureResponseMultiError, or nil if none found.
+func (m *SetCourseFeatureResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetCourseFeatureResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetCourseFeatureResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetCourseFeatureResponseMultiError is an error wrapping multiple validation
+// errors returned by SetCourseFeatureResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetCourseFeatureResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetCourseFeatureResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetCourseFeatureResponseMultiError) AllErrors() []error { return m }
+
+// SetCourseFeatureResponseValidationError is the validation error returned by
+// SetCourseFeatureResponse.Validate if the designated constraints aren't met.
+type SetCourseFeatureResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetCourseFeatureResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetCourseFeatureResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetCourseFeatureResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetCourseFeatureResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetCourseFeatureResponseValidationError) ErrorName() string {
+	return "SetCourseFeatureResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetCourseFeatureResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetCourseFeatureResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetCourseFeatureResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetCourseFeatureResponseValidationError{}
+
+// Validate checks the field values on GetAnnouncementCountByAuthorRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetAnnouncementCountByAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnnouncementCountByAuthorRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetAnnouncementCountByAuthorRequestMultiError, or nil if none found.
+func (m *GetAnnouncementCountByAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnnouncementCountByAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetAnnouncementCountByAuthorRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAnnouncementCountByAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnnouncementCountByAuthorRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetAnnouncementCountByAuthorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAnnouncementCountByAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnnouncementCountByAuthorRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnnouncementCountByAuthorRequestMultiError) AllErrors() []error { return m }
+
+// GetAnnouncementCountByAuthorRequestValidationError is the validation error
+// returned by GetAnnouncementCountByAuthorRequest.Validate if the designated
+// constraints aren't met.
+type GetAnnouncementCountByAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnnouncementCountByAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnnouncementCountByAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnnouncementCountByAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnnouncementCountByAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnnouncementCountByAuthorRequestValidationError) ErrorName() string {
+	return "GetAnnouncementCountByAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnnouncementCountByAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnnouncementCountByAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnnouncementCountByAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnnouncementCountByAuthorRequestValidationError{}
+
+// Validate checks the field values on GetAnnouncementCountByAuthorResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetAnnouncementCountByAuthorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnnouncementCountByAuthorResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetAnnouncementCountByAuthorResponseMultiError, or nil if none found.
+func (m *GetAnnouncementCountByAuthorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnnouncementCountByAuthorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAnnouncementCountByAuthorResponseValidationError{
+						field:  fmt.Sprintf("Counts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAnnouncementCountByAuthorResponseValidationError{
+						field:  fmt.Sprintf("Counts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAnnouncementCountByAuthorResponseValidationError{
+					field:  fmt.Sprintf("Counts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAnnouncementCountByAuthorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnnouncementCountByAuthorResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetAnnouncementCountByAuthorResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAnnouncementCountByAuthorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnnouncementCountByAuthorResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnnouncementCountByAuthorResponseMultiError) AllErrors() []error { return m }
+
+// GetAnnouncementCountByAuthorResponseValidationError is the validation error
+// returned by GetAnnouncementCountByAuthorResponse.Validate if the designated
+// constraints aren't met.
+type GetAnnouncementCountByAuthorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnnouncementCountByAuthorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnnouncementCountByAuthorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnnouncementCountByAuthorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnnouncementCountByAuthorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnnouncementCountByAuthorResponseValidationError) ErrorName() string {
+	return "GetAnnouncementCountByAuthorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnnouncementCountByAuthorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnnouncementCountByAuthorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnnouncementCountByAuthorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnnouncementCountByAuthorResponseValidationError{}
+
+// Validate checks the field values on AuthorAnnouncementCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthorAnnouncementCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorAnnouncementCount with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorAnnouncementCountMultiError, or nil if none found.
+func (m *AuthorAnnouncementCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorAnnouncementCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Author
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AuthorAnnouncementCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorAnnouncementCountMultiError is an error wrapping multiple validation
+// errors returned by AuthorAnnouncementCount.ValidateAll() if the designated
+// constraints aren't met.
+type AuthorAnnouncementCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorAnnouncementCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorAnnouncementCountMultiError) AllErrors() []error { return m }
+
+// AuthorAnnouncementCountValidationError is the validation error returned by
+// AuthorAnnouncementCount.Validate if the designated constraints aren't met.
+type AuthorAnnouncementCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorAnnouncementCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorAnnouncementCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorAnnouncementCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorAnnouncementCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorAnnouncementCountValidationError) ErrorName() string {
+	return "AuthorAnnouncementCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorAnnouncementCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorAnnouncementCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorAnnouncementCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorAnnouncementCountValidationError{}
+
+// Validate checks the field values on GetCourseSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseSnapshotRequestMultiError, or nil if none found.
+func (m *GetCourseSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if utf8.RuneCountInString(m.GetCourseID()) < 1 {
+		err := GetCourseSnapshotRequestValidationError{
+			field:  "CourseID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCourseSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseSnapshotRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCourseSnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCourseSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseSnapshotRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// GetCourseSnapshotRequestValidationError is the validation error returned by
+// GetCourseSnapshotRequest.Validate if the designated constraints aren't met.
+type GetCourseSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseSnapshotRequestValidationError) ErrorName() string {
+	return "GetCourseSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseSnapshotRequestValidationError{}
+
+// Validate checks the field values on GetCourseSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseSnapshotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseSnapshotResponseMultiError, or nil if none found.
+func (m *GetCourseSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTakenAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCourseSnapshotResponseValidationError{
+					field:  "TakenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCourseSnapshotResponseValidationError{
+					field:  "TakenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTakenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCourseSnapshotResponseValidationError{
+				field:  "TakenAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCourse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCourseSnapshotResponseValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCourseSnapshotResponseValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCourse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCourseSnapshotResponseValidationError{
+				field:  "Course",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetStudents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCourseSnapshotResponseValidationError{
+						field:  fmt.Sprintf("Students[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCourseSnapshotResponseValidationError{
+						field:  fmt.Sprintf("Students[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCourseSnapshotResponseValidationError{
+					field:  fmt.Sprintf("Students[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStaff() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCourseSnapshotResponseValidationError{
+						field:  fmt.Sprintf("Staff[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCourseSnapshotResponseValidationError{
+						field:  fmt.Sprintf("Staff[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCourseSnapshotResponseValidationError{
+					field:  fmt.Sprintf("Staff[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAnnouncements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCourseSnapshotResponseValidationError{
+						field:  fmt.Sprintf("Announcements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCourseSnapshotResponseValidationError{
+						field:  fmt.Sprintf("Announcements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCourseSnapshotResponseValidationError{
+					field:  fmt.Sprintf("Announcements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetQuietPeriods() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCourseSnapshotResponseValidationError{
+						field:  fmt.Sprintf("QuietPeriods[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCourseSnapshotResponseValidationError{
+						field:  fmt.Sprintf("QuietPeriods[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCourseSnapshotResponseValidationError{
+					field:  fmt.Sprintf("QuietPeriods[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCourseSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseSnapshotResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCourseSnapshotResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetCourseSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseSnapshotResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// GetCourseSnapshotResponseValidationError is the validation error returned by
+// GetCourseSnapshotResponse.Validate if the designated constraints aren't met.
+type GetCourseSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseSnapshotResponseValidationError) ErrorName() string {
+	return "GetCourseSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCourseSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseSnapshotResponseValidationError{}
+
+// Validate checks the field values on CourseStudent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CourseStudent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CourseStudent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CourseStudentMultiError, or
+// nil if none found.
+func (m *CourseStudent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CourseStudent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StudentID
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return CourseStudentMultiError(errors)
+	}
+
+	return nil
+}
+
+// CourseStudentMultiError is an error wrapping multiple validation errors
+// returned by CourseStudent.ValidateAll() if the designated constraints
+// aren't met.
+type CourseStudentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CourseStudentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CourseStudentMultiError) AllErrors() []error { return m }
+
+// CourseStudentValidationError is the validation error returned by
+// CourseStudent.Validate if the designated constraints aren't met.
+type CourseStudentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CourseStudentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CourseStudentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CourseStudentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CourseStudentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CourseStudentValidationError) ErrorName() string { return "CourseStudentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CourseStudentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCourseStudent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CourseStudentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CourseStudentValidationError{}
+
+// Validate checks the field values on GetDataShapeReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDataShapeReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDataShapeReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDataShapeReportRequestMultiError, or nil if none found.
+func (m *GetDataShapeReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDataShapeReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return GetDataShapeReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDataShapeReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDataShapeReportRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetDataShapeReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDataShapeReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDataShapeReportRequestMultiError) AllErrors() []error { return m }
+
+// GetDataShapeReportRequestValidationError is the validation error returned by
+// GetDataShapeReportRequest.Validate if the designated constraints aren't met.
+type GetDataShapeReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDataShapeReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDataShapeReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDataShapeReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDataShapeReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDataShapeReportRequestValidationError) ErrorName() string {
+	return "GetDataShapeReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDataShapeReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDataShapeReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDataShapeReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDataShapeReportRequestValidationError{}
+
+// Validate checks the field values on GetDataShapeReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDataShapeReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDataShapeReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDataShapeReportResponseMultiError, or nil if none found.
+func (m *GetDataShapeReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDataShapeReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGeneratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDataShapeReportResponseValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDataShapeReportResponseValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDataShapeReportResponseValidationError{
+				field:  "GeneratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetCoursesPerSemester() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDataShapeReportResponseValidationError{
+						field:  fmt.Sprintf("CoursesPerSemester[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDataShapeReportResponseValidationError{
+						field:  fmt.Sprintf("CoursesPerSemester[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDataShapeReportResponseValidationError{
+					field:  fmt.Sprintf("CoursesPerSemester[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnrollmentsPerCourse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDataShapeReportResponseValidationError{
+					field:  "EnrollmentsPerCourse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDataShapeReportResponseValidationError{
+					field:  "EnrollmentsPerCourse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnrollmentsPerCourse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDataShapeReportResponseValidationError{
+				field:  "EnrollmentsPerCourse",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnouncementsPerCourse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDataShapeReportResponseValidationError{
+					field:  "AnnouncementsPerCourse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDataShapeReportResponseValidationError{
+					field:  "AnnouncementsPerCourse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncementsPerCourse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDataShapeReportResponseValidationError{
+				field:  "AnnouncementsPerCourse",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTableRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDataShapeReportResponseValidationError{
+						field:  fmt.Sprintf("TableRows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDataShapeReportResponseValidationError{
+						field:  fmt.Sprintf("TableRows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDataShapeReportResponseValidationError{
+					field:  fmt.Sprintf("TableRows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDataShapeReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDataShapeReportResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDataShapeReportResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDataShapeReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDataShapeReportResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDataShapeReportResponseMultiError) AllErrors() []error { return m }
+
+// GetDataShapeReportResponseValidationError is the validation error returned
+// by GetDataShapeReportResponse.Validate if the designated constraints aren't met.
+type GetDataShapeReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDataShapeReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDataShapeReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDataShapeReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDataShapeReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDataShapeReportResponseValidationError) ErrorName() string {
+	return "GetDataShapeReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDataShapeReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDataShapeReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDataShapeReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDataShapeReportResponseValidationError{}
+
+// Validate checks the field values on SemesterCourseCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SemesterCourseCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SemesterCourseCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SemesterCourseCountMultiError, or nil if none found.
+func (m *SemesterCourseCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SemesterCourseCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Semester
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return SemesterCourseCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// SemesterCourseCountMultiError is an error wrapping multiple validation
+// errors returned by SemesterCourseCount.ValidateAll() if the designated
+// constraints aren't met.
+type SemesterCourseCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SemesterCourseCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SemesterCourseCountMultiError) AllErrors() []error { return m }
+
+// SemesterCourseCountValidationError is the validation error returned by
+// SemesterCourseCount.Validate if the designated constraints aren't met.
+type SemesterCourseCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SemesterCourseCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SemesterCourseCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SemesterCourseCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SemesterCourseCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SemesterCourseCountValidationError) ErrorName() string {
+	return "SemesterCourseCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SemesterCourseCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSemesterCourseCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SemesterCourseCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SemesterCourseCountValidationError{}
+
+// Validate checks the field values on CountDistribution with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CountDistribution) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CountDistribution with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CountDistributionMultiError, or nil if none found.
+func (m *CountDistribution) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CountDistribution) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for P50
+
+	// no validation rules for P95
+
+	// no validation rules for Max
+
+	if len(errors) > 0 {
+		return CountDistributionMultiError(errors)
+	}
+
+	return nil
+}
+
+// CountDistributionMultiError is an error wrapping multiple validation errors
+// returned by CountDistribution.ValidateAll() if the designated constraints
+// aren't met.
+type CountDistributionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CountDistributionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CountDistributionMultiError) AllErrors() []error { return m }
+
+// CountDistributionValidationError is the validation error returned by
+// CountDistribution.Validate if the designated constraints aren't met.
+type CountDistributionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CountDistributionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CountDistributionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CountDistributionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CountDistributionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CountDistributionValidationError) ErrorName() string {
+	return "CountDistributionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CountDistributionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCountDistribution.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CountDistributionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CountDistributionValidationError{}
+
+// Validate checks the field values on TableRowCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TableRowCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableRowCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TableRowCountMultiError, or
+// nil if none found.
+func (m *TableRowCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableRowCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Table
+
+	// no validation rules for Rows
+
+	if len(errors) > 0 {
+		return TableRowCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableRowCountMultiError is an error wrapping multiple validation errors
+// returned by TableRowCount.ValidateAll() if the designated constraints
+// aren't met.
+type TableRowCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableRowCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableRowCountMultiError) AllErrors() []error { return m }
+
+// TableRowCountValidationError is the validation error returned by
+// TableRowCount.Validate if the designated constraints aren't met.
+type TableRowCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableRowCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableRowCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableRowCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableRowCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableRowCountValidationError) ErrorName() string { return "TableRowCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableRowCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableRowCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableRowCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableRowCountValidationError{}
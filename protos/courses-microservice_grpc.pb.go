@@ -19,22 +19,66 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CoursesService_GetCourse_FullMethodName                    = "/courses.CoursesService/GetCourse"
-	CoursesService_CreateCourse_FullMethodName                 = "/courses.CoursesService/CreateCourse"
-	CoursesService_UpdateCourse_FullMethodName                 = "/courses.CoursesService/UpdateCourse"
-	CoursesService_DeleteCourse_FullMethodName                 = "/courses.CoursesService/DeleteCourse"
-	CoursesService_AddStudentToCourse_FullMethodName           = "/courses.CoursesService/AddStudentToCourse"
-	CoursesService_RemoveStudentFromCourse_FullMethodName      = "/courses.CoursesService/RemoveStudentFromCourse"
-	CoursesService_AddStaffToCourse_FullMethodName             = "/courses.CoursesService/AddStaffToCourse"
-	CoursesService_RemoveStaffFromCourse_FullMethodName        = "/courses.CoursesService/RemoveStaffFromCourse"
-	CoursesService_GetCourseStudents_FullMethodName            = "/courses.CoursesService/GetCourseStudents"
-	CoursesService_GetCourseStaff_FullMethodName               = "/courses.CoursesService/GetCourseStaff"
-	CoursesService_GetStudentCourses_FullMethodName            = "/courses.CoursesService/GetStudentCourses"
-	CoursesService_GetStaffCourses_FullMethodName              = "/courses.CoursesService/GetStaffCourses"
-	CoursesService_GetSemesterCourses_FullMethodName           = "/courses.CoursesService/GetSemesterCourses"
-	CoursesService_AddAnnouncementToCourse_FullMethodName      = "/courses.CoursesService/AddAnnouncementToCourse"
-	CoursesService_GetCourseAnnouncements_FullMethodName       = "/courses.CoursesService/GetCourseAnnouncements"
-	CoursesService_RemoveAnnouncementFromCourse_FullMethodName = "/courses.CoursesService/RemoveAnnouncementFromCourse"
+	CoursesService_GetCourse_FullMethodName                     = "/courses.CoursesService/GetCourse"
+	CoursesService_CreateCourse_FullMethodName                  = "/courses.CoursesService/CreateCourse"
+	CoursesService_UpdateCourse_FullMethodName                  = "/courses.CoursesService/UpdateCourse"
+	CoursesService_DeleteCourse_FullMethodName                  = "/courses.CoursesService/DeleteCourse"
+	CoursesService_AddStudentToCourse_FullMethodName            = "/courses.CoursesService/AddStudentToCourse"
+	CoursesService_RemoveStudentFromCourse_FullMethodName       = "/courses.CoursesService/RemoveStudentFromCourse"
+	CoursesService_SetStudentStatus_FullMethodName              = "/courses.CoursesService/SetStudentStatus"
+	CoursesService_GetStudentStatusTransitions_FullMethodName   = "/courses.CoursesService/GetStudentStatusTransitions"
+	CoursesService_BatchAddStudents_FullMethodName              = "/courses.CoursesService/BatchAddStudents"
+	CoursesService_TransferEnrollments_FullMethodName           = "/courses.CoursesService/TransferEnrollments"
+	CoursesService_FindDuplicateEnrollments_FullMethodName      = "/courses.CoursesService/FindDuplicateEnrollments"
+	CoursesService_DeduplicateEnrollments_FullMethodName        = "/courses.CoursesService/DeduplicateEnrollments"
+	CoursesService_AddStaffToCourse_FullMethodName              = "/courses.CoursesService/AddStaffToCourse"
+	CoursesService_RemoveStaffFromCourse_FullMethodName         = "/courses.CoursesService/RemoveStaffFromCourse"
+	CoursesService_GetCourseStudents_FullMethodName             = "/courses.CoursesService/GetCourseStudents"
+	CoursesService_GetCourseStaff_FullMethodName                = "/courses.CoursesService/GetCourseStaff"
+	CoursesService_GetStudentCourses_FullMethodName             = "/courses.CoursesService/GetStudentCourses"
+	CoursesService_GetEnrollmentsForStudent_FullMethodName      = "/courses.CoursesService/GetEnrollmentsForStudent"
+	CoursesService_GetStudentCredits_FullMethodName             = "/courses.CoursesService/GetStudentCredits"
+	CoursesService_GetStaffCourses_FullMethodName               = "/courses.CoursesService/GetStaffCourses"
+	CoursesService_GetSemesterCourses_FullMethodName            = "/courses.CoursesService/GetSemesterCourses"
+	CoursesService_ListCourses_FullMethodName                   = "/courses.CoursesService/ListCourses"
+	CoursesService_GetCoursesByMetadata_FullMethodName          = "/courses.CoursesService/GetCoursesByMetadata"
+	CoursesService_ListCoursesWithoutStaff_FullMethodName       = "/courses.CoursesService/ListCoursesWithoutStaff"
+	CoursesService_AddAnnouncementToCourse_FullMethodName       = "/courses.CoursesService/AddAnnouncementToCourse"
+	CoursesService_GetCourseAnnouncements_FullMethodName        = "/courses.CoursesService/GetCourseAnnouncements"
+	CoursesService_GetAnnouncement_FullMethodName               = "/courses.CoursesService/GetAnnouncement"
+	CoursesService_GetCourseAnnouncementsGrouped_FullMethodName = "/courses.CoursesService/GetCourseAnnouncementsGrouped"
+	CoursesService_RemoveAnnouncementFromCourse_FullMethodName  = "/courses.CoursesService/RemoveAnnouncementFromCourse"
+	CoursesService_BatchRemoveAnnouncements_FullMethodName      = "/courses.CoursesService/BatchRemoveAnnouncements"
+	CoursesService_CopyAnnouncements_FullMethodName             = "/courses.CoursesService/CopyAnnouncements"
+	CoursesService_SetEnrollmentWindow_FullMethodName           = "/courses.CoursesService/SetEnrollmentWindow"
+	CoursesService_GetEnrollmentStatus_FullMethodName           = "/courses.CoursesService/GetEnrollmentStatus"
+	CoursesService_SearchAllAnnouncements_FullMethodName        = "/courses.CoursesService/SearchAllAnnouncements"
+	CoursesService_GetAnnouncementsSince_FullMethodName         = "/courses.CoursesService/GetAnnouncementsSince"
+	CoursesService_GetEnrollmentDifference_FullMethodName       = "/courses.CoursesService/GetEnrollmentDifference"
+	CoursesService_SetQuietPeriods_FullMethodName               = "/courses.CoursesService/SetQuietPeriods"
+	CoursesService_SetAnnouncementsEnabled_FullMethodName       = "/courses.CoursesService/SetAnnouncementsEnabled"
+	CoursesService_CreateCourseAPIKey_FullMethodName            = "/courses.CoursesService/CreateCourseAPIKey"
+	CoursesService_ListCourseAPIKeys_FullMethodName             = "/courses.CoursesService/ListCourseAPIKeys"
+	CoursesService_RevokeCourseAPIKey_FullMethodName            = "/courses.CoursesService/RevokeCourseAPIKey"
+	CoursesService_AddCorequisite_FullMethodName                = "/courses.CoursesService/AddCorequisite"
+	CoursesService_GetCorequisites_FullMethodName               = "/courses.CoursesService/GetCorequisites"
+	CoursesService_GetSharedCourses_FullMethodName              = "/courses.CoursesService/GetSharedCourses"
+	CoursesService_SetGradingComponent_FullMethodName           = "/courses.CoursesService/SetGradingComponent"
+	CoursesService_RemoveGradingComponent_FullMethodName        = "/courses.CoursesService/RemoveGradingComponent"
+	CoursesService_GetGradingScheme_FullMethodName              = "/courses.CoursesService/GetGradingScheme"
+	CoursesService_FinalizeGradingScheme_FullMethodName         = "/courses.CoursesService/FinalizeGradingScheme"
+	CoursesService_GenerateCourseShareToken_FullMethodName      = "/courses.CoursesService/GenerateCourseShareToken"
+	CoursesService_GetCourseByShareToken_FullMethodName         = "/courses.CoursesService/GetCourseByShareToken"
+	CoursesService_GetCourseFeatures_FullMethodName             = "/courses.CoursesService/GetCourseFeatures"
+	CoursesService_SetCourseFeature_FullMethodName              = "/courses.CoursesService/SetCourseFeature"
+	CoursesService_GetAnnouncementCountByAuthor_FullMethodName  = "/courses.CoursesService/GetAnnouncementCountByAuthor"
+	CoursesService_GetDataShapeReport_FullMethodName            = "/courses.CoursesService/GetDataShapeReport"
+	CoursesService_GetCourseStudentCount_FullMethodName         = "/courses.CoursesService/GetCourseStudentCount"
+	CoursesService_GetCourseSnapshot_FullMethodName             = "/courses.CoursesService/GetCourseSnapshot"
+	CoursesService_UpdateAnnouncement_FullMethodName            = "/courses.CoursesService/UpdateAnnouncement"
+	CoursesService_ChangeCourseID_FullMethodName                = "/courses.CoursesService/ChangeCourseID"
+	CoursesService_UpsertAnnouncementBySlug_FullMethodName      = "/courses.CoursesService/UpsertAnnouncementBySlug"
+	CoursesService_CheckEnrollmentEligibility_FullMethodName    = "/courses.CoursesService/CheckEnrollmentEligibility"
 )
 
 // CoursesServiceClient is the client API for CoursesService service.
@@ -53,6 +97,19 @@ type CoursesServiceClient interface {
 	AddStudentToCourse(ctx context.Context, in *AddStudentRequest, opts ...grpc.CallOption) (*AddStudentResponse, error)
 	// Remove a student from a course.
 	RemoveStudentFromCourse(ctx context.Context, in *RemoveStudentRequest, opts ...grpc.CallOption) (*RemoveStudentResponse, error)
+	// Set the status of a student in a course, e.g. when they drop it.
+	// Changes GetStudentStatusTransitions does not allow fail with FAILED_PRECONDITION.
+	SetStudentStatus(ctx context.Context, in *SetStudentStatusRequest, opts ...grpc.CallOption) (*SetStudentStatusResponse, error)
+	// Get the statuses a student in a course may move to from each status.
+	GetStudentStatusTransitions(ctx context.Context, in *GetStudentStatusTransitionsRequest, opts ...grpc.CallOption) (*GetStudentStatusTransitionsResponse, error)
+	// Enroll many students in a course at once, e.g. from a registrar roster. Course staff only.
+	BatchAddStudents(ctx context.Context, in *BatchAddStudentsRequest, opts ...grpc.CallOption) (*BatchAddStudentsResponse, error)
+	// Enroll the students of a course in another course, e.g. its next-semester equivalent (admin only).
+	TransferEnrollments(ctx context.Context, in *TransferEnrollmentsRequest, opts ...grpc.CallOption) (*TransferEnrollmentsResponse, error)
+	// List students enrolled in a course more than once, left over from legacy data (admin only).
+	FindDuplicateEnrollments(ctx context.Context, in *FindDuplicateEnrollmentsRequest, opts ...grpc.CallOption) (*FindDuplicateEnrollmentsResponse, error)
+	// Remove the extra enrollments listed by FindDuplicateEnrollments, keeping one each (admin only).
+	DeduplicateEnrollments(ctx context.Context, in *DeduplicateEnrollmentsRequest, opts ...grpc.CallOption) (*DeduplicateEnrollmentsResponse, error)
 	// Add a staff member to a course.
 	AddStaffToCourse(ctx context.Context, in *AddStaffRequest, opts ...grpc.CallOption) (*AddStaffResponse, error)
 	// Remove a staff member from a course.
@@ -63,16 +120,95 @@ type CoursesServiceClient interface {
 	GetCourseStaff(ctx context.Context, in *GetCourseStaffRequest, opts ...grpc.CallOption) (*GetCourseStaffResponse, error)
 	// Get student's courses.
 	GetStudentCourses(ctx context.Context, in *GetStudentCoursesRequest, opts ...grpc.CallOption) (*GetStudentCoursesResponse, error)
+	// Get every enrollment of a student with its course and status (the student themselves or admin only).
+	GetEnrollmentsForStudent(ctx context.Context, in *GetEnrollmentsForStudentRequest, opts ...grpc.CallOption) (*GetEnrollmentsForStudentResponse, error)
+	// Get the credits a student is enrolled in during a semester (the student themselves or admin only).
+	GetStudentCredits(ctx context.Context, in *GetStudentCreditsRequest, opts ...grpc.CallOption) (*GetStudentCreditsResponse, error)
 	// Get staff's courses.
 	GetStaffCourses(ctx context.Context, in *GetStaffCoursesRequest, opts ...grpc.CallOption) (*GetStaffCoursesResponse, error)
 	// Get all courses in a semester.
 	GetSemesterCourses(ctx context.Context, in *GetSemesterCoursesRequest, opts ...grpc.CallOption) (*GetSemesterCoursesResponse, error)
+	// List the courses of the catalog, optionally filtered.
+	ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error)
+	// List the courses whose custom field key is set to value.
+	GetCoursesByMetadata(ctx context.Context, in *GetCoursesByMetadataRequest, opts ...grpc.CallOption) (*GetCoursesByMetadataResponse, error)
+	// List the courses in a semester that have no staff assigned. Admin only.
+	ListCoursesWithoutStaff(ctx context.Context, in *ListCoursesWithoutStaffRequest, opts ...grpc.CallOption) (*ListCoursesWithoutStaffResponse, error)
 	// Add an announcement to a course.
 	AddAnnouncementToCourse(ctx context.Context, in *AddAnnouncementRequest, opts ...grpc.CallOption) (*AddAnnouncementResponse, error)
-	// Get all announcements in a course.
+	// Get all announcements in a course, newest first.
 	GetCourseAnnouncements(ctx context.Context, in *GetCourseAnnouncementsRequest, opts ...grpc.CallOption) (*GetCourseAnnouncementsResponse, error)
+	// Get a single announcement of a course with its full content.
+	GetAnnouncement(ctx context.Context, in *GetAnnouncementRequest, opts ...grpc.CallOption) (*GetAnnouncementResponse, error)
+	// Get the announcements in a course grouped by the week or month they were posted in.
+	GetCourseAnnouncementsGrouped(ctx context.Context, in *GetCourseAnnouncementsGroupedRequest, opts ...grpc.CallOption) (*GetCourseAnnouncementsGroupedResponse, error)
 	// Remove an announcement from a course.
 	RemoveAnnouncementFromCourse(ctx context.Context, in *RemoveAnnouncementRequest, opts ...grpc.CallOption) (*RemoveAnnouncementResponse, error)
+	// Remove several announcements from a course at once (course staff or admin only).
+	BatchRemoveAnnouncements(ctx context.Context, in *BatchRemoveAnnouncementsRequest, opts ...grpc.CallOption) (*BatchRemoveAnnouncementsResponse, error)
+	// Copy all announcements of a course to another, e.g. a parallel section (staff of both courses or admin only).
+	CopyAnnouncements(ctx context.Context, in *CopyAnnouncementsRequest, opts ...grpc.CallOption) (*CopyAnnouncementsResponse, error)
+	// Set the enrollment window of a course.
+	SetEnrollmentWindow(ctx context.Context, in *SetEnrollmentWindowRequest, opts ...grpc.CallOption) (*SetEnrollmentWindowResponse, error)
+	// Get the enrollment status of a course.
+	GetEnrollmentStatus(ctx context.Context, in *GetEnrollmentStatusRequest, opts ...grpc.CallOption) (*GetEnrollmentStatusResponse, error)
+	// Search announcements across all courses (admin only).
+	SearchAllAnnouncements(ctx context.Context, in *SearchAllAnnouncementsRequest, opts ...grpc.CallOption) (*SearchAllAnnouncementsResponse, error)
+	// Get the announcements of all courses posted after a point in time (admin only).
+	GetAnnouncementsSince(ctx context.Context, in *GetAnnouncementsSinceRequest, opts ...grpc.CallOption) (*GetAnnouncementsSinceResponse, error)
+	// Compare the students enrolled in two courses.
+	GetEnrollmentDifference(ctx context.Context, in *GetEnrollmentDifferenceRequest, opts ...grpc.CallOption) (*GetEnrollmentDifferenceResponse, error)
+	// Set the periods during which non-urgent announcements may not be posted to a course.
+	SetQuietPeriods(ctx context.Context, in *SetQuietPeriodsRequest, opts ...grpc.CallOption) (*SetQuietPeriodsResponse, error)
+	// Set whether new announcements may be posted to a course. Course staff only.
+	SetAnnouncementsEnabled(ctx context.Context, in *SetAnnouncementsEnabledRequest, opts ...grpc.CallOption) (*SetAnnouncementsEnabledResponse, error)
+	// Create an API key granting a machine integration access to some RPCs of a course.
+	CreateCourseAPIKey(ctx context.Context, in *CreateCourseAPIKeyRequest, opts ...grpc.CallOption) (*CreateCourseAPIKeyResponse, error)
+	// List the API keys of a course.
+	ListCourseAPIKeys(ctx context.Context, in *ListCourseAPIKeysRequest, opts ...grpc.CallOption) (*ListCourseAPIKeysResponse, error)
+	// Revoke an API key of a course.
+	RevokeCourseAPIKey(ctx context.Context, in *RevokeCourseAPIKeyRequest, opts ...grpc.CallOption) (*RevokeCourseAPIKeyResponse, error)
+	// Require another course to be taken in the same semester as a course.
+	AddCorequisite(ctx context.Context, in *AddCorequisiteRequest, opts ...grpc.CallOption) (*AddCorequisiteResponse, error)
+	// Get the co-requisites of a course.
+	GetCorequisites(ctx context.Context, in *GetCorequisitesRequest, opts ...grpc.CallOption) (*GetCorequisitesResponse, error)
+	// Get the courses a staff member currently staffs and a student is enrolled in. The staff member or admins only.
+	GetSharedCourses(ctx context.Context, in *GetSharedCoursesRequest, opts ...grpc.CallOption) (*GetSharedCoursesResponse, error)
+	// Add or replace a component of the draft grading scheme of a course. Course staff only.
+	SetGradingComponent(ctx context.Context, in *SetGradingComponentRequest, opts ...grpc.CallOption) (*SetGradingComponentResponse, error)
+	// Remove a component from the draft grading scheme of a course. Course staff only.
+	RemoveGradingComponent(ctx context.Context, in *RemoveGradingComponentRequest, opts ...grpc.CallOption) (*RemoveGradingComponentResponse, error)
+	// Get the grading scheme of a course.
+	GetGradingScheme(ctx context.Context, in *GetGradingSchemeRequest, opts ...grpc.CallOption) (*GetGradingSchemeResponse, error)
+	// Check that the weights of the grading scheme of a course sum to 100 and freeze it. Course staff only.
+	FinalizeGradingScheme(ctx context.Context, in *FinalizeGradingSchemeRequest, opts ...grpc.CallOption) (*FinalizeGradingSchemeResponse, error)
+	// Create a token granting read access to a course until it expires. Course staff only.
+	GenerateCourseShareToken(ctx context.Context, in *GenerateCourseShareTokenRequest, opts ...grpc.CallOption) (*GenerateCourseShareTokenResponse, error)
+	// Get a course with a share token instead of a user token.
+	GetCourseByShareToken(ctx context.Context, in *GetCourseByShareTokenRequest, opts ...grpc.CallOption) (*GetCourseByShareTokenResponse, error)
+	// Get which features are enabled for a course.
+	GetCourseFeatures(ctx context.Context, in *GetCourseFeaturesRequest, opts ...grpc.CallOption) (*GetCourseFeaturesResponse, error)
+	// Enable or disable a feature for a course regardless of its rollout, or clear the override. Admin only.
+	SetCourseFeature(ctx context.Context, in *SetCourseFeatureRequest, opts ...grpc.CallOption) (*SetCourseFeatureResponse, error)
+	// Count the announcements each staff member posted to a course. Course staff only.
+	GetAnnouncementCountByAuthor(ctx context.Context, in *GetAnnouncementCountByAuthorRequest, opts ...grpc.CallOption) (*GetAnnouncementCountByAuthorResponse, error)
+	// Report how many courses, enrollments and announcements there are, for capacity planning. Admin only.
+	GetDataShapeReport(ctx context.Context, in *GetDataShapeReportRequest, opts ...grpc.CallOption) (*GetDataShapeReportResponse, error)
+	// Count the students enrolled in a course.
+	GetCourseStudentCount(ctx context.Context, in *GetCourseStudentCountRequest, opts ...grpc.CallOption) (*GetCourseStudentCountResponse, error)
+	// Get a course with its students, staff, announcements and quiet periods as they were at a single
+	// point in time, e.g. to export or back it up. Course staff only.
+	GetCourseSnapshot(ctx context.Context, in *GetCourseSnapshotRequest, opts ...grpc.CallOption) (*GetCourseSnapshotResponse, error)
+	// Update the title or content of an announcement (course staff or admin only).
+	UpdateAnnouncement(ctx context.Context, in *UpdateAnnouncementRequest, opts ...grpc.CallOption) (*UpdateAnnouncementResponse, error)
+	// Change the ID of a course created with a wrong one, keeping everything that belongs to it. Admin only.
+	ChangeCourseID(ctx context.Context, in *ChangeCourseIDRequest, opts ...grpc.CallOption) (*ChangeCourseIDResponse, error)
+	// Create or update the announcement of a course with a given slug, e.g. to sync announcements
+	// kept in files (course staff or admin only).
+	UpsertAnnouncementBySlug(ctx context.Context, in *UpsertAnnouncementBySlugRequest, opts ...grpc.CallOption) (*UpsertAnnouncementBySlugResponse, error)
+	// Check whether a student can enroll in a course and, if not, every reason why, before enrolling them
+	// (the student themselves, course staff or admin only).
+	CheckEnrollmentEligibility(ctx context.Context, in *CheckEnrollmentEligibilityRequest, opts ...grpc.CallOption) (*CheckEnrollmentEligibilityResponse, error)
 }
 
 type coursesServiceClient struct {
@@ -143,6 +279,66 @@ func (c *coursesServiceClient) RemoveStudentFromCourse(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *coursesServiceClient) SetStudentStatus(ctx context.Context, in *SetStudentStatusRequest, opts ...grpc.CallOption) (*SetStudentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStudentStatusResponse)
+	err := c.cc.Invoke(ctx, CoursesService_SetStudentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetStudentStatusTransitions(ctx context.Context, in *GetStudentStatusTransitionsRequest, opts ...grpc.CallOption) (*GetStudentStatusTransitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentStatusTransitionsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetStudentStatusTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) BatchAddStudents(ctx context.Context, in *BatchAddStudentsRequest, opts ...grpc.CallOption) (*BatchAddStudentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchAddStudentsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_BatchAddStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) TransferEnrollments(ctx context.Context, in *TransferEnrollmentsRequest, opts ...grpc.CallOption) (*TransferEnrollmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferEnrollmentsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_TransferEnrollments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) FindDuplicateEnrollments(ctx context.Context, in *FindDuplicateEnrollmentsRequest, opts ...grpc.CallOption) (*FindDuplicateEnrollmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindDuplicateEnrollmentsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_FindDuplicateEnrollments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) DeduplicateEnrollments(ctx context.Context, in *DeduplicateEnrollmentsRequest, opts ...grpc.CallOption) (*DeduplicateEnrollmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeduplicateEnrollmentsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_DeduplicateEnrollments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coursesServiceClient) AddStaffToCourse(ctx context.Context, in *AddStaffRequest, opts ...grpc.CallOption) (*AddStaffResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddStaffResponse)
@@ -193,6 +389,26 @@ func (c *coursesServiceClient) GetStudentCourses(ctx context.Context, in *GetStu
 	return out, nil
 }
 
+func (c *coursesServiceClient) GetEnrollmentsForStudent(ctx context.Context, in *GetEnrollmentsForStudentRequest, opts ...grpc.CallOption) (*GetEnrollmentsForStudentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEnrollmentsForStudentResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetEnrollmentsForStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetStudentCredits(ctx context.Context, in *GetStudentCreditsRequest, opts ...grpc.CallOption) (*GetStudentCreditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentCreditsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetStudentCredits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coursesServiceClient) GetStaffCourses(ctx context.Context, in *GetStaffCoursesRequest, opts ...grpc.CallOption) (*GetStaffCoursesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetStaffCoursesResponse)
@@ -213,6 +429,36 @@ func (c *coursesServiceClient) GetSemesterCourses(ctx context.Context, in *GetSe
 	return out, nil
 }
 
+func (c *coursesServiceClient) ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoursesResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ListCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetCoursesByMetadata(ctx context.Context, in *GetCoursesByMetadataRequest, opts ...grpc.CallOption) (*GetCoursesByMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoursesByMetadataResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetCoursesByMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) ListCoursesWithoutStaff(ctx context.Context, in *ListCoursesWithoutStaffRequest, opts ...grpc.CallOption) (*ListCoursesWithoutStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoursesWithoutStaffResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ListCoursesWithoutStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coursesServiceClient) AddAnnouncementToCourse(ctx context.Context, in *AddAnnouncementRequest, opts ...grpc.CallOption) (*AddAnnouncementResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddAnnouncementResponse)
@@ -233,6 +479,26 @@ func (c *coursesServiceClient) GetCourseAnnouncements(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *coursesServiceClient) GetAnnouncement(ctx context.Context, in *GetAnnouncementRequest, opts ...grpc.CallOption) (*GetAnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnnouncementResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetCourseAnnouncementsGrouped(ctx context.Context, in *GetCourseAnnouncementsGroupedRequest, opts ...grpc.CallOption) (*GetCourseAnnouncementsGroupedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseAnnouncementsGroupedResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetCourseAnnouncementsGrouped_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coursesServiceClient) RemoveAnnouncementFromCourse(ctx context.Context, in *RemoveAnnouncementRequest, opts ...grpc.CallOption) (*RemoveAnnouncementResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RemoveAnnouncementResponse)
@@ -243,405 +509,1731 @@ func (c *coursesServiceClient) RemoveAnnouncementFromCourse(ctx context.Context,
 	return out, nil
 }
 
-// CoursesServiceServer is the server API for CoursesService service.
-// All implementations must embed UnimplementedCoursesServiceServer
-// for forward compatibility.
-type CoursesServiceServer interface {
-	// Get course.
-	GetCourse(context.Context, *GetCourseRequest) (*GetCourseResponse, error)
-	// Create a new course.
-	CreateCourse(context.Context, *CreateCourseRequest) (*CreateCourseResponse, error)
-	// Update a course.
-	UpdateCourse(context.Context, *UpdateCourseRequest) (*UpdateCourseResponse, error)
-	// Delete a course.
-	DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error)
-	// Add a student to a course.
-	AddStudentToCourse(context.Context, *AddStudentRequest) (*AddStudentResponse, error)
-	// Remove a student from a course.
-	RemoveStudentFromCourse(context.Context, *RemoveStudentRequest) (*RemoveStudentResponse, error)
-	// Add a staff member to a course.
-	AddStaffToCourse(context.Context, *AddStaffRequest) (*AddStaffResponse, error)
-	// Remove a staff member from a course.
-	RemoveStaffFromCourse(context.Context, *RemoveStaffRequest) (*RemoveStaffResponse, error)
-	// Get all students enrolled in a course.
-	GetCourseStudents(context.Context, *GetCourseStudentsRequest) (*GetCourseStudentsResponse, error)
-	// Get all staff members assigned to a course.
-	GetCourseStaff(context.Context, *GetCourseStaffRequest) (*GetCourseStaffResponse, error)
-	// Get student's courses.
-	GetStudentCourses(context.Context, *GetStudentCoursesRequest) (*GetStudentCoursesResponse, error)
-	// Get staff's courses.
-	GetStaffCourses(context.Context, *GetStaffCoursesRequest) (*GetStaffCoursesResponse, error)
-	// Get all courses in a semester.
-	GetSemesterCourses(context.Context, *GetSemesterCoursesRequest) (*GetSemesterCoursesResponse, error)
-	// Add an announcement to a course.
-	AddAnnouncementToCourse(context.Context, *AddAnnouncementRequest) (*AddAnnouncementResponse, error)
-	// Get all announcements in a course.
-	GetCourseAnnouncements(context.Context, *GetCourseAnnouncementsRequest) (*GetCourseAnnouncementsResponse, error)
-	// Remove an announcement from a course.
-	RemoveAnnouncementFromCourse(context.Context, *RemoveAnnouncementRequest) (*RemoveAnnouncementResponse, error)
-	mustEmbedUnimplementedCoursesServiceServer()
+func (c *coursesServiceClient) BatchRemoveAnnouncements(ctx context.Context, in *BatchRemoveAnnouncementsRequest, opts ...grpc.CallOption) (*BatchRemoveAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchRemoveAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_BatchRemoveAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedCoursesServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCoursesServiceServer struct{}
+func (c *coursesServiceClient) CopyAnnouncements(ctx context.Context, in *CopyAnnouncementsRequest, opts ...grpc.CallOption) (*CopyAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_CopyAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedCoursesServiceServer) GetCourse(context.Context, *GetCourseRequest) (*GetCourseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
+func (c *coursesServiceClient) SetEnrollmentWindow(ctx context.Context, in *SetEnrollmentWindowRequest, opts ...grpc.CallOption) (*SetEnrollmentWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEnrollmentWindowResponse)
+	err := c.cc.Invoke(ctx, CoursesService_SetEnrollmentWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoursesServiceServer) CreateCourse(context.Context, *CreateCourseRequest) (*CreateCourseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
+
+func (c *coursesServiceClient) GetEnrollmentStatus(ctx context.Context, in *GetEnrollmentStatusRequest, opts ...grpc.CallOption) (*GetEnrollmentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEnrollmentStatusResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetEnrollmentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoursesServiceServer) UpdateCourse(context.Context, *UpdateCourseRequest) (*UpdateCourseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourse not implemented")
+
+func (c *coursesServiceClient) SearchAllAnnouncements(ctx context.Context, in *SearchAllAnnouncementsRequest, opts ...grpc.CallOption) (*SearchAllAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAllAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_SearchAllAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoursesServiceServer) DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
+
+func (c *coursesServiceClient) GetAnnouncementsSince(ctx context.Context, in *GetAnnouncementsSinceRequest, opts ...grpc.CallOption) (*GetAnnouncementsSinceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnnouncementsSinceResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetAnnouncementsSince_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoursesServiceServer) AddStudentToCourse(context.Context, *AddStudentRequest) (*AddStudentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddStudentToCourse not implemented")
+
+func (c *coursesServiceClient) GetEnrollmentDifference(ctx context.Context, in *GetEnrollmentDifferenceRequest, opts ...grpc.CallOption) (*GetEnrollmentDifferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEnrollmentDifferenceResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetEnrollmentDifference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoursesServiceServer) RemoveStudentFromCourse(context.Context, *RemoveStudentRequest) (*RemoveStudentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveStudentFromCourse not implemented")
+
+func (c *coursesServiceClient) SetQuietPeriods(ctx context.Context, in *SetQuietPeriodsRequest, opts ...grpc.CallOption) (*SetQuietPeriodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetQuietPeriodsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_SetQuietPeriods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoursesServiceServer) AddStaffToCourse(context.Context, *AddStaffRequest) (*AddStaffResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddStaffToCourse not implemented")
+
+func (c *coursesServiceClient) SetAnnouncementsEnabled(ctx context.Context, in *SetAnnouncementsEnabledRequest, opts ...grpc.CallOption) (*SetAnnouncementsEnabledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAnnouncementsEnabledResponse)
+	err := c.cc.Invoke(ctx, CoursesService_SetAnnouncementsEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoursesServiceServer) RemoveStaffFromCourse(context.Context, *RemoveStaffRequest) (*RemoveStaffResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveStaffFromCourse not implemented")
+
+func (c *coursesServiceClient) CreateCourseAPIKey(ctx context.Context, in *CreateCourseAPIKeyRequest, opts ...grpc.CallOption) (*CreateCourseAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCourseAPIKeyResponse)
+	err := c.cc.Invoke(ctx, CoursesService_CreateCourseAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoursesServiceServer) GetCourseStudents(context.Context, *GetCourseStudentsRequest) (*GetCourseStudentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourseStudents not implemented")
+
+func (c *coursesServiceClient) ListCourseAPIKeys(ctx context.Context, in *ListCourseAPIKeysRequest, opts ...grpc.CallOption) (*ListCourseAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCourseAPIKeysResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ListCourseAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoursesServiceServer) GetCourseStaff(context.Context, *GetCourseStaffRequest) (*GetCourseStaffResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourseStaff not implemented")
+
+func (c *coursesServiceClient) RevokeCourseAPIKey(ctx context.Context, in *RevokeCourseAPIKeyRequest, opts ...grpc.CallOption) (*RevokeCourseAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeCourseAPIKeyResponse)
+	err := c.cc.Invoke(ctx, CoursesService_RevokeCourseAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoursesServiceServer) GetStudentCourses(context.Context, *GetStudentCoursesRequest) (*GetStudentCoursesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStudentCourses not implemented")
+
+func (c *coursesServiceClient) AddCorequisite(ctx context.Context, in *AddCorequisiteRequest, opts ...grpc.CallOption) (*AddCorequisiteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCorequisiteResponse)
+	err := c.cc.Invoke(ctx, CoursesService_AddCorequisite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoursesServiceServer) GetStaffCourses(context.Context, *GetStaffCoursesRequest) (*GetStaffCoursesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStaffCourses not implemented")
+
+func (c *coursesServiceClient) GetCorequisites(ctx context.Context, in *GetCorequisitesRequest, opts ...grpc.CallOption) (*GetCorequisitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCorequisitesResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetCorequisites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoursesServiceServer) GetSemesterCourses(context.Context, *GetSemesterCoursesRequest) (*GetSemesterCoursesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSemesterCourses not implemented")
+
+func (c *coursesServiceClient) GetSharedCourses(ctx context.Context, in *GetSharedCoursesRequest, opts ...grpc.CallOption) (*GetSharedCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharedCoursesResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetSharedCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) SetGradingComponent(ctx context.Context, in *SetGradingComponentRequest, opts ...grpc.CallOption) (*SetGradingComponentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGradingComponentResponse)
+	err := c.cc.Invoke(ctx, CoursesService_SetGradingComponent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) RemoveGradingComponent(ctx context.Context, in *RemoveGradingComponentRequest, opts ...grpc.CallOption) (*RemoveGradingComponentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGradingComponentResponse)
+	err := c.cc.Invoke(ctx, CoursesService_RemoveGradingComponent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetGradingScheme(ctx context.Context, in *GetGradingSchemeRequest, opts ...grpc.CallOption) (*GetGradingSchemeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradingSchemeResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetGradingScheme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) FinalizeGradingScheme(ctx context.Context, in *FinalizeGradingSchemeRequest, opts ...grpc.CallOption) (*FinalizeGradingSchemeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinalizeGradingSchemeResponse)
+	err := c.cc.Invoke(ctx, CoursesService_FinalizeGradingScheme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GenerateCourseShareToken(ctx context.Context, in *GenerateCourseShareTokenRequest, opts ...grpc.CallOption) (*GenerateCourseShareTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateCourseShareTokenResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GenerateCourseShareToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetCourseByShareToken(ctx context.Context, in *GetCourseByShareTokenRequest, opts ...grpc.CallOption) (*GetCourseByShareTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseByShareTokenResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetCourseByShareToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetCourseFeatures(ctx context.Context, in *GetCourseFeaturesRequest, opts ...grpc.CallOption) (*GetCourseFeaturesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseFeaturesResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetCourseFeatures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) SetCourseFeature(ctx context.Context, in *SetCourseFeatureRequest, opts ...grpc.CallOption) (*SetCourseFeatureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCourseFeatureResponse)
+	err := c.cc.Invoke(ctx, CoursesService_SetCourseFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetAnnouncementCountByAuthor(ctx context.Context, in *GetAnnouncementCountByAuthorRequest, opts ...grpc.CallOption) (*GetAnnouncementCountByAuthorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnnouncementCountByAuthorResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetAnnouncementCountByAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetDataShapeReport(ctx context.Context, in *GetDataShapeReportRequest, opts ...grpc.CallOption) (*GetDataShapeReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataShapeReportResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetDataShapeReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetCourseStudentCount(ctx context.Context, in *GetCourseStudentCountRequest, opts ...grpc.CallOption) (*GetCourseStudentCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseStudentCountResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetCourseStudentCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetCourseSnapshot(ctx context.Context, in *GetCourseSnapshotRequest, opts ...grpc.CallOption) (*GetCourseSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseSnapshotResponse)
+	err := c.cc.Invoke(ctx, CoursesService_GetCourseSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) UpdateAnnouncement(ctx context.Context, in *UpdateAnnouncementRequest, opts ...grpc.CallOption) (*UpdateAnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAnnouncementResponse)
+	err := c.cc.Invoke(ctx, CoursesService_UpdateAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) ChangeCourseID(ctx context.Context, in *ChangeCourseIDRequest, opts ...grpc.CallOption) (*ChangeCourseIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeCourseIDResponse)
+	err := c.cc.Invoke(ctx, CoursesService_ChangeCourseID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) UpsertAnnouncementBySlug(ctx context.Context, in *UpsertAnnouncementBySlugRequest, opts ...grpc.CallOption) (*UpsertAnnouncementBySlugResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertAnnouncementBySlugResponse)
+	err := c.cc.Invoke(ctx, CoursesService_UpsertAnnouncementBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) CheckEnrollmentEligibility(ctx context.Context, in *CheckEnrollmentEligibilityRequest, opts ...grpc.CallOption) (*CheckEnrollmentEligibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckEnrollmentEligibilityResponse)
+	err := c.cc.Invoke(ctx, CoursesService_CheckEnrollmentEligibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoursesServiceServer is the server API for CoursesService service.
+// All implementations must embed UnimplementedCoursesServiceServer
+// for forward compatibility.
+type CoursesServiceServer interface {
+	// Get course.
+	GetCourse(context.Context, *GetCourseRequest) (*GetCourseResponse, error)
+	// Create a new course.
+	CreateCourse(context.Context, *CreateCourseRequest) (*CreateCourseResponse, error)
+	// Update a course.
+	UpdateCourse(context.Context, *UpdateCourseRequest) (*UpdateCourseResponse, error)
+	// Delete a course.
+	DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error)
+	// Add a student to a course.
+	AddStudentToCourse(context.Context, *AddStudentRequest) (*AddStudentResponse, error)
+	// Remove a student from a course.
+	RemoveStudentFromCourse(context.Context, *RemoveStudentRequest) (*RemoveStudentResponse, error)
+	// Set the status of a student in a course, e.g. when they drop it.
+	// Changes GetStudentStatusTransitions does not allow fail with FAILED_PRECONDITION.
+	SetStudentStatus(context.Context, *SetStudentStatusRequest) (*SetStudentStatusResponse, error)
+	// Get the statuses a student in a course may move to from each status.
+	GetStudentStatusTransitions(context.Context, *GetStudentStatusTransitionsRequest) (*GetStudentStatusTransitionsResponse, error)
+	// Enroll many students in a course at once, e.g. from a registrar roster. Course staff only.
+	BatchAddStudents(context.Context, *BatchAddStudentsRequest) (*BatchAddStudentsResponse, error)
+	// Enroll the students of a course in another course, e.g. its next-semester equivalent (admin only).
+	TransferEnrollments(context.Context, *TransferEnrollmentsRequest) (*TransferEnrollmentsResponse, error)
+	// List students enrolled in a course more than once, left over from legacy data (admin only).
+	FindDuplicateEnrollments(context.Context, *FindDuplicateEnrollmentsRequest) (*FindDuplicateEnrollmentsResponse, error)
+	// Remove the extra enrollments listed by FindDuplicateEnrollments, keeping one each (admin only).
+	DeduplicateEnrollments(context.Context, *DeduplicateEnrollmentsRequest) (*DeduplicateEnrollmentsResponse, error)
+	// Add a staff member to a course.
+	AddStaffToCourse(context.Context, *AddStaffRequest) (*AddStaffResponse, error)
+	// Remove a staff member from a course.
+	RemoveStaffFromCourse(context.Context, *RemoveStaffRequest) (*RemoveStaffResponse, error)
+	// Get all students enrolled in a course.
+	GetCourseStudents(context.Context, *GetCourseStudentsRequest) (*GetCourseStudentsResponse, error)
+	// Get all staff members assigned to a course.
+	GetCourseStaff(context.Context, *GetCourseStaffRequest) (*GetCourseStaffResponse, error)
+	// Get student's courses.
+	GetStudentCourses(context.Context, *GetStudentCoursesRequest) (*GetStudentCoursesResponse, error)
+	// Get every enrollment of a student with its course and status (the student themselves or admin only).
+	GetEnrollmentsForStudent(context.Context, *GetEnrollmentsForStudentRequest) (*GetEnrollmentsForStudentResponse, error)
+	// Get the credits a student is enrolled in during a semester (the student themselves or admin only).
+	GetStudentCredits(context.Context, *GetStudentCreditsRequest) (*GetStudentCreditsResponse, error)
+	// Get staff's courses.
+	GetStaffCourses(context.Context, *GetStaffCoursesRequest) (*GetStaffCoursesResponse, error)
+	// Get all courses in a semester.
+	GetSemesterCourses(context.Context, *GetSemesterCoursesRequest) (*GetSemesterCoursesResponse, error)
+	// List the courses of the catalog, optionally filtered.
+	ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error)
+	// List the courses whose custom field key is set to value.
+	GetCoursesByMetadata(context.Context, *GetCoursesByMetadataRequest) (*GetCoursesByMetadataResponse, error)
+	// List the courses in a semester that have no staff assigned. Admin only.
+	ListCoursesWithoutStaff(context.Context, *ListCoursesWithoutStaffRequest) (*ListCoursesWithoutStaffResponse, error)
+	// Add an announcement to a course.
+	AddAnnouncementToCourse(context.Context, *AddAnnouncementRequest) (*AddAnnouncementResponse, error)
+	// Get all announcements in a course, newest first.
+	GetCourseAnnouncements(context.Context, *GetCourseAnnouncementsRequest) (*GetCourseAnnouncementsResponse, error)
+	// Get a single announcement of a course with its full content.
+	GetAnnouncement(context.Context, *GetAnnouncementRequest) (*GetAnnouncementResponse, error)
+	// Get the announcements in a course grouped by the week or month they were posted in.
+	GetCourseAnnouncementsGrouped(context.Context, *GetCourseAnnouncementsGroupedRequest) (*GetCourseAnnouncementsGroupedResponse, error)
+	// Remove an announcement from a course.
+	RemoveAnnouncementFromCourse(context.Context, *RemoveAnnouncementRequest) (*RemoveAnnouncementResponse, error)
+	// Remove several announcements from a course at once (course staff or admin only).
+	BatchRemoveAnnouncements(context.Context, *BatchRemoveAnnouncementsRequest) (*BatchRemoveAnnouncementsResponse, error)
+	// Copy all announcements of a course to another, e.g. a parallel section (staff of both courses or admin only).
+	CopyAnnouncements(context.Context, *CopyAnnouncementsRequest) (*CopyAnnouncementsResponse, error)
+	// Set the enrollment window of a course.
+	SetEnrollmentWindow(context.Context, *SetEnrollmentWindowRequest) (*SetEnrollmentWindowResponse, error)
+	// Get the enrollment status of a course.
+	GetEnrollmentStatus(context.Context, *GetEnrollmentStatusRequest) (*GetEnrollmentStatusResponse, error)
+	// Search announcements across all courses (admin only).
+	SearchAllAnnouncements(context.Context, *SearchAllAnnouncementsRequest) (*SearchAllAnnouncementsResponse, error)
+	// Get the announcements of all courses posted after a point in time (admin only).
+	GetAnnouncementsSince(context.Context, *GetAnnouncementsSinceRequest) (*GetAnnouncementsSinceResponse, error)
+	// Compare the students enrolled in two courses.
+	GetEnrollmentDifference(context.Context, *GetEnrollmentDifferenceRequest) (*GetEnrollmentDifferenceResponse, error)
+	// Set the periods during which non-urgent announcements may not be posted to a course.
+	SetQuietPeriods(context.Context, *SetQuietPeriodsRequest) (*SetQuietPeriodsResponse, error)
+	// Set whether new announcements may be posted to a course. Course staff only.
+	SetAnnouncementsEnabled(context.Context, *SetAnnouncementsEnabledRequest) (*SetAnnouncementsEnabledResponse, error)
+	// Create an API key granting a machine integration access to some RPCs of a course.
+	CreateCourseAPIKey(context.Context, *CreateCourseAPIKeyRequest) (*CreateCourseAPIKeyResponse, error)
+	// List the API keys of a course.
+	ListCourseAPIKeys(context.Context, *ListCourseAPIKeysRequest) (*ListCourseAPIKeysResponse, error)
+	// Revoke an API key of a course.
+	RevokeCourseAPIKey(context.Context, *RevokeCourseAPIKeyRequest) (*RevokeCourseAPIKeyResponse, error)
+	// Require another course to be taken in the same semester as a course.
+	AddCorequisite(context.Context, *AddCorequisiteRequest) (*AddCorequisiteResponse, error)
+	// Get the co-requisites of a course.
+	GetCorequisites(context.Context, *GetCorequisitesRequest) (*GetCorequisitesResponse, error)
+	// Get the courses a staff member currently staffs and a student is enrolled in. The staff member or admins only.
+	GetSharedCourses(context.Context, *GetSharedCoursesRequest) (*GetSharedCoursesResponse, error)
+	// Add or replace a component of the draft grading scheme of a course. Course staff only.
+	SetGradingComponent(context.Context, *SetGradingComponentRequest) (*SetGradingComponentResponse, error)
+	// Remove a component from the draft grading scheme of a course. Course staff only.
+	RemoveGradingComponent(context.Context, *RemoveGradingComponentRequest) (*RemoveGradingComponentResponse, error)
+	// Get the grading scheme of a course.
+	GetGradingScheme(context.Context, *GetGradingSchemeRequest) (*GetGradingSchemeResponse, error)
+	// Check that the weights of the grading scheme of a course sum to 100 and freeze it. Course staff only.
+	FinalizeGradingScheme(context.Context, *FinalizeGradingSchemeRequest) (*FinalizeGradingSchemeResponse, error)
+	// Create a token granting read access to a course until it expires. Course staff only.
+	GenerateCourseShareToken(context.Context, *GenerateCourseShareTokenRequest) (*GenerateCourseShareTokenResponse, error)
+	// Get a course with a share token instead of a user token.
+	GetCourseByShareToken(context.Context, *GetCourseByShareTokenRequest) (*GetCourseByShareTokenResponse, error)
+	// Get which features are enabled for a course.
+	GetCourseFeatures(context.Context, *GetCourseFeaturesRequest) (*GetCourseFeaturesResponse, error)
+	// Enable or disable a feature for a course regardless of its rollout, or clear the override. Admin only.
+	SetCourseFeature(context.Context, *SetCourseFeatureRequest) (*SetCourseFeatureResponse, error)
+	// Count the announcements each staff member posted to a course. Course staff only.
+	GetAnnouncementCountByAuthor(context.Context, *GetAnnouncementCountByAuthorRequest) (*GetAnnouncementCountByAuthorResponse, error)
+	// Report how many courses, enrollments and announcements there are, for capacity planning. Admin only.
+	GetDataShapeReport(context.Context, *GetDataShapeReportRequest) (*GetDataShapeReportResponse, error)
+	// Count the students enrolled in a course.
+	GetCourseStudentCount(context.Context, *GetCourseStudentCountRequest) (*GetCourseStudentCountResponse, error)
+	// Get a course with its students, staff, announcements and quiet periods as they were at a single
+	// point in time, e.g. to export or back it up. Course staff only.
+	GetCourseSnapshot(context.Context, *GetCourseSnapshotRequest) (*GetCourseSnapshotResponse, error)
+	// Update the title or content of an announcement (course staff or admin only).
+	UpdateAnnouncement(context.Context, *UpdateAnnouncementRequest) (*UpdateAnnouncementResponse, error)
+	// Change the ID of a course created with a wrong one, keeping everything that belongs to it. Admin only.
+	ChangeCourseID(context.Context, *ChangeCourseIDRequest) (*ChangeCourseIDResponse, error)
+	// Create or update the announcement of a course with a given slug, e.g. to sync announcements
+	// kept in files (course staff or admin only).
+	UpsertAnnouncementBySlug(context.Context, *UpsertAnnouncementBySlugRequest) (*UpsertAnnouncementBySlugResponse, error)
+	// Check whether a student can enroll in a course and, if not, every reason why, before enrolling them
+	// (the student themselves, course staff or admin only).
+	CheckEnrollmentEligibility(context.Context, *CheckEnrollmentEligibilityRequest) (*CheckEnrollmentEligibilityResponse, error)
+	mustEmbedUnimplementedCoursesServiceServer()
+}
+
+// UnimplementedCoursesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoursesServiceServer struct{}
+
+func (UnimplementedCoursesServiceServer) GetCourse(context.Context, *GetCourseRequest) (*GetCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
+}
+func (UnimplementedCoursesServiceServer) CreateCourse(context.Context, *CreateCourseRequest) (*CreateCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
+}
+func (UnimplementedCoursesServiceServer) UpdateCourse(context.Context, *UpdateCourseRequest) (*UpdateCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourse not implemented")
+}
+func (UnimplementedCoursesServiceServer) DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
+}
+func (UnimplementedCoursesServiceServer) AddStudentToCourse(context.Context, *AddStudentRequest) (*AddStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStudentToCourse not implemented")
+}
+func (UnimplementedCoursesServiceServer) RemoveStudentFromCourse(context.Context, *RemoveStudentRequest) (*RemoveStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStudentFromCourse not implemented")
+}
+func (UnimplementedCoursesServiceServer) SetStudentStatus(context.Context, *SetStudentStatusRequest) (*SetStudentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStudentStatus not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetStudentStatusTransitions(context.Context, *GetStudentStatusTransitionsRequest) (*GetStudentStatusTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentStatusTransitions not implemented")
+}
+func (UnimplementedCoursesServiceServer) BatchAddStudents(context.Context, *BatchAddStudentsRequest) (*BatchAddStudentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAddStudents not implemented")
+}
+func (UnimplementedCoursesServiceServer) TransferEnrollments(context.Context, *TransferEnrollmentsRequest) (*TransferEnrollmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferEnrollments not implemented")
+}
+func (UnimplementedCoursesServiceServer) FindDuplicateEnrollments(context.Context, *FindDuplicateEnrollmentsRequest) (*FindDuplicateEnrollmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicateEnrollments not implemented")
+}
+func (UnimplementedCoursesServiceServer) DeduplicateEnrollments(context.Context, *DeduplicateEnrollmentsRequest) (*DeduplicateEnrollmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeduplicateEnrollments not implemented")
+}
+func (UnimplementedCoursesServiceServer) AddStaffToCourse(context.Context, *AddStaffRequest) (*AddStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStaffToCourse not implemented")
+}
+func (UnimplementedCoursesServiceServer) RemoveStaffFromCourse(context.Context, *RemoveStaffRequest) (*RemoveStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStaffFromCourse not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCourseStudents(context.Context, *GetCourseStudentsRequest) (*GetCourseStudentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseStudents not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCourseStaff(context.Context, *GetCourseStaffRequest) (*GetCourseStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseStaff not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetStudentCourses(context.Context, *GetStudentCoursesRequest) (*GetStudentCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetEnrollmentsForStudent(context.Context, *GetEnrollmentsForStudentRequest) (*GetEnrollmentsForStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsForStudent not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetStudentCredits(context.Context, *GetStudentCreditsRequest) (*GetStudentCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentCredits not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetStaffCourses(context.Context, *GetStaffCoursesRequest) (*GetStaffCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStaffCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetSemesterCourses(context.Context, *GetSemesterCoursesRequest) (*GetSemesterCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSemesterCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCoursesByMetadata(context.Context, *GetCoursesByMetadataRequest) (*GetCoursesByMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoursesByMetadata not implemented")
+}
+func (UnimplementedCoursesServiceServer) ListCoursesWithoutStaff(context.Context, *ListCoursesWithoutStaffRequest) (*ListCoursesWithoutStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoursesWithoutStaff not implemented")
+}
+func (UnimplementedCoursesServiceServer) AddAnnouncementToCourse(context.Context, *AddAnnouncementRequest) (*AddAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAnnouncementToCourse not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCourseAnnouncements(context.Context, *GetCourseAnnouncementsRequest) (*GetCourseAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseAnnouncements not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetAnnouncement(context.Context, *GetAnnouncementRequest) (*GetAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnouncement not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCourseAnnouncementsGrouped(context.Context, *GetCourseAnnouncementsGroupedRequest) (*GetCourseAnnouncementsGroupedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseAnnouncementsGrouped not implemented")
+}
+func (UnimplementedCoursesServiceServer) RemoveAnnouncementFromCourse(context.Context, *RemoveAnnouncementRequest) (*RemoveAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAnnouncementFromCourse not implemented")
+}
+func (UnimplementedCoursesServiceServer) BatchRemoveAnnouncements(context.Context, *BatchRemoveAnnouncementsRequest) (*BatchRemoveAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRemoveAnnouncements not implemented")
+}
+func (UnimplementedCoursesServiceServer) CopyAnnouncements(context.Context, *CopyAnnouncementsRequest) (*CopyAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyAnnouncements not implemented")
+}
+func (UnimplementedCoursesServiceServer) SetEnrollmentWindow(context.Context, *SetEnrollmentWindowRequest) (*SetEnrollmentWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEnrollmentWindow not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetEnrollmentStatus(context.Context, *GetEnrollmentStatusRequest) (*GetEnrollmentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentStatus not implemented")
+}
+func (UnimplementedCoursesServiceServer) SearchAllAnnouncements(context.Context, *SearchAllAnnouncementsRequest) (*SearchAllAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAllAnnouncements not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetAnnouncementsSince(context.Context, *GetAnnouncementsSinceRequest) (*GetAnnouncementsSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnouncementsSince not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetEnrollmentDifference(context.Context, *GetEnrollmentDifferenceRequest) (*GetEnrollmentDifferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentDifference not implemented")
+}
+func (UnimplementedCoursesServiceServer) SetQuietPeriods(context.Context, *SetQuietPeriodsRequest) (*SetQuietPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuietPeriods not implemented")
+}
+func (UnimplementedCoursesServiceServer) SetAnnouncementsEnabled(context.Context, *SetAnnouncementsEnabledRequest) (*SetAnnouncementsEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAnnouncementsEnabled not implemented")
+}
+func (UnimplementedCoursesServiceServer) CreateCourseAPIKey(context.Context, *CreateCourseAPIKeyRequest) (*CreateCourseAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCourseAPIKey not implemented")
+}
+func (UnimplementedCoursesServiceServer) ListCourseAPIKeys(context.Context, *ListCourseAPIKeysRequest) (*ListCourseAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourseAPIKeys not implemented")
+}
+func (UnimplementedCoursesServiceServer) RevokeCourseAPIKey(context.Context, *RevokeCourseAPIKeyRequest) (*RevokeCourseAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCourseAPIKey not implemented")
+}
+func (UnimplementedCoursesServiceServer) AddCorequisite(context.Context, *AddCorequisiteRequest) (*AddCorequisiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCorequisite not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCorequisites(context.Context, *GetCorequisitesRequest) (*GetCorequisitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCorequisites not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetSharedCourses(context.Context, *GetSharedCoursesRequest) (*GetSharedCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) SetGradingComponent(context.Context, *SetGradingComponentRequest) (*SetGradingComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGradingComponent not implemented")
+}
+func (UnimplementedCoursesServiceServer) RemoveGradingComponent(context.Context, *RemoveGradingComponentRequest) (*RemoveGradingComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGradingComponent not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetGradingScheme(context.Context, *GetGradingSchemeRequest) (*GetGradingSchemeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradingScheme not implemented")
+}
+func (UnimplementedCoursesServiceServer) FinalizeGradingScheme(context.Context, *FinalizeGradingSchemeRequest) (*FinalizeGradingSchemeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeGradingScheme not implemented")
+}
+func (UnimplementedCoursesServiceServer) GenerateCourseShareToken(context.Context, *GenerateCourseShareTokenRequest) (*GenerateCourseShareTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateCourseShareToken not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCourseByShareToken(context.Context, *GetCourseByShareTokenRequest) (*GetCourseByShareTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseByShareToken not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCourseFeatures(context.Context, *GetCourseFeaturesRequest) (*GetCourseFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseFeatures not implemented")
+}
+func (UnimplementedCoursesServiceServer) SetCourseFeature(context.Context, *SetCourseFeatureRequest) (*SetCourseFeatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCourseFeature not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetAnnouncementCountByAuthor(context.Context, *GetAnnouncementCountByAuthorRequest) (*GetAnnouncementCountByAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnouncementCountByAuthor not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetDataShapeReport(context.Context, *GetDataShapeReportRequest) (*GetDataShapeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataShapeReport not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCourseStudentCount(context.Context, *GetCourseStudentCountRequest) (*GetCourseStudentCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseStudentCount not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCourseSnapshot(context.Context, *GetCourseSnapshotRequest) (*GetCourseSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseSnapshot not implemented")
+}
+func (UnimplementedCoursesServiceServer) UpdateAnnouncement(context.Context, *UpdateAnnouncementRequest) (*UpdateAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnnouncement not implemented")
+}
+func (UnimplementedCoursesServiceServer) ChangeCourseID(context.Context, *ChangeCourseIDRequest) (*ChangeCourseIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeCourseID not implemented")
+}
+func (UnimplementedCoursesServiceServer) UpsertAnnouncementBySlug(context.Context, *UpsertAnnouncementBySlugRequest) (*UpsertAnnouncementBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertAnnouncementBySlug not implemented")
+}
+func (UnimplementedCoursesServiceServer) CheckEnrollmentEligibility(context.Context, *CheckEnrollmentEligibilityRequest) (*CheckEnrollmentEligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckEnrollmentEligibility not implemented")
+}
+func (UnimplementedCoursesServiceServer) mustEmbedUnimplementedCoursesServiceServer() {}
+func (UnimplementedCoursesServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCoursesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoursesServiceServer will
+// result in compilation errors.
+type UnsafeCoursesServiceServer interface {
+	mustEmbedUnimplementedCoursesServiceServer()
+}
+
+func RegisterCoursesServiceServer(s grpc.ServiceRegistrar, srv CoursesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCoursesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoursesService_ServiceDesc, srv)
+}
+
+func _CoursesService_GetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCourse(ctx, req.(*GetCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).CreateCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_CreateCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).CreateCourse(ctx, req.(*CreateCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_UpdateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).UpdateCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_UpdateCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).UpdateCourse(ctx, req.(*UpdateCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_DeleteCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).DeleteCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_DeleteCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).DeleteCourse(ctx, req.(*DeleteCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_AddStudentToCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).AddStudentToCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_AddStudentToCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).AddStudentToCourse(ctx, req.(*AddStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_RemoveStudentFromCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).RemoveStudentFromCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_RemoveStudentFromCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).RemoveStudentFromCourse(ctx, req.(*RemoveStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_SetStudentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStudentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).SetStudentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_SetStudentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).SetStudentStatus(ctx, req.(*SetStudentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetStudentStatusTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentStatusTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetStudentStatusTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetStudentStatusTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetStudentStatusTransitions(ctx, req.(*GetStudentStatusTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_BatchAddStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAddStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).BatchAddStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_BatchAddStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).BatchAddStudents(ctx, req.(*BatchAddStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_TransferEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferEnrollmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).TransferEnrollments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_TransferEnrollments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).TransferEnrollments(ctx, req.(*TransferEnrollmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_FindDuplicateEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicateEnrollmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).FindDuplicateEnrollments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_FindDuplicateEnrollments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).FindDuplicateEnrollments(ctx, req.(*FindDuplicateEnrollmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_DeduplicateEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeduplicateEnrollmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).DeduplicateEnrollments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_DeduplicateEnrollments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).DeduplicateEnrollments(ctx, req.(*DeduplicateEnrollmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_AddStaffToCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).AddStaffToCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_AddStaffToCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).AddStaffToCourse(ctx, req.(*AddStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_RemoveStaffFromCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).RemoveStaffFromCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_RemoveStaffFromCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).RemoveStaffFromCourse(ctx, req.(*RemoveStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetCourseStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCourseStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCourseStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCourseStudents(ctx, req.(*GetCourseStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetCourseStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCourseStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCourseStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCourseStaff(ctx, req.(*GetCourseStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetStudentCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetStudentCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetStudentCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetStudentCourses(ctx, req.(*GetStudentCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetEnrollmentsForStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnrollmentsForStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetEnrollmentsForStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetEnrollmentsForStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetEnrollmentsForStudent(ctx, req.(*GetEnrollmentsForStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetStudentCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetStudentCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetStudentCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetStudentCredits(ctx, req.(*GetStudentCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetStaffCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStaffCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetStaffCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetStaffCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetStaffCourses(ctx, req.(*GetStaffCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetSemesterCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSemesterCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetSemesterCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetSemesterCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetSemesterCourses(ctx, req.(*GetSemesterCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ListCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ListCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ListCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ListCourses(ctx, req.(*ListCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetCoursesByMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoursesByMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCoursesByMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCoursesByMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCoursesByMetadata(ctx, req.(*GetCoursesByMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ListCoursesWithoutStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoursesWithoutStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ListCoursesWithoutStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ListCoursesWithoutStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ListCoursesWithoutStaff(ctx, req.(*ListCoursesWithoutStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_AddAnnouncementToCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).AddAnnouncementToCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_AddAnnouncementToCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).AddAnnouncementToCourse(ctx, req.(*AddAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetCourseAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCourseAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCourseAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCourseAnnouncements(ctx, req.(*GetCourseAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetAnnouncement(ctx, req.(*GetAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetCourseAnnouncementsGrouped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseAnnouncementsGroupedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCourseAnnouncementsGrouped(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCourseAnnouncementsGrouped_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCourseAnnouncementsGrouped(ctx, req.(*GetCourseAnnouncementsGroupedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_RemoveAnnouncementFromCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).RemoveAnnouncementFromCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_RemoveAnnouncementFromCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).RemoveAnnouncementFromCourse(ctx, req.(*RemoveAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_BatchRemoveAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRemoveAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).BatchRemoveAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_BatchRemoveAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).BatchRemoveAnnouncements(ctx, req.(*BatchRemoveAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_CopyAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).CopyAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_CopyAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).CopyAnnouncements(ctx, req.(*CopyAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_SetEnrollmentWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEnrollmentWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).SetEnrollmentWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_SetEnrollmentWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).SetEnrollmentWindow(ctx, req.(*SetEnrollmentWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetEnrollmentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnrollmentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetEnrollmentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetEnrollmentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetEnrollmentStatus(ctx, req.(*GetEnrollmentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_SearchAllAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAllAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).SearchAllAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_SearchAllAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).SearchAllAnnouncements(ctx, req.(*SearchAllAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetAnnouncementsSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnouncementsSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetAnnouncementsSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetAnnouncementsSince_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetAnnouncementsSince(ctx, req.(*GetAnnouncementsSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetEnrollmentDifference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnrollmentDifferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetEnrollmentDifference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetEnrollmentDifference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetEnrollmentDifference(ctx, req.(*GetEnrollmentDifferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_SetQuietPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuietPeriodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).SetQuietPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_SetQuietPeriods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).SetQuietPeriods(ctx, req.(*SetQuietPeriodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_SetAnnouncementsEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnnouncementsEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).SetAnnouncementsEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_SetAnnouncementsEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).SetAnnouncementsEnabled(ctx, req.(*SetAnnouncementsEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_CreateCourseAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCourseAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).CreateCourseAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_CreateCourseAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).CreateCourseAPIKey(ctx, req.(*CreateCourseAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoursesServiceServer) AddAnnouncementToCourse(context.Context, *AddAnnouncementRequest) (*AddAnnouncementResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAnnouncementToCourse not implemented")
+
+func _CoursesService_ListCourseAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCourseAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ListCourseAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ListCourseAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ListCourseAPIKeys(ctx, req.(*ListCourseAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoursesServiceServer) GetCourseAnnouncements(context.Context, *GetCourseAnnouncementsRequest) (*GetCourseAnnouncementsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourseAnnouncements not implemented")
+
+func _CoursesService_RevokeCourseAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCourseAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).RevokeCourseAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_RevokeCourseAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).RevokeCourseAPIKey(ctx, req.(*RevokeCourseAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoursesServiceServer) RemoveAnnouncementFromCourse(context.Context, *RemoveAnnouncementRequest) (*RemoveAnnouncementResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveAnnouncementFromCourse not implemented")
+
+func _CoursesService_AddCorequisite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCorequisiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).AddCorequisite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_AddCorequisite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).AddCorequisite(ctx, req.(*AddCorequisiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoursesServiceServer) mustEmbedUnimplementedCoursesServiceServer() {}
-func (UnimplementedCoursesServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeCoursesServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CoursesServiceServer will
-// result in compilation errors.
-type UnsafeCoursesServiceServer interface {
-	mustEmbedUnimplementedCoursesServiceServer()
+func _CoursesService_GetCorequisites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCorequisitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCorequisites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCorequisites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCorequisites(ctx, req.(*GetCorequisitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCoursesServiceServer(s grpc.ServiceRegistrar, srv CoursesServiceServer) {
-	// If the following call pancis, it indicates UnimplementedCoursesServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _CoursesService_GetSharedCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&CoursesService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetSharedCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetSharedCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetSharedCourses(ctx, req.(*GetSharedCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_GetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCourseRequest)
+func _CoursesService_SetGradingComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGradingComponentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).GetCourse(ctx, in)
+		return srv.(CoursesServiceServer).SetGradingComponent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_GetCourse_FullMethodName,
+		FullMethod: CoursesService_SetGradingComponent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).GetCourse(ctx, req.(*GetCourseRequest))
+		return srv.(CoursesServiceServer).SetGradingComponent(ctx, req.(*SetGradingComponentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCourseRequest)
+func _CoursesService_RemoveGradingComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGradingComponentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).CreateCourse(ctx, in)
+		return srv.(CoursesServiceServer).RemoveGradingComponent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_CreateCourse_FullMethodName,
+		FullMethod: CoursesService_RemoveGradingComponent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).CreateCourse(ctx, req.(*CreateCourseRequest))
+		return srv.(CoursesServiceServer).RemoveGradingComponent(ctx, req.(*RemoveGradingComponentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_UpdateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCourseRequest)
+func _CoursesService_GetGradingScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradingSchemeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).UpdateCourse(ctx, in)
+		return srv.(CoursesServiceServer).GetGradingScheme(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_UpdateCourse_FullMethodName,
+		FullMethod: CoursesService_GetGradingScheme_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).UpdateCourse(ctx, req.(*UpdateCourseRequest))
+		return srv.(CoursesServiceServer).GetGradingScheme(ctx, req.(*GetGradingSchemeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_DeleteCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCourseRequest)
+func _CoursesService_FinalizeGradingScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeGradingSchemeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).DeleteCourse(ctx, in)
+		return srv.(CoursesServiceServer).FinalizeGradingScheme(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_DeleteCourse_FullMethodName,
+		FullMethod: CoursesService_FinalizeGradingScheme_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).DeleteCourse(ctx, req.(*DeleteCourseRequest))
+		return srv.(CoursesServiceServer).FinalizeGradingScheme(ctx, req.(*FinalizeGradingSchemeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_AddStudentToCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddStudentRequest)
+func _CoursesService_GenerateCourseShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateCourseShareTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).AddStudentToCourse(ctx, in)
+		return srv.(CoursesServiceServer).GenerateCourseShareToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_AddStudentToCourse_FullMethodName,
+		FullMethod: CoursesService_GenerateCourseShareToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).AddStudentToCourse(ctx, req.(*AddStudentRequest))
+		return srv.(CoursesServiceServer).GenerateCourseShareToken(ctx, req.(*GenerateCourseShareTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_RemoveStudentFromCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveStudentRequest)
+func _CoursesService_GetCourseByShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseByShareTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).RemoveStudentFromCourse(ctx, in)
+		return srv.(CoursesServiceServer).GetCourseByShareToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_RemoveStudentFromCourse_FullMethodName,
+		FullMethod: CoursesService_GetCourseByShareToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).RemoveStudentFromCourse(ctx, req.(*RemoveStudentRequest))
+		return srv.(CoursesServiceServer).GetCourseByShareToken(ctx, req.(*GetCourseByShareTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_AddStaffToCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddStaffRequest)
+func _CoursesService_GetCourseFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseFeaturesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).AddStaffToCourse(ctx, in)
+		return srv.(CoursesServiceServer).GetCourseFeatures(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_AddStaffToCourse_FullMethodName,
+		FullMethod: CoursesService_GetCourseFeatures_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).AddStaffToCourse(ctx, req.(*AddStaffRequest))
+		return srv.(CoursesServiceServer).GetCourseFeatures(ctx, req.(*GetCourseFeaturesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_RemoveStaffFromCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveStaffRequest)
+func _CoursesService_SetCourseFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCourseFeatureRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).RemoveStaffFromCourse(ctx, in)
+		return srv.(CoursesServiceServer).SetCourseFeature(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_RemoveStaffFromCourse_FullMethodName,
+		FullMethod: CoursesService_SetCourseFeature_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).RemoveStaffFromCourse(ctx, req.(*RemoveStaffRequest))
+		return srv.(CoursesServiceServer).SetCourseFeature(ctx, req.(*SetCourseFeatureRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_GetCourseStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCourseStudentsRequest)
+func _CoursesService_GetAnnouncementCountByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnouncementCountByAuthorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).GetCourseStudents(ctx, in)
+		return srv.(CoursesServiceServer).GetAnnouncementCountByAuthor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_GetCourseStudents_FullMethodName,
+		FullMethod: CoursesService_GetAnnouncementCountByAuthor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).GetCourseStudents(ctx, req.(*GetCourseStudentsRequest))
+		return srv.(CoursesServiceServer).GetAnnouncementCountByAuthor(ctx, req.(*GetAnnouncementCountByAuthorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_GetCourseStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCourseStaffRequest)
+func _CoursesService_GetDataShapeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataShapeReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).GetCourseStaff(ctx, in)
+		return srv.(CoursesServiceServer).GetDataShapeReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_GetCourseStaff_FullMethodName,
+		FullMethod: CoursesService_GetDataShapeReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).GetCourseStaff(ctx, req.(*GetCourseStaffRequest))
+		return srv.(CoursesServiceServer).GetDataShapeReport(ctx, req.(*GetDataShapeReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_GetStudentCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStudentCoursesRequest)
+func _CoursesService_GetCourseStudentCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseStudentCountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).GetStudentCourses(ctx, in)
+		return srv.(CoursesServiceServer).GetCourseStudentCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_GetStudentCourses_FullMethodName,
+		FullMethod: CoursesService_GetCourseStudentCount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).GetStudentCourses(ctx, req.(*GetStudentCoursesRequest))
+		return srv.(CoursesServiceServer).GetCourseStudentCount(ctx, req.(*GetCourseStudentCountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_GetStaffCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStaffCoursesRequest)
+func _CoursesService_GetCourseSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).GetStaffCourses(ctx, in)
+		return srv.(CoursesServiceServer).GetCourseSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_GetStaffCourses_FullMethodName,
+		FullMethod: CoursesService_GetCourseSnapshot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).GetStaffCourses(ctx, req.(*GetStaffCoursesRequest))
+		return srv.(CoursesServiceServer).GetCourseSnapshot(ctx, req.(*GetCourseSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_GetSemesterCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSemesterCoursesRequest)
+func _CoursesService_UpdateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAnnouncementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).GetSemesterCourses(ctx, in)
+		return srv.(CoursesServiceServer).UpdateAnnouncement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_GetSemesterCourses_FullMethodName,
+		FullMethod: CoursesService_UpdateAnnouncement_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).GetSemesterCourses(ctx, req.(*GetSemesterCoursesRequest))
+		return srv.(CoursesServiceServer).UpdateAnnouncement(ctx, req.(*UpdateAnnouncementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_AddAnnouncementToCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddAnnouncementRequest)
+func _CoursesService_ChangeCourseID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeCourseIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).AddAnnouncementToCourse(ctx, in)
+		return srv.(CoursesServiceServer).ChangeCourseID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_AddAnnouncementToCourse_FullMethodName,
+		FullMethod: CoursesService_ChangeCourseID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).AddAnnouncementToCourse(ctx, req.(*AddAnnouncementRequest))
+		return srv.(CoursesServiceServer).ChangeCourseID(ctx, req.(*ChangeCourseIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_GetCourseAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCourseAnnouncementsRequest)
+func _CoursesService_UpsertAnnouncementBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertAnnouncementBySlugRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).GetCourseAnnouncements(ctx, in)
+		return srv.(CoursesServiceServer).UpsertAnnouncementBySlug(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_GetCourseAnnouncements_FullMethodName,
+		FullMethod: CoursesService_UpsertAnnouncementBySlug_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).GetCourseAnnouncements(ctx, req.(*GetCourseAnnouncementsRequest))
+		return srv.(CoursesServiceServer).UpsertAnnouncementBySlug(ctx, req.(*UpsertAnnouncementBySlugRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoursesService_RemoveAnnouncementFromCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveAnnouncementRequest)
+func _CoursesService_CheckEnrollmentEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckEnrollmentEligibilityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoursesServiceServer).RemoveAnnouncementFromCourse(ctx, in)
+		return srv.(CoursesServiceServer).CheckEnrollmentEligibility(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CoursesService_RemoveAnnouncementFromCourse_FullMethodName,
+		FullMethod: CoursesService_CheckEnrollmentEligibility_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoursesServiceServer).RemoveAnnouncementFromCourse(ctx, req.(*RemoveAnnouncementRequest))
+		return srv.(CoursesServiceServer).CheckEnrollmentEligibility(ctx, req.(*CheckEnrollmentEligibilityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -677,6 +2269,30 @@ var CoursesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveStudentFromCourse",
 			Handler:    _CoursesService_RemoveStudentFromCourse_Handler,
 		},
+		{
+			MethodName: "SetStudentStatus",
+			Handler:    _CoursesService_SetStudentStatus_Handler,
+		},
+		{
+			MethodName: "GetStudentStatusTransitions",
+			Handler:    _CoursesService_GetStudentStatusTransitions_Handler,
+		},
+		{
+			MethodName: "BatchAddStudents",
+			Handler:    _CoursesService_BatchAddStudents_Handler,
+		},
+		{
+			MethodName: "TransferEnrollments",
+			Handler:    _CoursesService_TransferEnrollments_Handler,
+		},
+		{
+			MethodName: "FindDuplicateEnrollments",
+			Handler:    _CoursesService_FindDuplicateEnrollments_Handler,
+		},
+		{
+			MethodName: "DeduplicateEnrollments",
+			Handler:    _CoursesService_DeduplicateEnrollments_Handler,
+		},
 		{
 			MethodName: "AddStaffToCourse",
 			Handler:    _CoursesService_AddStaffToCourse_Handler,
@@ -697,6 +2313,14 @@ var CoursesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStudentCourses",
 			Handler:    _CoursesService_GetStudentCourses_Handler,
 		},
+		{
+			MethodName: "GetEnrollmentsForStudent",
+			Handler:    _CoursesService_GetEnrollmentsForStudent_Handler,
+		},
+		{
+			MethodName: "GetStudentCredits",
+			Handler:    _CoursesService_GetStudentCredits_Handler,
+		},
 		{
 			MethodName: "GetStaffCourses",
 			Handler:    _CoursesService_GetStaffCourses_Handler,
@@ -705,6 +2329,18 @@ var CoursesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSemesterCourses",
 			Handler:    _CoursesService_GetSemesterCourses_Handler,
 		},
+		{
+			MethodName: "ListCourses",
+			Handler:    _CoursesService_ListCourses_Handler,
+		},
+		{
+			MethodName: "GetCoursesByMetadata",
+			Handler:    _CoursesService_GetCoursesByMetadata_Handler,
+		},
+		{
+			MethodName: "ListCoursesWithoutStaff",
+			Handler:    _CoursesService_ListCoursesWithoutStaff_Handler,
+		},
 		{
 			MethodName: "AddAnnouncementToCourse",
 			Handler:    _CoursesService_AddAnnouncementToCourse_Handler,
@@ -713,10 +2349,142 @@ var CoursesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCourseAnnouncements",
 			Handler:    _CoursesService_GetCourseAnnouncements_Handler,
 		},
+		{
+			MethodName: "GetAnnouncement",
+			Handler:    _CoursesService_GetAnnouncement_Handler,
+		},
+		{
+			MethodName: "GetCourseAnnouncementsGrouped",
+			Handler:    _CoursesService_GetCourseAnnouncementsGrouped_Handler,
+		},
 		{
 			MethodName: "RemoveAnnouncementFromCourse",
 			Handler:    _CoursesService_RemoveAnnouncementFromCourse_Handler,
 		},
+		{
+			MethodName: "BatchRemoveAnnouncements",
+			Handler:    _CoursesService_BatchRemoveAnnouncements_Handler,
+		},
+		{
+			MethodName: "CopyAnnouncements",
+			Handler:    _CoursesService_CopyAnnouncements_Handler,
+		},
+		{
+			MethodName: "SetEnrollmentWindow",
+			Handler:    _CoursesService_SetEnrollmentWindow_Handler,
+		},
+		{
+			MethodName: "GetEnrollmentStatus",
+			Handler:    _CoursesService_GetEnrollmentStatus_Handler,
+		},
+		{
+			MethodName: "SearchAllAnnouncements",
+			Handler:    _CoursesService_SearchAllAnnouncements_Handler,
+		},
+		{
+			MethodName: "GetAnnouncementsSince",
+			Handler:    _CoursesService_GetAnnouncementsSince_Handler,
+		},
+		{
+			MethodName: "GetEnrollmentDifference",
+			Handler:    _CoursesService_GetEnrollmentDifference_Handler,
+		},
+		{
+			MethodName: "SetQuietPeriods",
+			Handler:    _CoursesService_SetQuietPeriods_Handler,
+		},
+		{
+			MethodName: "SetAnnouncementsEnabled",
+			Handler:    _CoursesService_SetAnnouncementsEnabled_Handler,
+		},
+		{
+			MethodName: "CreateCourseAPIKey",
+			Handler:    _CoursesService_CreateCourseAPIKey_Handler,
+		},
+		{
+			MethodName: "ListCourseAPIKeys",
+			Handler:    _CoursesService_ListCourseAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeCourseAPIKey",
+			Handler:    _CoursesService_RevokeCourseAPIKey_Handler,
+		},
+		{
+			MethodName: "AddCorequisite",
+			Handler:    _CoursesService_AddCorequisite_Handler,
+		},
+		{
+			MethodName: "GetCorequisites",
+			Handler:    _CoursesService_GetCorequisites_Handler,
+		},
+		{
+			MethodName: "GetSharedCourses",
+			Handler:    _CoursesService_GetSharedCourses_Handler,
+		},
+		{
+			MethodName: "SetGradingComponent",
+			Handler:    _CoursesService_SetGradingComponent_Handler,
+		},
+		{
+			MethodName: "RemoveGradingComponent",
+			Handler:    _CoursesService_RemoveGradingComponent_Handler,
+		},
+		{
+			MethodName: "GetGradingScheme",
+			Handler:    _CoursesService_GetGradingScheme_Handler,
+		},
+		{
+			MethodName: "FinalizeGradingScheme",
+			Handler:    _CoursesService_FinalizeGradingScheme_Handler,
+		},
+		{
+			MethodName: "GenerateCourseShareToken",
+			Handler:    _CoursesService_GenerateCourseShareToken_Handler,
+		},
+		{
+			MethodName: "GetCourseByShareToken",
+			Handler:    _CoursesService_GetCourseByShareToken_Handler,
+		},
+		{
+			MethodName: "GetCourseFeatures",
+			Handler:    _CoursesService_GetCourseFeatures_Handler,
+		},
+		{
+			MethodName: "SetCourseFeature",
+			Handler:    _CoursesService_SetCourseFeature_Handler,
+		},
+		{
+			MethodName: "GetAnnouncementCountByAuthor",
+			Handler:    _CoursesService_GetAnnouncementCountByAuthor_Handler,
+		},
+		{
+			MethodName: "GetDataShapeReport",
+			Handler:    _CoursesService_GetDataShapeReport_Handler,
+		},
+		{
+			MethodName: "GetCourseStudentCount",
+			Handler:    _CoursesService_GetCourseStudentCount_Handler,
+		},
+		{
+			MethodName: "GetCourseSnapshot",
+			Handler:    _CoursesService_GetCourseSnapshot_Handler,
+		},
+		{
+			MethodName: "UpdateAnnouncement",
+			Handler:    _CoursesService_UpdateAnnouncement_Handler,
+		},
+		{
+			MethodName: "ChangeCourseID",
+			Handler:    _CoursesService_ChangeCourseID_Handler,
+		},
+		{
+			MethodName: "UpsertAnnouncementBySlug",
+			Handler:    _CoursesService_UpsertAnnouncementBySlug_Handler,
+		},
+		{
+			MethodName: "CheckEnrollmentEligibility",
+			Handler:    _CoursesService_CheckEnrollmentEligibility_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "courses-microservice.proto",
@@ -1,14 +1,23 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"math"
+	"net/url"
 	"os"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	cpb "github.com/BetterGR/courses-microservice/protos"
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/driver/pgdriver"
@@ -17,42 +26,133 @@ import (
 
 // CourseDBInterface defines the core CRUD operations for courses.
 type CourseDBInterface interface {
-	AddCourse(ctx context.Context, course *cpb.Course) (*Course, error)
+	AddCourse(ctx context.Context, course *cpb.Course, staff ...CourseStaff) (*Course, error)
 	GetCourse(ctx context.Context, courseID string) (*Course, error)
 	UpdateCourse(ctx context.Context, course *cpb.Course) (*Course, error)
 	DeleteCourse(ctx context.Context, courseID string) error
-	GetCoursesBySemester(ctx context.Context, semester string) ([]*Course, error)
+	GetCoursesBySemester(ctx context.Context, semester string, limit int) ([]*Course, error)
+	ListCourses(ctx context.Context, filter CourseFilter, page Page) ([]*Course, error)
+	SetEnrollmentWindow(ctx context.Context, courseID string, opensAt, closesAt time.Time) error
+	EnrollmentStatus(ctx context.Context, courseID string) (*EnrollmentWindow, error)
+	SetQuietPeriods(ctx context.Context, courseID string, periods []QuietPeriod) error
+	GetCoursesByMetadata(ctx context.Context, key, value string, page Page) ([]*Course, error)
 }
 
 // StudentDBInterface defines operations related to student enrollments.
 type StudentDBInterface interface {
-	AddStudentToCourse(ctx context.Context, courseID, studentID string) error
+	AddStudentToCourse(ctx context.Context, courseID, studentID string, allowStaff, allowFull bool) error
+	AddStudentsToCourse(ctx context.Context, courseID string, studentIDs []string,
+		allowStaff, allowFull bool) ([]BatchEnrollment, error)
 	RemoveStudentFromCourse(ctx context.Context, courseID, studentID string) error
-	GetCourseStudents(ctx context.Context, courseID string) ([]string, error)
-	GetStudentCourses(ctx context.Context, studentID string) ([]string, error)
+	SetStudentStatus(ctx context.Context, courseID, studentID, status string) error
+	GetCourseStudents(ctx context.Context, courseID string, includeInactive bool) ([]string, error)
+	CountCourseStudents(ctx context.Context, courseID string) (int, error)
+	GetStudentCourses(ctx context.Context, studentID, semester string) ([]string, error)
+	GetEnrollmentsForStudent(ctx context.Context, studentID string) ([]Enrollment, error)
+	IsKnownStudent(ctx context.Context, studentID string) (bool, error)
+	GetEnrollmentDifference(ctx context.Context, courseIDA, courseIDB string, page Page) (*EnrollmentDifference, error)
+}
+
+// AdminDBInterface defines admin operations on the data as a whole: repairing data written by
+// earlier versions, moving enrollments and correcting course IDs, reporting its shape, exporting
+// courses and checking the connection to it.
+type AdminDBInterface interface {
+	FindDuplicateEnrollments(ctx context.Context) ([]DuplicateEnrollment, error)
+	DeduplicateEnrollments(ctx context.Context) (int, error)
+	TransferEnrollments(ctx context.Context, fromCourseID, toCourseID string) (*EnrollmentTransfer, error)
+	ChangeCourseID(ctx context.Context, oldID, newID string) error
+	GetDataShape(ctx context.Context) (*DataShape, error)
+	GetCourseSnapshot(ctx context.Context, courseID string) (*CourseSnapshot, error)
+	Ping(ctx context.Context) error
+}
+
+// AdvisingDBInterface defines the checks advising a student on their enrollments.
+type AdvisingDBInterface interface {
+	CountSemesterEnrollments(ctx context.Context, courseID, studentID string) (int, error)
+	AddCorequisite(ctx context.Context, courseID, corequisiteID string) error
+	GetCorequisites(ctx context.Context, courseID string) ([]string, error)
+	MissingCorequisites(ctx context.Context, courseID, studentID string) ([]string, error)
+	GetStudentCredits(ctx context.Context, studentID, semester string) (int64, error)
+	GetSharedCourses(ctx context.Context, staffID, studentID string) ([]string, error)
 }
 
 // StaffDBInterface defines operations related to staff assignments.
 type StaffDBInterface interface {
-	AddStaffToCourse(ctx context.Context, courseID, staffID string) error
+	AddStaffToCourse(ctx context.Context, courseID, staffID string, validFrom, validUntil time.Time,
+		allowStudent bool) error
 	RemoveStaffFromCourse(ctx context.Context, courseID, staffID string) error
-	GetCourseStaff(ctx context.Context, courseID string) ([]string, error)
-	GetStaffCourses(ctx context.Context, staffID string) ([]string, error)
+	GetCourseStaff(ctx context.Context, courseID string, includeExpired bool) ([]string, error)
+	GetCourseStaffPage(ctx context.Context, courseID string, includeExpired bool, page Page) (*StaffPage, error)
+	GetStaffCourses(ctx context.Context, staffID, semester string) ([]string, error)
+	IsKnownStaff(ctx context.Context, staffID string) (bool, error)
+	ListCoursesWithoutStaff(ctx context.Context, semester string) ([]*Course, error)
+	GetLapsedStaff(ctx context.Context, now time.Time) ([]CourseStaff, error)
+	MarkStaffLapseReported(ctx context.Context, staff CourseStaff, reportedAt time.Time) error
 }
 
 // AnnouncementDBInterface defines operations related to course announcements.
 type AnnouncementDBInterface interface {
-	AddAnnouncement(ctx context.Context, req *cpb.AddAnnouncementRequest) error
-	GetAnnouncements(ctx context.Context, courseID string) ([]Announcement, error)
+	AddAnnouncement(ctx context.Context, req *cpb.AddAnnouncementRequest, excerptLength int) (*Announcement, error)
+	GetAnnouncement(ctx context.Context, courseID, announcementID string, filter AnnouncementFilter) (*Announcement, error)
+	SetAnnouncementsEnabled(ctx context.Context, courseID string, enabled bool) error
+	GetAnnouncements(ctx context.Context, courseID string, filter AnnouncementFilter, page Page) ([]Announcement, error)
+	SummarizeAnnouncements(ctx context.Context, courseID string, filter AnnouncementFilter) (*AnnouncementStats, error)
+	GetAnnouncementCountByAuthor(ctx context.Context, courseID string) (map[string]int, error)
+	GetAnnouncementGroups(ctx context.Context, courseID string, filter AnnouncementFilter,
+		grouping cpb.AnnouncementGrouping, loc *time.Location, page Page) ([]AnnouncementGroup, error)
 	RemoveAnnouncement(ctx context.Context, courseID, announcementID string) error
+	UpdateAnnouncement(ctx context.Context, courseID, announcementID, title, content string,
+		visibility *cpb.AnnouncementVisibility, excerptLength int) (*Announcement, error)
+	UpsertAnnouncementBySlug(ctx context.Context, req *cpb.AddAnnouncementRequest,
+		excerptLength int) (*Announcement, bool, error)
+}
+
+// AnnouncementFeedDBInterface defines operations on many announcements at once, of one course or
+// of several or all courses.
+type AnnouncementFeedDBInterface interface {
+	RemoveAnnouncements(ctx context.Context, courseID string, announcementIDs []string) ([]string, error)
+	CopyAnnouncements(ctx context.Context, fromCourseID, toCourseID string) (int, error)
+	SearchAllAnnouncements(ctx context.Context, query string, page Page) ([]Announcement, error)
+	GetAnnouncementsSince(ctx context.Context, after AnnouncementPosition, limit int) ([]Announcement, error)
+	RepostDueAnnouncements(ctx context.Context, now time.Time) (int, error)
+}
+
+// APIKeyDBInterface defines operations related to course API keys.
+type APIKeyDBInterface interface {
+	AddAPIKey(ctx context.Context, key *CourseAPIKey) error
+	GetAPIKey(ctx context.Context, keyID string) (*CourseAPIKey, error)
+	ListAPIKeys(ctx context.Context, courseID string) ([]CourseAPIKey, error)
+	RevokeAPIKey(ctx context.Context, courseID, keyID string) error
+}
+
+// GradingDBInterface defines operations related to the grading schemes of courses.
+type GradingDBInterface interface {
+	SetGradingComponent(ctx context.Context, component GradingComponent) error
+	RemoveGradingComponent(ctx context.Context, courseID, name string) error
+	GetGradingScheme(ctx context.Context, courseID string) (*GradingScheme, error)
+	CountDraftGradingComponents(ctx context.Context, courseIDs ...string) (map[string]int, error)
+	FinalizeGradingScheme(ctx context.Context, courseID string) (*GradingScheme, error)
+}
+
+// FeatureDBInterface defines operations related to the feature overrides of courses.
+type FeatureDBInterface interface {
+	GetFeatureOverrides(ctx context.Context, courseID string) (map[string]bool, error)
+	SetFeatureOverride(ctx context.Context, courseID, feature string, enabled bool) error
+	ClearFeatureOverride(ctx context.Context, courseID, feature string) error
 }
 
 // DBInterface combines all database operation interfaces.
 type DBInterface interface {
 	CourseDBInterface
 	StudentDBInterface
+	AdminDBInterface
+	AdvisingDBInterface
 	StaffDBInterface
 	AnnouncementDBInterface
+	AnnouncementFeedDBInterface
+	APIKeyDBInterface
+	GradingDBInterface
+	FeatureDBInterface
 }
 
 // Database encapsulates the PostgreSQL connection.
@@ -64,65 +164,214 @@ type Database struct {
 var _ DBInterface = (*Database)(nil)
 
 var (
-	ErrCourseNil         = errors.New("course is nil")
-	ErrCourseIDEmpty     = errors.New("course ID is empty")
-	ErrCourseNotFound    = errors.New("course not found")
-	ErrStudentIDEmpty    = errors.New("student ID is empty")
-	ErrStaffIDEmpty      = errors.New("staff ID is empty")
-	ErrAnnouncementEmpty = errors.New("announcement is empty")
-	ErrSemesterEmpty     = errors.New("semester is empty")
+	ErrCourseNil             = errors.New("course is nil")
+	ErrCourseIDEmpty         = errors.New("course ID is empty")
+	ErrCourseNotFound        = errors.New("course not found")
+	ErrStudentIDEmpty        = errors.New("student ID is empty")
+	ErrStaffIDEmpty          = errors.New("staff ID is empty")
+	ErrAnnouncementEmpty     = errors.New("announcement is empty")
+	ErrAnnouncementNotFound  = errors.New("announcement not found")
+	ErrStudentNotFound       = errors.New("student is in no course and never was")
+	ErrStaffNotFound         = errors.New("staff member is in no course and never was")
+	ErrSemesterEmpty         = errors.New("semester is empty")
+	ErrInvalidWindow         = errors.New("enrollment window opens after it closes")
+	ErrInvalidAccess         = errors.New("staff access starts after it ends")
+	ErrSearchQueryEmpty      = errors.New("search query is empty")
+	ErrInvalidQuiet          = errors.New("quiet period starts after it ends")
+	ErrQuietPeriod           = errors.New("announcements are paused during a quiet period")
+	ErrAnnouncementsDisabled = errors.New("announcements are disabled for the course")
+	ErrAPIKeyNotFound        = errors.New("API key not found")
+	ErrDatabaseName          = errors.New("database name is not configured")
+	ErrDatabaseMismatch      = errors.New("DSN and DP_NAME name different databases")
+	ErrDatabaseMissing       = errors.New("database does not exist")
+	ErrSelfCorequisite       = errors.New("course cannot be its own co-requisite")
+	ErrInvalidStatus         = errors.New("student status is not enrolled, dropped, withdrawn or completed")
+	ErrDuplicateStaff        = errors.New("staff member is listed more than once")
+	ErrCapacityExceeded      = errors.New("course has too few free places")
+	ErrDatabaseReadOnly      = errors.New("database is read-only")
+	ErrConflictingRole       = errors.New("ID is both a student and staff in the course")
+
+	ErrInvalidStatusTransition = errors.New("student status cannot change this way")
+	ErrInvalidMetadata         = errors.New("course metadata is invalid")
+	ErrAnnouncementExists      = errors.New("course already has an announcement with this ID or slug")
+	ErrSlugEmpty               = errors.New("announcement slug is empty")
+	ErrUnknownEnumValue        = errors.New("stored value is not a value of its enum")
+	ErrDuplicateMembers        = errors.New("members are listed in a course more than once")
+
+	ErrGradingComponentEmpty    = errors.New("grading component name is empty")
+	ErrInvalidWeight            = errors.New("grading component weight is not above 0 and at most 100")
+	ErrGradingComponentNotFound = errors.New("grading component not found")
+	ErrGradingSchemeFinalized   = errors.New("grading scheme is finalized")
+	ErrGradingWeights           = errors.New("grading component weights do not sum to 100")
 )
 
+// deduplicateMembersEnv is the environment variable letting startup remove the extra rows of members
+// listed in a course more than once, left from before the role tables had primary keys.
+const deduplicateMembersEnv = "DEDUPLICATE_MEMBERS"
+
+// maintenanceDatabase is the database connected to while checking for and creating the application database.
+const maintenanceDatabase = "postgres"
+
+// autoCreateDatabaseEnv is the environment variable turning off creating a missing application database,
+// for database users without the privilege to create databases.
+const autoCreateDatabaseEnv = "AUTO_CREATE_DB"
+
+// invalidCatalogSQLState is the SQLSTATE Postgres reports when connecting to a database that does not exist.
+const invalidCatalogSQLState = "3D000"
+
+// readOnlySQLState is the SQLSTATE Postgres reports for writes to a read-only database,
+// such as a standby while the primary fails over.
+const readOnlySQLState = "25006"
+
+// announcementSlugIndex is the unique index keeping the slugs of the announcements of a course apart.
+const announcementSlugIndex = "announcements_course_slug_idx"
+
+// uniqueViolationSQLState is the SQLSTATE Postgres reports for inserts breaking a unique index.
+const uniqueViolationSQLState = "23505"
+
+// databaseReadOnly reports whether err was caused by the database refusing writes.
+func databaseReadOnly(err error) bool {
+	var pgErr pgdriver.Error
+	if errors.As(err, &pgErr) && pgErr.Field('C') == readOnlySQLState {
+		return true
+	}
+
+	return errors.Is(err, ErrDatabaseReadOnly)
+}
+
 // InitializeDatabase ensures that the database exists and initializes the schema.
 func InitializeDatabase() (*Database, error) {
-	createDatabaseIfNotExists()
+	maintenanceDSN, appDSN, dbName, err := databaseDSNs(os.Getenv("DSN"), os.Getenv("DP_NAME"))
+	if err != nil {
+		return nil, err
+	}
 
-	database, err := ConnectDB()
+	ctx := context.Background()
+
+	database, err := openApplicationDatabase(ctx, maintenanceDSN, appDSN, dbName)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := database.createSchemaIfNotExists(context.Background()); err != nil {
+	if err := database.createSchemaIfNotExists(ctx); err != nil {
 		klog.Fatalf("Failed to create schema: %v", err)
 	}
 
+	// Tables left in another shape by earlier deployments are reported, not changed.
+	differences, err := schemaDifferences(ctx, database.db)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, difference := range differences {
+		klog.Warningf("Schema differs from the models: %s", difference)
+	}
+
 	return database, nil
 }
 
-// createDatabaseIfNotExists ensures the database exists.
-func createDatabaseIfNotExists() {
-	dsn := os.Getenv("DSN")
-	connector := pgdriver.NewConnector(pgdriver.WithDSN(dsn))
+// openApplicationDatabase connects to the application database, first creating it if it is missing
+// unless AUTO_CREATE_DB is off.
+func openApplicationDatabase(ctx context.Context, maintenanceDSN, appDSN, dbName string) (*Database, error) {
+	autoCreate, err := autoCreateDatabaseFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	if autoCreate {
+		if err := createDatabaseIfNotExists(ctx, maintenanceDSN, dbName); err != nil {
+			return nil, err
+		}
+	}
+
+	database, err := connectDSN(appDSN)
+
+	var pgErr pgdriver.Error
+	if errors.As(err, &pgErr) && pgErr.Field('C') == invalidCatalogSQLState {
+		return nil, fmt.Errorf("%w: create %s or set %s=true", ErrDatabaseMissing, dbName, autoCreateDatabaseEnv)
+	}
+
+	return database, err
+}
+
+// databaseDSNs derives the DSN of the maintenance database and the DSN of the application database
+// from the configured DSN and DP_NAME. Either may name the application database, but if both do
+// they must agree.
+func databaseDSNs(dsn, dbName string) (string, string, string, error) {
+	parsed, err := url.Parse(dsn)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to parse DSN: %w", err)
+	}
+
+	dsnName := strings.TrimPrefix(parsed.Path, "/")
+
+	switch {
+	case dbName == "" && dsnName == "":
+		return "", "", "", fmt.Errorf("%w: set DP_NAME or a database in the DSN", ErrDatabaseName)
+	case dbName == "":
+		dbName = dsnName
+	case dsnName != "" && dsnName != dbName:
+		return "", "", "", fmt.Errorf("%w: DSN targets %q but DP_NAME is %q", ErrDatabaseMismatch, dsnName, dbName)
+	}
+
+	parsed.Path = "/" + maintenanceDatabase
+	maintenanceDSN := parsed.String()
+
+	parsed.Path = "/" + dbName
+
+	return maintenanceDSN, parsed.String(), dbName, nil
+}
+
+// autoCreateDatabaseFromEnv reads whether a missing application database is created, defaulting to creating it.
+func autoCreateDatabaseFromEnv() (bool, error) {
+	return toggleFromEnv(autoCreateDatabaseEnv, true)
+}
+
+// createDatabaseIfNotExists connects to the maintenance database and creates dbName if it is missing.
+func createDatabaseIfNotExists(ctx context.Context, maintenanceDSN, dbName string) error {
+	connector := pgdriver.NewConnector(pgdriver.WithDSN(maintenanceDSN))
 
 	sqldb := sql.OpenDB(connector)
 	defer sqldb.Close()
 
-	ctx := context.Background()
-
-	dbName := os.Getenv("DP_NAME")
 	query := "SELECT 1 FROM pg_database WHERE datname = $1;"
 
 	var exists int
 
 	err := sqldb.QueryRowContext(ctx, query, dbName).Scan(&exists)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		klog.Fatalf("Failed to check db existence: %v", err)
+		return fmt.Errorf("failed to check database existence: %w", err)
 	}
 
-	if errors.Is(err, sql.ErrNoRows) {
-		if _, err = sqldb.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE %s;", dbName)); err != nil {
-			klog.Fatalf("Failed to create database: %v", err)
-		}
-
-		klog.V(logLevelDebug).Infof("Database %s created successfully.", dbName)
-	} else {
+	if err == nil {
 		klog.V(logLevelDebug).Infof("Database %s already exists.", dbName)
+
+		return nil
 	}
+
+	// Quote the name as an identifier so it is created exactly as configured.
+	createQuery := bun.NewDB(sqldb, pgdialect.New()).NewRaw("CREATE DATABASE ?", bun.Ident(dbName))
+	if _, err := createQuery.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create database: %w", err)
+	}
+
+	klog.V(logLevelDebug).Infof("Database %s created successfully.", dbName)
+
+	return nil
 }
 
-// ConnectDB connects to the database.
+// ConnectDB connects to the application database configured by DSN and DP_NAME.
 func ConnectDB() (*Database, error) {
-	dsn := os.Getenv("DSN")
+	_, appDSN, _, err := databaseDSNs(os.Getenv("DSN"), os.Getenv("DP_NAME"))
+	if err != nil {
+		return nil, err
+	}
+
+	return connectDSN(appDSN)
+}
+
+// connectDSN connects to the database at dsn.
+func connectDSN(dsn string) (*Database, error) {
 	connector := pgdriver.NewConnector(pgdriver.WithDSN(dsn))
 	sqldb := sql.OpenDB(connector)
 	database := bun.NewDB(sqldb, pgdialect.New())
@@ -137,405 +386,3987 @@ func ConnectDB() (*Database, error) {
 	return &Database{db: database}, nil
 }
 
-// createSchemaIfNotExists creates the database schema if it doesn't exist.
-func (d *Database) createSchemaIfNotExists(ctx context.Context) error {
-	models := []interface{}{
+// Ping checks that the database can be reached.
+func (d *Database) Ping(ctx context.Context) error {
+	if err := d.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to ping the database: %w", err)
+	}
+
+	return nil
+}
+
+// schemaModels returns the models of every table of the schema. Each names its table explicitly,
+// so that renaming a type never points it at another table.
+func schemaModels() []interface{} {
+	return []interface{}{
 		(*Course)(nil),
 		(*CourseStudent)(nil),
 		(*CourseStaff)(nil),
 		(*Announcement)(nil),
+		(*AnnouncementBody)(nil),
+		(*QuietPeriod)(nil),
+		(*CourseAPIKey)(nil),
+		(*CourseCorequisite)(nil),
+		(*GradingComponent)(nil),
+		(*CourseFeature)(nil),
 	}
+}
 
-	for _, model := range models {
-		if _, err := d.db.NewCreateTable().IfNotExists().Model(model).Exec(ctx); err != nil {
+// createSchemaIfNotExists creates the database schema if it doesn't exist.
+func (d *Database) createSchemaIfNotExists(ctx context.Context) error {
+	// Rows declaring a Course relation reference their course and are deleted together with it.
+	for _, model := range schemaModels() {
+		if _, err := d.db.NewCreateTable().IfNotExists().WithForeignKeys().Model(model).Exec(ctx); err != nil {
 			return fmt.Errorf("failed to create table: %w", err)
 		}
 	}
 
-	klog.V(logLevelDebug).Info("Database schema initialized.")
-
-	return nil
-}
+	if err := d.migrateSchema(ctx); err != nil {
+		return err
+	}
 
-// Course represents the database schema for courses.
-type Course struct {
-	CourseID    string    `bun:"course_id,unique,pk,notnull"`
-	CourseName  string    `bun:"course_name,notnull"`
-	Semester    string    `bun:"semester,notnull"`
-	Description string    `bun:"description"`
-	CreatedAt   time.Time `bun:"created_at,default:current_timestamp"`
-	UpdatedAt   time.Time `bun:"updated_at,default:current_timestamp"`
-}
+	indexes := []string{
+		"CREATE INDEX IF NOT EXISTS announcements_search_idx ON announcements " +
+			"USING GIN (" + announcementSearchVector + ")",
+		"CREATE INDEX IF NOT EXISTS announcement_bodies_search_idx ON announcement_bodies " +
+			"USING GIN (" + announcementBodySearchVector + ")",
+		"CREATE INDEX IF NOT EXISTS announcements_created_at_idx ON announcements (created_at)",
+		"CREATE INDEX IF NOT EXISTS course_staffs_semester_idx ON course_staffs (staff_id, semester)",
+		"CREATE INDEX IF NOT EXISTS course_students_semester_idx ON course_students (student_id, semester)",
+		"CREATE INDEX IF NOT EXISTS courses_metadata_idx ON courses USING GIN (metadata jsonb_path_ops)",
+		"CREATE UNIQUE INDEX IF NOT EXISTS " + announcementSlugIndex + " ON announcements (course_id, slug)",
+	}
 
-type Announcement struct {
-	AnnouncementID string    `bun:"announcement_id,notnull"`
-	CourseID       string    `bun:"course_id,notnull"`
-	Title          string    `bun:"title,notnull"`
-	Content        string    `bun:"content,notnull"`
-	CreatedAt      time.Time `bun:"created_at,default:current_timestamp"`
-	UpdatedAt      time.Time `bun:"updated_at,default:current_timestamp"`
-}
+	for _, index := range indexes {
+		if _, err := d.db.ExecContext(ctx, index); err != nil {
+			return fmt.Errorf("failed to create index: %w", err)
+		}
+	}
 
-type CourseStudent struct {
-	CourseID  string `bun:"course_id,notnull"`
-	StudentID string `bun:"student_id,notnull"`
-}
+	klog.V(logLevelDebug).Info("Database schema initialized.")
 
-type CourseStaff struct {
-	CourseID string `bun:"course_id,notnull"`
-	StaffID  string `bun:"staff_id,notnull"`
+	return nil
 }
 
-// AddCourse inserts a new course into the database using the proto message.
-func (d *Database) AddCourse(ctx context.Context, course *cpb.Course) (*Course, error) {
-	if course == nil {
-		return nil, fmt.Errorf("%w", ErrCourseNil)
-	}
-
-	if course.GetCourseID() == "" {
-		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+// migrateSchema brings tables created by earlier versions up to date: it adds the columns and
+// foreign keys introduced since and backfills them.
+func (d *Database) migrateSchema(ctx context.Context) error {
+	migrations := []string{
+		"ALTER TABLE courses ADD COLUMN IF NOT EXISTS enrollment_opens_at timestamptz",
+		"ALTER TABLE courses ADD COLUMN IF NOT EXISTS enrollment_closes_at timestamptz",
+		"ALTER TABLE course_staffs ADD COLUMN IF NOT EXISTS valid_from timestamptz",
+		"ALTER TABLE course_staffs ADD COLUMN IF NOT EXISTS valid_until timestamptz",
+		"ALTER TABLE announcements ADD COLUMN IF NOT EXISTS visibility text NOT NULL DEFAULT 'EVERYONE'",
+		"ALTER TABLE announcements ADD COLUMN IF NOT EXISTS recurrence text NOT NULL DEFAULT 'NONE'",
+		"ALTER TABLE announcements ADD COLUMN IF NOT EXISTS next_post_at timestamptz",
+		"ALTER TABLE course_students ADD COLUMN IF NOT EXISTS status text NOT NULL DEFAULT 'enrolled'",
+		"ALTER TABLE courses ADD COLUMN IF NOT EXISTS capacity integer NOT NULL DEFAULT 0",
+		"ALTER TABLE courses ADD COLUMN IF NOT EXISTS announcements_enabled boolean NOT NULL DEFAULT true",
+		"ALTER TABLE courses ADD COLUMN IF NOT EXISTS grading_finalized_at timestamptz",
+		"ALTER TABLE courses ADD COLUMN IF NOT EXISTS credits integer NOT NULL DEFAULT 0",
+		"ALTER TABLE course_staffs ADD COLUMN IF NOT EXISTS semester text NOT NULL DEFAULT ''",
+		"ALTER TABLE course_students ADD COLUMN IF NOT EXISTS semester text NOT NULL DEFAULT ''",
+		"ALTER TABLE announcements ADD COLUMN IF NOT EXISTS has_full_body boolean NOT NULL DEFAULT false",
+		"ALTER TABLE courses ADD COLUMN IF NOT EXISTS metadata jsonb NOT NULL DEFAULT '{}'",
+		"ALTER TABLE announcements ADD COLUMN IF NOT EXISTS author text NOT NULL DEFAULT ''",
+		"ALTER TABLE announcements ADD COLUMN IF NOT EXISTS slug text",
+		"ALTER TABLE quiet_periods ADD COLUMN IF NOT EXISTS weekly boolean NOT NULL DEFAULT false",
+		// Assignments that lapsed before lapses were published are not published on upgrade.
+		"DO $$ BEGIN " +
+			"IF NOT EXISTS (SELECT 1 FROM information_schema.columns " +
+			"WHERE table_name = 'course_staffs' AND column_name = 'lapse_reported_at') THEN " +
+			"ALTER TABLE course_staffs ADD COLUMN lapse_reported_at timestamptz; " +
+			"UPDATE course_staffs SET lapse_reported_at = valid_until WHERE valid_until <= now(); " +
+			"END IF; END $$",
+		duplicateAnnouncementIDsMigration(),
+		// Backfill the semesters of assignments made before they were recorded.
+		"UPDATE course_staffs AS cs SET semester = c.semester FROM courses AS c " +
+			"WHERE c.course_id = cs.course_id AND cs.semester <> c.semester",
+		"UPDATE course_students AS cs SET semester = c.semester FROM courses AS c " +
+			"WHERE c.course_id = cs.course_id AND cs.semester <> c.semester",
 	}
 
-	newCourse := &Course{
-		CourseID:    course.GetCourseID(),
-		CourseName:  course.GetCourseName(),
-		Semester:    course.GetSemester(),
-		Description: course.GetDescription(),
+	if err := d.runMigrations(ctx, migrations); err != nil {
+		return err
 	}
 
-	_, err := d.db.NewInsert().Model(newCourse).Exec(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to add course: %w", err)
+	// Enrollments and staff had no key before, so a member could be listed twice.
+	if err := d.resolveDuplicateMembers(ctx); err != nil {
+		return err
 	}
 
-	return newCourse, nil
-}
-
-// GetCourse retrieves a course by its course_id and returns the proto message.
-func (d *Database) GetCourse(ctx context.Context, courseID string) (*Course, error) {
-	if courseID == "" {
-		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	keys := []string{
+		primaryKeyMigration("course_students", "student_id"),
+		primaryKeyMigration("course_staffs", "staff_id"),
+		// The duplicate IDs were made unique above; the key replaces the unique index that kept
+		// IDs apart since.
+		primaryKeyMigration("announcements", "announcement_id"),
+		"DROP INDEX IF EXISTS announcements_course_announcement_idx",
+		courseForeignKeyMigration("announcements"),
+		// WithForeignKeys skips relations over primary key columns, so these always get their key here.
+		courseForeignKeyMigration("course_students"),
+		courseForeignKeyMigration("course_staffs"),
+		courseForeignKeyMigration("announcement_bodies"),
+		courseForeignKeyMigration("course_features"),
+		checkConstraintMigration("course_students", "status", studentStatuses()),
 	}
 
-	course := new(Course)
-	if err := d.db.NewSelect().Model(course).Where("course_id = ?", courseID).Scan(ctx); err != nil {
-		return nil, fmt.Errorf("failed to get course: %w", err)
+	for _, column := range enumColumns() {
+		keys = append(keys, checkConstraintMigration(column.table, column.column, column.names()))
 	}
 
-	return course, nil
+	return d.runMigrations(ctx, keys)
 }
 
-// UpdateCourse updates an existing course in the database using the proto message.
-func (d *Database) UpdateCourse(ctx context.Context, course *cpb.Course) (*Course, error) {
-	if course == nil {
-		return nil, fmt.Errorf("%w", ErrCourseNil)
-	}
+// duplicateAnnouncementIDsMigration makes announcement IDs unique within their course, as they were
+// not before. The first announcement with an ID keeps it and the later ones get a UUID suffix, which
+// no other announcement has. The full content the announcements shared under the old ID is copied
+// to the new ID of each announcement that has one.
+func duplicateAnnouncementIDsMigration() string {
+	return "WITH duplicates AS (SELECT ctid, announcement_id, " +
+		"row_number() OVER (PARTITION BY course_id, announcement_id ORDER BY created_at) AS n " +
+		"FROM announcements), " +
+		"renamed AS (UPDATE announcements AS a SET announcement_id = a.announcement_id || '-' || gen_random_uuid() " +
+		"FROM duplicates AS d WHERE a.ctid = d.ctid AND d.n > 1 " +
+		"RETURNING a.course_id, d.announcement_id AS old_id, a.announcement_id AS new_id, a.has_full_body) " +
+		"INSERT INTO announcement_bodies (course_id, announcement_id, content) " +
+		"SELECT r.course_id, r.new_id, b.content FROM renamed AS r JOIN announcement_bodies AS b " +
+		"ON b.course_id = r.course_id AND b.announcement_id = r.old_id WHERE r.has_full_body"
+}
 
-	if course.GetCourseID() == "" {
-		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+// runMigrations runs schema migrations in order.
+func (d *Database) runMigrations(ctx context.Context, migrations []string) error {
+	for _, migration := range migrations {
+		if _, err := d.db.ExecContext(ctx, migration); err != nil {
+			return fmt.Errorf("failed to migrate schema: %w", err)
+		}
 	}
 
-	// get existing course.
-	existingCourse, err := d.GetCourse(ctx, course.GetCourseID())
-	if err != nil {
-		return nil, fmt.Errorf("failed to get course: %w", err)
-	}
+	return nil
+}
 
-	// Update the fields.
-	updateField := func(field *string, newValue string) {
-		if newValue != "" {
-			*field = newValue
-		}
-	}
+// courseForeignKeyMigration adds the foreign key from a table created without one to courses,
+// as WithForeignKeys would have created it. Rows of courses deleted before are dropped first,
+// since the key could not be added over them.
+func courseForeignKeyMigration(table string) string {
+	constraint := table + "_course_id_fkey"
 
-	updateField(&existingCourse.CourseName, course.GetCourseName())
-	updateField(&existingCourse.Semester, course.GetSemester())
-	updateField(&existingCourse.Description, course.GetDescription())
+	return "DO $$ BEGIN " +
+		"IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = '" + constraint + "') THEN " +
+		"DELETE FROM " + table + " AS t WHERE NOT EXISTS " +
+		"(SELECT 1 FROM courses AS c WHERE c.course_id = t.course_id); " +
+		"ALTER TABLE " + table + " ADD CONSTRAINT " + constraint + " FOREIGN KEY (course_id) " +
+		"REFERENCES courses (course_id) ON DELETE CASCADE; " +
+		"END IF; END $$"
+}
 
-	_, err = d.db.NewUpdate().Model(existingCourse).WherePK().Exec(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update course: %w", err)
-	}
+// primaryKeyMigration adds the (course_id, keyColumn) primary key to a table created without one,
+// such as a role table. It fails over rows sharing a key, which must be removed first.
+func primaryKeyMigration(table, keyColumn string) string {
+	constraint := table + "_pkey"
 
-	return existingCourse, nil
+	return "DO $$ BEGIN " +
+		"IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = '" + constraint + "') THEN " +
+		"ALTER TABLE " + table + " ADD CONSTRAINT " + constraint +
+		" PRIMARY KEY (course_id, " + keyColumn + "); " +
+		"END IF; END $$"
 }
 
-// DeleteCourse removes a course by course_id.
-func (d *Database) DeleteCourse(ctx context.Context, courseID string) error {
-	if courseID == "" {
-		return fmt.Errorf("%w", ErrCourseIDEmpty)
+// resolveDuplicateMembers checks, before the role tables get their primary keys, that no member is
+// listed in a course more than once. Which rows of a member to keep is left to operators: startup
+// fails over such rows unless DEDUPLICATE_MEMBERS is set, which removes them with DeduplicateEnrollments
+// and deduplicateStaff and logs each member affected.
+func (d *Database) resolveDuplicateMembers(ctx context.Context) error {
+	students, err := d.FindDuplicateEnrollments(ctx)
+	if err != nil {
+		return err
 	}
 
-	res, err := d.db.NewDelete().Model((*Course)(nil)).Where("course_id = ?", courseID).Exec(ctx)
+	staff, err := d.findDuplicateStaff(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete course: %w", err)
+		return err
 	}
 
-	if num, _ := res.RowsAffected(); num == 0 {
-		return fmt.Errorf("%w", ErrCourseNotFound)
+	if len(students) == 0 && len(staff) == 0 {
+		return nil
 	}
 
-	// Delete all students and staff associated with the course.
-	_, err = d.db.NewDelete().Model((*CourseStudent)(nil)).Where("course_id = ?", courseID).Exec(ctx)
+	deduplicate, err := toggleFromEnv(deduplicateMembersEnv, false)
 	if err != nil {
-		return fmt.Errorf("failed to delete course students: %w", err)
+		return err
 	}
 
-	_, err = d.db.NewDelete().Model((*CourseStaff)(nil)).Where("course_id = ?", courseID).Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to delete course staff: %w", err)
+	if !deduplicate {
+		return fmt.Errorf("%w: %d students and %d staff members; set %s=true to keep one row of each "+
+			"with DeduplicateEnrollments", ErrDuplicateMembers, len(students), len(staff), deduplicateMembersEnv)
 	}
 
-	return nil
-}
-
-// AddStudentToCourse adds a student to a course.
-func (d *Database) AddStudentToCourse(ctx context.Context, courseID, studentID string) error {
-	if courseID == "" {
-		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	for _, duplicate := range students {
+		klog.Warningf("Removing %d extra rows of student %s of course %s",
+			duplicate.Count-1, duplicate.StudentID, duplicate.CourseID)
 	}
 
-	if studentID == "" {
-		return fmt.Errorf("%w", ErrStudentIDEmpty)
+	for _, duplicate := range staff {
+		klog.Warningf("Removing %d extra rows of staff member %s of course %s",
+			duplicate.Count-1, duplicate.StaffID, duplicate.CourseID)
 	}
 
-	_, err := d.db.NewInsert().Model(&CourseStudent{
-		CourseID:  courseID,
-		StudentID: studentID,
-	}).Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to add student to course: %w", err)
+	if _, err := d.DeduplicateEnrollments(ctx); err != nil {
+		return err
 	}
 
-	return nil
+	return d.deduplicateStaff(ctx)
 }
 
-// RemoveStudentFromCourse removes a student from a course.
-func (d *Database) RemoveStudentFromCourse(ctx context.Context, courseID, studentID string) error {
-	if courseID == "" {
-		return fmt.Errorf("%w", ErrCourseIDEmpty)
-	}
+// announcementSearchVector is the full-text document of announcements that are not split.
+// It must match the expression of announcements_search_idx for the index to be used.
+const announcementSearchVector = "to_tsvector('simple', title || ' ' || content)"
 
-	if studentID == "" {
-		return fmt.Errorf("%w", ErrStudentIDEmpty)
-	}
+// announcementBodySearchVector is the full-text document of the full content of split announcements,
+// indexed by announcement_bodies_search_idx.
+const announcementBodySearchVector = "to_tsvector('simple', content)"
 
-	res, err := d.db.NewDelete().Model(
-		(*CourseStudent)(nil)).Where("course_id = ? AND student_id = ?", courseID, studentID).Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to remove student from course: %w", err)
-	}
+// announcementSearchDocument is the full-text document announcements are searched by: the title and
+// the full content, which split announcements keep in announcement_bodies.
+const announcementSearchDocument = "to_tsvector('simple', announcement.title || ' ' || " +
+	"coalesce(announcement_body.content, announcement.content))"
 
-	if num, _ := res.RowsAffected(); num == 0 {
-		return fmt.Errorf("%w", ErrCourseNotFound)
-	}
+// Page selects a slice of an ordered result set.
+type Page struct {
+	Limit  int
+	Offset int
+}
 
-	return nil
+// EnrollmentTransfer counts the students a transfer enrolled in the destination course and
+// the students it skipped because they already were in it.
+type EnrollmentTransfer struct {
+	Transferred int
+	Skipped     int
 }
 
-// AddStaffToCourse adds a staff member to a course.
-func (d *Database) AddStaffToCourse(ctx context.Context, courseID, staffID string) error {
-	if courseID == "" {
-		return fmt.Errorf("%w", ErrCourseIDEmpty)
-	}
+// planEnrollmentTransfer returns the students enrolled in the source course who are missing from the
+// destination course and counts those skipped because they are in it. It checks that the missing
+// students fit into the capacity of the destination alongside the students already enrolled in it.
+func planEnrollmentTransfer(source, destination []CourseStudent, capacity int32) ([]string, int, error) {
+	inDestination := make(map[string]bool, len(destination))
+	enrolled := 0
 
-	if staffID == "" {
-		return fmt.Errorf("%w", ErrStaffIDEmpty)
-	}
+	for _, student := range destination {
+		inDestination[student.StudentID] = true
 
-	_, err := d.db.NewInsert().Model(&CourseStaff{
-		CourseID: courseID,
-		StaffID:  staffID,
-	}).Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to add staff to course: %w", err)
+		if student.Status == studentEnrolled {
+			enrolled++
+		}
 	}
 
-	return nil
-}
-
-// RemoveStaffFromCourse removes a staff member from a course.
-func (d *Database) RemoveStaffFromCourse(ctx context.Context, courseID, staffID string) error {
-	if courseID == "" {
-		return fmt.Errorf("%w", ErrCourseIDEmpty)
-	}
+	studentIDs := make([]string, 0, len(source))
+	skipped := 0
 
-	if staffID == "" {
-		return fmt.Errorf("%w", ErrStaffIDEmpty)
+	for _, student := range source {
+		switch {
+		case student.Status != studentEnrolled:
+		case inDestination[student.StudentID]:
+			skipped++
+		default:
+			studentIDs = append(studentIDs, student.StudentID)
+		}
 	}
 
-	res, err := d.db.NewDelete().Model(
-		(*CourseStaff)(nil)).Where("course_id = ? AND staff_id = ?", courseID, staffID).Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to remove staff from course: %w", err)
+	if capacity > 0 && enrolled+len(studentIDs) > int(capacity) {
+		return nil, 0, fmt.Errorf("%w: %d of %d places taken, %d students to transfer",
+			ErrCapacityExceeded, enrolled, capacity, len(studentIDs))
 	}
 
-	if num, _ := res.RowsAffected(); num == 0 {
-		return fmt.Errorf("%w", ErrCourseNotFound)
-	}
+	return studentIDs, skipped, nil
+}
 
-	return nil
+// BatchEnrollment is the outcome of enrolling one student of a batch.
+type BatchEnrollment struct {
+	StudentID string
+	Outcome   cpb.BatchEnrollmentOutcome
 }
 
-// GetCourseStudents retrieves all students enrolled in a course.
-func (d *Database) GetCourseStudents(ctx context.Context, courseID string) ([]string, error) {
-	if courseID == "" {
-		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+// planBatchEnrollment decides the outcome of enrolling each of studentIDs in a course, given the
+// students already in it, unless staff may enroll its staff, and its free places. Students are
+// given the free places in the order of studentIDs. It returns the outcomes in the order of
+// studentIDs and the students to insert.
+func planBatchEnrollment(studentIDs, existing, staff []string, free int) ([]BatchEnrollment, []string) {
+	inCourse := make(map[string]bool, len(existing)+len(studentIDs))
+	for _, studentID := range existing {
+		inCourse[studentID] = true
 	}
 
-	var studentIDs []string
+	results := make([]BatchEnrollment, 0, len(studentIDs))
+	inserted := make([]string, 0, len(studentIDs))
 
-	// Query the database for student IDs enrolled in the course
-	err := d.db.NewSelect().
-		Model((*CourseStudent)(nil)). // Use a pointer to the model type
-		Column("student_id").
-		Where("course_id = ?", courseID).
-		Scan(ctx, &studentIDs) // Scan directly into the slice of strings
-	if err != nil {
-		return nil, fmt.Errorf("failed to get course students: %w", err)
+	for _, studentID := range studentIDs {
+		outcome := cpb.BatchEnrollmentOutcome_STUDENT_ADDED
+
+		switch {
+		case inCourse[studentID]:
+			outcome = cpb.BatchEnrollmentOutcome_ALREADY_IN_COURSE
+		case slices.Contains(staff, studentID):
+			outcome = cpb.BatchEnrollmentOutcome_STAFF_OF_COURSE
+		case len(inserted) >= free:
+			outcome = cpb.BatchEnrollmentOutcome_COURSE_FULL
+		default:
+			inCourse[studentID] = true
+
+			inserted = append(inserted, studentID)
+		}
+
+		results = append(results, BatchEnrollment{StudentID: studentID, Outcome: outcome})
 	}
 
-	return studentIDs, nil
+	return results, inserted
 }
 
-// GetCourseStaff retrieves all staff members associated with a course.
-func (d *Database) GetCourseStaff(ctx context.Context, courseID string) ([]string, error) {
-	if courseID == "" {
-		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
-	}
+// Enrollment is a course a student is in together with their status in it.
+type Enrollment struct {
+	Course
 
-	var staffIDs []string
+	Status string `bun:"status"`
+}
 
-	err := d.db.NewSelect().
-		Model((*CourseStaff)(nil)).
-		Column("staff_id").
-		Where("course_id = ?", courseID).
-		Scan(ctx, &staffIDs)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get course staff: %w", err)
-	}
+// semesterOrder orders courses aliased c by semester like compareSemesters.
+const semesterOrder = "right(c.semester, 4), CASE WHEN c.semester LIKE 'Winter%' THEN 0 " +
+	"WHEN c.semester LIKE 'Spring%' THEN 1 WHEN c.semester LIKE 'Summer%' THEN 2 ELSE -1 END"
 
-	return staffIDs, nil
+// compareSemesters orders semesters by year, then Winter, Spring and Summer within a year.
+func compareSemesters(semesterA, semesterB string) int {
+	return cmp.Or(
+		strings.Compare(semesterYear(semesterA), semesterYear(semesterB)),
+		cmp.Compare(semesterSeason(semesterA), semesterSeason(semesterB)),
+	)
 }
 
-// GetStudentCourses retrieves all courses a student is enrolled in.
-func (d *Database) GetStudentCourses(ctx context.Context, studentID string) ([]string, error) {
+// semesterYear returns the year a semester such as "Winter 2025" ends with.
+func semesterYear(semester string) string {
+	return semester[max(0, len(semester)-len("2025")):]
+}
+
+// semesterSeason returns the position of a semester within its year, or -1 if it names no season.
+func semesterSeason(semester string) int {
+	for season, name := range []string{"Winter", "Spring", "Summer"} {
+		if strings.HasPrefix(semester, name) {
+			return season
+		}
+	}
+
+	return -1
+}
+
+// EnrollmentDifference splits the students of two courses by where they are enrolled.
+type EnrollmentDifference struct {
+	OnlyInA []string
+	OnlyInB []string
+	InBoth  []string
+}
+
+// StaffPage is a page of the staff of a course, ordered by staff ID.
+type StaffPage struct {
+	StaffIDs []string
+	// Total is the number of staff members on all pages.
+	Total int
+}
+
+// Course represents the database schema for courses.
+type Course struct {
+	bun.BaseModel `bun:"table:courses,alias:course"`
+
+	CourseID             string    `bun:"course_id,unique,pk,notnull"`
+	CourseName           string    `bun:"course_name,notnull"`
+	Semester             string    `bun:"semester,notnull"`
+	Description          string    `bun:"description"`
+	Capacity             int32     `bun:"capacity,notnull,default:0"`
+	Credits              int32     `bun:"credits,notnull,default:0"`
+	AnnouncementsEnabled bool      `bun:"announcements_enabled,notnull,default:true"`
+	EnrollmentOpensAt    time.Time `bun:"enrollment_opens_at,nullzero"`
+	EnrollmentClosesAt   time.Time `bun:"enrollment_closes_at,nullzero"`
+	GradingFinalizedAt   time.Time `bun:"grading_finalized_at,nullzero"`
+	// Metadata holds custom fields departments attach to their courses, such as the room.
+	Metadata  map[string]string `bun:"metadata,type:jsonb,notnull,default:'{}'"`
+	CreatedAt time.Time         `bun:"created_at,default:current_timestamp"`
+	UpdatedAt time.Time         `bun:"updated_at,default:current_timestamp"`
+}
+
+const (
+	// maxMetadataFields is the most custom fields a course may have.
+	maxMetadataFields = 32
+	// maxMetadataKeyLength and maxMetadataValueLength bound the size of each custom field, in bytes.
+	maxMetadataKeyLength   = 64
+	maxMetadataValueLength = 1024
+)
+
+// mergeMetadata returns the custom fields of a course after applying updates to them.
+// An empty value removes its field.
+func mergeMetadata(metadata, updates map[string]string) (map[string]string, error) {
+	merged := make(map[string]string, len(metadata)+len(updates))
+	maps.Copy(merged, metadata)
+
+	for key, value := range updates {
+		if key == "" || len(key) > maxMetadataKeyLength {
+			return nil, fmt.Errorf("%w: key %q is not 1 to %d bytes", ErrInvalidMetadata, key, maxMetadataKeyLength)
+		}
+
+		if len(value) > maxMetadataValueLength {
+			return nil, fmt.Errorf("%w: value of %q is over %d bytes", ErrInvalidMetadata, key, maxMetadataValueLength)
+		}
+
+		if value == "" {
+			delete(merged, key)
+		} else {
+			merged[key] = value
+		}
+	}
+
+	if len(merged) > maxMetadataFields {
+		return nil, fmt.Errorf("%w: over %d fields", ErrInvalidMetadata, maxMetadataFields)
+	}
+
+	return merged, nil
+}
+
+// CourseFilter selects courses of the catalog. Zero fields leave the selection unconstrained.
+type CourseFilter struct {
+	Semester string
+	// NameContains selects courses whose name contains it, ignoring case.
+	NameContains string
+	// CreatedAfter selects courses created after it.
+	CreatedAfter time.Time
+}
+
+// matches reports whether the filter selects a course.
+func (f CourseFilter) matches(course *Course) bool {
+	if f.Semester != "" && course.Semester != f.Semester {
+		return false
+	}
+
+	if f.NameContains != "" && !strings.Contains(strings.ToLower(course.CourseName), strings.ToLower(f.NameContains)) {
+		return false
+	}
+
+	return f.CreatedAfter.IsZero() || course.CreatedAt.After(f.CreatedAfter)
+}
+
+// EnrollmentWindow describes when enrollment to a course is allowed.
+// A zero OpensAt or ClosesAt leaves that side of the window unbounded.
+type EnrollmentWindow struct {
+	Status   cpb.EnrollmentStatus
+	OpensAt  time.Time
+	ClosesAt time.Time
+	// ResolvedAt is the time Status was resolved at.
+	ResolvedAt time.Time
+}
+
+// validateTimeRange checks that a range with optional bounds does not start after it ends.
+func validateTimeRange(start, end time.Time, invalidErr error) error {
+	if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+		return fmt.Errorf("%w", invalidErr)
+	}
+
+	return nil
+}
+
+// validateInitialStaff checks the staff a course is created with, before anything is written.
+func validateInitialStaff(staff []CourseStaff) error {
+	seen := make(map[string]bool, len(staff))
+
+	for _, member := range staff {
+		if member.StaffID == "" {
+			return fmt.Errorf("%w", ErrStaffIDEmpty)
+		}
+
+		if seen[member.StaffID] {
+			return fmt.Errorf("%w: %s", ErrDuplicateStaff, member.StaffID)
+		}
+
+		seen[member.StaffID] = true
+
+		if err := validateTimeRange(member.ValidFrom, member.ValidUntil, ErrInvalidAccess); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// staffAccessExpired reports whether a staff assignment has lapsed at the given time.
+func staffAccessExpired(validUntil, now time.Time) bool {
+	return !validUntil.IsZero() && !now.Before(validUntil)
+}
+
+// staffAccessActive reports whether a staff assignment has started and not lapsed at the given time.
+func staffAccessActive(member CourseStaff, now time.Time) bool {
+	return !now.Before(member.ValidFrom) && !staffAccessExpired(member.ValidUntil, now)
+}
+
+// resolveEnrollmentWindow computes the enrollment status of a course at the given time.
+func resolveEnrollmentWindow(course *Course, now time.Time) *EnrollmentWindow {
+	status := cpb.EnrollmentStatus_OPEN
+
+	switch {
+	case !course.EnrollmentOpensAt.IsZero() && now.Before(course.EnrollmentOpensAt):
+		status = cpb.EnrollmentStatus_NOT_YET_OPEN
+	case !course.EnrollmentClosesAt.IsZero() && !now.Before(course.EnrollmentClosesAt):
+		status = cpb.EnrollmentStatus_CLOSED
+	}
+
+	return &EnrollmentWindow{
+		Status:     status,
+		OpensAt:    course.EnrollmentOpensAt,
+		ClosesAt:   course.EnrollmentClosesAt,
+		ResolvedAt: now,
+	}
+}
+
+// QuietPeriod is a period during which non-urgent announcements may not be posted to a course.
+// A weekly period recurs every week from its first occurrence, StartsAt to EndsAt.
+type QuietPeriod struct {
+	bun.BaseModel `bun:"table:quiet_periods,alias:quiet_period"`
+
+	CourseID string    `bun:"course_id,notnull"`
+	StartsAt time.Time `bun:"starts_at,notnull"`
+	EndsAt   time.Time `bun:"ends_at,notnull"`
+	Weekly   bool      `bun:"weekly,notnull,default:false"`
+}
+
+// endOfOccurrence returns the end of the occurrence of the period that when falls in, if any.
+func (p QuietPeriod) endOfOccurrence(when time.Time) (time.Time, bool) {
+	if when.Before(p.StartsAt) {
+		return time.Time{}, false
+	}
+
+	startsAt := p.StartsAt
+
+	if p.Weekly {
+		elapsed := when.Sub(p.StartsAt)
+		startsAt = startsAt.Add(elapsed - elapsed%recurrencePeriod)
+	}
+
+	endsAt := startsAt.Add(p.EndsAt.Sub(p.StartsAt))
+	if !when.Before(endsAt) {
+		return time.Time{}, false
+	}
+
+	return endsAt, true
+}
+
+// validateQuietPeriods checks that every quiet period is bounded and does not start after it ends,
+// and that weekly periods leave part of the week open.
+func validateQuietPeriods(periods []QuietPeriod) error {
+	for _, period := range periods {
+		if period.StartsAt.IsZero() || period.EndsAt.IsZero() || !period.StartsAt.Before(period.EndsAt) {
+			return fmt.Errorf("%w", ErrInvalidQuiet)
+		}
+
+		if period.Weekly && period.EndsAt.Sub(period.StartsAt) >= recurrencePeriod {
+			return fmt.Errorf("%w: weekly quiet hours must be shorter than a week", ErrInvalidQuiet)
+		}
+	}
+
+	return nil
+}
+
+// nextAllowedAt returns the first time at or after now outside every quiet period, skipping over
+// overlapping periods.
+func nextAllowedAt(periods []QuietPeriod, now time.Time) time.Time {
+	allowedAt := now
+
+	for extended := true; extended; {
+		extended = false
+
+		for _, period := range periods {
+			if endsAt, ok := period.endOfOccurrence(allowedAt); ok {
+				allowedAt, extended = endsAt, true
+			}
+		}
+	}
+
+	return allowedAt
+}
+
+// checkQuietPeriods rejects a non-urgent announcement posted during one of the quiet periods.
+// The error names the time announcements are allowed again, skipping over overlapping periods.
+func checkQuietPeriods(courseID string, periods []QuietPeriod, now time.Time, urgent bool) error {
+	allowedAt := nextAllowedAt(periods, now)
+	if allowedAt.Equal(now) {
+		return nil
+	}
+
+	if urgent {
+		klog.Infof("Urgent announcement posted to course %s during a quiet period ending at %s",
+			courseID, allowedAt.Format(time.RFC3339))
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: next allowed at %s", ErrQuietPeriod, allowedAt.Format(time.RFC3339))
+}
+
+// CourseAPIKey grants a machine integration access to the scoped RPCs of a single course.
+// Only a hash of the secret is stored.
+type CourseAPIKey struct {
+	bun.BaseModel `bun:"table:course_api_keys,alias:course_api_key"`
+
+	KeyID      string    `bun:"key_id,pk,notnull"`
+	CourseID   string    `bun:"course_id,notnull"`
+	SecretHash string    `bun:"secret_hash,notnull"`
+	Scopes     []string  `bun:"scopes,array"`
+	ExpiresAt  time.Time `bun:"expires_at,nullzero"`
+	CreatedAt  time.Time `bun:"created_at,default:current_timestamp"`
+}
+
+const (
+	// daysPerWeek is the number of days in a week.
+	daysPerWeek = 7
+	// recurrencePeriod is the time between two posts of a weekly announcement.
+	recurrencePeriod = daysPerWeek * 24 * time.Hour
+)
+
+// firstRecurrence returns when an announcement posted at now is first reposted,
+// or the zero time if it does not recur.
+func firstRecurrence(recurrence cpb.AnnouncementRecurrence, now time.Time) time.Time {
+	if recurrence != cpb.AnnouncementRecurrence_WEEKLY {
+		return time.Time{}
+	}
+
+	return now.Add(recurrencePeriod)
+}
+
+// nextRecurrence returns the first repost time after now on the schedule of nextPostAt.
+// Reposts missed while the worker was not running are skipped rather than posted in a burst.
+func nextRecurrence(nextPostAt, now time.Time) time.Time {
+	for !nextPostAt.After(now) {
+		nextPostAt = nextPostAt.Add(recurrencePeriod)
+	}
+
+	return nextPostAt
+}
+
+// repostOf returns the copy of a recurring announcement posted at now. The copy is dated by its
+// scheduled post time and does not recur itself.
+func repostOf(announcement Announcement, now time.Time) Announcement {
+	return Announcement{
+		AnnouncementID: announcement.AnnouncementID + "-" + announcement.NextPostAt.Format(time.DateOnly),
+		CourseID:       announcement.CourseID,
+		Title:          announcement.Title,
+		Content:        announcement.Content,
+		Author:         announcement.Author,
+		HasFullBody:    announcement.HasFullBody,
+		Visibility:     announcement.Visibility,
+		Recurrence:     cpb.AnnouncementRecurrence_NONE.String(),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// copyOf returns a copy of an announcement in the course toCourseID, posted at now under a new ID.
+// A recurring copy is first reposted on the schedule it would have if it had been posted at now.
+func copyOf(announcement Announcement, toCourseID string, now time.Time) (Announcement, error) {
+	recurrence, err := recurrenceFromDB(announcement.Recurrence)
+	if err != nil {
+		return Announcement{}, err
+	}
+
+	return Announcement{
+		AnnouncementID: uuid.NewString(),
+		CourseID:       toCourseID,
+		Title:          announcement.Title,
+		Content:        announcement.Content,
+		Author:         announcement.Author,
+		HasFullBody:    announcement.HasFullBody,
+		Visibility:     announcement.Visibility,
+		Recurrence:     announcement.Recurrence,
+		NextPostAt:     firstRecurrence(recurrence, now),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// AnnouncementPosition is a position in the order announcements of all courses are polled in: by
+// creation time, then course ID and announcement ID. A position without an announcement ID is
+// before every announcement created after CreatedAt and after all others.
+type AnnouncementPosition struct {
+	CreatedAt      time.Time
+	CourseID       string
+	AnnouncementID string
+}
+
+// before reports whether the position is before the announcement.
+func (p AnnouncementPosition) before(announcement Announcement) bool {
+	if p.AnnouncementID == "" {
+		return announcement.CreatedAt.After(p.CreatedAt)
+	}
+
+	return cmp.Or(
+		p.CreatedAt.Compare(announcement.CreatedAt),
+		strings.Compare(p.CourseID, announcement.CourseID),
+		strings.Compare(p.AnnouncementID, announcement.AnnouncementID),
+	) < 0
+}
+
+// AnnouncementFilter selects which announcements of a course are retrieved. It is the only place
+// deciding which announcements a caller may see, so every read of announcement content, whether
+// listed, counted or fetched alone, goes through matches or apply.
+type AnnouncementFilter struct {
+	// IncludeStaffOnly also selects staff-only announcements.
+	IncludeStaffOnly bool
+	// CreatedFrom and CreatedBefore bound the creation time; a zero bound is left open.
+	CreatedFrom   time.Time
+	CreatedBefore time.Time
+}
+
+// matches reports whether an announcement is selected by the filter.
+func (f AnnouncementFilter) matches(announcement Announcement) bool {
+	if !f.IncludeStaffOnly && announcement.Visibility != cpb.AnnouncementVisibility_EVERYONE.String() {
+		return false
+	}
+
+	if !f.CreatedFrom.IsZero() && announcement.CreatedAt.Before(f.CreatedFrom) {
+		return false
+	}
+
+	return f.CreatedBefore.IsZero() || announcement.CreatedAt.Before(f.CreatedBefore)
+}
+
+// apply restricts an announcement query to the announcements selected by the filter.
+func (f AnnouncementFilter) apply(query *bun.SelectQuery) *bun.SelectQuery {
+	if !f.IncludeStaffOnly {
+		query = query.Where("visibility = ?", cpb.AnnouncementVisibility_EVERYONE.String())
+	}
+
+	if !f.CreatedFrom.IsZero() {
+		query = query.Where("created_at >= ?", f.CreatedFrom)
+	}
+
+	if !f.CreatedBefore.IsZero() {
+		query = query.Where("created_at < ?", f.CreatedBefore)
+	}
+
+	return query
+}
+
+// AnnouncementStats summarizes the announcements of a course selected by a filter.
+type AnnouncementStats struct {
+	Count int
+	// LastUpdatedAt is the latest update of any of the announcements, or zero if there are none.
+	LastUpdatedAt time.Time
+}
+
+// AnnouncementGroup summarizes the announcements of a course posted in [StartsAt, EndsAt).
+type AnnouncementGroup struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+	Count    int
+	Newest   Announcement
+}
+
+// groupStart returns the start of the week or month containing posted in loc. Weeks start on Monday.
+func groupStart(posted time.Time, grouping cpb.AnnouncementGrouping, loc *time.Location) time.Time {
+	local := posted.In(loc)
+	if grouping == cpb.AnnouncementGrouping_MONTH {
+		return time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, loc)
+	}
+
+	daysSinceMonday := (int(local.Weekday()) + daysPerWeek - 1) % daysPerWeek
+
+	return time.Date(local.Year(), local.Month(), local.Day()-daysSinceMonday, 0, 0, 0, 0, loc)
+}
+
+// groupEnd returns the start of the group following the group starting at start.
+func groupEnd(start time.Time, grouping cpb.AnnouncementGrouping) time.Time {
+	if grouping == cpb.AnnouncementGrouping_MONTH {
+		return start.AddDate(0, 1, 0)
+	}
+
+	return start.AddDate(0, 0, daysPerWeek)
+}
+
+// groupingUnit returns the date_trunc unit matching a grouping.
+func groupingUnit(grouping cpb.AnnouncementGrouping) string {
+	if grouping == cpb.AnnouncementGrouping_MONTH {
+		return "month"
+	}
+
+	return "week"
+}
+
+// Announcement is a post to a course. The content of a long announcement is split: Content holds
+// only an excerpt, HasFullBody is set and the full content is kept in an AnnouncementBody, so
+// listing announcements stays cheap.
+type Announcement struct {
+	bun.BaseModel `bun:"table:announcements,alias:announcement"`
+
+	CourseID       string    `bun:"course_id,pk"`
+	AnnouncementID string    `bun:"announcement_id,pk"`
+	Title          string    `bun:"title,notnull"`
+	Content        string    `bun:"content,notnull"`
+	Author         string    `bun:"author,notnull,default:''"`
+	Slug           string    `bun:"slug,nullzero"`
+	HasFullBody    bool      `bun:"has_full_body,notnull,default:false"`
+	Visibility     string    `bun:"visibility,notnull,default:'EVERYONE'"`
+	Recurrence     string    `bun:"recurrence,notnull,default:'NONE'"`
+	NextPostAt     time.Time `bun:"next_post_at,nullzero"`
+	CreatedAt      time.Time `bun:"created_at,default:current_timestamp"`
+	UpdatedAt      time.Time `bun:"updated_at,default:current_timestamp"`
+	Course         *Course   `bun:"rel:belongs-to,join:course_id=course_id,on_delete:CASCADE"`
+}
+
+// AnnouncementBody is the full content of an announcement whose Content is an excerpt.
+type AnnouncementBody struct {
+	bun.BaseModel `bun:"table:announcement_bodies,alias:announcement_body"`
+
+	CourseID       string `bun:"course_id,pk"`
+	AnnouncementID string `bun:"announcement_id,pk"`
+	Content        string `bun:"content,notnull"`
+}
+
+// splitAnnouncementContent returns the content stored with an announcement and whether the full
+// content must be stored apart. Content longer than excerptLength bytes is cut to an excerpt at a
+// character boundary; an excerptLength of 0 never splits.
+func splitAnnouncementContent(content string, excerptLength int) (string, bool) {
+	if excerptLength == 0 || len(content) <= excerptLength {
+		return content, false
+	}
+
+	end := excerptLength
+	for end > 0 && !utf8.RuneStart(content[end]) {
+		end--
+	}
+
+	return content[:end], true
+}
+
+// CourseStudent enrolls a student in a course. Students who drop or withdraw keep their row
+// with an inactive status, so they can still be listed. Semester is copied from the course,
+// so per-semester lookups need no join.
+type CourseStudent struct {
+	bun.BaseModel `bun:"table:course_students,alias:course_student"`
+
+	CourseID  string  `bun:"course_id,pk,notnull"`
+	StudentID string  `bun:"student_id,pk,notnull"`
+	Status    string  `bun:"status,notnull,default:'enrolled'"`
+	Semester  string  `bun:"semester,notnull,default:''"`
+	Course    *Course `bun:"rel:belongs-to,join:course_id=course_id,on_delete:CASCADE"`
+}
+
+// Statuses of a student in a course. Only enrolled students are currently taking the course;
+// completed students finished it.
+const (
+	studentEnrolled  = "enrolled"
+	studentDropped   = "dropped"
+	studentWithdrawn = "withdrawn"
+	studentCompleted = "completed"
+)
+
+// studentStatuses returns every student status, the values course_students.status may hold.
+func studentStatuses() []string {
+	return []string{studentEnrolled, studentDropped, studentWithdrawn, studentCompleted}
+}
+
+// validateStudentStatus checks that status is a known student status.
+func validateStudentStatus(status string) error {
+	if !slices.Contains(studentStatuses(), status) {
+		return fmt.Errorf("%w: %q", ErrInvalidStatus, status)
+	}
+
+	return nil
+}
+
+// studentStatusTransitions returns the statuses a student may move to from each status.
+// Students who dropped a course may enroll in it again; withdrawals and completions are final.
+func studentStatusTransitions() map[string][]string {
+	return map[string][]string{
+		studentEnrolled:  {studentDropped, studentWithdrawn, studentCompleted},
+		studentDropped:   {studentEnrolled},
+		studentWithdrawn: {},
+		studentCompleted: {},
+	}
+}
+
+// checkStatusTransition fails unless a student may move from one status to another.
+// Keeping the current status is always allowed, so retries succeed.
+func checkStatusTransition(from, to string) error {
+	if from == to || slices.Contains(studentStatusTransitions()[from], to) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: from %s to %s", ErrInvalidStatusTransition, from, to)
+}
+
+// CourseCorequisite requires the course CorequisiteID to be taken in the same semester as CourseID.
+type CourseCorequisite struct {
+	bun.BaseModel `bun:"table:course_corequisites,alias:course_corequisite"`
+
+	CourseID      string `bun:"course_id,pk,notnull"`
+	CorequisiteID string `bun:"corequisite_id,pk,notnull"`
+}
+
+// GradingComponent is a part of the final grade of a course, such as the exam or the homework.
+// Weight is the percentage of the final grade it makes up. Kind optionally names the kind of
+// assignment or exam the grades service links it to.
+type GradingComponent struct {
+	bun.BaseModel `bun:"table:grading_components,alias:grading_component"`
+
+	CourseID string  `bun:"course_id,pk,notnull"`
+	Name     string  `bun:"name,pk,notnull"`
+	Weight   float64 `bun:"weight,notnull"`
+	Kind     string  `bun:"kind"`
+}
+
+// CourseFeature overrides whether a feature is enabled for a course, regardless of its rollout.
+type CourseFeature struct {
+	bun.BaseModel `bun:"table:course_features,alias:course_feature"`
+
+	CourseID string `bun:"course_id,pk,notnull"`
+	Feature  string `bun:"feature,pk,notnull"`
+	Enabled  bool   `bun:"enabled,notnull"`
+}
+
+// GradingScheme is the grading breakdown of a course. It is a draft until FinalizedAt is set,
+// after which it can no longer change.
+type GradingScheme struct {
+	Components  []GradingComponent
+	FinalizedAt time.Time
+}
+
+const (
+	// gradingTotalWeight is the sum of component weights a finalized grading scheme must reach.
+	gradingTotalWeight = 100
+	// gradingWeightTolerance absorbs the floating-point error of summing weights such as 33.33.
+	gradingWeightTolerance = 1e-6
+)
+
+// validateGradingComponent checks a grading component before it is stored.
+func validateGradingComponent(component GradingComponent) error {
+	if component.CourseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if component.Name == "" {
+		return fmt.Errorf("%w", ErrGradingComponentEmpty)
+	}
+
+	// Written to also reject NaN.
+	if !(component.Weight > 0 && component.Weight <= 100) {
+		return fmt.Errorf("%w: %g", ErrInvalidWeight, component.Weight)
+	}
+
+	return nil
+}
+
+// checkGradingWeights checks that the weights of a grading scheme add up to 100 percent.
+func checkGradingWeights(components []GradingComponent) error {
+	sum := 0.0
+	for _, component := range components {
+		sum += component.Weight
+	}
+
+	if math.Abs(sum-gradingTotalWeight) > gradingWeightTolerance {
+		return fmt.Errorf("%w: they sum to %g", ErrGradingWeights, sum)
+	}
+
+	return nil
+}
+
+// CourseStaff assigns a staff member to a course, optionally only for a limited period.
+// Semester is copied from the course, so teaching history can be told apart per semester.
+type CourseStaff struct {
+	bun.BaseModel `bun:"table:course_staffs,alias:course_staff"`
+
+	CourseID   string    `bun:"course_id,pk,notnull"`
+	StaffID    string    `bun:"staff_id,pk,notnull"`
+	ValidFrom  time.Time `bun:"valid_from,nullzero"`
+	ValidUntil time.Time `bun:"valid_until,nullzero"`
+	Semester   string    `bun:"semester,notnull,default:''"`
+	// LapseReportedAt is when the lapse of the assignment at ValidUntil was published, zero until then.
+	LapseReportedAt time.Time `bun:"lapse_reported_at,nullzero"`
+	Course          *Course   `bun:"rel:belongs-to,join:course_id=course_id,on_delete:CASCADE"`
+}
+
+// AddCourse inserts a new course into the database using the proto message, together with its
+// initial staff. Either the course and all of its staff are added or nothing is.
+func (d *Database) AddCourse(ctx context.Context, course *cpb.Course, staff ...CourseStaff) (*Course, error) {
+	if course == nil {
+		return nil, fmt.Errorf("%w", ErrCourseNil)
+	}
+
+	if course.GetCourseID() == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	metadata, err := mergeMetadata(nil, course.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	newCourse := &Course{
+		CourseID:    course.GetCourseID(),
+		CourseName:  course.GetCourseName(),
+		Semester:    course.GetSemester(),
+		Description: course.GetDescription(),
+		Capacity:    course.GetCapacity(),
+		Credits:     course.GetCredits(),
+		// Announcements are disabled only through SetAnnouncementsEnabled.
+		AnnouncementsEnabled: true,
+		Metadata:             metadata,
+	}
+
+	if err := validateInitialStaff(staff); err != nil {
+		return nil, err
+	}
+
+	err = d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		if _, err := transaction.NewInsert().Model(newCourse).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to insert course: %w", err)
+		}
+
+		if len(staff) == 0 {
+			return nil
+		}
+
+		rows := make([]CourseStaff, 0, len(staff))
+
+		for _, member := range staff {
+			member.CourseID, member.Semester = newCourse.CourseID, newCourse.Semester
+			rows = append(rows, member)
+		}
+
+		// A staff member listed twice is added once, with the access first listed.
+		if _, err := transaction.NewInsert().Model(&rows).On("CONFLICT DO NOTHING").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to insert course staff: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add course: %w", err)
+	}
+
+	return newCourse, nil
+}
+
+// GetCourse retrieves a course by its course_id and returns the proto message.
+func (d *Database) GetCourse(ctx context.Context, courseID string) (*Course, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	course := new(Course)
+
+	err := d.db.NewSelect().Model(course).Where("course_id = ?", courseID).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course: %w", err)
+	}
+
+	return course, nil
+}
+
+// UpdateCourse updates an existing course in the database using the proto message.
+// A new semester is copied to the staff and students of the course in the same transaction.
+func (d *Database) UpdateCourse(ctx context.Context, course *cpb.Course) (*Course, error) {
+	if course == nil {
+		return nil, fmt.Errorf("%w", ErrCourseNil)
+	}
+
+	if course.GetCourseID() == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	existingCourse := &Course{}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		err := transaction.NewSelect().
+			Model(existingCourse).
+			Where("course_id = ?", course.GetCourseID()).
+			For("UPDATE").
+			Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w", ErrCourseNotFound)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get course: %w", err)
+		}
+
+		previousSemester := existingCourse.Semester
+
+		if err := applyCourseUpdate(existingCourse, course); err != nil {
+			return err
+		}
+
+		existingCourse.UpdatedAt = time.Now()
+
+		if _, err := transaction.NewUpdate().Model(existingCourse).WherePK().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update course: %w", err)
+		}
+
+		if existingCourse.Semester == previousSemester {
+			return nil
+		}
+
+		return copyCourseSemester(ctx, transaction, existingCourse)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update course: %w", err)
+	}
+
+	return existingCourse, nil
+}
+
+// copyCourseSemester copies the semester of a course onto its staff and student rows.
+func copyCourseSemester(ctx context.Context, database bun.IDB, course *Course) error {
+	for _, model := range []any{(*CourseStaff)(nil), (*CourseStudent)(nil)} {
+		_, err := database.NewUpdate().
+			Model(model).
+			Set("semester = ?", course.Semester).
+			Where("course_id = ?", course.CourseID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update course semester: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// applyCourseUpdate copies the fields set in the proto message onto the course,
+// merging its custom fields into those of the course.
+func applyCourseUpdate(existingCourse *Course, course *cpb.Course) error {
+	metadata, err := mergeMetadata(existingCourse.Metadata, course.GetMetadata())
+	if err != nil {
+		return err
+	}
+
+	existingCourse.Metadata = metadata
+
+	updateField := func(field *string, newValue string) {
+		if newValue != "" {
+			*field = newValue
+		}
+	}
+
+	updateField(&existingCourse.CourseName, course.GetCourseName())
+	updateField(&existingCourse.Semester, course.GetSemester())
+	updateField(&existingCourse.Description, course.GetDescription())
+
+	if course.GetCapacity() != 0 {
+		existingCourse.Capacity = course.GetCapacity()
+	}
+
+	if course.GetCredits() != 0 {
+		existingCourse.Credits = course.GetCredits()
+	}
+
+	return nil
+}
+
+// SetEnrollmentWindow sets the enrollment window of a course.
+func (d *Database) SetEnrollmentWindow(ctx context.Context, courseID string, opensAt, closesAt time.Time) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if err := validateTimeRange(opensAt, closesAt, ErrInvalidWindow); err != nil {
+		return err
+	}
+
+	course := &Course{
+		CourseID:           courseID,
+		EnrollmentOpensAt:  opensAt,
+		EnrollmentClosesAt: closesAt,
+		UpdatedAt:          time.Now(),
+	}
+
+	res, err := d.db.NewUpdate().
+		Model(course).
+		Column("enrollment_opens_at", "enrollment_closes_at", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set enrollment window: %w", err)
+	}
+
+	if num, _ := res.RowsAffected(); num == 0 {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	return nil
+}
+
+// EnrollmentStatus resolves the enrollment status of a course from its window and the current time.
+func (d *Database) EnrollmentStatus(ctx context.Context, courseID string) (*EnrollmentWindow, error) {
+	course, err := d.GetCourse(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveEnrollmentWindow(course, time.Now()), nil
+}
+
+// SetAnnouncementsEnabled sets whether new announcements may be posted to a course.
+func (d *Database) SetAnnouncementsEnabled(ctx context.Context, courseID string, enabled bool) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	course := &Course{CourseID: courseID, AnnouncementsEnabled: enabled, UpdatedAt: time.Now()}
+
+	res, err := d.db.NewUpdate().
+		Model(course).
+		Column("announcements_enabled", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set announcements enabled: %w", err)
+	}
+
+	if num, _ := res.RowsAffected(); num == 0 {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	return nil
+}
+
+// SetQuietPeriods replaces the quiet periods of a course.
+func (d *Database) SetQuietPeriods(ctx context.Context, courseID string, periods []QuietPeriod) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if err := validateQuietPeriods(periods); err != nil {
+		return err
+	}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		exists, err := transaction.NewSelect().Model((*Course)(nil)).Where("course_id = ?", courseID).Exists(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check course: %w", err)
+		}
+
+		if !exists {
+			return fmt.Errorf("%w", ErrCourseNotFound)
+		}
+
+		_, err = transaction.NewDelete().Model((*QuietPeriod)(nil)).Where("course_id = ?", courseID).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to clear quiet periods: %w", err)
+		}
+
+		if len(periods) == 0 {
+			return nil
+		}
+
+		rows := make([]QuietPeriod, 0, len(periods))
+		for _, period := range periods {
+			rows = append(rows, QuietPeriod{
+				CourseID: courseID, StartsAt: period.StartsAt, EndsAt: period.EndsAt, Weekly: period.Weekly,
+			})
+		}
+
+		if _, err := transaction.NewInsert().Model(&rows).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to insert quiet periods: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set quiet periods: %w", err)
+	}
+
+	return nil
+}
+
+// AddCorequisite requires corequisiteID to be taken in the same semester as courseID.
+// Adding an existing co-requisite again has no effect.
+func (d *Database) AddCorequisite(ctx context.Context, courseID, corequisiteID string) error {
+	if courseID == "" || corequisiteID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if courseID == corequisiteID {
+		return fmt.Errorf("%w", ErrSelfCorequisite)
+	}
+
+	courseIDs := []string{courseID, corequisiteID}
+
+	count, err := d.db.NewSelect().Model((*Course)(nil)).Where("course_id IN (?)", bun.In(courseIDs)).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check courses: %w", err)
+	}
+
+	if count != len(courseIDs) {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	_, err = d.db.NewInsert().
+		Model(&CourseCorequisite{CourseID: courseID, CorequisiteID: corequisiteID}).
+		On("CONFLICT DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to add co-requisite: %w", err)
+	}
+
+	return nil
+}
+
+// GetCorequisites retrieves the co-requisites of a course.
+func (d *Database) GetCorequisites(ctx context.Context, courseID string) ([]string, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	corequisiteIDs := []string{}
+
+	err := d.db.NewSelect().
+		Model((*CourseCorequisite)(nil)).
+		Column("corequisite_id").
+		Where("course_id = ?", courseID).
+		Order("corequisite_id").
+		Scan(ctx, &corequisiteIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get co-requisites: %w", err)
+	}
+
+	return corequisiteIDs, nil
+}
+
+// DeleteCourse removes a course by course_id, together with everything that belongs to it.
+func (d *Database) DeleteCourse(ctx context.Context, courseID string) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		res, err := transaction.NewDelete().Model((*Course)(nil)).Where("course_id = ?", courseID).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete course: %w", err)
+		}
+
+		if num, _ := res.RowsAffected(); num == 0 {
+			return fmt.Errorf("%w", ErrCourseNotFound)
+		}
+
+		return deleteCourseData(ctx, transaction, courseID)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete course: %w", err)
+	}
+
+	return nil
+}
+
+// deleteCourseData deletes the rows that belong to a course but do not reference it with a
+// foreign key; the database deletes the rest with the course.
+func deleteCourseData(ctx context.Context, database bun.IDB, courseID string) error {
+	tables := []struct {
+		model any
+		name  string
+	}{
+		{(*QuietPeriod)(nil), "quiet periods"},
+		{(*CourseAPIKey)(nil), "API keys"},
+		{(*GradingComponent)(nil), "grading components"},
+	}
+
+	for _, table := range tables {
+		_, err := database.NewDelete().Model(table.model).Where("course_id = ?", courseID).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete course %s: %w", table.name, err)
+		}
+	}
+
+	_, err := database.NewDelete().
+		Model((*CourseCorequisite)(nil)).
+		Where("course_id = ? OR corequisite_id = ?", courseID, courseID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete course co-requisites: %w", err)
+	}
+
+	return nil
+}
+
+// ChangeCourseID moves a course to a new ID together with everything that belongs to it, such as
+// its enrollments, staff and announcements. newID must not be taken by another course.
+func (d *Database) ChangeCourseID(ctx context.Context, oldID, newID string) error {
+	if oldID == "" || newID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		// The course is copied to its new ID first, so the rows moved to it always reference a course.
+		if err := copyCourse(ctx, transaction, oldID, newID); err != nil {
+			return err
+		}
+
+		if err := moveCourseData(ctx, transaction, oldID, newID); err != nil {
+			return err
+		}
+
+		_, err := transaction.NewDelete().Model((*Course)(nil)).Where("course_id = ?", oldID).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete course: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to change course ID: %w", err)
+	}
+
+	return nil
+}
+
+// copyCourse inserts a copy of the course oldID under the ID newID, locking the original.
+func copyCourse(ctx context.Context, database bun.IDB, oldID, newID string) error {
+	course := new(Course)
+
+	err := database.NewSelect().Model(course).Where("course_id = ?", oldID).For("UPDATE").Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get course: %w", err)
+	}
+
+	course.CourseID = newID
+	course.UpdatedAt = time.Now()
+
+	if _, err := database.NewInsert().Model(course).Exec(ctx); err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == uniqueViolationSQLState {
+			return fmt.Errorf("%w: %s", ErrCourseAlreadyExists, newID)
+		}
+
+		return fmt.Errorf("failed to insert course: %w", err)
+	}
+
+	return nil
+}
+
+// moveCourseData points every row belonging to the course oldID at the course newID,
+// including the co-requisites naming it.
+func moveCourseData(ctx context.Context, database bun.IDB, oldID, newID string) error {
+	for _, model := range schemaModels() {
+		if _, ok := model.(*Course); ok {
+			continue
+		}
+
+		_, err := database.NewUpdate().Model(model).Set("course_id = ?", newID).Where("course_id = ?", oldID).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to move course rows: %w", err)
+		}
+	}
+
+	_, err := database.NewUpdate().
+		Model((*CourseCorequisite)(nil)).
+		Set("corequisite_id = ?", newID).
+		Where("corequisite_id = ?", oldID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to move course co-requisites: %w", err)
+	}
+
+	return nil
+}
+
+// TransferEnrollments enrolls the students enrolled in fromCourseID in toCourseID, skipping those
+// already in it. Nobody is transferred if the students do not all fit into toCourseID.
+func (d *Database) TransferEnrollments(ctx context.Context, fromCourseID, toCourseID string,
+) (*EnrollmentTransfer, error) {
+	if fromCourseID == "" || toCourseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	var transfer *EnrollmentTransfer
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		var err error
+
+		transfer, err = transferEnrollments(ctx, transaction, fromCourseID, toCourseID)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to transfer enrollments: %w", err)
+	}
+
+	return transfer, nil
+}
+
+// transferEnrollments performs TransferEnrollments within transaction.
+func transferEnrollments(ctx context.Context, transaction bun.Tx, fromCourseID, toCourseID string,
+) (*EnrollmentTransfer, error) {
+	var courses []Course
+
+	// Lock both courses so concurrent transfers cannot overfill the destination together.
+	err := transaction.NewSelect().
+		Model(&courses).
+		Where("course_id IN (?)", bun.In([]string{fromCourseID, toCourseID})).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get courses: %w", err)
+	}
+
+	found := make(map[string]Course, len(courses))
+	for _, course := range courses {
+		found[course.CourseID] = course
+	}
+
+	destination, destinationFound := found[toCourseID]
+	if _, sourceFound := found[fromCourseID]; !sourceFound || !destinationFound {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	source, err := courseEnrollments(ctx, transaction, fromCourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := courseEnrollments(ctx, transaction, toCourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	studentIDs, skipped, err := planEnrollmentTransfer(source, existing, destination.Capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := insertEnrollments(ctx, transaction, destination, studentIDs); err != nil {
+		return nil, err
+	}
+
+	return &EnrollmentTransfer{Transferred: len(studentIDs), Skipped: skipped}, nil
+}
+
+// insertEnrollments enrolls the given students in a course.
+func insertEnrollments(ctx context.Context, database bun.IDB, course Course, studentIDs []string) error {
+	if len(studentIDs) == 0 {
+		return nil
+	}
+
+	rows := make([]CourseStudent, 0, len(studentIDs))
+	for _, studentID := range studentIDs {
+		rows = append(rows, CourseStudent{
+			CourseID:  course.CourseID,
+			StudentID: studentID,
+			Status:    studentEnrolled,
+			Semester:  course.Semester,
+		})
+	}
+
+	if _, err := database.NewInsert().Model(&rows).On("CONFLICT DO NOTHING").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to enroll students: %w", err)
+	}
+
+	return nil
+}
+
+// courseEnrollments retrieves the students of a course with their status.
+func courseEnrollments(ctx context.Context, database bun.IDB, courseID string) ([]CourseStudent, error) {
+	var students []CourseStudent
+
+	if err := database.NewSelect().Model(&students).Where("course_id = ?", courseID).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get course enrollments: %w", err)
+	}
+
+	return students, nil
+}
+
+// AddStudentToCourse adds a student to a course, or enrolls them again if they already are in it.
+// Unless allowStaff is set, staff of the course cannot also be enrolled in it.
+func (d *Database) AddStudentToCourse(ctx context.Context, courseID, studentID string, allowStaff, allowFull bool,
+) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if studentID == "" {
+		return fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		semester, free, err := lockCoursePlaces(ctx, transaction, courseID, allowFull)
+		if err != nil {
+			return err
+		}
+
+		if !allowStaff {
+			err := checkRoleConflict(ctx, transaction, (*CourseStaff)(nil), "staff_id", courseID, studentID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := checkFreePlace(ctx, transaction, courseID, studentID, free); err != nil {
+			return err
+		}
+
+		_, err = transaction.NewInsert().Model(&CourseStudent{
+			CourseID:  courseID,
+			StudentID: studentID,
+			Status:    studentEnrolled,
+			Semester:  semester,
+		}).On("CONFLICT (course_id, student_id) DO UPDATE").Set("status = EXCLUDED.status").Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to insert enrollment: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add student to course: %w", err)
+	}
+
+	return nil
+}
+
+// AddStudentsToCourse enrolls many students in a course in a single insert. Students already in
+// the course, unless allowStaff is set its staff, and unless allowFull is set those left without a
+// place are skipped rather than failing the batch.
+func (d *Database) AddStudentsToCourse(ctx context.Context, courseID string, studentIDs []string,
+	allowStaff, allowFull bool,
+) ([]BatchEnrollment, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if slices.Contains(studentIDs, "") {
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	var results []BatchEnrollment
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		semester, free, err := lockCoursePlaces(ctx, transaction, courseID, allowFull)
+		if err != nil || len(studentIDs) == 0 {
+			return err
+		}
+
+		existing, err := courseMembers(ctx, transaction, (*CourseStudent)(nil), "student_id", courseID, studentIDs)
+		if err != nil {
+			return err
+		}
+
+		var staff []string
+		if !allowStaff {
+			staff, err = courseMembers(ctx, transaction, (*CourseStaff)(nil), "staff_id", courseID, studentIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		var inserted []string
+
+		results, inserted = planBatchEnrollment(studentIDs, existing, staff, free)
+
+		return insertEnrollments(ctx, transaction, Course{CourseID: courseID, Semester: semester}, inserted)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add students to course: %w", err)
+	}
+
+	return results, nil
+}
+
+// courseMembers returns which of the given users are in the given column of the course's role table.
+func courseMembers(ctx context.Context, db bun.IDB, model any, column, courseID string,
+	userIDs []string,
+) ([]string, error) {
+	var members []string
+
+	err := db.NewSelect().Model(model).
+		Column(column).
+		Where("course_id = ? AND ? IN (?)", courseID, bun.Ident(column), bun.In(userIDs)).
+		Scan(ctx, &members)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course members: %w", err)
+	}
+
+	return members, nil
+}
+
+// lockCourseSemester returns the semester of a course, locking the course so that its semester
+// cannot change before the transaction copies it onto a new staff or student row.
+func lockCourseSemester(ctx context.Context, db bun.IDB, courseID string) (string, error) {
+	var semester string
+
+	err := db.NewSelect().
+		Model((*Course)(nil)).
+		Column("semester").
+		Where("course_id = ?", courseID).
+		For("SHARE").
+		Scan(ctx, &semester)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get course semester: %w", err)
+	}
+
+	return semester, nil
+}
+
+// lockCoursePlaces returns the semester of a course and how many of its places are free, or
+// math.MaxInt if it has no capacity or allowFull is set. Counting places locks the course for
+// update, so that concurrent enrollments cannot take the same place.
+func lockCoursePlaces(ctx context.Context, database bun.IDB, courseID string, allowFull bool,
+) (string, int, error) {
+	if allowFull {
+		semester, err := lockCourseSemester(ctx, database, courseID)
+
+		return semester, math.MaxInt, err
+	}
+
+	var course Course
+
+	err := database.NewSelect().
+		Model(&course).
+		Column("semester", "capacity").
+		Where("course_id = ?", courseID).
+		For("UPDATE").
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", 0, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get course capacity: %w", err)
+	}
+
+	if course.Capacity == 0 {
+		return course.Semester, math.MaxInt, nil
+	}
+
+	enrolled, err := database.NewSelect().
+		Model((*CourseStudent)(nil)).
+		Where("course_id = ? AND status = ?", courseID, studentEnrolled).
+		Count(ctx)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to count course students: %w", err)
+	}
+
+	return course.Semester, max(int(course.Capacity)-enrolled, 0), nil
+}
+
+// checkFreePlace fails if free, the free places of a course, leaves none for the student. Enrolling an
+// enrolled student again takes no place.
+func checkFreePlace(ctx context.Context, database bun.IDB, courseID, studentID string, free int) error {
+	if free > 0 {
+		return nil
+	}
+
+	enrolled, err := database.NewSelect().
+		Model((*CourseStudent)(nil)).
+		Where("course_id = ? AND student_id = ? AND status = ?", courseID, studentID, studentEnrolled).
+		Exists(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check enrollment: %w", err)
+	}
+
+	if !enrolled {
+		return fmt.Errorf("%w: %s", ErrCapacityExceeded, courseID)
+	}
+
+	return nil
+}
+
+// checkRoleConflict fails if the user is already in the given column of the course's role table.
+func checkRoleConflict(ctx context.Context, db bun.IDB, model any, column, courseID, userID string) error {
+	exists, err := db.NewSelect().Model(model).
+		Where("course_id = ? AND ? = ?", courseID, bun.Ident(column), userID).
+		Exists(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check course roles: %w", err)
+	}
+
+	if exists {
+		return fmt.Errorf("%w: %s", ErrConflictingRole, userID)
+	}
+
+	return nil
+}
+
+// RemoveStudentFromCourse removes a student from a course.
+func (d *Database) RemoveStudentFromCourse(ctx context.Context, courseID, studentID string) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if studentID == "" {
+		return fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	res, err := d.db.NewDelete().Model(
+		(*CourseStudent)(nil)).Where("course_id = ? AND student_id = ?", courseID, studentID).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove student from course: %w", err)
+	}
+
+	if num, _ := res.RowsAffected(); num == 0 {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	return nil
+}
+
+// SetStudentStatus sets the status of a student in a course, if their current status allows it.
+func (d *Database) SetStudentStatus(ctx context.Context, courseID, studentID, status string) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if studentID == "" {
+		return fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	if err := validateStudentStatus(status); err != nil {
+		return err
+	}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		var current string
+
+		err := transaction.NewSelect().
+			Model((*CourseStudent)(nil)).
+			Column("status").
+			Where("course_id = ? AND student_id = ?", courseID, studentID).
+			Limit(1).
+			For("UPDATE").
+			Scan(ctx, &current)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w", ErrCourseNotFound)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get student status: %w", err)
+		}
+
+		if err := checkStatusTransition(current, status); err != nil {
+			return err
+		}
+
+		_, err = transaction.NewUpdate().
+			Model((*CourseStudent)(nil)).
+			Set("status = ?", status).
+			Where("course_id = ? AND student_id = ?", courseID, studentID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update student status: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set student status: %w", err)
+	}
+
+	return nil
+}
+
+// AddStaffToCourse adds a staff member to a course, with access limited to the given period.
+// Zero validFrom or validUntil leave the access unbounded on that side. Adding a staff member
+// already in the course replaces their access period.
+// Unless allowStudent is set, students of the course cannot also be its staff.
+func (d *Database) AddStaffToCourse(ctx context.Context, courseID, staffID string,
+	validFrom, validUntil time.Time, allowStudent bool,
+) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if staffID == "" {
+		return fmt.Errorf("%w", ErrStaffIDEmpty)
+	}
+
+	if err := validateTimeRange(validFrom, validUntil, ErrInvalidAccess); err != nil {
+		return err
+	}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		semester, err := lockCourseSemester(ctx, transaction, courseID)
+		if err != nil {
+			return err
+		}
+
+		if !allowStudent {
+			err := checkRoleConflict(ctx, transaction, (*CourseStudent)(nil), "student_id", courseID, staffID)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = transaction.NewInsert().Model(&CourseStaff{
+			CourseID:   courseID,
+			StaffID:    staffID,
+			ValidFrom:  validFrom,
+			ValidUntil: validUntil,
+			Semester:   semester,
+		}).
+			On("CONFLICT (course_id, staff_id) DO UPDATE").
+			Set("valid_from = EXCLUDED.valid_from").
+			Set("valid_until = EXCLUDED.valid_until").
+			// A new end of the assignment lapses anew.
+			Set("lapse_reported_at = CASE WHEN ?TableAlias.valid_until IS NOT DISTINCT FROM EXCLUDED.valid_until " +
+				"THEN ?TableAlias.lapse_reported_at END").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to insert staff: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add staff to course: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveStaffFromCourse removes a staff member from a course.
+func (d *Database) RemoveStaffFromCourse(ctx context.Context, courseID, staffID string) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if staffID == "" {
+		return fmt.Errorf("%w", ErrStaffIDEmpty)
+	}
+
+	res, err := d.db.NewDelete().Model(
+		(*CourseStaff)(nil)).Where("course_id = ? AND staff_id = ?", courseID, staffID).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove staff from course: %w", err)
+	}
+
+	if num, _ := res.RowsAffected(); num == 0 {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	return nil
+}
+
+// GetCourseStudents retrieves the students of a course.
+// Students who are no longer enrolled are only included when includeInactive is set.
+func (d *Database) GetCourseStudents(ctx context.Context, courseID string, includeInactive bool) ([]string, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	var studentIDs []string
+
+	// Query the database for student IDs enrolled in the course
+	query := d.db.NewSelect().
+		Model((*CourseStudent)(nil)). // Use a pointer to the model type
+		Column("student_id").
+		Where("course_id = ?", courseID)
+
+	if !includeInactive {
+		query = query.Where("status = ?", studentEnrolled)
+	}
+
+	err := query.Scan(ctx, &studentIDs) // Scan directly into the slice of strings
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course students: %w", err)
+	}
+
+	return studentIDs, nil
+}
+
+// CountCourseStudents counts the students currently enrolled in a course.
+func (d *Database) CountCourseStudents(ctx context.Context, courseID string) (int, error) {
+	if courseID == "" {
+		return 0, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	exists, err := d.courseExists(ctx, courseID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !exists {
+		return 0, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	count, err := d.db.NewSelect().
+		Model((*CourseStudent)(nil)).
+		Where("course_id = ? AND status = ?", courseID, studentEnrolled).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count course students: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetCourseStaff retrieves the staff members associated with a course.
+// Staff whose access has expired are only included when includeExpired is set.
+func (d *Database) GetCourseStaff(ctx context.Context, courseID string, includeExpired bool) ([]string, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	var staffIDs []string
+
+	query := d.db.NewSelect().
+		Model((*CourseStaff)(nil)).
+		Column("staff_id").
+		Where("course_id = ?", courseID)
+
+	if !includeExpired {
+		query = query.Where("valid_until IS NULL OR valid_until > current_timestamp")
+	}
+
+	err := query.Scan(ctx, &staffIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course staff: %w", err)
+	}
+
+	return staffIDs, nil
+}
+
+// GetCourseStaffPage retrieves a page of the staff members of a course, ordered by staff ID.
+func (d *Database) GetCourseStaffPage(ctx context.Context, courseID string, includeExpired bool,
+	page Page,
+) (*StaffPage, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	exists, err := d.courseExists(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	var staff []CourseStaff
+
+	query := d.db.NewSelect().
+		Model(&staff).
+		Column("staff_id").
+		Where("course_id = ?", courseID)
+
+	if !includeExpired {
+		query = query.Where("valid_until IS NULL OR valid_until > current_timestamp")
+	}
+
+	total, err := query.Order("staff_id").Limit(page.Limit).Offset(page.Offset).ScanAndCount(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course staff: %w", err)
+	}
+
+	result := &StaffPage{StaffIDs: make([]string, 0, len(staff)), Total: total}
+	for _, member := range staff {
+		result.StaffIDs = append(result.StaffIDs, member.StaffID)
+	}
+
+	return result, nil
+}
+
+// GetStudentCourses retrieves all courses a student is enrolled in, only those of the given
+// semester if it is set.
+func (d *Database) GetStudentCourses(ctx context.Context, studentID, semester string) ([]string, error) {
+	if studentID == "" {
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	var courseIDs []string
+
+	query := d.db.NewSelect().
+		Model((*CourseStudent)(nil)).
+		Column("course_id").
+		Where("student_id = ?", studentID)
+
+	if semester != "" {
+		query = query.Where("semester = ?", semester)
+	}
+
+	err := query.Scan(ctx, &courseIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student courses: %w", err)
+	}
+
+	return courseIDs, nil
+}
+
+// IsKnownStudent reports whether a student is or was in any course, whatever their status.
+func (d *Database) IsKnownStudent(ctx context.Context, studentID string) (bool, error) {
+	if studentID == "" {
+		return false, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	known, err := d.db.NewSelect().Model((*CourseStudent)(nil)).Where("student_id = ?", studentID).Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check student: %w", err)
+	}
+
+	return known, nil
+}
+
+// GetEnrollmentsForStudent retrieves every enrollment of a student, whatever its status,
+// ordered by semester.
+func (d *Database) GetEnrollmentsForStudent(ctx context.Context, studentID string) ([]Enrollment, error) {
 	if studentID == "" {
 		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
 	}
 
-	var courseIDs []string
+	enrollments := []Enrollment{}
+
+	err := d.db.NewSelect().
+		TableExpr("course_students AS cs").
+		Join("JOIN courses AS c ON c.course_id = cs.course_id").
+		ColumnExpr("c.*").
+		ColumnExpr("cs.status").
+		Where("cs.student_id = ?", studentID).
+		OrderExpr(semesterOrder).
+		OrderExpr("c.course_id").
+		Scan(ctx, &enrollments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student enrollments: %w", err)
+	}
+
+	return enrollments, nil
+}
+
+// CountSemesterEnrollments counts the other courses a student is enrolled in during the
+// semester of the given course. Courses the student dropped or withdrew from do not count.
+func (d *Database) CountSemesterEnrollments(ctx context.Context, courseID, studentID string) (int, error) {
+	if courseID == "" {
+		return 0, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if studentID == "" {
+		return 0, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	count, err := d.db.NewSelect().
+		Model((*CourseStudent)(nil)).
+		Where("student_id = ?", studentID).
+		Where("status = ?", studentEnrolled).
+		Where("course_id <> ?", courseID).
+		Where("semester = (SELECT semester FROM courses WHERE course_id = ?)", courseID).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count semester enrollments: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetStudentCredits sums the credits of the courses a student is enrolled in during a semester.
+// Courses the student dropped or withdrew from do not count.
+func (d *Database) GetStudentCredits(ctx context.Context, studentID, semester string) (int64, error) {
+	if studentID == "" {
+		return 0, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	if semester == "" {
+		return 0, fmt.Errorf("%w", ErrSemesterEmpty)
+	}
+
+	var credits int64
+
+	err := d.db.NewSelect().
+		TableExpr("course_students AS cs").
+		Join("JOIN courses AS c ON c.course_id = cs.course_id").
+		ColumnExpr("COALESCE(SUM(c.credits), 0)").
+		Where("cs.student_id = ?", studentID).
+		Where("cs.status = ?", studentEnrolled).
+		Where("cs.semester = ?", semester).
+		Scan(ctx, &credits)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum student credits: %w", err)
+	}
+
+	return credits, nil
+}
+
+// MissingCorequisites retrieves the co-requisites of a course the student is not enrolled in.
+// Co-requisites the student dropped or withdrew from are missing.
+func (d *Database) MissingCorequisites(ctx context.Context, courseID, studentID string) ([]string, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if studentID == "" {
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	missingIDs := []string{}
+
+	err := d.db.NewSelect().
+		Model((*CourseCorequisite)(nil)).
+		Column("corequisite_id").
+		Where("course_id = ?", courseID).
+		Where("corequisite_id NOT IN (SELECT course_id FROM course_students WHERE student_id = ? AND status = ?)",
+			studentID, studentEnrolled).
+		Order("corequisite_id").
+		Scan(ctx, &missingIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get missing co-requisites: %w", err)
+	}
+
+	return missingIDs, nil
+}
+
+// GetSharedCourses retrieves the courses a staff member currently staffs and a student is
+// enrolled in, ordered by course ID.
+func (d *Database) GetSharedCourses(ctx context.Context, staffID, studentID string) ([]string, error) {
+	if staffID == "" {
+		return nil, fmt.Errorf("%w", ErrStaffIDEmpty)
+	}
+
+	if studentID == "" {
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	courseIDs := []string{}
+
+	err := d.db.NewSelect().
+		TableExpr("course_staffs AS staff").
+		Join("JOIN course_students AS cs ON cs.course_id = staff.course_id").
+		ColumnExpr("staff.course_id").
+		Where("staff.staff_id = ?", staffID).
+		Where("staff.valid_until IS NULL OR staff.valid_until > current_timestamp").
+		Where("cs.student_id = ?", studentID).
+		Where("cs.status = ?", studentEnrolled).
+		Order("staff.course_id").
+		Scan(ctx, &courseIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shared courses: %w", err)
+	}
+
+	return courseIDs, nil
+}
+
+// GetStaffCourses retrieves all courses a staff member is associated with, only those of the
+// given semester if it is set.
+func (d *Database) GetStaffCourses(ctx context.Context, staffID, semester string) ([]string, error) {
+	if staffID == "" {
+		return nil, fmt.Errorf("%w", ErrStaffIDEmpty)
+	}
+
+	var courseIDs []string
+
+	query := d.db.NewSelect().
+		Model((*CourseStaff)(nil)).
+		Column("course_id").
+		Where("staff_id = ?", staffID)
+
+	if semester != "" {
+		query = query.Where("semester = ?", semester)
+	}
+
+	err := query.Scan(ctx, &courseIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staff courses: %w", err)
+	}
+
+	return courseIDs, nil
+}
+
+// IsKnownStaff reports whether a staff member is or was assigned to any course, expired assignments included.
+func (d *Database) IsKnownStaff(ctx context.Context, staffID string) (bool, error) {
+	if staffID == "" {
+		return false, fmt.Errorf("%w", ErrStaffIDEmpty)
+	}
+
+	known, err := d.db.NewSelect().Model((*CourseStaff)(nil)).Where("staff_id = ?", staffID).Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check staff member: %w", err)
+	}
+
+	return known, nil
+}
+
+// ListCoursesWithoutStaff retrieves the courses of a semester that have no staff assigned,
+// ordered by ID.
+func (d *Database) ListCoursesWithoutStaff(ctx context.Context, semester string) ([]*Course, error) {
+	if semester == "" {
+		return nil, fmt.Errorf("%w", ErrSemesterEmpty)
+	}
+
+	courses := []*Course{}
+
+	err := d.db.NewSelect().
+		Model(&courses).
+		// Assignments that have not started or have lapsed do not staff the course.
+		Join("LEFT JOIN course_staffs AS cs").
+		JoinOn("cs.course_id = course.course_id").
+		JoinOn("cs.valid_from IS NULL OR cs.valid_from <= current_timestamp").
+		JoinOn("cs.valid_until IS NULL OR cs.valid_until > current_timestamp").
+		Where("course.semester = ?", semester).
+		Where("cs.staff_id IS NULL").
+		Order("course.course_id").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list courses without staff: %w", err)
+	}
+
+	return courses, nil
+}
+
+// GetLapsedStaff retrieves the staff assignments that lapsed by now and whose lapse was not
+// reported yet, in the order they lapsed.
+func (d *Database) GetLapsedStaff(ctx context.Context, now time.Time) ([]CourseStaff, error) {
+	staff := []CourseStaff{}
+
+	err := d.db.NewSelect().
+		Model(&staff).
+		Where("valid_until <= ?", now).
+		Where("lapse_reported_at IS NULL").
+		Order("valid_until", "course_id", "staff_id").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lapsed staff: %w", err)
+	}
+
+	return staff, nil
+}
+
+// MarkStaffLapseReported records that the lapse of a staff assignment was reported. An assignment
+// extended since it was read is left alone, so that its new end is reported when it comes.
+func (d *Database) MarkStaffLapseReported(ctx context.Context, staff CourseStaff, reportedAt time.Time) error {
+	_, err := d.db.NewUpdate().
+		Model((*CourseStaff)(nil)).
+		Set("lapse_reported_at = ?", reportedAt).
+		Where("course_id = ? AND staff_id = ?", staff.CourseID, staff.StaffID).
+		Where("valid_until = ?", staff.ValidUntil).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark staff lapse reported: %w", err)
+	}
+
+	return nil
+}
+
+// GetCoursesBySemester retrieves the courses of a specific semester ordered by ID,
+// at most limit of them unless limit is 0.
+func (d *Database) GetCoursesBySemester(ctx context.Context, semester string, limit int) ([]*Course, error) {
+	if semester == "" {
+		return nil, fmt.Errorf("%w", ErrSemesterEmpty)
+	}
+
+	var courses []*Course
+
+	err := d.db.NewSelect().
+		Model(&courses).
+		Where("semester = ?", semester).
+		Order("course_id").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get courses by semester: %w", err)
+	}
+
+	return courses, nil
+}
+
+// ListCourses lists the courses selected by the filter, ordered by course ID.
+func (d *Database) ListCourses(ctx context.Context, filter CourseFilter, page Page) ([]*Course, error) {
+	courses := []*Course{}
+
+	err := d.db.NewSelect().
+		Model(&courses).
+		WhereGroup(" AND ", func(query *bun.SelectQuery) *bun.SelectQuery {
+			if filter.Semester != "" {
+				query = query.Where("semester = ?", filter.Semester)
+			}
+
+			if filter.NameContains != "" {
+				query = query.Where("strpos(lower(course_name), lower(?)) > 0", filter.NameContains)
+			}
+
+			if !filter.CreatedAfter.IsZero() {
+				query = query.Where("created_at > ?", filter.CreatedAfter)
+			}
+
+			return query
+		}).
+		Order("course_id").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list courses: %w", err)
+	}
+
+	return courses, nil
+}
+
+// GetCoursesByMetadata retrieves the courses whose custom field key is set to value, ordered by course ID.
+func (d *Database) GetCoursesByMetadata(ctx context.Context, key, value string, page Page) ([]*Course, error) {
+	if key == "" {
+		return nil, fmt.Errorf("%w: key is empty", ErrInvalidMetadata)
+	}
+
+	containment, err := json.Marshal(map[string]string{key: value})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode metadata filter: %w", err)
+	}
+
+	courses := []*Course{}
+
+	// Containment is answered by courses_metadata_idx.
+	err = d.db.NewSelect().
+		Model(&courses).
+		Where("metadata @> ?::jsonb", string(containment)).
+		Order("course_id").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get courses by metadata: %w", err)
+	}
+
+	return courses, nil
+}
+
+// courseExists reports whether a course with the given ID exists.
+func (d *Database) courseExists(ctx context.Context, courseID string) (bool, error) {
+	exists, err := d.db.NewSelect().Model((*Course)(nil)).Where("course_id = ?", courseID).Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check course existence: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetEnrollmentDifference compares the students of two courses using SQL set operations.
+func (d *Database) GetEnrollmentDifference(ctx context.Context, courseIDA, courseIDB string,
+	page Page,
+) (*EnrollmentDifference, error) {
+	if courseIDA == "" || courseIDB == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	for _, courseID := range []string{courseIDA, courseIDB} {
+		exists, err := d.courseExists(ctx, courseID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, fmt.Errorf("%w", ErrCourseNotFound)
+		}
+	}
+
+	studentsOf := func(courseID string) *bun.SelectQuery {
+		return d.db.NewSelect().Model((*CourseStudent)(nil)).Column("student_id").Where("course_id = ?", courseID)
+	}
+
+	difference := &EnrollmentDifference{}
+	sets := []struct {
+		query  *bun.SelectQuery
+		target *[]string
+	}{
+		{studentsOf(courseIDA).Except(studentsOf(courseIDB)), &difference.OnlyInA},
+		{studentsOf(courseIDB).Except(studentsOf(courseIDA)), &difference.OnlyInB},
+		{studentsOf(courseIDA).Intersect(studentsOf(courseIDB)), &difference.InBoth},
+	}
+
+	for _, set := range sets {
+		err := d.db.NewSelect().
+			TableExpr("(?) AS student_set", set.query).
+			Column("student_id").
+			Order("student_id").
+			Limit(page.Limit).
+			Offset(page.Offset).
+			Scan(ctx, set.target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare course students: %w", err)
+		}
+	}
+
+	return difference, nil
+}
+
+// DuplicateEnrollment is a student enrolled in a course by more than one row.
+type DuplicateEnrollment struct {
+	CourseID  string `bun:"course_id"`
+	StudentID string `bun:"student_id"`
+	Count     int    `bun:"count"`
+}
+
+// FindDuplicateEnrollments lists the students enrolled in a course by more than one row,
+// left over from before enrollments had a primary key. migrateSchema adds the key only once
+// there are none left, see resolveDuplicateMembers.
+func (d *Database) FindDuplicateEnrollments(ctx context.Context) ([]DuplicateEnrollment, error) {
+	duplicates := []DuplicateEnrollment{}
+
+	err := d.db.NewSelect().
+		Model((*CourseStudent)(nil)).
+		ColumnExpr("course_id, student_id, count(*) AS count").
+		Group("course_id", "student_id").
+		Having("count(*) > 1").
+		Order("course_id", "student_id").
+		Scan(ctx, &duplicates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate enrollments: %w", err)
+	}
+
+	return duplicates, nil
+}
+
+// DeduplicateEnrollments removes all but one row of every student enrolled in a course more than
+// once and returns how many rows it removed. An enrolled row is kept over inactive ones.
+func (d *Database) DeduplicateEnrollments(ctx context.Context) (int, error) {
+	removed := 0
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		// Block new enrollments so none is duplicated while the extras are removed.
+		_, err := transaction.ExecContext(ctx, "LOCK TABLE course_students IN SHARE ROW EXCLUSIVE MODE")
+		if err != nil {
+			return fmt.Errorf("failed to lock enrollments: %w", err)
+		}
+
+		ranked := transaction.NewSelect().
+			Model((*CourseStudent)(nil)).
+			ColumnExpr("ctid").
+			ColumnExpr("row_number() OVER (PARTITION BY course_id, student_id "+
+				"ORDER BY status = ? DESC, ctid) AS rank", studentEnrolled)
+
+		res, err := transaction.NewDelete().
+			Model((*CourseStudent)(nil)).
+			Where("ctid IN (SELECT ctid FROM (?) AS ranked WHERE rank > 1)", ranked).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete duplicate enrollments: %w", err)
+		}
+
+		num, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to count deleted enrollments: %w", err)
+		}
+
+		removed = int(num)
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to deduplicate enrollments: %w", err)
+	}
+
+	return removed, nil
+}
+
+// DuplicateStaff is a staff member listed in a course by more than one row.
+type DuplicateStaff struct {
+	CourseID string `bun:"course_id"`
+	StaffID  string `bun:"staff_id"`
+	Count    int    `bun:"count"`
+}
+
+// findDuplicateStaff lists the staff members listed in a course by more than one row,
+// left over from before staff had a primary key.
+func (d *Database) findDuplicateStaff(ctx context.Context) ([]DuplicateStaff, error) {
+	duplicates := []DuplicateStaff{}
+
+	err := d.db.NewSelect().
+		Model((*CourseStaff)(nil)).
+		ColumnExpr("course_id, staff_id, count(*) AS count").
+		Group("course_id", "staff_id").
+		Having("count(*) > 1").
+		Order("course_id", "staff_id").
+		Scan(ctx, &duplicates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate staff: %w", err)
+	}
+
+	return duplicates, nil
+}
+
+// deduplicateStaff removes all but one row of every staff member listed in a course more than once,
+// keeping the row granting the longest access.
+func (d *Database) deduplicateStaff(ctx context.Context) error {
+	ranked := d.db.NewSelect().
+		Model((*CourseStaff)(nil)).
+		ColumnExpr("ctid").
+		ColumnExpr("row_number() OVER (PARTITION BY course_id, staff_id ORDER BY valid_until DESC NULLS FIRST, ctid) AS rank")
+
+	_, err := d.db.NewDelete().
+		Model((*CourseStaff)(nil)).
+		Where("ctid IN (SELECT ctid FROM (?) AS ranked WHERE rank > 1)", ranked).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete duplicate staff: %w", err)
+	}
+
+	return nil
+}
+
+// DataShape summarizes how much data there is, for capacity planning.
+type DataShape struct {
+	GeneratedAt        time.Time
+	CoursesPerSemester map[string]int
+	// EnrollmentsPerCourse and AnnouncementsPerCourse spread over every course, including
+	// courses with none.
+	EnrollmentsPerCourse   Distribution
+	AnnouncementsPerCourse Distribution
+	// TableRows counts the rows of each table of dataShapeTables.
+	TableRows map[string]int
+}
+
+// Distribution summarizes a count over courses by its median, 95th percentile and maximum.
+type Distribution struct {
+	P50 int `bun:"p50"`
+	P95 int `bun:"p95"`
+	Max int `bun:"max"`
+}
+
+// dataShapeTables are the tables whose rows the data shape report counts.
+func dataShapeTables() []string {
+	return []string{"announcements", "course_staffs", "course_students", "courses"}
+}
+
+// dataShapeTimeout limits each query of the data shape report, so the report never holds up
+// the database for long.
+const dataShapeTimeout = 30 * time.Second
+
+// percentileDisc returns the smallest of the sorted counts that at least fraction of them do not
+// exceed, as percentile_disc does in PostgreSQL. It returns 0 for no counts.
+func percentileDisc(sorted []int, fraction float64) int {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(fraction * float64(len(sorted))))
+
+	return sorted[max(rank, 1)-1]
+}
+
+// distributionOf summarizes counts by their percentiles and maximum.
+func distributionOf(counts []int) Distribution {
+	sorted := slices.Sorted(slices.Values(counts))
+
+	var distribution Distribution
+	if len(sorted) > 0 {
+		distribution = Distribution{
+			P50: percentileDisc(sorted, dataShapeMedian),
+			P95: percentileDisc(sorted, dataShapeHighPercentile),
+			Max: sorted[len(sorted)-1],
+		}
+	}
+
+	return distribution
+}
+
+// Percentiles of the distributions of the data shape report.
+const (
+	dataShapeMedian         = 0.5
+	dataShapeHighPercentile = 0.95
+)
+
+// GetDataShape reports how much data there is, computing the distributions in the database.
+// It runs in a read-only transaction whose queries each stop after dataShapeTimeout.
+func (d *Database) GetDataShape(ctx context.Context) (*DataShape, error) {
+	shape := &DataShape{GeneratedAt: time.Now(), TableRows: make(map[string]int)}
+
+	err := d.db.RunInTx(ctx, &sql.TxOptions{ReadOnly: true}, func(ctx context.Context, transaction bun.Tx) error {
+		_, err := transaction.ExecContext(ctx, "SET LOCAL statement_timeout = ?", dataShapeTimeout.Milliseconds())
+		if err != nil {
+			return fmt.Errorf("failed to limit statement time: %w", err)
+		}
+
+		if shape.CoursesPerSemester, err = coursesPerSemester(ctx, transaction); err != nil {
+			return err
+		}
+
+		if shape.EnrollmentsPerCourse, err = perCourseDistribution(ctx, transaction, "course_students"); err != nil {
+			return err
+		}
+
+		if shape.AnnouncementsPerCourse, err = perCourseDistribution(ctx, transaction, "announcements"); err != nil {
+			return err
+		}
+
+		for _, table := range dataShapeTables() {
+			rows, err := transaction.NewSelect().TableExpr("?", bun.Ident(table)).Count(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to count rows of %s: %w", table, err)
+			}
+
+			shape.TableRows[table] = rows
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data shape: %w", err)
+	}
+
+	return shape, nil
+}
+
+// coursesPerSemester counts the courses of each semester.
+func coursesPerSemester(ctx context.Context, database bun.IDB) (map[string]int, error) {
+	var counts []struct {
+		Semester string `bun:"semester"`
+		Count    int    `bun:"count"`
+	}
+
+	err := database.NewSelect().
+		Model((*Course)(nil)).
+		ColumnExpr("semester, count(*) AS count").
+		Group("semester").
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count courses per semester: %w", err)
+	}
+
+	perSemester := make(map[string]int, len(counts))
+	for _, count := range counts {
+		perSemester[count.Semester] = count.Count
+	}
+
+	return perSemester, nil
+}
+
+// perCourseDistribution summarizes how the rows of a table referencing courses spread over them.
+func perCourseDistribution(ctx context.Context, database bun.IDB, table string) (Distribution, error) {
+	perCourse := database.NewSelect().
+		TableExpr("courses AS c").
+		ColumnExpr("count(t.course_id) AS n").
+		Join("LEFT JOIN ? AS t ON t.course_id = c.course_id", bun.Ident(table)).
+		Group("c.course_id")
+
+	var distribution Distribution
+
+	err := database.NewSelect().
+		TableExpr("(?) AS per_course", perCourse).
+		ColumnExpr("coalesce(percentile_disc(?) WITHIN GROUP (ORDER BY n), 0) AS p50", dataShapeMedian).
+		ColumnExpr("coalesce(percentile_disc(?) WITHIN GROUP (ORDER BY n), 0) AS p95", dataShapeHighPercentile).
+		ColumnExpr("coalesce(max(n), 0) AS max").
+		Scan(ctx, &distribution)
+	if err != nil {
+		return Distribution{}, fmt.Errorf("failed to summarize %s per course: %w", table, err)
+	}
+
+	return distribution, nil
+}
+
+// CourseSnapshot is a course with everything attached to it, read at a single point in time.
+type CourseSnapshot struct {
+	TakenAt  time.Time
+	Course   Course
+	Students []CourseStudent
+	Staff    []CourseStaff
+	// Announcements have their full content, even if it is stored apart.
+	Announcements []Announcement
+	QuietPeriods  []QuietPeriod
+}
+
+// GetCourseSnapshot reads a course with its students, staff, announcements and quiet periods in a
+// single repeatable read transaction, so that all of them are read from the same snapshot of the
+// database and agree with each other even while writers change the course.
+func (d *Database) GetCourseSnapshot(ctx context.Context, courseID string) (*CourseSnapshot, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	snapshot := &CourseSnapshot{}
+	options := &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+
+	err := d.db.RunInTx(ctx, options, func(ctx context.Context, transaction bun.Tx) error {
+		// The snapshot of a repeatable read transaction is taken by its first query.
+		snapshot.TakenAt = time.Now()
+
+		err := transaction.NewSelect().Model(&snapshot.Course).Where("course_id = ?", courseID).Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w", ErrCourseNotFound)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get course: %w", err)
+		}
+
+		return readCourseRelations(ctx, transaction, snapshot)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// readCourseRelations reads the students, staff, announcements and quiet periods of the course of
+// a snapshot into it.
+func readCourseRelations(ctx context.Context, database bun.IDB, snapshot *CourseSnapshot) error {
+	courseID := snapshot.Course.CourseID
+
+	err := database.NewSelect().Model(&snapshot.Students).Where("course_id = ?", courseID).
+		Order("student_id").Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get course students: %w", err)
+	}
+
+	err = database.NewSelect().Model(&snapshot.Staff).Where("course_id = ?", courseID).
+		Order("staff_id").Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get course staff: %w", err)
+	}
+
+	err = database.NewSelect().Model(&snapshot.QuietPeriods).Where("course_id = ?", courseID).
+		Order("starts_at").Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get quiet periods: %w", err)
+	}
+
+	err = database.NewSelect().Model(&snapshot.Announcements).Where("course_id = ?", courseID).
+		OrderExpr("created_at DESC, announcement_id").Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get announcements: %w", err)
+	}
+
+	var bodies []AnnouncementBody
+	if err := database.NewSelect().Model(&bodies).Where("course_id = ?", courseID).Scan(ctx); err != nil {
+		return fmt.Errorf("failed to get announcement bodies: %w", err)
+	}
+
+	contents := make(map[string]string, len(bodies))
+	for _, body := range bodies {
+		contents[body.AnnouncementID] = body.Content
+	}
+
+	for i, announcement := range snapshot.Announcements {
+		if content, ok := contents[announcement.AnnouncementID]; ok && announcement.HasFullBody {
+			snapshot.Announcements[i].Content = content
+		}
+	}
+
+	return nil
+}
+
+// AddAnnouncement adds an announcement to a course and returns it. Content longer than excerptLength
+// bytes is stored apart, leaving an excerpt with the announcement. An announcement without an ID is
+// given a new UUID, while an ID already used in the course is rejected.
+func (d *Database) AddAnnouncement(ctx context.Context, req *cpb.AddAnnouncementRequest,
+	excerptLength int,
+) (*Announcement, error) {
+	if (req.GetCourseID() == "") || (req.GetAnnouncement().GetAnnouncementContent() == "") {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	now := time.Now()
+	announcement := newAnnouncement(req, now, excerptLength)
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		err := lockAnnouncementCourse(ctx, transaction, req.GetCourseID(), now, req.GetUrgent())
+		if err != nil {
+			return err
+		}
+
+		return insertAnnouncement(ctx, transaction, &announcement, req.GetAnnouncement().GetAnnouncementContent())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add announcement: %w", err)
+	}
+
+	return &announcement, nil
+}
+
+// newAnnouncement builds the announcement an add request posts at now, with its content cut to an
+// excerpt if it is longer than excerptLength.
+func newAnnouncement(req *cpb.AddAnnouncementRequest, now time.Time, excerptLength int) Announcement {
+	content, split := splitAnnouncementContent(req.GetAnnouncement().GetAnnouncementContent(), excerptLength)
+
+	return Announcement{
+		CourseID:       req.GetCourseID(),
+		AnnouncementID: announcementIDOrNew(req.GetAnnouncement().GetAnnouncementID()),
+		Title:          req.GetAnnouncement().GetAnnouncementTitle(),
+		Content:        content,
+		Author:         req.GetAnnouncement().GetAuthor(),
+		Slug:           req.GetAnnouncement().GetSlug(),
+		HasFullBody:    split,
+		Visibility:     req.GetAnnouncement().GetVisibility().String(),
+		Recurrence:     req.GetAnnouncement().GetRecurrence().String(),
+		NextPostAt:     firstRecurrence(req.GetAnnouncement().GetRecurrence(), now),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// announcementIDOrNew returns the ID requested for a new announcement, or a new UUID if none was.
+func announcementIDOrNew(announcementID string) string {
+	if announcementID != "" {
+		return announcementID
+	}
+
+	return uuid.NewString()
+}
+
+// lockAnnouncementCourse checks that a course accepts an announcement posted at now, locking
+// the course so that it cannot be deleted before the announcement is inserted.
+func lockAnnouncementCourse(ctx context.Context, database bun.IDB, courseID string, now time.Time, urgent bool) error {
+	var enabled bool
+
+	err := database.NewSelect().
+		Model((*Course)(nil)).
+		Column("announcements_enabled").
+		Where("course_id = ?", courseID).
+		For("SHARE").
+		Scan(ctx, &enabled)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get course: %w", err)
+	}
+
+	if !enabled {
+		return fmt.Errorf("%w: %s", ErrAnnouncementsDisabled, courseID)
+	}
+
+	periods, err := getQuietPeriods(ctx, database, courseID)
+	if err != nil {
+		return err
+	}
+
+	return checkQuietPeriods(courseID, periods, now, urgent)
+}
+
+// getQuietPeriods returns the quiet periods of a course.
+func getQuietPeriods(ctx context.Context, database bun.IDB, courseID string) ([]QuietPeriod, error) {
+	var periods []QuietPeriod
+
+	err := database.NewSelect().
+		Model((*QuietPeriod)(nil)).
+		Where("course_id = ?", courseID).
+		Scan(ctx, &periods)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quiet periods: %w", err)
+	}
+
+	return periods, nil
+}
+
+// insertAnnouncement inserts an announcement, and its full content if the announcement holds
+// only an excerpt.
+func insertAnnouncement(ctx context.Context, database bun.IDB, announcement *Announcement, content string) error {
+	if _, err := database.NewInsert().Model(announcement).Exec(ctx); err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == uniqueViolationSQLState {
+			if pgErr.Field('n') == announcementSlugIndex {
+				return fmt.Errorf("%w: slug %s", ErrAnnouncementExists, announcement.Slug)
+			}
+
+			return fmt.Errorf("%w: %s", ErrAnnouncementExists, announcement.AnnouncementID)
+		}
+
+		return fmt.Errorf("failed to insert announcement: %w", err)
+	}
+
+	if !announcement.HasFullBody {
+		return nil
+	}
+
+	_, err := database.NewInsert().Model(&AnnouncementBody{
+		CourseID:       announcement.CourseID,
+		AnnouncementID: announcement.AnnouncementID,
+		Content:        content,
+	}).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to insert announcement body: %w", err)
+	}
+
+	return nil
+}
+
+// UpsertAnnouncementBySlug updates the title and content of the announcement of a course with the
+// slug of the requested announcement, or adds the announcement if the course has none with that slug.
+// It reports whether the announcement was added.
+func (d *Database) UpsertAnnouncementBySlug(ctx context.Context, req *cpb.AddAnnouncementRequest,
+	excerptLength int,
+) (*Announcement, bool, error) {
+	if (req.GetCourseID() == "") || (req.GetAnnouncement().GetAnnouncementContent() == "") {
+		return nil, false, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if req.GetAnnouncement().GetSlug() == "" {
+		return nil, false, fmt.Errorf("%w", ErrSlugEmpty)
+	}
+
+	announcement := new(Announcement)
+	created := false
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		err := transaction.NewSelect().
+			Model(announcement).
+			Where("course_id = ? AND slug = ?", req.GetCourseID(), req.GetAnnouncement().GetSlug()).
+			For("UPDATE").
+			Scan(ctx)
+		if err == nil {
+			return updateAnnouncement(ctx, transaction, announcement, req.GetAnnouncement().GetAnnouncementTitle(),
+				req.GetAnnouncement().GetAnnouncementContent(), excerptLength)
+		}
+
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to get announcement: %w", err)
+		}
+
+		now := time.Now()
+		*announcement = newAnnouncement(req, now, excerptLength)
+		created = true
+
+		if err := lockAnnouncementCourse(ctx, transaction, req.GetCourseID(), now, req.GetUrgent()); err != nil {
+			return err
+		}
+
+		return insertAnnouncement(ctx, transaction, announcement, req.GetAnnouncement().GetAnnouncementContent())
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to upsert announcement: %w", err)
+	}
+
+	return announcement, created, nil
+}
+
+// GetAnnouncement retrieves an announcement of a course with its full content, if the filter selects it.
+func (d *Database) GetAnnouncement(ctx context.Context, courseID, announcementID string,
+	filter AnnouncementFilter,
+) (*Announcement, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if announcementID == "" {
+		return nil, fmt.Errorf("%w", ErrAnnouncementEmpty)
+	}
+
+	announcement := new(Announcement)
+
+	query := d.db.NewSelect().
+		Model(announcement).
+		Where("course_id = ? AND announcement_id = ?", courseID, announcementID).
+		Limit(1)
+
+	err := filter.apply(query).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w", ErrAnnouncementNotFound)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcement: %w", err)
+	}
+
+	if !announcement.HasFullBody {
+		return announcement, nil
+	}
+
+	err = d.db.NewSelect().
+		Model((*AnnouncementBody)(nil)).
+		Column("content").
+		Where("course_id = ? AND announcement_id = ?", courseID, announcementID).
+		Scan(ctx, &announcement.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcement body: %w", err)
+	}
+
+	return announcement, nil
+}
+
+// GetAnnouncements retrieves a page of the announcements of a course selected by the filter, newest first.
+// A page without a limit holds every announcement from its offset on.
+func (d *Database) GetAnnouncements(ctx context.Context, courseID string,
+	filter AnnouncementFilter, page Page,
+) ([]Announcement, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	var announcements []Announcement
+
+	query := d.db.NewSelect().
+		Model((*Announcement)(nil)).
+		Where("course_id = ?", courseID).
+		OrderExpr("created_at DESC, announcement_id").
+		Limit(page.Limit).
+		Offset(page.Offset)
+
+	err := filter.apply(query).Scan(ctx, &announcements)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcements: %w", err)
+	}
+
+	return announcements, nil
+}
+
+// SummarizeAnnouncements counts the announcements of a course selected by the filter and finds
+// their latest update in a single aggregate query.
+func (d *Database) SummarizeAnnouncements(ctx context.Context, courseID string,
+	filter AnnouncementFilter,
+) (*AnnouncementStats, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	var row struct {
+		Count         int       `bun:"count"`
+		LastUpdatedAt time.Time `bun:"last_updated_at,nullzero"`
+	}
+
+	query := d.db.NewSelect().
+		Model((*Announcement)(nil)).
+		ColumnExpr("count(*) AS count, max(updated_at) AS last_updated_at").
+		Where("course_id = ?", courseID)
+
+	if err := filter.apply(query).Scan(ctx, &row); err != nil {
+		return nil, fmt.Errorf("failed to summarize announcements: %w", err)
+	}
+
+	return &AnnouncementStats{Count: row.Count, LastUpdatedAt: row.LastUpdatedAt}, nil
+}
+
+// GetAnnouncementCountByAuthor counts the announcements each author posted to a course.
+// Announcements posted before authors were recorded have none and are not counted.
+func (d *Database) GetAnnouncementCountByAuthor(ctx context.Context, courseID string) (map[string]int, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	var rows []struct {
+		Author string `bun:"author"`
+		Count  int    `bun:"count"`
+	}
+
+	err := d.db.NewSelect().
+		Model((*Announcement)(nil)).
+		ColumnExpr("author, count(*) AS count").
+		Where("course_id = ? AND author <> ''", courseID).
+		Group("author").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count announcements by author: %w", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Author] = row.Count
+	}
+
+	return counts, nil
+}
+
+// announcementGroupRow is an announcement together with the group it was posted in.
+type announcementGroupRow struct {
+	Announcement
+
+	Bucket     time.Time `bun:"bucket"`
+	GroupCount int       `bun:"group_count"`
+	GroupRank  int       `bun:"group_rank"`
+}
+
+// GetAnnouncementGroups groups the announcements of a course selected by the filter by the week or
+// month they were posted in, in the time zone loc. Groups are ordered newest first.
+func (d *Database) GetAnnouncementGroups(ctx context.Context, courseID string, filter AnnouncementFilter,
+	grouping cpb.AnnouncementGrouping, loc *time.Location, page Page,
+) ([]AnnouncementGroup, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	unit, zone := groupingUnit(grouping), loc.String()
+	bucket := "date_trunc(?, created_at AT TIME ZONE ?)"
+
+	grouped := d.db.NewSelect().
+		Model((*Announcement)(nil)).
+		ColumnExpr("announcement.*").
+		ColumnExpr(bucket+" AS bucket", unit, zone).
+		ColumnExpr("count(*) OVER (PARTITION BY "+bucket+") AS group_count", unit, zone).
+		ColumnExpr("row_number() OVER (PARTITION BY "+bucket+
+			" ORDER BY created_at DESC, announcement_id) AS group_rank", unit, zone).
+		Where("course_id = ?", courseID)
+
+	var rows []announcementGroupRow
+
+	err := d.db.NewSelect().
+		TableExpr("(?) AS grouped", filter.apply(grouped)).
+		Where("group_rank = 1").
+		OrderExpr("bucket DESC").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to group announcements: %w", err)
+	}
+
+	groups := make([]AnnouncementGroup, 0, len(rows))
+
+	for _, row := range rows {
+		// The bucket is a local time without zone; pin it to loc.
+		start := time.Date(row.Bucket.Year(), row.Bucket.Month(), row.Bucket.Day(), 0, 0, 0, 0, loc)
+		groups = append(groups, AnnouncementGroup{
+			StartsAt: start,
+			EndsAt:   groupEnd(start, grouping),
+			Count:    row.GroupCount,
+			Newest:   row.Announcement,
+		})
+	}
+
+	return groups, nil
+}
+
+// RemoveAnnouncement removes an announcement from a course.
+func (d *Database) RemoveAnnouncement(ctx context.Context, courseID, announcementID string) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if announcementID == "" {
+		return fmt.Errorf("%w", ErrAnnouncementEmpty)
+	}
+
+	removed, err := d.deleteAnnouncements(ctx, courseID, []string{announcementID})
+	if err != nil {
+		return fmt.Errorf("failed to remove announcement: %w", err)
+	}
+
+	if len(removed) == 0 {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	return nil
+}
+
+// UpdateAnnouncement updates the title, content and visibility of an announcement of a course and
+// returns it. An empty title or content, or a nil visibility, leaves the field unchanged. Content
+// longer than excerptLength bytes is stored apart, leaving an excerpt with the announcement.
+func (d *Database) UpdateAnnouncement(ctx context.Context, courseID, announcementID, title, content string,
+	visibility *cpb.AnnouncementVisibility, excerptLength int,
+) (*Announcement, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if announcementID == "" {
+		return nil, fmt.Errorf("%w", ErrAnnouncementEmpty)
+	}
+
+	announcement := new(Announcement)
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		err := transaction.NewSelect().
+			Model(announcement).
+			Where("course_id = ? AND announcement_id = ?", courseID, announcementID).
+			For("UPDATE").
+			Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w", ErrCourseNotFound)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get announcement: %w", err)
+		}
+
+		if visibility != nil {
+			announcement.Visibility = visibility.String()
+		}
+
+		return updateAnnouncement(ctx, transaction, announcement, title, content, excerptLength)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update announcement: %w", err)
+	}
+
+	return announcement, nil
+}
+
+// updateAnnouncement applies a new title and content to an announcement and stores it together with
+// its visibility, replacing its full content if the content changed.
+func updateAnnouncement(ctx context.Context, database bun.IDB, announcement *Announcement,
+	title, content string, excerptLength int,
+) error {
+	if title != "" {
+		announcement.Title = title
+	}
+
+	if content != "" {
+		announcement.Content, announcement.HasFullBody = splitAnnouncementContent(content, excerptLength)
+
+		_, err := database.NewDelete().
+			Model((*AnnouncementBody)(nil)).
+			Where("course_id = ? AND announcement_id = ?", announcement.CourseID, announcement.AnnouncementID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete announcement body: %w", err)
+		}
+
+		if announcement.HasFullBody {
+			_, err := database.NewInsert().Model(&AnnouncementBody{
+				CourseID:       announcement.CourseID,
+				AnnouncementID: announcement.AnnouncementID,
+				Content:        content,
+			}).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to insert announcement body: %w", err)
+			}
+		}
+	}
+
+	announcement.UpdatedAt = time.Now()
+
+	_, err := database.NewUpdate().
+		Model(announcement).
+		Column("title", "content", "has_full_body", "visibility", "updated_at").
+		Where("course_id = ? AND announcement_id = ?", announcement.CourseID, announcement.AnnouncementID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update announcement: %w", err)
+	}
+
+	return nil
+}
+
+// deleteAnnouncements deletes the given announcements of a course together with their bodies
+// and returns the IDs of those deleted.
+func (d *Database) deleteAnnouncements(ctx context.Context, courseID string, announcementIDs []string,
+) ([]string, error) {
+	var removed []string
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		_, err := transaction.NewDelete().
+			Model((*Announcement)(nil)).
+			Where("course_id = ? AND announcement_id IN (?)", courseID, bun.In(announcementIDs)).
+			Returning("announcement_id").
+			Exec(ctx, &removed)
+		if err != nil {
+			return fmt.Errorf("failed to delete announcements: %w", err)
+		}
+
+		_, err = transaction.NewDelete().
+			Model((*AnnouncementBody)(nil)).
+			Where("course_id = ? AND announcement_id IN (?)", courseID, bun.In(announcementIDs)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete announcement bodies: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete announcements: %w", err)
+	}
+
+	return removed, nil
+}
+
+// RemoveAnnouncements removes the given announcements from a course and returns the IDs of those removed.
+func (d *Database) RemoveAnnouncements(ctx context.Context, courseID string, announcementIDs []string,
+) ([]string, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if len(announcementIDs) == 0 {
+		return nil, fmt.Errorf("%w", ErrAnnouncementEmpty)
+	}
+
+	removed, err := d.deleteAnnouncements(ctx, courseID, announcementIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove announcements: %w", err)
+	}
+
+	return removed, nil
+}
+
+// CopyAnnouncements copies all announcements of fromCourseID to toCourseID under new IDs, dated now,
+// and returns how many were copied. The copies are independent of the originals.
+func (d *Database) CopyAnnouncements(ctx context.Context, fromCourseID, toCourseID string) (int, error) {
+	if fromCourseID == "" || toCourseID == "" {
+		return 0, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	copied := 0
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		var err error
+
+		copied, err = copyAnnouncements(ctx, transaction, fromCourseID, toCourseID)
+
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to copy announcements: %w", err)
+	}
+
+	return copied, nil
+}
+
+// lockCopyCourses locks the courses announcements are copied between and checks that both exist
+// and that the destination accepts announcements.
+func lockCopyCourses(ctx context.Context, transaction bun.Tx, fromCourseID, toCourseID string) error {
+	var courses []Course
+
+	err := transaction.NewSelect().
+		Model(&courses).
+		Column("course_id", "announcements_enabled").
+		Where("course_id IN (?)", bun.In([]string{fromCourseID, toCourseID})).
+		For("SHARE").
+		Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get courses: %w", err)
+	}
+
+	found := make(map[string]Course, len(courses))
+	for _, course := range courses {
+		found[course.CourseID] = course
+	}
 
-	err := d.db.NewSelect().
-		Model((*CourseStudent)(nil)).
-		Column("course_id").
-		Where("student_id = ?", studentID).
-		Scan(ctx, &courseIDs)
+	destination, destinationFound := found[toCourseID]
+	if _, sourceFound := found[fromCourseID]; !sourceFound || !destinationFound {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	if !destination.AnnouncementsEnabled {
+		return fmt.Errorf("%w: %s", ErrAnnouncementsDisabled, toCourseID)
+	}
+
+	return nil
+}
+
+// copyAnnouncements performs CopyAnnouncements within transaction.
+func copyAnnouncements(ctx context.Context, transaction bun.Tx, fromCourseID, toCourseID string) (int, error) {
+	if err := lockCopyCourses(ctx, transaction, fromCourseID, toCourseID); err != nil {
+		return 0, err
+	}
+
+	var originals []Announcement
+
+	err := transaction.NewSelect().Model(&originals).Where("course_id = ?", fromCourseID).Scan(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get student courses: %w", err)
+		return 0, fmt.Errorf("failed to get announcements: %w", err)
 	}
 
-	return courseIDs, nil
+	if len(originals) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+	copies := make([]Announcement, 0, len(originals))
+
+	for _, original := range originals {
+		announcementCopy, err := copyOf(original, toCourseID, now)
+		if err != nil {
+			return 0, fmt.Errorf("failed to copy announcement: %w", err)
+		}
+
+		copies = append(copies, announcementCopy)
+
+		if !original.HasFullBody {
+			continue
+		}
+
+		_, err = transaction.NewRaw("INSERT INTO announcement_bodies (course_id, announcement_id, content) "+
+			"SELECT ?, ?, content FROM announcement_bodies WHERE course_id = ? AND announcement_id = ?",
+			toCourseID, announcementCopy.AnnouncementID, fromCourseID, original.AnnouncementID).
+			Exec(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to copy announcement body: %w", err)
+		}
+	}
+
+	if _, err := transaction.NewInsert().Model(&copies).Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to insert announcement copies: %w", err)
+	}
+
+	return len(copies), nil
 }
 
-// GetStaffCourses retrieves all courses a staff member is associated with.
-func (d *Database) GetStaffCourses(ctx context.Context, staffID string) ([]string, error) {
-	if staffID == "" {
-		return nil, fmt.Errorf("%w", ErrStaffIDEmpty)
+// SearchAllAnnouncements searches the title and content of announcements across all courses.
+// All words of the query must appear in a match; results are ordered newest first.
+func (d *Database) SearchAllAnnouncements(ctx context.Context, query string, page Page) ([]Announcement, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("%w", ErrSearchQueryEmpty)
 	}
 
-	var courseIDs []string
+	var announcements []Announcement
 
 	err := d.db.NewSelect().
-		Model((*CourseStaff)(nil)).
-		Column("course_id").
-		Where("staff_id = ?", staffID).
-		Scan(ctx, &courseIDs)
+		Model((*Announcement)(nil)).
+		Join("LEFT JOIN announcement_bodies AS announcement_body").
+		JoinOn("announcement_body.course_id = announcement.course_id").
+		JoinOn("announcement_body.announcement_id = announcement.announcement_id").
+		Where(announcementSearchDocument+" @@ plainto_tsquery('simple', ?)", query).
+		OrderExpr("announcement.created_at DESC, announcement.course_id, announcement.announcement_id").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Scan(ctx, &announcements)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get staff courses: %w", err)
+		return nil, fmt.Errorf("failed to search announcements: %w", err)
 	}
 
-	return courseIDs, nil
+	return announcements, nil
 }
 
-// GetCoursesBySemester retrieves all courses for a specific semester.
-func (d *Database) GetCoursesBySemester(ctx context.Context, semester string) ([]*Course, error) {
-	if semester == "" {
-		return nil, fmt.Errorf("%w", ErrSemesterEmpty)
+// GetAnnouncementsSince retrieves at most limit announcements of all courses positioned after the
+// given position, oldest first.
+func (d *Database) GetAnnouncementsSince(ctx context.Context, after AnnouncementPosition, limit int,
+) ([]Announcement, error) {
+	var announcements []Announcement
+
+	query := d.db.NewSelect().
+		Model((*Announcement)(nil)).
+		OrderExpr("created_at, course_id, announcement_id").
+		Limit(limit)
+
+	if after.AnnouncementID == "" {
+		query.Where("created_at > ?", after.CreatedAt)
+	} else {
+		query.Where("(created_at, course_id, announcement_id) > (?, ?, ?)",
+			after.CreatedAt, after.CourseID, after.AnnouncementID)
 	}
 
-	var courses []*Course
+	if err := query.Scan(ctx, &announcements); err != nil {
+		return nil, fmt.Errorf("failed to get announcements since %s: %w", after.CreatedAt.Format(time.RFC3339), err)
+	}
 
-	err := d.db.NewSelect().
-		Model(&courses).
-		Where("semester = ?", semester).
+	return announcements, nil
+}
+
+// lockGradingScheme locks a course for a change to its grading scheme and returns when the
+// scheme was finalized, or the zero time while it is a draft.
+func lockGradingScheme(ctx context.Context, transaction bun.Tx, courseID string) (time.Time, error) {
+	course := new(Course)
+
+	err := transaction.NewSelect().
+		Model(course).
+		Column("grading_finalized_at").
+		Where("course_id = ?", courseID).
+		For("UPDATE").
 		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
 	if err != nil {
-		return nil, fmt.Errorf("failed to get courses by semester: %w", err)
+		return time.Time{}, fmt.Errorf("failed to lock course: %w", err)
 	}
 
-	return courses, nil
+	return course.GradingFinalizedAt, nil
 }
 
-// AddAnnouncement adds an announcement to a course.
-func (d *Database) AddAnnouncement(ctx context.Context, req *cpb.AddAnnouncementRequest) error {
-	if (req.GetCourseID() == "") || (req.GetAnnouncement().GetAnnouncementContent() == "") {
+// gradingComponents retrieves the grading components of a course ordered by name.
+func gradingComponents(ctx context.Context, idb bun.IDB, courseID string) ([]GradingComponent, error) {
+	components := []GradingComponent{}
+
+	err := idb.NewSelect().Model(&components).Where("course_id = ?", courseID).Order("name").Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grading components: %w", err)
+	}
+
+	return components, nil
+}
+
+// SetGradingComponent adds a grading component to the draft grading scheme of a course,
+// or replaces the component of the same name.
+func (d *Database) SetGradingComponent(ctx context.Context, component GradingComponent) error {
+	if err := validateGradingComponent(component); err != nil {
+		return err
+	}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		finalizedAt, err := lockGradingScheme(ctx, transaction, component.CourseID)
+		if err != nil {
+			return err
+		}
+
+		if !finalizedAt.IsZero() {
+			return fmt.Errorf("%w", ErrGradingSchemeFinalized)
+		}
+
+		_, err = transaction.NewInsert().
+			Model(&component).
+			On("CONFLICT (course_id, name) DO UPDATE").
+			Set("weight = EXCLUDED.weight").
+			Set("kind = EXCLUDED.kind").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to upsert grading component: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set grading component: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveGradingComponent removes a grading component from the draft grading scheme of a course.
+func (d *Database) RemoveGradingComponent(ctx context.Context, courseID, name string) error {
+	if courseID == "" {
 		return fmt.Errorf("%w", ErrCourseIDEmpty)
 	}
 
-	_, err := d.db.NewInsert().Model(&Announcement{
-		CourseID:       req.GetCourseID(),
-		AnnouncementID: req.GetAnnouncement().GetAnnouncementID(),
-		Title:          req.GetAnnouncement().GetAnnouncementTitle(),
-		Content:        req.GetAnnouncement().GetAnnouncementContent(),
-	}).Exec(ctx)
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		finalizedAt, err := lockGradingScheme(ctx, transaction, courseID)
+		if err != nil {
+			return err
+		}
+
+		if !finalizedAt.IsZero() {
+			return fmt.Errorf("%w", ErrGradingSchemeFinalized)
+		}
+
+		res, err := transaction.NewDelete().
+			Model((*GradingComponent)(nil)).
+			Where("course_id = ? AND name = ?", courseID, name).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete grading component: %w", err)
+		}
+
+		if num, _ := res.RowsAffected(); num == 0 {
+			return fmt.Errorf("%w", ErrGradingComponentNotFound)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove grading component: %w", err)
+	}
+
+	return nil
+}
+
+// GetGradingScheme retrieves the grading scheme of a course.
+func (d *Database) GetGradingScheme(ctx context.Context, courseID string) (*GradingScheme, error) {
+	course, err := d.GetCourse(ctx, courseID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := gradingComponents(ctx, d.db, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GradingScheme{Components: components, FinalizedAt: course.GradingFinalizedAt}, nil
+}
+
+// CountDraftGradingComponents returns how many components the grading scheme of each of the
+// courses has while it is still a draft. Courses without any, or with a finalized scheme, are left out.
+func (d *Database) CountDraftGradingComponents(ctx context.Context, courseIDs ...string) (map[string]int, error) {
+	counts := make(map[string]int)
+	if len(courseIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		CourseID string `bun:"course_id"`
+		Count    int    `bun:"count"`
+	}
+
+	err := d.db.NewSelect().
+		Model((*GradingComponent)(nil)).
+		ColumnExpr("grading_component.course_id, count(*) AS count").
+		Join("JOIN courses AS c ON c.course_id = grading_component.course_id").
+		Where("grading_component.course_id IN (?)", bun.In(courseIDs)).
+		Where("c.grading_finalized_at IS NULL").
+		Group("grading_component.course_id").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count draft grading components: %w", err)
+	}
+
+	for _, row := range rows {
+		counts[row.CourseID] = row.Count
+	}
+
+	return counts, nil
+}
+
+// FinalizeGradingScheme checks that the weights of the grading scheme of a course sum to 100
+// and freezes it. Finalizing a finalized scheme returns it unchanged.
+func (d *Database) FinalizeGradingScheme(ctx context.Context, courseID string) (*GradingScheme, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	scheme := new(GradingScheme)
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		var err error
+
+		scheme.FinalizedAt, err = lockGradingScheme(ctx, transaction, courseID)
+		if err != nil {
+			return err
+		}
+
+		scheme.Components, err = gradingComponents(ctx, transaction, courseID)
+		if err != nil || !scheme.FinalizedAt.IsZero() {
+			return err
+		}
+
+		if err := checkGradingWeights(scheme.Components); err != nil {
+			return err
+		}
+
+		scheme.FinalizedAt = time.Now()
+
+		_, err = transaction.NewUpdate().
+			Model(&Course{CourseID: courseID, GradingFinalizedAt: scheme.FinalizedAt, UpdatedAt: scheme.FinalizedAt}).
+			Column("grading_finalized_at", "updated_at").
+			WherePK().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to mark grading scheme finalized: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize grading scheme: %w", err)
+	}
+
+	return scheme, nil
+}
+
+// AddAPIKey stores a new API key of a course.
+func (d *Database) AddAPIKey(ctx context.Context, key *CourseAPIKey) error {
+	if key.CourseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	exists, err := d.courseExists(ctx, key.CourseID)
 	if err != nil {
-		return fmt.Errorf("failed to add announcement: %w", err)
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	if _, err := d.db.NewInsert().Model(key).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to add API key: %w", err)
 	}
 
 	return nil
 }
 
-// GetAnnouncements retrieves all announcements for a course.
-func (d *Database) GetAnnouncements(ctx context.Context, courseID string) ([]Announcement, error) {
+// GetAPIKey retrieves an API key by its ID. Expired keys are reported as not found.
+func (d *Database) GetAPIKey(ctx context.Context, keyID string) (*CourseAPIKey, error) {
+	key := new(CourseAPIKey)
+
+	err := d.db.NewSelect().
+		Model(key).
+		Where("key_id = ?", keyID).
+		Where("expires_at IS NULL OR expires_at > current_timestamp").
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w", ErrAPIKeyNotFound)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	return key, nil
+}
+
+// ListAPIKeys retrieves all API keys of a course, including expired ones.
+func (d *Database) ListAPIKeys(ctx context.Context, courseID string) ([]CourseAPIKey, error) {
 	if courseID == "" {
 		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
 	}
 
-	var announcements []Announcement
+	var keys []CourseAPIKey
 
 	err := d.db.NewSelect().
-		Model((*Announcement)(nil)).
+		Model(&keys).
 		Where("course_id = ?", courseID).
-		Scan(ctx, &announcements)
+		OrderExpr("created_at, key_id").
+		Scan(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get announcements: %w", err)
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
 	}
 
-	return announcements, nil
+	return keys, nil
 }
 
-// RemoveAnnouncement removes an announcement from a course.
-func (d *Database) RemoveAnnouncement(ctx context.Context, courseID, announcementID string) error {
+// RevokeAPIKey deletes an API key of a course.
+func (d *Database) RevokeAPIKey(ctx context.Context, courseID, keyID string) error {
 	if courseID == "" {
 		return fmt.Errorf("%w", ErrCourseIDEmpty)
 	}
 
-	if announcementID == "" {
-		return fmt.Errorf("%w", ErrAnnouncementEmpty)
-	}
-
 	res, err := d.db.NewDelete().
-		Model((*Announcement)(nil)).
-		Where("course_id = ? AND announcement_id = ?", courseID, announcementID).
+		Model((*CourseAPIKey)(nil)).
+		Where("course_id = ? AND key_id = ?", courseID, keyID).
 		Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to remove announcement: %w", err)
+		return fmt.Errorf("failed to revoke API key: %w", err)
 	}
 
 	if num, _ := res.RowsAffected(); num == 0 {
+		return fmt.Errorf("%w", ErrAPIKeyNotFound)
+	}
+
+	return nil
+}
+
+// repostAnnouncement inserts the repost of a recurring announcement, copying its full content
+// if it is stored apart.
+func repostAnnouncement(ctx context.Context, transaction bun.Tx, announcement Announcement, now time.Time) error {
+	repost := repostOf(announcement, now)
+	if _, err := transaction.NewInsert().Model(&repost).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to repost announcement: %w", err)
+	}
+
+	if !repost.HasFullBody {
+		return nil
+	}
+
+	_, err := transaction.NewRaw("INSERT INTO announcement_bodies (course_id, announcement_id, content) "+
+		"SELECT course_id, ?, content FROM announcement_bodies WHERE course_id = ? AND announcement_id = ?",
+		repost.AnnouncementID, announcement.CourseID, announcement.AnnouncementID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to repost announcement body: %w", err)
+	}
+
+	return nil
+}
+
+// RepostDueAnnouncements posts a fresh copy of every recurring announcement due at now and
+// schedules its next repost. It returns the number of copies posted. Reposts due during a quiet
+// period of their course are held until it ends.
+func (d *Database) RepostDueAnnouncements(ctx context.Context, now time.Time) (int, error) {
+	reposted := 0
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, transaction bun.Tx) error {
+		var due []Announcement
+
+		// Skip rows locked by another replica reposting concurrently.
+		err := transaction.NewSelect().
+			Model(&due).
+			Where("recurrence = ?", cpb.AnnouncementRecurrence_WEEKLY.String()).
+			Where("next_post_at <= ?", now).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get due announcements: %w", err)
+		}
+
+		quiet := make(map[string][]QuietPeriod)
+
+		for _, announcement := range due {
+			periods, ok := quiet[announcement.CourseID]
+			if !ok {
+				if periods, err = getQuietPeriods(ctx, transaction, announcement.CourseID); err != nil {
+					return err
+				}
+
+				quiet[announcement.CourseID] = periods
+			}
+
+			if !nextAllowedAt(periods, now).Equal(now) {
+				continue
+			}
+
+			if err := repostAnnouncement(ctx, transaction, announcement, now); err != nil {
+				return err
+			}
+
+			_, err := transaction.NewUpdate().
+				Model((*Announcement)(nil)).
+				Set("next_post_at = ?", nextRecurrence(announcement.NextPostAt, now)).
+				Where("course_id = ? AND announcement_id = ?", announcement.CourseID, announcement.AnnouncementID).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to schedule next repost: %w", err)
+			}
+
+			reposted++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to repost due announcements: %w", err)
+	}
+
+	return reposted, nil
+}
+
+// GetFeatureOverrides retrieves the features a course overrides and whether it enables them.
+func (d *Database) GetFeatureOverrides(ctx context.Context, courseID string) (map[string]bool, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	var features []CourseFeature
+	if err := d.db.NewSelect().Model(&features).Where("course_id = ?", courseID).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get feature overrides: %w", err)
+	}
+
+	overrides := make(map[string]bool, len(features))
+	for _, feature := range features {
+		overrides[feature.Feature] = feature.Enabled
+	}
+
+	return overrides, nil
+}
+
+// SetFeatureOverride enables or disables a feature for a course, replacing an earlier override.
+func (d *Database) SetFeatureOverride(ctx context.Context, courseID, feature string, enabled bool) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	exists, err := d.courseExists(ctx, courseID)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
 		return fmt.Errorf("%w", ErrCourseNotFound)
 	}
 
+	_, err = d.db.NewInsert().
+		Model(&CourseFeature{CourseID: courseID, Feature: feature, Enabled: enabled}).
+		On("CONFLICT (course_id, feature) DO UPDATE").
+		Set("enabled = EXCLUDED.enabled").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set feature override: %w", err)
+	}
+
+	return nil
+}
+
+// ClearFeatureOverride removes the override of a feature for a course, if any,
+// so its rollout applies again.
+func (d *Database) ClearFeatureOverride(ctx context.Context, courseID, feature string) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	_, err := d.db.NewDelete().
+		Model((*CourseFeature)(nil)).
+		Where("course_id = ? AND feature = ?", courseID, feature).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to clear feature override: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// Environment variable with the base URL of the staff directory; contacts are not looked up without it.
+	staffDirectoryURLEnv = "STAFF_DIRECTORY_URL"
+	// How long a single contact lookup may take before the directory is considered unavailable.
+	staffDirectoryTimeout = 2 * time.Second
+)
+
+var (
+	ErrStaffContactNotFound     = errors.New("staff member is not in the directory")
+	ErrStaffDirectoryFailed     = errors.New("staff directory request failed")
+	ErrInvalidStaffDirectoryURL = errors.New("staff directory URL must be an absolute http or https URL")
+)
+
+// StaffContact is how students can reach a staff member.
+type StaffContact struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// StaffDirectory looks up the contact details of staff members in an external directory.
+type StaffDirectory interface {
+	GetStaffContact(ctx context.Context, staffID string) (*StaffContact, error)
+}
+
+// noStaffDirectory is the StaffDirectory used when none is configured. It knows no contacts.
+type noStaffDirectory struct{}
+
+// GetStaffContact returns an empty contact.
+func (noStaffDirectory) GetStaffContact(_ context.Context, _ string) (*StaffContact, error) {
+	return &StaffContact{}, nil
+}
+
+// httpStaffDirectory looks up contacts with GET <baseURL>/staff/<staffID>, which answers with
+// a JSON StaffContact, or 404 for unknown staff.
+type httpStaffDirectory struct {
+	baseURL string
+	client  *http.Client
+}
+
+// GetStaffContact fetches the contact of a staff member from the directory.
+func (d *httpStaffDirectory) GetStaffContact(ctx context.Context, staffID string) (*StaffContact, error) {
+	ctx, cancel := context.WithTimeout(ctx, staffDirectoryTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		d.baseURL+"/staff/"+url.PathEscape(staffID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build staff directory request: %w", err)
+	}
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrStaffDirectoryFailed, err)
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrStaffContactNotFound, staffID)
+	default:
+		return nil, fmt.Errorf("%w: status %d", ErrStaffDirectoryFailed, response.StatusCode)
+	}
+
+	contact := new(StaffContact)
+	if err := json.NewDecoder(response.Body).Decode(contact); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrStaffDirectoryFailed, err)
+	}
+
+	return contact, nil
+}
+
+// staffDirectoryFromEnv returns the directory at STAFF_DIRECTORY_URL, or one that knows no
+// contacts if it is unset.
+func staffDirectoryFromEnv() (StaffDirectory, error) {
+	value := os.Getenv(staffDirectoryURLEnv)
+	if value == "" {
+		return noStaffDirectory{}, nil
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid %s %q: %w", staffDirectoryURLEnv, value, ErrInvalidStaffDirectoryURL)
+	}
+
+	return &httpStaffDirectory{baseURL: strings.TrimSuffix(value, "/"), client: &http.Client{}}, nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	cpb "github.com/BetterGR/courses-microservice/protos"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// enumColumn is a column storing the names of the values of a proto enum, such as "EVERYONE".
+type enumColumn struct {
+	table  string
+	column string
+	enum   protoreflect.EnumDescriptor
+}
+
+// visibilityColumn stores the visibility of announcements.
+func visibilityColumn() enumColumn {
+	return enumColumn{table: "announcements", column: "visibility", enum: cpb.AnnouncementVisibility(0).Descriptor()}
+}
+
+// recurrenceColumn stores the recurrence of announcements.
+func recurrenceColumn() enumColumn {
+	return enumColumn{table: "announcements", column: "recurrence", enum: cpb.AnnouncementRecurrence(0).Descriptor()}
+}
+
+// enumColumns lists every column storing a proto enum. Each gets a CHECK constraint in migrateSchema.
+func enumColumns() []enumColumn {
+	return []enumColumn{visibilityColumn(), recurrenceColumn()}
+}
+
+// String names the column as table.column.
+func (c enumColumn) String() string {
+	return c.table + "." + c.column
+}
+
+// names returns the values the column may hold.
+func (c enumColumn) names() []string {
+	values := c.enum.Values()
+	names := make([]string, 0, values.Len())
+
+	for i := range values.Len() {
+		names = append(names, string(values.Get(i).Name()))
+	}
+
+	return names
+}
+
+// number returns the enum number of a value read from the column. Values the enum does not know
+// fail with ErrUnknownEnumValue naming the column rather than mapping to the zero value.
+func (c enumColumn) number(value string) (protoreflect.EnumNumber, error) {
+	enumValue := c.enum.Values().ByName(protoreflect.Name(value))
+	if enumValue == nil {
+		return 0, fmt.Errorf("%w: %s holds %q", ErrUnknownEnumValue, c, value)
+	}
+
+	return enumValue.Number(), nil
+}
+
+// visibilityFromDB maps a stored announcement visibility to its proto enum.
+func visibilityFromDB(value string) (cpb.AnnouncementVisibility, error) {
+	number, err := visibilityColumn().number(value)
+
+	return cpb.AnnouncementVisibility(number), err
+}
+
+// recurrenceFromDB maps a stored announcement recurrence to its proto enum.
+func recurrenceFromDB(value string) (cpb.AnnouncementRecurrence, error) {
+	number, err := recurrenceColumn().number(value)
+
+	return cpb.AnnouncementRecurrence(number), err
+}
+
+// checkConstraintMigration limits a column to a set of values. The constraint is replaced on every
+// start so that values added since are accepted. It is NOT VALID, so rows written before it existed
+// do not fail the migration; they are reported when read instead.
+func checkConstraintMigration(table, column string, values []string) string {
+	constraint := table + "_" + column + "_check"
+
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, "'"+value+"'")
+	}
+
+	return "DO $$ BEGIN " +
+		"ALTER TABLE " + table + " DROP CONSTRAINT IF EXISTS " + constraint + "; " +
+		"ALTER TABLE " + table + " ADD CONSTRAINT " + constraint + " CHECK (" + column +
+		" IN (" + strings.Join(quoted, ", ") + ")) NOT VALID; " +
+		"END $$"
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// Environment variable with the base URL events are posted to; events are only logged without it.
+	eventsURLEnv = "EVENTS_URL"
+	// How long posting a single event may take before it is considered failed.
+	eventsTimeout = 5 * time.Second
+	// staffAccessExpiredEvent is the type of the event published when a staff assignment lapses.
+	staffAccessExpiredEvent = "StaffAccessExpired"
+)
+
+var (
+	ErrEventNotDelivered = errors.New("event was not delivered")
+	ErrInvalidEventsURL  = errors.New("events URL must be an absolute http or https URL")
+)
+
+// StaffAccessExpired is published once the access of a staff member to a course lapses.
+type StaffAccessExpired struct {
+	CourseID  string    `json:"courseId"`
+	StaffID   string    `json:"staffId"`
+	ExpiredAt time.Time `json:"expiredAt"`
+}
+
+// EventPublisher tells other services about changes to courses they may act on.
+type EventPublisher interface {
+	PublishStaffAccessExpired(ctx context.Context, event StaffAccessExpired) error
+}
+
+// logEventPublisher is the EventPublisher used when none is configured. It only logs events.
+type logEventPublisher struct{}
+
+// PublishStaffAccessExpired logs the event.
+func (logEventPublisher) PublishStaffAccessExpired(ctx context.Context, event StaffAccessExpired) error {
+	klog.FromContext(ctx).Info(staffAccessExpiredEvent,
+		"courseId", event.CourseID, "staffId", event.StaffID, "expiredAt", event.ExpiredAt)
+
+	return nil
+}
+
+// httpEventPublisher posts each event as JSON to <baseURL>/events/<type>, which must answer
+// with a 2xx status.
+type httpEventPublisher struct {
+	baseURL string
+	client  *http.Client
+}
+
+// PublishStaffAccessExpired posts the event.
+func (p *httpEventPublisher) PublishStaffAccessExpired(ctx context.Context, event StaffAccessExpired) error {
+	return p.post(ctx, staffAccessExpiredEvent, event)
+}
+
+// post posts an event of the given type.
+func (p *httpEventPublisher) post(ctx context.Context, eventType string, event any) error {
+	ctx, cancel := context.WithTimeout(ctx, eventsTimeout)
+	defer cancel()
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", eventType, err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		p.baseURL+"/events/"+url.PathEscape(eventType), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build %s event request: %w", eventType, err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrEventNotDelivered, eventType, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %s: status %d", ErrEventNotDelivered, eventType, response.StatusCode)
+	}
+
+	return nil
+}
+
+// eventPublisherFromEnv returns the publisher posting to EVENTS_URL, or one that only logs events
+// if it is unset.
+func eventPublisherFromEnv() (EventPublisher, error) {
+	value := os.Getenv(eventsURLEnv)
+	if value == "" {
+		return logEventPublisher{}, nil
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid %s %q: %w", eventsURLEnv, value, ErrInvalidEventsURL)
+	}
+
+	return &httpEventPublisher{baseURL: strings.TrimSuffix(value, "/"), client: &http.Client{}}, nil
+}
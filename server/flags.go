@@ -0,0 +1,138 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Features that can be piloted on some courses before being enabled everywhere.
+	featureQA         = "qa"
+	featureSelfEnroll = "self_enroll"
+	featureFeedback   = "feedback"
+	// Environment variable overriding the rules of features, e.g. "qa=on,feedback=25%".
+	courseFeaturesEnv = "COURSE_FEATURES"
+	// Number of buckets courses are hashed into for percentage rollouts.
+	rolloutBuckets = 100
+)
+
+var (
+	ErrUnknownFeature     = errors.New("feature is unknown")
+	ErrInvalidFeatureRule = errors.New("feature rule must be on, off or a percentage from 0% to 100%")
+	ErrFeatureDisabled    = errors.New("feature disabled")
+)
+
+// FeatureRule decides whether a feature is enabled for courses without an override.
+type FeatureRule struct {
+	// Enabled is whether the feature is enabled by default.
+	Enabled bool
+	// Rollout, if set, enables the feature for this percentage of courses instead of the default.
+	// Courses are chosen by a hash of their ID, so a course stays in or out as the rollout grows.
+	Rollout uint32
+}
+
+// FeatureFlags holds the rules of every known feature.
+type FeatureFlags struct {
+	rules map[string]FeatureRule
+}
+
+// defaultFeatureFlags returns the rules used when COURSE_FEATURES does not override them.
+// Self-enrollment predates the flags and stays enabled.
+func defaultFeatureFlags() FeatureFlags {
+	return FeatureFlags{rules: map[string]FeatureRule{
+		featureQA:         {},
+		featureSelfEnroll: {Enabled: true},
+		featureFeedback:   {},
+	}}
+}
+
+// names returns the known features in order.
+func (f FeatureFlags) names() []string {
+	return slices.Sorted(maps.Keys(f.rules))
+}
+
+// known reports whether the feature exists.
+func (f FeatureFlags) known(feature string) bool {
+	_, ok := f.rules[feature]
+
+	return ok
+}
+
+// enabled reports whether a feature is enabled for a course. An override of the course beats
+// the rollout of the feature, which beats its default.
+func (f FeatureFlags) enabled(courseID, feature string, overrides map[string]bool) bool {
+	if enabled, ok := overrides[feature]; ok {
+		return enabled
+	}
+
+	rule := f.rules[feature]
+	if rule.Rollout > 0 {
+		return rolloutBucket(courseID, feature) < rule.Rollout
+	}
+
+	return rule.Enabled
+}
+
+// rolloutBucket deterministically assigns a course to one of rolloutBuckets buckets per feature,
+// so different features are piloted on different courses.
+func rolloutBucket(courseID, feature string) uint32 {
+	hash := fnv.New32a()
+	hash.Write([]byte(feature + "/" + courseID))
+
+	return hash.Sum32() % rolloutBuckets
+}
+
+// featureFlagsFromEnv reads the rules of features from COURSE_FEATURES, a comma-separated list of
+// feature=rule where the rule is on, off or a rollout percentage. Unlisted features keep their default.
+func featureFlagsFromEnv() (FeatureFlags, error) {
+	flags := defaultFeatureFlags()
+
+	value := os.Getenv(courseFeaturesEnv)
+	if value == "" {
+		return flags, nil
+	}
+
+	for entry := range strings.SplitSeq(value, ",") {
+		feature, setting, _ := strings.Cut(strings.TrimSpace(entry), "=")
+		if !flags.known(feature) {
+			return FeatureFlags{}, fmt.Errorf("invalid %s: %w: %q", courseFeaturesEnv, ErrUnknownFeature, feature)
+		}
+
+		rule, err := parseFeatureRule(setting)
+		if err != nil {
+			return FeatureFlags{}, fmt.Errorf("invalid %s for %s: %w", courseFeaturesEnv, feature, err)
+		}
+
+		flags.rules[feature] = rule
+	}
+
+	return flags, nil
+}
+
+// parseFeatureRule parses on, off or a rollout percentage such as 25%.
+func parseFeatureRule(setting string) (FeatureRule, error) {
+	switch setting {
+	case "on":
+		return FeatureRule{Enabled: true}, nil
+	case "off":
+		return FeatureRule{}, nil
+	}
+
+	percent, found := strings.CutSuffix(setting, "%")
+	if !found {
+		return FeatureRule{}, fmt.Errorf("%w: %q", ErrInvalidFeatureRule, setting)
+	}
+
+	rollout, err := strconv.ParseUint(percent, 10, 32)
+	if err != nil || rollout > rolloutBuckets {
+		return FeatureRule{}, fmt.Errorf("%w: %q", ErrInvalidFeatureRule, setting)
+	}
+
+	return FeatureRule{Rollout: uint32(rollout)}, nil
+}
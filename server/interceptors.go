@@ -0,0 +1,432 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	cpb "github.com/BetterGR/courses-microservice/protos"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// apiKeyMetadata is the metadata key machine integrations send their course API key in.
+	apiKeyMetadata = "x-api-key"
+	// authorizationMetadata is the metadata key callers send their bearer token in.
+	authorizationMetadata = "authorization"
+	// bearerScheme prefixes the token in the authorization metadata.
+	bearerScheme = "Bearer "
+	// readOnlyReason is the ErrorInfo reason of writes refused because the database is read-only.
+	readOnlyReason = "DATABASE_READ_ONLY"
+	// readOnlyDomain is the ErrorInfo domain of writes refused because the database is read-only.
+	readOnlyDomain = "courses-microservice"
+	// readOnlyRetryDelay is how long clients should wait before retrying a refused write.
+	// Failovers make the database read-only for up to a minute.
+	readOnlyRetryDelay = 10 * time.Second
+	// writesHealthService is the health check service name reporting whether writes are served.
+	// Reads keep being served while the database is read-only, so the service itself stays SERVING.
+	writesHealthService = "courses.CoursesService/writes"
+	// healthMethodPrefix prefixes the methods of the health service, which are never rate limited.
+	healthMethodPrefix = "/grpc.health.v1.Health/"
+)
+
+// newHealthServer returns a health service reporting the service and its writes as SERVING.
+func newHealthServer() *health.Server {
+	server := health.NewServer()
+	server.SetServingStatus(writesHealthService, healthpb.HealthCheckResponse_SERVING)
+
+	return server
+}
+
+// apiKeyContextKey is the context key of the API key a request was authorized with.
+type apiKeyContextKey struct{}
+
+// claimsContextKey is the context key of the claims of the verified token a request carries.
+type claimsContextKey struct{}
+
+// tokenRequest is implemented by requests carrying the caller's token.
+type tokenRequest interface {
+	GetToken() string
+}
+
+// courseRequest is implemented by requests addressing a single course.
+type courseRequest interface {
+	GetCourseID() string
+}
+
+// validator is implemented by request messages carrying protoc-gen-validate rules.
+type validator interface {
+	ValidateAll() error
+}
+
+// fieldError is implemented by the errors protoc-gen-validate reports for a single field.
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError is implemented by the errors protoc-gen-validate reports for a whole message.
+type multiError interface {
+	AllErrors() []error
+}
+
+// serverOptions returns the options every CoursesServer gRPC server is created with.
+func serverOptions(server *CoursesServer) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(server.concurrencyInterceptor, server.apiKeyInterceptor, server.authInterceptor,
+			validationInterceptor, server.readOnlyInterceptor),
+	}
+}
+
+// concurrencyLimiter caps the number of requests handled at once.
+type concurrencyLimiter struct {
+	// slots holds a value for every request in flight.
+	slots chan struct{}
+	// maxWait is how long a request waits for a slot before it is rejected.
+	maxWait time.Duration
+}
+
+// newConcurrencyLimiter returns a limiter allowing limit requests in flight, or nil if limit is 0.
+func newConcurrencyLimiter(limit int, maxWait time.Duration) *concurrencyLimiter {
+	if limit == 0 {
+		return nil
+	}
+
+	return &concurrencyLimiter{slots: make(chan struct{}, limit), maxWait: maxWait}
+}
+
+// acquire takes a slot, waiting up to maxWait for one to free up. It reports whether it got one.
+func (l *concurrencyLimiter) acquire(ctx context.Context) bool {
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+	}
+
+	if l.maxWait == 0 {
+		return false
+	}
+
+	timer := time.NewTimer(l.maxWait)
+	defer timer.Stop()
+
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	case <-timer.C:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// release frees the slot of a finished request.
+func (l *concurrencyLimiter) release() {
+	<-l.slots
+}
+
+// inFlight returns the number of requests currently being handled.
+func (l *concurrencyLimiter) inFlight() int {
+	return len(l.slots)
+}
+
+// concurrencyInterceptor protects the database during spikes by rejecting requests beyond the
+// concurrency limit with codes.ResourceExhausted, and counts the requests in flight whether or not
+// they are limited. Health checks are always served and never counted.
+func (s *CoursesServer) concurrencyInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+		return handler(ctx, req)
+	}
+
+	if s.limiter == nil {
+		return s.countInFlight(ctx, req, handler)
+	}
+
+	if !s.limiter.acquire(ctx) {
+		klog.FromContext(ctx).Info("Too many requests in flight, rejecting",
+			"method", info.FullMethod, "inFlight", s.limiter.inFlight())
+
+		return nil, fmt.Errorf("overloaded: %w", status.Errorf(codes.ResourceExhausted,
+			"server is handling the maximum of %d requests, retry later", cap(s.limiter.slots)))
+	}
+	defer s.limiter.release()
+
+	return s.countInFlight(ctx, req, handler)
+}
+
+// countInFlight handles a request, counting it as in flight meanwhile.
+func (s *CoursesServer) countInFlight(ctx context.Context, req any, handler grpc.UnaryHandler) (any, error) {
+	s.inFlight.Add(1)
+	defer s.inFlight.Add(-1)
+
+	return handler(ctx, req)
+}
+
+// apiKeyInterceptor authorizes requests carrying a course API key. Such a request may only
+// call an RPC the key is scoped to, and only for the course the key belongs to.
+// Requests without an API key are passed on to the token checks of the handlers.
+func (s *CoursesServer) apiKeyInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	secrets := metadata.ValueFromIncomingContext(ctx, apiKeyMetadata)
+	if len(secrets) == 0 {
+		return handler(ctx, req)
+	}
+
+	key, err := s.authorizeAPIKey(ctx, secrets[0], path.Base(info.FullMethod), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(context.WithValue(ctx, apiKeyContextKey{}, key), req)
+}
+
+// authorizeAPIKey resolves an API key secret and checks that it may call method for the
+// course addressed by req. The returned error already carries the matching gRPC status.
+func (s *CoursesServer) authorizeAPIKey(ctx context.Context, secret, method string, req any,
+) (*CourseAPIKey, error) {
+	key, err := s.db.GetAPIKey(ctx, apiKeyID(secret))
+	if err != nil && !errors.Is(err, ErrAPIKeyNotFound) {
+		return nil, fmt.Errorf("failed to get API key: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	if err != nil || subtle.ConstantTimeCompare([]byte(hashAPIKeySecret(secret)), []byte(key.SecretHash)) != 1 {
+		return nil, fmt.Errorf("authentication failed: %w", status.Error(codes.Unauthenticated, "invalid API key"))
+	}
+
+	if !slices.Contains(key.Scopes, method) {
+		return nil, fmt.Errorf("authorization failed: %w",
+			status.Errorf(codes.PermissionDenied, "API key is not scoped to %s", method))
+	}
+
+	if request, ok := req.(courseRequest); !ok || request.GetCourseID() != key.CourseID {
+		return nil, fmt.Errorf("authorization failed: %w",
+			status.Errorf(codes.PermissionDenied, "API key is only valid for course %s", key.CourseID))
+	}
+
+	return key, nil
+}
+
+// apiKeyID returns the ID of the key an API key secret belongs to.
+func apiKeyID(secret string) string {
+	keyID, _, _ := strings.Cut(secret, ".")
+
+	return keyID
+}
+
+// hashAPIKeySecret returns the hash an API key secret is stored as.
+func hashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// authInterceptor verifies the bearer token in the authorization metadata, falling back to the
+// token field of the request, and stores its claims in the context for the handlers.
+// Requests authorized by a course API key and requests without a token field, such as those
+// of the health service, are passed on unchanged.
+func (s *CoursesServer) authInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if _, ok := ctx.Value(apiKeyContextKey{}).(*CourseAPIKey); ok {
+		return handler(ctx, req)
+	}
+
+	request, ok := req.(tokenRequest)
+	if !ok || s.Claims != nil {
+		return handler(ctx, req)
+	}
+
+	token := bearerToken(ctx)
+	if token == "" {
+		token = request.GetToken()
+	}
+
+	if token == "" {
+		return nil, fmt.Errorf("authentication failed: %w", status.Error(codes.Unauthenticated, "missing token"))
+	}
+
+	claims, err := s.verifyToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(context.WithValue(ctx, claimsContextKey{}, claims), req)
+}
+
+// bearerToken returns the bearer token in the authorization metadata, or an empty string if there is none.
+func bearerToken(ctx context.Context) string {
+	for _, value := range metadata.ValueFromIncomingContext(ctx, authorizationMetadata) {
+		if token, ok := strings.CutPrefix(value, bearerScheme); ok {
+			return strings.TrimSpace(token)
+		}
+	}
+
+	return ""
+}
+
+// readOnlyInterceptor tracks whether the database accepts writes. A write refused because the
+// database is read-only marks writes as NOT_SERVING in the health service and is returned with an
+// ErrorInfo and a RetryInfo detail; the next successful write marks them SERVING again.
+// Reads, and the methods of other services such as health checks, are passed through unchanged.
+func (s *CoursesServer) readOnlyInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	resp, err := handler(ctx, req)
+	if !slices.Contains(writeMethods(), info.FullMethod) {
+		return resp, err
+	}
+
+	switch {
+	case err == nil:
+		s.setWritable(true)
+	case status.Code(err) == codes.Unavailable:
+		s.setWritable(false)
+
+		return nil, fmt.Errorf("database is read-only: %w", readOnlyStatus(err).Err())
+	}
+
+	return resp, err
+}
+
+// writeMethods lists the RPCs writing to the database. Only their results tell whether the database
+// accepts writes; every other RPC succeeds either way.
+func writeMethods() []string {
+	return []string{
+		cpb.CoursesService_CreateCourse_FullMethodName,
+		cpb.CoursesService_UpdateCourse_FullMethodName,
+		cpb.CoursesService_DeleteCourse_FullMethodName,
+		cpb.CoursesService_AddStudentToCourse_FullMethodName,
+		cpb.CoursesService_RemoveStudentFromCourse_FullMethodName,
+		cpb.CoursesService_SetStudentStatus_FullMethodName,
+		cpb.CoursesService_BatchAddStudents_FullMethodName,
+		cpb.CoursesService_TransferEnrollments_FullMethodName,
+		cpb.CoursesService_DeduplicateEnrollments_FullMethodName,
+		cpb.CoursesService_AddStaffToCourse_FullMethodName,
+		cpb.CoursesService_RemoveStaffFromCourse_FullMethodName,
+		cpb.CoursesService_AddAnnouncementToCourse_FullMethodName,
+		cpb.CoursesService_RemoveAnnouncementFromCourse_FullMethodName,
+		cpb.CoursesService_BatchRemoveAnnouncements_FullMethodName,
+		cpb.CoursesService_CopyAnnouncements_FullMethodName,
+		cpb.CoursesService_SetEnrollmentWindow_FullMethodName,
+		cpb.CoursesService_SetQuietPeriods_FullMethodName,
+		cpb.CoursesService_SetAnnouncementsEnabled_FullMethodName,
+		cpb.CoursesService_CreateCourseAPIKey_FullMethodName,
+		cpb.CoursesService_RevokeCourseAPIKey_FullMethodName,
+		cpb.CoursesService_AddCorequisite_FullMethodName,
+		cpb.CoursesService_SetGradingComponent_FullMethodName,
+		cpb.CoursesService_RemoveGradingComponent_FullMethodName,
+		cpb.CoursesService_FinalizeGradingScheme_FullMethodName,
+		cpb.CoursesService_SetCourseFeature_FullMethodName,
+		cpb.CoursesService_UpdateAnnouncement_FullMethodName,
+		cpb.CoursesService_ChangeCourseID_FullMethodName,
+		cpb.CoursesService_UpsertAnnouncementBySlug_FullMethodName,
+	}
+}
+
+// setWritable records whether the database accepts writes and reports it to the health service.
+func (s *CoursesServer) setWritable(writable bool) {
+	if s.readOnly.Swap(!writable) == !writable {
+		return
+	}
+
+	if writable {
+		klog.Info("Database accepts writes again")
+		s.health.SetServingStatus(writesHealthService, healthpb.HealthCheckResponse_SERVING)
+
+		return
+	}
+
+	klog.Warning("Database is read-only, refusing writes")
+	s.health.SetServingStatus(writesHealthService, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// readOnlyStatus converts the error of a write refused by a read-only database into an Unavailable
+// status telling the client why and when to retry.
+func readOnlyStatus(err error) *status.Status {
+	readOnly := status.New(codes.Unavailable, err.Error())
+
+	detailed, detailErr := readOnly.WithDetails(
+		&errdetails.ErrorInfo{Reason: readOnlyReason, Domain: readOnlyDomain},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(readOnlyRetryDelay)},
+	)
+	if detailErr != nil {
+		return readOnly
+	}
+
+	return detailed
+}
+
+// validationInterceptor rejects requests violating their proto validation rules
+// with codes.InvalidArgument, listing each violated field in a BadRequest detail.
+func validationInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if msg, ok := req.(validator); ok {
+		if err := msg.ValidateAll(); err != nil {
+			return nil, fmt.Errorf("request validation failed: %w", validationStatus(err).Err())
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// validationStatus converts a validation error into an InvalidArgument status with field details.
+func validationStatus(err error) *status.Status {
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid request: %v", err))
+
+	detailed, detailErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: fieldViolations(err, "")})
+	if detailErr != nil {
+		return st
+	}
+
+	return detailed
+}
+
+// fieldViolations lists the violated fields of a validation error. Violations inside
+// embedded messages are reported by their dotted path, e.g. "Course.Semester".
+func fieldViolations(err error, prefix string) []*errdetails.BadRequest_FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		var violations []*errdetails.BadRequest_FieldViolation
+		for _, inner := range multi.AllErrors() {
+			violations = append(violations, fieldViolations(inner, prefix)...)
+		}
+
+		return violations
+	}
+
+	var field fieldError
+	if !errors.As(err, &field) {
+		return nil
+	}
+
+	if field.Cause() != nil {
+		if nested := fieldViolations(field.Cause(), prefix+field.Field()+"."); len(nested) > 0 {
+			return nested
+		}
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{{
+		Field:       prefix + field.Field(),
+		Description: field.Reason(),
+	}}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	// metricsPortEnv is the environment variable naming the port metrics are served on.
+	// Metrics are not served if it is unset.
+	metricsPortEnv = "METRICS_PORT"
+	// metricsPath is the HTTP path serving the metrics as a JSON object.
+	metricsPath = "/metrics"
+	// metricsReadHeaderTimeout bounds how long a scrape may take to send its headers.
+	metricsReadHeaderTimeout = 5 * time.Second
+)
+
+// metrics returns the metrics of the server. Every metric reads the state of the server when the
+// metrics are served, so they are never out of date.
+func (s *CoursesServer) metrics() *expvar.Map {
+	metrics := new(expvar.Map).Init()
+	metrics.Set("database_read_only", expvar.Func(func() any { return s.readOnly.Load() }))
+	metrics.Set("in_flight_requests", expvar.Func(func() any { return s.inFlight.Load() }))
+	metrics.Set("data_shape", expvar.Func(s.dataShapeGauges))
+
+	return metrics
+}
+
+// dataShapeGauges returns the headline numbers of the last data shape report, or nil before the first.
+func (s *CoursesServer) dataShapeGauges() any {
+	shape := s.dataShape.Load()
+	if shape == nil {
+		return nil
+	}
+
+	return map[string]any{
+		"generated_at":                 shape.GeneratedAt.Unix(),
+		"courses_per_semester":         shape.CoursesPerSemester,
+		"enrollments_per_course_p50":   shape.EnrollmentsPerCourse.P50,
+		"enrollments_per_course_p95":   shape.EnrollmentsPerCourse.P95,
+		"enrollments_per_course_max":   shape.EnrollmentsPerCourse.Max,
+		"announcements_per_course_p50": shape.AnnouncementsPerCourse.P50,
+		"announcements_per_course_p95": shape.AnnouncementsPerCourse.P95,
+		"announcements_per_course_max": shape.AnnouncementsPerCourse.Max,
+		"table_rows":                   shape.TableRows,
+	}
+}
+
+// metricsHandler serves metrics as a JSON object on metricsPath.
+func metricsHandler(metrics expvar.Var) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(metricsPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, metrics.String())
+	})
+
+	return mux
+}
+
+// serveMetrics serves metrics on the listener until it fails.
+func serveMetrics(listener net.Listener, metrics expvar.Var) error {
+	server := &http.Server{Handler: metricsHandler(metrics), ReadHeaderTimeout: metricsReadHeaderTimeout}
+	if err := server.Serve(listener); err != nil {
+		return fmt.Errorf("failed to serve metrics: %w", err)
+	}
+
+	return nil
+}
@@ -1,10 +1,16 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	cpb "github.com/BetterGR/courses-microservice/protos"
 )
@@ -14,32 +20,51 @@ var ErrCourseAlreadyExists = errors.New("course already exists")
 
 // MockDatabase is a in-memory implementation of DBInterface for testing.
 type MockDatabase struct {
-	courses        map[string]*Course
-	courseStudents map[string][]string
-	courseStaff    map[string][]string
-	studentCourses map[string][]string
-	staffCourses   map[string][]string
-	announcements  map[string][]Announcement
-	mutex          sync.RWMutex
+	courses map[string]*Course
+	// students and staff are the members of courses in each role.
+	students      *roster[studentEnrollment]
+	staff         *roster[CourseStaff]
+	announcements map[string][]Announcement
+	// bodies holds the full content of split announcements by course and announcement ID.
+	bodies       map[string]map[string]string
+	quietPeriods map[string][]QuietPeriod
+	apiKeys      map[string]CourseAPIKey
+	corequisites map[string][]string
+	grading      map[string]map[string]GradingComponent
+	features     map[string]map[string]bool
+	now          func() time.Time
+	mutex        sync.RWMutex
 }
 
 // Verify that MockDatabase implements DBInterface at compile time.
 var _ DBInterface = (*MockDatabase)(nil)
 
+// studentEnrollment is the status of a student in a course of the mock database. Rows counts the
+// rows listing the student, which is more than one only for duplicates left over from legacy data.
+type studentEnrollment struct {
+	status string
+	rows   int
+}
+
 // NewMockDatabase creates a new MockDatabase instance.
 func NewMockDatabase() *MockDatabase {
 	return &MockDatabase{
-		courses:        make(map[string]*Course),
-		courseStudents: make(map[string][]string),
-		courseStaff:    make(map[string][]string),
-		studentCourses: make(map[string][]string),
-		staffCourses:   make(map[string][]string),
-		announcements:  make(map[string][]Announcement),
+		courses:       make(map[string]*Course),
+		students:      newRoster[studentEnrollment](),
+		staff:         newRoster[CourseStaff](),
+		announcements: make(map[string][]Announcement),
+		bodies:        make(map[string]map[string]string),
+		quietPeriods:  make(map[string][]QuietPeriod),
+		apiKeys:       make(map[string]CourseAPIKey),
+		corequisites:  make(map[string][]string),
+		grading:       make(map[string]map[string]GradingComponent),
+		features:      make(map[string]map[string]bool),
+		now:           time.Now,
 	}
 }
 
 // AddCourse adds a course to the mock database.
-func (m *MockDatabase) AddCourse(_ context.Context, course *cpb.Course) (*Course, error) {
+func (m *MockDatabase) AddCourse(_ context.Context, course *cpb.Course, staff ...CourseStaff) (*Course, error) {
 	if course == nil {
 		return nil, fmt.Errorf("%w", ErrCourseNil)
 	}
@@ -48,6 +73,15 @@ func (m *MockDatabase) AddCourse(_ context.Context, course *cpb.Course) (*Course
 		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
 	}
 
+	if err := validateInitialStaff(staff); err != nil {
+		return nil, err
+	}
+
+	metadata, err := mergeMetadata(nil, course.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
@@ -61,10 +95,24 @@ func (m *MockDatabase) AddCourse(_ context.Context, course *cpb.Course) (*Course
 		CourseName:  course.GetCourseName(),
 		Semester:    course.GetSemester(),
 		Description: course.GetDescription(),
+		Capacity:    course.GetCapacity(),
+		Credits:     course.GetCredits(),
+		// Announcements are disabled only through SetAnnouncementsEnabled.
+		AnnouncementsEnabled: true,
+		Metadata:             metadata,
+		CreatedAt:            m.now(),
+		UpdatedAt:            m.now(),
 	}
 
 	m.courses[course.GetCourseID()] = newCourse
 
+	for _, member := range staff {
+		member.CourseID = newCourse.CourseID
+		if !m.staff.has(member.CourseID, member.StaffID) {
+			m.staff.set(member.CourseID, member.StaffID, member)
+		}
+	}
+
 	return newCourse, nil
 }
 
@@ -103,6 +151,11 @@ func (m *MockDatabase) UpdateCourse(_ context.Context, course *cpb.Course) (*Cou
 		return nil, fmt.Errorf("%w", ErrCourseNotFound)
 	}
 
+	metadata, err := mergeMetadata(existingCourse.Metadata, course.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
 	// Update the fields.
 	if course.GetCourseName() != "" {
 		existingCourse.CourseName = course.GetCourseName()
@@ -116,416 +169,1991 @@ func (m *MockDatabase) UpdateCourse(_ context.Context, course *cpb.Course) (*Cou
 		existingCourse.Description = course.GetDescription()
 	}
 
+	if course.GetCapacity() != 0 {
+		existingCourse.Capacity = course.GetCapacity()
+	}
+
+	if course.GetCredits() != 0 {
+		existingCourse.Credits = course.GetCredits()
+	}
+
+	existingCourse.Metadata = metadata
+	existingCourse.UpdatedAt = m.now()
 	m.courses[course.GetCourseID()] = existingCourse
 
 	return existingCourse, nil
 }
 
-// DeleteCourse removes a course from the mock database.
-func (m *MockDatabase) DeleteCourse(_ context.Context, courseID string) error {
+// SetEnrollmentWindow sets the enrollment window of a course in the mock database.
+func (m *MockDatabase) SetEnrollmentWindow(_ context.Context, courseID string, opensAt, closesAt time.Time) error {
 	if courseID == "" {
 		return fmt.Errorf("%w", ErrCourseIDEmpty)
 	}
 
+	if err := validateTimeRange(opensAt, closesAt, ErrInvalidWindow); err != nil {
+		return err
+	}
+
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	if _, exists := m.courses[courseID]; !exists {
+	course, exists := m.courses[courseID]
+	if !exists {
 		return fmt.Errorf("%w", ErrCourseNotFound)
 	}
 
-	delete(m.courses, courseID)
-	delete(m.courseStudents, courseID)
-	delete(m.courseStaff, courseID)
-	delete(m.announcements, courseID)
-
-	// Clean up student-course associations.
-	for studentID, courses := range m.studentCourses {
-		updatedCourses := make([]string, 0)
-
-		for _, cID := range courses {
-			if cID != courseID {
-				updatedCourses = append(updatedCourses, cID)
-			}
-		}
-
-		m.studentCourses[studentID] = updatedCourses
-	}
-
-	// Clean up staff-course associations.
-	for staffID, courses := range m.staffCourses {
-		updatedCourses := make([]string, 0)
-
-		for _, cID := range courses {
-			if cID != courseID {
-				updatedCourses = append(updatedCourses, cID)
-			}
-		}
-
-		m.staffCourses[staffID] = updatedCourses
-	}
+	course.EnrollmentOpensAt = opensAt
+	course.EnrollmentClosesAt = closesAt
+	course.UpdatedAt = m.now()
 
 	return nil
 }
 
-// addEntityToCourse is a helper method for adding a student or staff to a course.
-func (m *MockDatabase) addEntityToCourse(courseID, entityID string,
-	entityMap map[string][]string, courseMap map[string][]string, emptyErr error,
-) error {
+// SetAnnouncementsEnabled sets whether new announcements may be posted to a course
+// in the mock database.
+func (m *MockDatabase) SetAnnouncementsEnabled(_ context.Context, courseID string, enabled bool) error {
 	if courseID == "" {
 		return fmt.Errorf("%w", ErrCourseIDEmpty)
 	}
 
-	if entityID == "" {
-		return fmt.Errorf("%w", emptyErr)
-	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 
-	// Check if course exists.
-	if _, exists := m.courses[courseID]; !exists {
+	course, exists := m.courses[courseID]
+	if !exists {
 		return fmt.Errorf("%w", ErrCourseNotFound)
 	}
 
-	// Add entity to course.
-	if _, exists := entityMap[courseID]; !exists {
-		entityMap[courseID] = make([]string, 0)
-	}
-
-	for _, eID := range entityMap[courseID] {
-		if eID == entityID {
-			return nil
-		}
-	}
+	course.AnnouncementsEnabled = enabled
+	course.UpdatedAt = m.now()
 
-	entityMap[courseID] = append(entityMap[courseID], entityID)
+	return nil
+}
 
-	// Add course to entity.
-	if _, exists := courseMap[entityID]; !exists {
-		courseMap[entityID] = make([]string, 0)
+// EnrollmentStatus resolves the enrollment status of a course in the mock database.
+func (m *MockDatabase) EnrollmentStatus(_ context.Context, courseID string) (*EnrollmentWindow, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
 	}
 
-	for _, cID := range courseMap[entityID] {
-		if cID == courseID {
-			return nil
-		}
-	}
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
 
-	courseMap[entityID] = append(courseMap[entityID], courseID)
+	course, exists := m.courses[courseID]
+	if !exists {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
 
-	return nil
+	return resolveEnrollmentWindow(course, m.now()), nil
 }
 
-// validateRemoveEntityParams validates parameters for entity removal operations.
-func (m *MockDatabase) validateRemoveEntityParams(courseID, entityID string, emptyErr error) error {
+// SetQuietPeriods replaces the quiet periods of a course in the mock database.
+func (m *MockDatabase) SetQuietPeriods(_ context.Context, courseID string, periods []QuietPeriod) error {
 	if courseID == "" {
 		return fmt.Errorf("%w", ErrCourseIDEmpty)
 	}
 
-	if entityID == "" {
-		return fmt.Errorf("%w", emptyErr)
+	if err := validateQuietPeriods(periods); err != nil {
+		return err
 	}
 
-	// Check if course exists
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
 	if _, exists := m.courses[courseID]; !exists {
 		return fmt.Errorf("%w", ErrCourseNotFound)
 	}
 
+	m.quietPeriods[courseID] = slices.Clone(periods)
+
 	return nil
 }
 
-// removeEntityFromMap removes an entity from a course's entity list and returns true if found.
-func (m *MockDatabase) removeEntityFromMap(courseID, entityID string, entityMap map[string][]string) bool {
-	found := false
+// AddCorequisite requires corequisiteID to be taken in the same semester as courseID in the mock database.
+func (m *MockDatabase) AddCorequisite(_ context.Context, courseID, corequisiteID string) error {
+	if courseID == "" || corequisiteID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
 
-	if entities, exists := entityMap[courseID]; exists {
-		updatedEntities := make([]string, 0)
+	if courseID == corequisiteID {
+		return fmt.Errorf("%w", ErrSelfCorequisite)
+	}
 
-		for _, eID := range entities {
-			if eID != entityID {
-				updatedEntities = append(updatedEntities, eID)
-			} else {
-				found = true
-			}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, cID := range []string{courseID, corequisiteID} {
+		if _, exists := m.courses[cID]; !exists {
+			return fmt.Errorf("%w", ErrCourseNotFound)
 		}
+	}
 
-		entityMap[courseID] = updatedEntities
+	if !slices.Contains(m.corequisites[courseID], corequisiteID) {
+		m.corequisites[courseID] = append(m.corequisites[courseID], corequisiteID)
 	}
 
-	return found
+	return nil
 }
 
-// removeCourseFromEntityMap removes a course from an entity's course list.
-func (m *MockDatabase) removeCourseFromEntityMap(courseID, entityID string, courseMap map[string][]string) {
-	if courses, exists := courseMap[entityID]; exists {
-		updatedCourses := make([]string, 0)
+// GetCorequisites retrieves the co-requisites of a course from the mock database.
+func (m *MockDatabase) GetCorequisites(_ context.Context, courseID string) ([]string, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
 
-		for _, cID := range courses {
-			if cID != courseID {
-				updatedCourses = append(updatedCourses, cID)
-			}
-		}
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
 
-		courseMap[entityID] = updatedCourses
+	corequisiteIDs := append([]string{}, m.corequisites[courseID]...)
+	slices.Sort(corequisiteIDs)
+
+	return corequisiteIDs, nil
+}
+
+// removeCorequisite removes a course and every requirement to take it from the co-requisites.
+func (m *MockDatabase) removeCorequisite(courseID string) {
+	delete(m.corequisites, courseID)
+
+	for cID, corequisiteIDs := range m.corequisites {
+		m.corequisites[cID] = slices.DeleteFunc(corequisiteIDs, func(id string) bool {
+			return id == courseID
+		})
 	}
 }
 
-// removeEntityFromCourse is a helper method for removing a student or staff from a course.
-func (m *MockDatabase) removeEntityFromCourse(courseID, entityID string,
-	entityMap map[string][]string, courseMap map[string][]string, emptyErr error,
-) error {
-	// Validate inputs.
-	if err := m.validateRemoveEntityParams(courseID, entityID, emptyErr); err != nil {
-		return err
+// DeleteCourse removes a course from the mock database.
+func (m *MockDatabase) DeleteCourse(_ context.Context, courseID string) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
 	}
 
-	// Process entity removal.
-	if !m.removeEntityFromMap(courseID, entityID, entityMap) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.courses[courseID]; !exists {
 		return fmt.Errorf("%w", ErrCourseNotFound)
 	}
 
-	// Process course removal from entity's list.
-	m.removeCourseFromEntityMap(courseID, entityID, courseMap)
+	delete(m.courses, courseID)
+	m.students.removeCourse(courseID)
+	m.staff.removeCourse(courseID)
+	delete(m.announcements, courseID)
+	delete(m.bodies, courseID)
+	delete(m.quietPeriods, courseID)
+	delete(m.grading, courseID)
+	delete(m.features, courseID)
+	maps.DeleteFunc(m.apiKeys, func(_ string, key CourseAPIKey) bool {
+		return key.CourseID == courseID
+	})
+	m.removeCorequisite(courseID)
 
 	return nil
 }
 
-// AddStudentToCourse adds a student to a course in the mock database.
-func (m *MockDatabase) AddStudentToCourse(_ context.Context, courseID, studentID string) error {
+// ChangeCourseID moves a course of the mock database to a new ID together with everything that belongs to it.
+func (m *MockDatabase) ChangeCourseID(_ context.Context, oldID, newID string) error {
+	if oldID == "" || newID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	return m.addEntityToCourse(courseID, studentID, m.courseStudents, m.studentCourses, ErrStudentIDEmpty)
-}
+	course, exists := m.courses[oldID]
+	if !exists {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
 
-// RemoveStudentFromCourse removes a student from a course in the mock database.
-func (m *MockDatabase) RemoveStudentFromCourse(_ context.Context, courseID, studentID string) error {
-	m.mutex.Lock()
-	defer m.mutex.Unlock()
+	if _, taken := m.courses[newID]; taken {
+		return fmt.Errorf("%w: %s", ErrCourseAlreadyExists, newID)
+	}
 
-	return m.removeEntityFromCourse(courseID, studentID, m.courseStudents, m.studentCourses, ErrStudentIDEmpty)
-}
+	moved := *course
+	moved.CourseID = newID
+	moved.UpdatedAt = m.now()
+	m.courses[newID] = &moved
+	delete(m.courses, oldID)
 
-// AddStaffToCourse adds a staff member to a course in the mock database.
-func (m *MockDatabase) AddStaffToCourse(_ context.Context, courseID, staffID string) error {
-	m.mutex.Lock()
-	defer m.mutex.Unlock()
+	m.students.renameCourse(oldID, newID)
+	m.staff.renameCourse(oldID, newID)
 
-	return m.addEntityToCourse(courseID, staffID, m.courseStaff, m.staffCourses, ErrStaffIDEmpty)
-}
+	for _, staffID := range m.staff.members(newID) {
+		assignment, _ := m.staff.get(newID, staffID)
+		assignment.CourseID = newID
+		m.staff.set(newID, staffID, assignment)
+	}
 
-// RemoveStaffFromCourse removes a staff member from a course in the mock database.
-func (m *MockDatabase) RemoveStaffFromCourse(_ context.Context, courseID, staffID string) error {
-	m.mutex.Lock()
-	defer m.mutex.Unlock()
+	m.moveCourseData(oldID, newID)
 
-	return m.removeEntityFromCourse(courseID, staffID, m.courseStaff, m.staffCourses, ErrStaffIDEmpty)
+	return nil
 }
 
-// GetCourseStudents retrieves all students enrolled in a course from the mock database.
-func (m *MockDatabase) GetCourseStudents(_ context.Context, courseID string) ([]string, error) {
-	if courseID == "" {
-		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+// moveCourseData points everything else belonging to the course oldID at the course newID.
+func (m *MockDatabase) moveCourseData(oldID, newID string) {
+	for i := range m.announcements[oldID] {
+		m.announcements[oldID][i].CourseID = newID
 	}
 
-	m.mutex.RLock()
-	defer m.mutex.RUnlock()
+	for i := range m.quietPeriods[oldID] {
+		m.quietPeriods[oldID][i].CourseID = newID
+	}
 
-	// Check if course exists.
-	if _, exists := m.courses[courseID]; !exists {
-		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	for name, component := range m.grading[oldID] {
+		component.CourseID = newID
+		m.grading[oldID][name] = component
 	}
 
-	students, exists := m.courseStudents[courseID]
-	if !exists {
-		return []string{}, nil
+	moveKey(m.announcements, oldID, newID)
+	moveKey(m.bodies, oldID, newID)
+	moveKey(m.quietPeriods, oldID, newID)
+	moveKey(m.grading, oldID, newID)
+	moveKey(m.features, oldID, newID)
+	moveKey(m.corequisites, oldID, newID)
+
+	for keyID, key := range m.apiKeys {
+		if key.CourseID == oldID {
+			key.CourseID = newID
+			m.apiKeys[keyID] = key
+		}
 	}
 
-	// Return a copy to prevent modification of the original slice.
-	result := make([]string, len(students))
-	copy(result, students)
+	for _, corequisiteIDs := range m.corequisites {
+		if index := slices.Index(corequisiteIDs, oldID); index >= 0 {
+			corequisiteIDs[index] = newID
+		}
+	}
+}
 
-	return result, nil
+// moveKey moves the value of oldKey in a map to newKey, if there is one.
+func moveKey[V any](values map[string]V, oldKey, newKey string) {
+	if value, ok := values[oldKey]; ok {
+		values[newKey] = value
+		delete(values, oldKey)
+	}
 }
 
-// GetCourseStaff retrieves all staff members assigned to a course from the mock database.
-func (m *MockDatabase) GetCourseStaff(_ context.Context, courseID string) ([]string, error) {
+// checkMember validates the course and member of a roster change and checks that the course exists.
+func (m *MockDatabase) checkMember(courseID, memberID string, emptyErr error) error {
 	if courseID == "" {
-		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
 	}
 
-	m.mutex.RLock()
-	defer m.mutex.RUnlock()
+	if memberID == "" {
+		return fmt.Errorf("%w", emptyErr)
+	}
 
-	// Check if course exists.
 	if _, exists := m.courses[courseID]; !exists {
-		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+		return fmt.Errorf("%w", ErrCourseNotFound)
 	}
 
-	staff, exists := m.courseStaff[courseID]
+	return nil
+}
+
+// enroll enrolls a student in a course, re-enrolling them if they already are in it.
+func (m *MockDatabase) enroll(courseID, studentID string) {
+	enrollment, exists := m.students.get(courseID, studentID)
 	if !exists {
-		return []string{}, nil
+		enrollment.rows = 1
 	}
 
-	// Return a copy to prevent modification of the original slice.
-	result := make([]string, len(staff))
-	copy(result, staff)
-
-	return result, nil
+	enrollment.status = studentEnrolled
+	m.students.set(courseID, studentID, enrollment)
 }
 
-// GetStudentCourses retrieves all courses a student is enrolled in from the mock database.
-func (m *MockDatabase) GetStudentCourses(_ context.Context, studentID string) ([]string, error) {
-	if studentID == "" {
-		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+// freePlaces returns how many places of a course are free, or math.MaxInt if it has no capacity or
+// allowFull is set. The caller must hold the lock.
+func (m *MockDatabase) freePlaces(courseID string, allowFull bool) int {
+	course, exists := m.courses[courseID]
+	if allowFull || !exists || course.Capacity == 0 {
+		return math.MaxInt
 	}
 
-	m.mutex.RLock()
-	defer m.mutex.RUnlock()
+	enrolled := 0
 
-	courses, exists := m.studentCourses[studentID]
-	if !exists {
-		return []string{}, nil
+	for _, studentID := range m.students.members(courseID) {
+		if m.isEnrolled(courseID, studentID) {
+			enrolled++
+		}
 	}
 
-	// Return a copy to prevent modification of the original slice.
-	result := make([]string, len(courses))
-	copy(result, courses)
-
-	return result, nil
+	return max(int(course.Capacity)-enrolled, 0)
 }
 
-// GetStaffCourses retrieves all courses a staff member is assigned to from the mock database.
-func (m *MockDatabase) GetStaffCourses(_ context.Context, staffID string) ([]string, error) {
-	if staffID == "" {
-		return nil, fmt.Errorf("%w", ErrStaffIDEmpty)
-	}
+// isEnrolled reports whether a student is currently enrolled in a course.
+func (m *MockDatabase) isEnrolled(courseID, studentID string) bool {
+	enrollment, exists := m.students.get(courseID, studentID)
+
+	return exists && enrollment.status == studentEnrolled
+}
 
+// checkInvariants verifies that the rosters are consistent and only list courses that exist.
+func (m *MockDatabase) checkInvariants() error {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
 
-	courses, exists := m.staffCourses[staffID]
-	if !exists {
-		return []string{}, nil
+	if err := m.students.checkInvariants(); err != nil {
+		return fmt.Errorf("students: %w", err)
 	}
 
-	// Return a copy to prevent modification of the original slice
-	result := make([]string, len(courses))
-	copy(result, courses)
-
-	return result, nil
-}
-
-func (m *MockDatabase) GetCoursesBySemester(_ context.Context, semester string) ([]*Course, error) {
-	if semester == "" {
-		return nil, fmt.Errorf("%w", ErrSemesterEmpty)
+	if err := m.staff.checkInvariants(); err != nil {
+		return fmt.Errorf("staff: %w", err)
 	}
 
-	m.mutex.RLock()
-	defer m.mutex.RUnlock()
-
-	var courses []*Course
-	for _, course := range m.courses {
-		if course.Semester == semester {
-			courses = append(courses, course)
+	for _, index := range []map[string][]string{m.students.byCourse, m.staff.byCourse} {
+		for courseID := range index {
+			if _, exists := m.courses[courseID]; !exists {
+				return fmt.Errorf("%w: members of deleted course %q", ErrRosterInconsistent, courseID)
+			}
 		}
 	}
 
-	return courses, nil
+	return nil
 }
 
-// AddAnnouncement adds an announcement to a course in the mock database.
-func (m *MockDatabase) AddAnnouncement(_ context.Context, req *cpb.AddAnnouncementRequest) error {
-	if req.GetCourseID() == "" || req.GetAnnouncement().GetAnnouncementContent() == "" {
-		return fmt.Errorf("%w", ErrCourseIDEmpty)
-	}
-
+// AddStudentToCourse adds a student to a course in the mock database.
+func (m *MockDatabase) AddStudentToCourse(_ context.Context, courseID, studentID string, allowStaff, allowFull bool,
+) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	// Check if course exists.
-	if _, exists := m.courses[req.GetCourseID()]; !exists {
-		return fmt.Errorf("%w", ErrCourseNotFound)
+	if !allowStaff && m.staff.has(courseID, studentID) {
+		return fmt.Errorf("%w: %s", ErrConflictingRole, studentID)
 	}
 
-	announcement := Announcement{
-		CourseID:       req.GetCourseID(),
-		AnnouncementID: req.GetAnnouncement().GetAnnouncementID(),
-		Title:          req.GetAnnouncement().GetAnnouncementTitle(),
-		Content:        req.GetAnnouncement().GetAnnouncementContent(),
+	if err := m.checkMember(courseID, studentID, ErrStudentIDEmpty); err != nil {
+		return err
 	}
 
-	if _, exists := m.announcements[req.GetCourseID()]; !exists {
-		m.announcements[req.GetCourseID()] = make([]Announcement, 0)
+	// Enrolling an enrolled student again takes no place.
+	if m.freePlaces(courseID, allowFull) == 0 && !m.isEnrolled(courseID, studentID) {
+		return fmt.Errorf("%w: %s", ErrCapacityExceeded, courseID)
 	}
 
-	m.announcements[req.GetCourseID()] = append(m.announcements[req.GetCourseID()], announcement)
+	m.enroll(courseID, studentID)
 
 	return nil
 }
 
-// GetAnnouncements retrieves all announcements for a course from the mock database.
-func (m *MockDatabase) GetAnnouncements(_ context.Context, courseID string) ([]Announcement, error) {
+// AddStudentsToCourse enrolls many students in a course in the mock database, skipping students
+// already in the course, unless allowStaff is set its staff, and unless allowFull is set those left
+// without a place.
+func (m *MockDatabase) AddStudentsToCourse(_ context.Context, courseID string, studentIDs []string,
+	allowStaff, allowFull bool,
+) ([]BatchEnrollment, error) {
 	if courseID == "" {
 		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
 	}
 
-	m.mutex.RLock()
-	defer m.mutex.RUnlock()
+	if slices.Contains(studentIDs, "") {
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 
-	// Check if course exists.
 	if _, exists := m.courses[courseID]; !exists {
 		return nil, fmt.Errorf("%w", ErrCourseNotFound)
 	}
 
-	announcements, exists := m.announcements[courseID]
-	if !exists {
-		return []Announcement{}, nil
+	var staff []string
+	if !allowStaff {
+		staff = m.staff.members(courseID)
 	}
 
-	// Return a copy to prevent modification of the original slice.
-	result := make([]Announcement, len(announcements))
-	copy(result, announcements)
+	results, inserted := planBatchEnrollment(studentIDs, m.students.members(courseID), staff,
+		m.freePlaces(courseID, allowFull))
+	for _, studentID := range inserted {
+		m.enroll(courseID, studentID)
+	}
 
-	return result, nil
+	return results, nil
 }
 
-// RemoveAnnouncement removes an announcement from a course in the mock database.
-func (m *MockDatabase) RemoveAnnouncement(_ context.Context, courseID, announcementID string) error {
+// RemoveStudentFromCourse removes a student from a course in the mock database.
+func (m *MockDatabase) RemoveStudentFromCourse(_ context.Context, courseID, studentID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if err := m.checkMember(courseID, studentID, ErrStudentIDEmpty); err != nil {
+		return err
+	}
+
+	if !m.students.remove(courseID, studentID) {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	return nil
+}
+
+// SetStudentStatus sets the status of a student in a course in the mock database.
+func (m *MockDatabase) SetStudentStatus(_ context.Context, courseID, studentID, status string) error {
 	if courseID == "" {
 		return fmt.Errorf("%w", ErrCourseIDEmpty)
 	}
 
-	if announcementID == "" {
-		return fmt.Errorf("%w", ErrAnnouncementEmpty)
+	if studentID == "" {
+		return fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	if err := validateStudentStatus(status); err != nil {
+		return err
 	}
 
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	// Check if course exists.
-	if _, exists := m.courses[courseID]; !exists {
+	enrollment, enrolled := m.students.get(courseID, studentID)
+	if !enrolled {
 		return fmt.Errorf("%w", ErrCourseNotFound)
 	}
 
-	// Remove announcement from course.
-	found := false
+	if err := checkStatusTransition(enrollment.status, status); err != nil {
+		return err
+	}
 
-	if announcements, exists := m.announcements[courseID]; exists {
-		updatedAnnouncements := make([]Announcement, 0)
+	enrollment.status = status
+	m.students.set(courseID, studentID, enrollment)
 
-		for _, a := range announcements {
-			if a.AnnouncementID != announcementID {
-				updatedAnnouncements = append(updatedAnnouncements, a)
-			} else {
-				found = true
-			}
-		}
+	return nil
+}
 
-		m.announcements[courseID] = updatedAnnouncements
+// AddStaffToCourse adds a staff member to a course in the mock database.
+func (m *MockDatabase) AddStaffToCourse(_ context.Context, courseID, staffID string,
+	validFrom, validUntil time.Time, allowStudent bool,
+) error {
+	if err := validateTimeRange(validFrom, validUntil, ErrInvalidAccess); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !allowStudent && m.students.has(courseID, staffID) {
+		return fmt.Errorf("%w: %s", ErrConflictingRole, staffID)
+	}
+
+	if err := m.checkMember(courseID, staffID, ErrStaffIDEmpty); err != nil {
+		return err
+	}
+
+	member := CourseStaff{
+		CourseID:   courseID,
+		StaffID:    staffID,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}
+
+	// A new end of the assignment lapses anew.
+	if previous, exists := m.staff.get(courseID, staffID); exists && previous.ValidUntil.Equal(validUntil) {
+		member.LapseReportedAt = previous.LapseReportedAt
+	}
+
+	m.staff.set(courseID, staffID, member)
+
+	return nil
+}
+
+// RemoveStaffFromCourse removes a staff member from a course in the mock database.
+func (m *MockDatabase) RemoveStaffFromCourse(_ context.Context, courseID, staffID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if err := m.checkMember(courseID, staffID, ErrStaffIDEmpty); err != nil {
+		return err
+	}
+
+	if !m.staff.remove(courseID, staffID) {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	return nil
+}
+
+// GetCourseStudents retrieves the students of a course from the mock database.
+// Students who are no longer enrolled are only included when includeInactive is set.
+func (m *MockDatabase) GetCourseStudents(_ context.Context, courseID string, includeInactive bool) ([]string, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	// Check if course exists.
+	if _, exists := m.courses[courseID]; !exists {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	students := m.students.members(courseID)
+	if !includeInactive {
+		students = slices.DeleteFunc(students, func(studentID string) bool {
+			enrollment, _ := m.students.get(courseID, studentID)
+
+			return enrollment.status != studentEnrolled
+		})
+	}
+
+	if students == nil {
+		return []string{}, nil
+	}
+
+	return students, nil
+}
+
+// CountCourseStudents counts the students currently enrolled in a course in the mock database.
+func (m *MockDatabase) CountCourseStudents(ctx context.Context, courseID string) (int, error) {
+	students, err := m.GetCourseStudents(ctx, courseID, false)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(students), nil
+}
+
+// GetCourseStaff retrieves the staff members assigned to a course from the mock database.
+func (m *MockDatabase) GetCourseStaff(_ context.Context, courseID string, includeExpired bool) ([]string, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	// Check if course exists.
+	if _, exists := m.courses[courseID]; !exists {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	staff := m.staff.members(courseID)
+	if !includeExpired {
+		staff = slices.DeleteFunc(staff, func(staffID string) bool {
+			member, _ := m.staff.get(courseID, staffID)
+
+			return staffAccessExpired(member.ValidUntil, m.now())
+		})
+	}
+
+	if staff == nil {
+		return []string{}, nil
+	}
+
+	return staff, nil
+}
+
+// GetCourseStaffPage retrieves a page of the staff members of a course from the mock database.
+func (m *MockDatabase) GetCourseStaffPage(ctx context.Context, courseID string, includeExpired bool,
+	page Page,
+) (*StaffPage, error) {
+	staffIDs, err := m.GetCourseStaff(ctx, courseID, includeExpired)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(staffIDs)
+
+	return &StaffPage{StaffIDs: paginate(staffIDs, page), Total: len(staffIDs)}, nil
+}
+
+// GetStudentCourses retrieves all courses a student is enrolled in from the mock database.
+func (m *MockDatabase) GetStudentCourses(_ context.Context, studentID, semester string) ([]string, error) {
+	if studentID == "" {
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.coursesInSemester(m.students.courses(studentID), semester), nil
+}
+
+// IsKnownStudent reports whether a student is or was in any course of the mock database.
+func (m *MockDatabase) IsKnownStudent(_ context.Context, studentID string) (bool, error) {
+	if studentID == "" {
+		return false, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return len(m.students.courses(studentID)) > 0, nil
+}
+
+// coursesInSemester returns a copy of the course IDs, keeping only the courses of the given
+// semester if it is set.
+func (m *MockDatabase) coursesInSemester(courseIDs []string, semester string) []string {
+	result := make([]string, 0, len(courseIDs))
+
+	for _, courseID := range courseIDs {
+		if course, exists := m.courses[courseID]; semester == "" || exists && course.Semester == semester {
+			result = append(result, courseID)
+		}
+	}
+
+	return result
+}
+
+// GetEnrollmentsForStudent retrieves every enrollment of a student, ordered by semester,
+// from the mock database.
+func (m *MockDatabase) GetEnrollmentsForStudent(_ context.Context, studentID string) ([]Enrollment, error) {
+	if studentID == "" {
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	enrollments := []Enrollment{}
+
+	for _, courseID := range m.students.courses(studentID) {
+		if course, exists := m.courses[courseID]; exists {
+			enrollment, _ := m.students.get(courseID, studentID)
+			enrollments = append(enrollments, Enrollment{Course: *course, Status: enrollment.status})
+		}
+	}
+
+	slices.SortFunc(enrollments, func(enrollmentA, enrollmentB Enrollment) int {
+		return cmp.Or(
+			compareSemesters(enrollmentA.Semester, enrollmentB.Semester),
+			strings.Compare(enrollmentA.CourseID, enrollmentB.CourseID),
+		)
+	})
+
+	return enrollments, nil
+}
+
+// CountSemesterEnrollments counts the other courses a student is enrolled in during the
+// semester of the given course in the mock database.
+func (m *MockDatabase) CountSemesterEnrollments(_ context.Context, courseID, studentID string) (int, error) {
+	if courseID == "" {
+		return 0, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if studentID == "" {
+		return 0, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	course, exists := m.courses[courseID]
+	if !exists {
+		return 0, nil
+	}
+
+	count := 0
+
+	for _, cID := range m.students.courses(studentID) {
+		other, exists := m.courses[cID]
+		if exists && cID != courseID && other.Semester == course.Semester && m.isEnrolled(cID, studentID) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// GetStudentCredits sums the credits of the courses a student is enrolled in during a semester
+// in the mock database.
+func (m *MockDatabase) GetStudentCredits(_ context.Context, studentID, semester string) (int64, error) {
+	if studentID == "" {
+		return 0, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	if semester == "" {
+		return 0, fmt.Errorf("%w", ErrSemesterEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var credits int64
+
+	for _, courseID := range m.students.courses(studentID) {
+		course, exists := m.courses[courseID]
+		if exists && course.Semester == semester && m.isEnrolled(courseID, studentID) {
+			credits += int64(course.Credits)
+		}
+	}
+
+	return credits, nil
+}
+
+// MissingCorequisites retrieves the co-requisites of a course the student is not enrolled in
+// from the mock database.
+func (m *MockDatabase) MissingCorequisites(_ context.Context, courseID, studentID string) ([]string, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if studentID == "" {
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	missingIDs := []string{}
+
+	for _, corequisiteID := range m.corequisites[courseID] {
+		if !m.isEnrolled(corequisiteID, studentID) {
+			missingIDs = append(missingIDs, corequisiteID)
+		}
+	}
+
+	slices.Sort(missingIDs)
+
+	return missingIDs, nil
+}
+
+// GetSharedCourses retrieves the courses a staff member currently staffs and a student is
+// enrolled in from the mock database, ordered by course ID.
+func (m *MockDatabase) GetSharedCourses(_ context.Context, staffID, studentID string) ([]string, error) {
+	if staffID == "" {
+		return nil, fmt.Errorf("%w", ErrStaffIDEmpty)
+	}
+
+	if studentID == "" {
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	courseIDs := []string{}
+
+	for _, courseID := range m.staff.courses(staffID) {
+		if member, _ := m.staff.get(courseID, staffID); staffAccessExpired(member.ValidUntil, m.now()) {
+			continue
+		}
+
+		if m.isEnrolled(courseID, studentID) {
+			courseIDs = append(courseIDs, courseID)
+		}
+	}
+
+	slices.Sort(courseIDs)
+
+	return courseIDs, nil
+}
+
+// TransferEnrollments enrolls the students enrolled in fromCourseID in toCourseID in the mock database,
+// skipping those already in it.
+func (m *MockDatabase) TransferEnrollments(_ context.Context, fromCourseID, toCourseID string,
+) (*EnrollmentTransfer, error) {
+	if fromCourseID == "" || toCourseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	destination, destinationFound := m.courses[toCourseID]
+	if _, sourceFound := m.courses[fromCourseID]; !sourceFound || !destinationFound {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	studentIDs, skipped, err := planEnrollmentTransfer(m.courseEnrollments(fromCourseID),
+		m.courseEnrollments(toCourseID), destination.Capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, studentID := range studentIDs {
+		m.enroll(toCourseID, studentID)
+	}
+
+	return &EnrollmentTransfer{Transferred: len(studentIDs), Skipped: skipped}, nil
+}
+
+// FindDuplicateEnrollments lists the students listed more than once in a course in the mock database.
+func (m *MockDatabase) FindDuplicateEnrollments(_ context.Context) ([]DuplicateEnrollment, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	duplicates := []DuplicateEnrollment{}
+
+	for pair, enrollment := range m.students.pairs {
+		if enrollment.rows > 1 {
+			duplicates = append(duplicates, DuplicateEnrollment{
+				CourseID: pair.courseID, StudentID: pair.memberID, Count: enrollment.rows,
+			})
+		}
+	}
+
+	slices.SortFunc(duplicates, func(left, right DuplicateEnrollment) int {
+		return cmp.Or(strings.Compare(left.CourseID, right.CourseID), strings.Compare(left.StudentID, right.StudentID))
+	})
+
+	return duplicates, nil
+}
+
+// DeduplicateEnrollments removes the repeated listings of students in a course in the mock database.
+func (m *MockDatabase) DeduplicateEnrollments(_ context.Context) (int, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	removed := 0
+
+	for pair, enrollment := range m.students.pairs {
+		if enrollment.rows > 1 {
+			removed += enrollment.rows - 1
+			enrollment.rows = 1
+			m.students.pairs[pair] = enrollment
+		}
+	}
+
+	return removed, nil
+}
+
+// GetDataShape reports how much data there is in the mock database.
+func (m *MockDatabase) GetDataShape(_ context.Context) (*DataShape, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	shape := &DataShape{GeneratedAt: m.now(), CoursesPerSemester: make(map[string]int)}
+	enrollments := make([]int, 0, len(m.courses))
+	announcements := make([]int, 0, len(m.courses))
+	enrollmentRows := 0
+
+	for courseID, course := range m.courses {
+		shape.CoursesPerSemester[course.Semester]++
+
+		courseRows := 0
+
+		for _, studentID := range m.students.byCourse[courseID] {
+			enrollment, _ := m.students.get(courseID, studentID)
+			courseRows += enrollment.rows
+		}
+
+		enrollmentRows += courseRows
+		enrollments = append(enrollments, courseRows)
+		announcements = append(announcements, len(m.announcements[courseID]))
+	}
+
+	shape.EnrollmentsPerCourse = distributionOf(enrollments)
+	shape.AnnouncementsPerCourse = distributionOf(announcements)
+	shape.TableRows = map[string]int{
+		"announcements":   sumInts(announcements),
+		"course_staffs":   len(m.staff.pairs),
+		"course_students": enrollmentRows,
+		"courses":         len(m.courses),
+	}
+
+	return shape, nil
+}
+
+// GetCourseSnapshot reads a course with everything attached to it from the mock database under a
+// single lock, so that no writer changes the course while it is read.
+func (m *MockDatabase) GetCourseSnapshot(_ context.Context, courseID string) (*CourseSnapshot, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	course, exists := m.courses[courseID]
+	if !exists {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	snapshot := &CourseSnapshot{
+		TakenAt:       m.now(),
+		Course:        *course,
+		Students:      m.courseEnrollments(courseID),
+		Staff:         make([]CourseStaff, 0, len(m.staff.byCourse[courseID])),
+		Announcements: slices.Clone(m.announcements[courseID]),
+		QuietPeriods:  slices.Clone(m.quietPeriods[courseID]),
+	}
+
+	for _, staffID := range m.staff.members(courseID) {
+		member, _ := m.staff.get(courseID, staffID)
+		snapshot.Staff = append(snapshot.Staff, member)
+	}
+
+	for i, announcement := range snapshot.Announcements {
+		if announcement.HasFullBody {
+			snapshot.Announcements[i].Content = m.bodies[courseID][announcement.AnnouncementID]
+		}
+	}
+
+	slices.SortFunc(snapshot.Students, func(studentA, studentB CourseStudent) int {
+		return strings.Compare(studentA.StudentID, studentB.StudentID)
+	})
+	slices.SortFunc(snapshot.Staff, func(memberA, memberB CourseStaff) int {
+		return strings.Compare(memberA.StaffID, memberB.StaffID)
+	})
+	slices.SortFunc(snapshot.Announcements, compareNewestFirst)
+	slices.SortFunc(snapshot.QuietPeriods, func(periodA, periodB QuietPeriod) int {
+		return periodA.StartsAt.Compare(periodB.StartsAt)
+	})
+
+	return snapshot, nil
+}
+
+// Ping always succeeds, since the mock database has no connection to lose.
+func (m *MockDatabase) Ping(_ context.Context) error {
+	return nil
+}
+
+// sumInts returns the sum of values.
+func sumInts(values []int) int {
+	sum := 0
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum
+}
+
+// courseEnrollments returns the students of a course with their status.
+func (m *MockDatabase) courseEnrollments(courseID string) []CourseStudent {
+	studentIDs := m.students.members(courseID)
+
+	students := make([]CourseStudent, 0, len(studentIDs))
+	for _, studentID := range studentIDs {
+		enrollment, _ := m.students.get(courseID, studentID)
+		students = append(students, CourseStudent{
+			CourseID:  courseID,
+			StudentID: studentID,
+			Status:    enrollment.status,
+		})
+	}
+
+	return students
+}
+
+// GetEnrollmentDifference compares the students of two courses in the mock database.
+func (m *MockDatabase) GetEnrollmentDifference(_ context.Context, courseIDA, courseIDB string,
+	page Page,
+) (*EnrollmentDifference, error) {
+	if courseIDA == "" || courseIDB == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for _, courseID := range []string{courseIDA, courseIDB} {
+		if _, exists := m.courses[courseID]; !exists {
+			return nil, fmt.Errorf("%w", ErrCourseNotFound)
+		}
+	}
+
+	inB := make(map[string]bool)
+	for _, studentID := range m.students.members(courseIDB) {
+		inB[studentID] = true
+	}
+
+	difference := &EnrollmentDifference{OnlyInA: []string{}, OnlyInB: []string{}, InBoth: []string{}}
+
+	for _, studentID := range m.students.members(courseIDA) {
+		if inB[studentID] {
+			difference.InBoth = append(difference.InBoth, studentID)
+			delete(inB, studentID)
+		} else {
+			difference.OnlyInA = append(difference.OnlyInA, studentID)
+		}
+	}
+
+	for studentID := range inB {
+		difference.OnlyInB = append(difference.OnlyInB, studentID)
+	}
+
+	for _, set := range []*[]string{&difference.OnlyInA, &difference.OnlyInB, &difference.InBoth} {
+		slices.Sort(*set)
+		*set = paginate(*set, page)
+	}
+
+	return difference, nil
+}
+
+// GetStaffCourses retrieves all courses a staff member is assigned to from the mock database.
+func (m *MockDatabase) GetStaffCourses(_ context.Context, staffID, semester string) ([]string, error) {
+	if staffID == "" {
+		return nil, fmt.Errorf("%w", ErrStaffIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.coursesInSemester(m.staff.courses(staffID), semester), nil
+}
+
+// IsKnownStaff reports whether a staff member is or was assigned to any course of the mock database.
+func (m *MockDatabase) IsKnownStaff(_ context.Context, staffID string) (bool, error) {
+	if staffID == "" {
+		return false, fmt.Errorf("%w", ErrStaffIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return len(m.staff.courses(staffID)) > 0, nil
+}
+
+// ListCoursesWithoutStaff retrieves the courses of a semester that have no staff assigned
+// from the mock database.
+func (m *MockDatabase) ListCoursesWithoutStaff(_ context.Context, semester string) ([]*Course, error) {
+	if semester == "" {
+		return nil, fmt.Errorf("%w", ErrSemesterEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	courses := []*Course{}
+
+	for courseID, course := range m.courses {
+		if course.Semester != semester {
+			continue
+		}
+
+		staffed := slices.ContainsFunc(m.staff.members(courseID), func(staffID string) bool {
+			member, _ := m.staff.get(courseID, staffID)
+
+			return staffAccessActive(member, m.now())
+		})
+		if !staffed {
+			courses = append(courses, course)
+		}
+	}
+
+	slices.SortFunc(courses, func(courseA, courseB *Course) int {
+		return strings.Compare(courseA.CourseID, courseB.CourseID)
+	})
+
+	return courses, nil
+}
+
+// GetLapsedStaff retrieves the staff assignments that lapsed by now and whose lapse was not
+// reported yet from the mock database, in the order they lapsed.
+func (m *MockDatabase) GetLapsedStaff(_ context.Context, now time.Time) ([]CourseStaff, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	staff := []CourseStaff{}
+
+	for courseID := range m.courses {
+		for _, staffID := range m.staff.members(courseID) {
+			member, _ := m.staff.get(courseID, staffID)
+			if staffAccessExpired(member.ValidUntil, now) && member.LapseReportedAt.IsZero() {
+				staff = append(staff, member)
+			}
+		}
+	}
+
+	slices.SortFunc(staff, func(staffA, staffB CourseStaff) int {
+		return cmp.Or(
+			staffA.ValidUntil.Compare(staffB.ValidUntil),
+			strings.Compare(staffA.CourseID, staffB.CourseID),
+			strings.Compare(staffA.StaffID, staffB.StaffID),
+		)
+	})
+
+	return staff, nil
+}
+
+// MarkStaffLapseReported records that the lapse of a staff assignment was reported in the mock
+// database. An assignment extended since it was read is left alone.
+func (m *MockDatabase) MarkStaffLapseReported(_ context.Context, staff CourseStaff, reportedAt time.Time) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	member, exists := m.staff.get(staff.CourseID, staff.StaffID)
+	if exists && member.ValidUntil.Equal(staff.ValidUntil) {
+		member.LapseReportedAt = reportedAt
+		m.staff.set(staff.CourseID, staff.StaffID, member)
+	}
+
+	return nil
+}
+
+// ListCourses lists the courses selected by the filter in the mock database.
+func (m *MockDatabase) ListCourses(_ context.Context, filter CourseFilter, page Page) ([]*Course, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	courses := []*Course{}
+
+	for _, course := range m.courses {
+		if filter.matches(course) {
+			courses = append(courses, course)
+		}
+	}
+
+	slices.SortFunc(courses, func(courseA, courseB *Course) int {
+		return strings.Compare(courseA.CourseID, courseB.CourseID)
+	})
+
+	return paginate(courses, page), nil
+}
+
+// GetCoursesByMetadata retrieves the courses whose custom field key is set to value from the mock database.
+func (m *MockDatabase) GetCoursesByMetadata(_ context.Context, key, value string, page Page) ([]*Course, error) {
+	if key == "" {
+		return nil, fmt.Errorf("%w: key is empty", ErrInvalidMetadata)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	courses := []*Course{}
+
+	for _, course := range m.courses {
+		if field, ok := course.Metadata[key]; ok && field == value {
+			courses = append(courses, course)
+		}
+	}
+
+	slices.SortFunc(courses, func(courseA, courseB *Course) int {
+		return strings.Compare(courseA.CourseID, courseB.CourseID)
+	})
+
+	return paginate(courses, page), nil
+}
+
+func (m *MockDatabase) GetCoursesBySemester(_ context.Context, semester string, limit int) ([]*Course, error) {
+	if semester == "" {
+		return nil, fmt.Errorf("%w", ErrSemesterEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var courses []*Course
+	for _, course := range m.courses {
+		if course.Semester == semester {
+			courses = append(courses, course)
+		}
+	}
+
+	slices.SortFunc(courses, func(courseA, courseB *Course) int {
+		return strings.Compare(courseA.CourseID, courseB.CourseID)
+	})
+
+	if limit > 0 && len(courses) > limit {
+		courses = courses[:limit]
+	}
+
+	return courses, nil
+}
+
+// AddAnnouncement adds an announcement to a course in the mock database, storing content longer
+// than excerptLength bytes apart like the real database.
+func (m *MockDatabase) AddAnnouncement(_ context.Context, req *cpb.AddAnnouncementRequest,
+	excerptLength int,
+) (*Announcement, error) {
+	if req.GetCourseID() == "" || req.GetAnnouncement().GetAnnouncementContent() == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.addAnnouncement(req, excerptLength)
+}
+
+// addAnnouncement performs AddAnnouncement. The caller must hold the write lock.
+func (m *MockDatabase) addAnnouncement(req *cpb.AddAnnouncementRequest, excerptLength int) (*Announcement, error) {
+	// Check if course exists.
+	course, exists := m.courses[req.GetCourseID()]
+	if !exists {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	if !course.AnnouncementsEnabled {
+		return nil, fmt.Errorf("%w: %s", ErrAnnouncementsDisabled, req.GetCourseID())
+	}
+
+	if err := checkQuietPeriods(req.GetCourseID(), m.quietPeriods[req.GetCourseID()], m.now(),
+		req.GetUrgent()); err != nil {
+		return nil, err
+	}
+
+	announcement := newAnnouncement(req, m.now(), excerptLength)
+	for _, existing := range m.announcements[req.GetCourseID()] {
+		if existing.AnnouncementID == announcement.AnnouncementID {
+			return nil, fmt.Errorf("%w: %s", ErrAnnouncementExists, announcement.AnnouncementID)
+		}
+
+		if announcement.Slug != "" && existing.Slug == announcement.Slug {
+			return nil, fmt.Errorf("%w: slug %s", ErrAnnouncementExists, announcement.Slug)
+		}
+	}
+
+	if announcement.HasFullBody {
+		m.setBody(req.GetCourseID(), announcement.AnnouncementID, req.GetAnnouncement().GetAnnouncementContent())
+	}
+
+	if _, exists := m.announcements[req.GetCourseID()]; !exists {
+		m.announcements[req.GetCourseID()] = make([]Announcement, 0)
+	}
+
+	m.announcements[req.GetCourseID()] = append(m.announcements[req.GetCourseID()], announcement)
+
+	return &announcement, nil
+}
+
+// setBody stores the full content of a split announcement. The caller must hold the write lock.
+func (m *MockDatabase) setBody(courseID, announcementID, content string) {
+	if m.bodies[courseID] == nil {
+		m.bodies[courseID] = make(map[string]string)
+	}
+
+	m.bodies[courseID][announcementID] = content
+}
+
+// GetAnnouncement retrieves an announcement of a course with its full content from the mock database,
+// if the filter selects it.
+func (m *MockDatabase) GetAnnouncement(_ context.Context, courseID, announcementID string,
+	filter AnnouncementFilter,
+) (*Announcement, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if announcementID == "" {
+		return nil, fmt.Errorf("%w", ErrAnnouncementEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for _, announcement := range m.announcements[courseID] {
+		if announcement.AnnouncementID != announcementID || !filter.matches(announcement) {
+			continue
+		}
+
+		if announcement.HasFullBody {
+			announcement.Content = m.bodies[courseID][announcementID]
+		}
+
+		return &announcement, nil
+	}
+
+	return nil, fmt.Errorf("%w", ErrAnnouncementNotFound)
+}
+
+// GetAnnouncements retrieves a page of the announcements of a course selected by the filter from the mock
+// database, newest first.
+func (m *MockDatabase) GetAnnouncements(_ context.Context, courseID string,
+	filter AnnouncementFilter, page Page,
+) ([]Announcement, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	// Check if course exists.
+	if _, exists := m.courses[courseID]; !exists {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	// Return a copy to prevent modification of the original slice.
+	result := make([]Announcement, 0, len(m.announcements[courseID]))
+
+	for _, announcement := range m.announcements[courseID] {
+		if filter.matches(announcement) {
+			result = append(result, announcement)
+		}
+	}
+
+	slices.SortFunc(result, compareNewestFirst)
+
+	return paginate(result, page), nil
+}
+
+// SummarizeAnnouncements counts the announcements of a course selected by the filter in the mock
+// database and finds their latest update.
+func (m *MockDatabase) SummarizeAnnouncements(ctx context.Context, courseID string,
+	filter AnnouncementFilter,
+) (*AnnouncementStats, error) {
+	announcements, err := m.GetAnnouncements(ctx, courseID, filter, Page{})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &AnnouncementStats{Count: len(announcements)}
+	for _, announcement := range announcements {
+		if announcement.UpdatedAt.After(stats.LastUpdatedAt) {
+			stats.LastUpdatedAt = announcement.UpdatedAt
+		}
+	}
+
+	return stats, nil
+}
+
+// GetAnnouncementCountByAuthor counts the announcements each author posted to a course in the mock
+// database. Announcements without an author are not counted.
+func (m *MockDatabase) GetAnnouncementCountByAuthor(_ context.Context, courseID string) (map[string]int, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	counts := make(map[string]int)
+
+	for _, announcement := range m.announcements[courseID] {
+		if announcement.Author != "" {
+			counts[announcement.Author]++
+		}
+	}
+
+	return counts, nil
+}
+
+// GetAnnouncementGroups groups the announcements of a course in the mock database by the
+// week or month they were posted in.
+func (m *MockDatabase) GetAnnouncementGroups(_ context.Context, courseID string, filter AnnouncementFilter,
+	grouping cpb.AnnouncementGrouping, loc *time.Location, page Page,
+) ([]AnnouncementGroup, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if _, exists := m.courses[courseID]; !exists {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	groupsByStart := make(map[int64]*AnnouncementGroup)
+
+	for _, announcement := range m.announcements[courseID] {
+		if !filter.matches(announcement) {
+			continue
+		}
+
+		start := groupStart(announcement.CreatedAt, grouping, loc)
+
+		group, exists := groupsByStart[start.Unix()]
+		if !exists {
+			group = &AnnouncementGroup{StartsAt: start, EndsAt: groupEnd(start, grouping), Newest: announcement}
+			groupsByStart[start.Unix()] = group
+		}
+
+		group.Count++
+
+		if compareNewestFirst(announcement, group.Newest) < 0 {
+			group.Newest = announcement
+		}
+	}
+
+	groups := make([]AnnouncementGroup, 0, len(groupsByStart))
+	for _, group := range groupsByStart {
+		groups = append(groups, *group)
+	}
+
+	slices.SortFunc(groups, func(left, right AnnouncementGroup) int {
+		return right.StartsAt.Compare(left.StartsAt)
+	})
+
+	return paginate(groups, page), nil
+}
+
+// RemoveAnnouncement removes an announcement from a course in the mock database.
+func (m *MockDatabase) RemoveAnnouncement(_ context.Context, courseID, announcementID string) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if announcementID == "" {
+		return fmt.Errorf("%w", ErrAnnouncementEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	// Check if course exists.
+	if _, exists := m.courses[courseID]; !exists {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	// Remove announcement from course.
+	found := false
+
+	if announcements, exists := m.announcements[courseID]; exists {
+		updatedAnnouncements := make([]Announcement, 0)
+
+		for _, a := range announcements {
+			if a.AnnouncementID != announcementID {
+				updatedAnnouncements = append(updatedAnnouncements, a)
+			} else {
+				found = true
+			}
+		}
+
+		m.announcements[courseID] = updatedAnnouncements
+	}
+
+	delete(m.bodies[courseID], announcementID)
+
+	if !found {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	return nil
+}
+
+// UpdateAnnouncement updates the title, content and visibility of an announcement of a course in the
+// mock database and returns it. An empty title or content, or a nil visibility, leaves the field unchanged.
+func (m *MockDatabase) UpdateAnnouncement(_ context.Context, courseID, announcementID, title, content string,
+	visibility *cpb.AnnouncementVisibility, excerptLength int,
+) (*Announcement, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if announcementID == "" {
+		return nil, fmt.Errorf("%w", ErrAnnouncementEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	index := slices.IndexFunc(m.announcements[courseID], func(announcement Announcement) bool {
+		return announcement.AnnouncementID == announcementID
+	})
+	if index < 0 {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	if visibility != nil {
+		m.announcements[courseID][index].Visibility = visibility.String()
+	}
+
+	return m.updateAnnouncement(courseID, index, title, content, excerptLength), nil
+}
+
+// updateAnnouncement applies a new title and content to the announcement at index in a course,
+// returning a copy of it. The caller must hold the write lock.
+func (m *MockDatabase) updateAnnouncement(courseID string, index int, title, content string,
+	excerptLength int,
+) *Announcement {
+	announcement := &m.announcements[courseID][index]
+	if title != "" {
+		announcement.Title = title
+	}
+
+	if content != "" {
+		announcement.Content, announcement.HasFullBody = splitAnnouncementContent(content, excerptLength)
+
+		delete(m.bodies[courseID], announcement.AnnouncementID)
+
+		if announcement.HasFullBody {
+			m.setBody(courseID, announcement.AnnouncementID, content)
+		}
+	}
+
+	announcement.UpdatedAt = m.now()
+	updated := *announcement
+
+	return &updated
+}
+
+// UpsertAnnouncementBySlug updates or adds the announcement of a course with a given slug in the mock database.
+func (m *MockDatabase) UpsertAnnouncementBySlug(_ context.Context, req *cpb.AddAnnouncementRequest,
+	excerptLength int,
+) (*Announcement, bool, error) {
+	if req.GetCourseID() == "" || req.GetAnnouncement().GetAnnouncementContent() == "" {
+		return nil, false, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	slug := req.GetAnnouncement().GetSlug()
+	if slug == "" {
+		return nil, false, fmt.Errorf("%w", ErrSlugEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	index := slices.IndexFunc(m.announcements[req.GetCourseID()], func(announcement Announcement) bool {
+		return announcement.Slug == slug
+	})
+	if index >= 0 {
+		return m.updateAnnouncement(req.GetCourseID(), index, req.GetAnnouncement().GetAnnouncementTitle(),
+			req.GetAnnouncement().GetAnnouncementContent(), excerptLength), false, nil
+	}
+
+	announcement, err := m.addAnnouncement(req, excerptLength)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return announcement, true, nil
+}
+
+// RemoveAnnouncements removes the given announcements from a course in the mock database.
+func (m *MockDatabase) RemoveAnnouncements(_ context.Context, courseID string, announcementIDs []string,
+) ([]string, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if len(announcementIDs) == 0 {
+		return nil, fmt.Errorf("%w", ErrAnnouncementEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	removed := make([]string, 0, len(announcementIDs))
+	m.announcements[courseID] = slices.DeleteFunc(slices.Clone(m.announcements[courseID]), func(a Announcement) bool {
+		if !slices.Contains(announcementIDs, a.AnnouncementID) {
+			return false
+		}
+
+		removed = append(removed, a.AnnouncementID)
+
+		return true
+	})
+
+	for _, announcementID := range announcementIDs {
+		delete(m.bodies[courseID], announcementID)
 	}
 
-	if !found {
+	return removed, nil
+}
+
+// CopyAnnouncements copies all announcements of fromCourseID to toCourseID under new IDs
+// in the mock database.
+func (m *MockDatabase) CopyAnnouncements(_ context.Context, fromCourseID, toCourseID string) (int, error) {
+	if fromCourseID == "" || toCourseID == "" {
+		return 0, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	destination, destinationFound := m.courses[toCourseID]
+	if _, sourceFound := m.courses[fromCourseID]; !sourceFound || !destinationFound {
+		return 0, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	if !destination.AnnouncementsEnabled {
+		return 0, fmt.Errorf("%w: %s", ErrAnnouncementsDisabled, toCourseID)
+	}
+
+	originals := m.announcements[fromCourseID]
+	copies := make([]Announcement, 0, len(originals))
+
+	for _, original := range originals {
+		announcementCopy, err := copyOf(original, toCourseID, m.now())
+		if err != nil {
+			return 0, fmt.Errorf("failed to copy announcement: %w", err)
+		}
+
+		copies = append(copies, announcementCopy)
+	}
+
+	for i, original := range originals {
+		if original.HasFullBody {
+			m.setBody(toCourseID, copies[i].AnnouncementID, m.bodies[fromCourseID][original.AnnouncementID])
+		}
+	}
+
+	m.announcements[toCourseID] = append(m.announcements[toCourseID], copies...)
+
+	return len(originals), nil
+}
+
+// SearchAllAnnouncements searches announcements across all courses in the mock database.
+func (m *MockDatabase) SearchAllAnnouncements(_ context.Context, query string, page Page) ([]Announcement, error) {
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("%w", ErrSearchQueryEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	matches := make([]Announcement, 0)
+
+	for _, announcements := range m.announcements {
+		for _, announcement := range announcements {
+			content := announcement.Content
+			if announcement.HasFullBody {
+				content = m.bodies[announcement.CourseID][announcement.AnnouncementID]
+			}
+
+			if matchesAllTerms(strings.ToLower(announcement.Title+" "+content), terms) {
+				matches = append(matches, announcement)
+			}
+		}
+	}
+
+	slices.SortFunc(matches, compareNewestFirst)
+
+	return paginate(matches, page), nil
+}
+
+// GetAnnouncementsSince retrieves at most limit announcements of all courses positioned after the
+// given position, oldest first, from the mock database.
+func (m *MockDatabase) GetAnnouncementsSince(_ context.Context, after AnnouncementPosition, limit int,
+) ([]Announcement, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	matches := make([]Announcement, 0)
+
+	for _, announcements := range m.announcements {
+		for _, a := range announcements {
+			if after.before(a) {
+				matches = append(matches, a)
+			}
+		}
+	}
+
+	slices.SortFunc(matches, func(left, right Announcement) int {
+		return cmp.Or(
+			left.CreatedAt.Compare(right.CreatedAt),
+			strings.Compare(left.CourseID, right.CourseID),
+			strings.Compare(left.AnnouncementID, right.AnnouncementID),
+		)
+	})
+
+	return paginate(matches, Page{Limit: limit}), nil
+}
+
+// checkDraftGradingScheme fails unless the course exists and its grading scheme is still a draft.
+func (m *MockDatabase) checkDraftGradingScheme(courseID string) error {
+	course, exists := m.courses[courseID]
+	if !exists {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	if !course.GradingFinalizedAt.IsZero() {
+		return fmt.Errorf("%w", ErrGradingSchemeFinalized)
+	}
+
+	return nil
+}
+
+// gradingComponents returns the grading components of a course ordered by name.
+func (m *MockDatabase) gradingComponents(courseID string) []GradingComponent {
+	components := make([]GradingComponent, 0, len(m.grading[courseID]))
+	for _, component := range m.grading[courseID] {
+		components = append(components, component)
+	}
+
+	slices.SortFunc(components, func(left, right GradingComponent) int {
+		return strings.Compare(left.Name, right.Name)
+	})
+
+	return components
+}
+
+// SetGradingComponent adds or replaces a grading component of a course in the mock database.
+func (m *MockDatabase) SetGradingComponent(_ context.Context, component GradingComponent) error {
+	if err := validateGradingComponent(component); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if err := m.checkDraftGradingScheme(component.CourseID); err != nil {
+		return err
+	}
+
+	if _, exists := m.grading[component.CourseID]; !exists {
+		m.grading[component.CourseID] = make(map[string]GradingComponent)
+	}
+
+	m.grading[component.CourseID][component.Name] = component
+
+	return nil
+}
+
+// RemoveGradingComponent removes a grading component of a course from the mock database.
+func (m *MockDatabase) RemoveGradingComponent(_ context.Context, courseID, name string) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if err := m.checkDraftGradingScheme(courseID); err != nil {
+		return err
+	}
+
+	if _, exists := m.grading[courseID][name]; !exists {
+		return fmt.Errorf("%w", ErrGradingComponentNotFound)
+	}
+
+	delete(m.grading[courseID], name)
+
+	return nil
+}
+
+// GetGradingScheme retrieves the grading scheme of a course from the mock database.
+func (m *MockDatabase) GetGradingScheme(_ context.Context, courseID string) (*GradingScheme, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	course, exists := m.courses[courseID]
+	if !exists {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	return &GradingScheme{Components: m.gradingComponents(courseID), FinalizedAt: course.GradingFinalizedAt}, nil
+}
+
+// CountDraftGradingComponents returns how many components each draft grading scheme of the
+// courses has in the mock database.
+func (m *MockDatabase) CountDraftGradingComponents(_ context.Context, courseIDs ...string) (map[string]int, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	counts := make(map[string]int)
+
+	for _, courseID := range courseIDs {
+		course, exists := m.courses[courseID]
+		if !exists || !course.GradingFinalizedAt.IsZero() || len(m.grading[courseID]) == 0 {
+			continue
+		}
+
+		counts[courseID] = len(m.grading[courseID])
+	}
+
+	return counts, nil
+}
+
+// FinalizeGradingScheme checks and freezes the grading scheme of a course in the mock database.
+func (m *MockDatabase) FinalizeGradingScheme(_ context.Context, courseID string) (*GradingScheme, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	course, exists := m.courses[courseID]
+	if !exists {
+		return nil, fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	scheme := &GradingScheme{Components: m.gradingComponents(courseID), FinalizedAt: course.GradingFinalizedAt}
+	if !scheme.FinalizedAt.IsZero() {
+		return scheme, nil
+	}
+
+	if err := checkGradingWeights(scheme.Components); err != nil {
+		return nil, err
+	}
+
+	course.GradingFinalizedAt = m.now()
+	course.UpdatedAt = course.GradingFinalizedAt
+	scheme.FinalizedAt = course.GradingFinalizedAt
+
+	return scheme, nil
+}
+
+// AddAPIKey stores a new API key of a course in the mock database.
+func (m *MockDatabase) AddAPIKey(_ context.Context, key *CourseAPIKey) error {
+	if key.CourseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.courses[key.CourseID]; !exists {
+		return fmt.Errorf("%w", ErrCourseNotFound)
+	}
+
+	key.CreatedAt = m.now()
+	m.apiKeys[key.KeyID] = *key
+
+	return nil
+}
+
+// GetAPIKey retrieves an unexpired API key by its ID from the mock database.
+func (m *MockDatabase) GetAPIKey(_ context.Context, keyID string) (*CourseAPIKey, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	key, exists := m.apiKeys[keyID]
+	if !exists || (!key.ExpiresAt.IsZero() && !m.now().Before(key.ExpiresAt)) {
+		return nil, fmt.Errorf("%w", ErrAPIKeyNotFound)
+	}
+
+	return &key, nil
+}
+
+// ListAPIKeys retrieves all API keys of a course from the mock database.
+func (m *MockDatabase) ListAPIKeys(_ context.Context, courseID string) ([]CourseAPIKey, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	keys := make([]CourseAPIKey, 0)
+
+	for _, key := range m.apiKeys {
+		if key.CourseID == courseID {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.SortFunc(keys, func(left, right CourseAPIKey) int {
+		if c := left.CreatedAt.Compare(right.CreatedAt); c != 0 {
+			return c
+		}
+
+		return strings.Compare(left.KeyID, right.KeyID)
+	})
+
+	return keys, nil
+}
+
+// RevokeAPIKey deletes an API key of a course from the mock database.
+func (m *MockDatabase) RevokeAPIKey(_ context.Context, courseID, keyID string) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if key, exists := m.apiKeys[keyID]; !exists || key.CourseID != courseID {
+		return fmt.Errorf("%w", ErrAPIKeyNotFound)
+	}
+
+	delete(m.apiKeys, keyID)
+
+	return nil
+}
+
+// RepostDueAnnouncements posts a fresh copy of every recurring announcement due at now
+// in the mock database and schedules its next repost. Reposts due during a quiet period are held.
+func (m *MockDatabase) RepostDueAnnouncements(_ context.Context, now time.Time) (int, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	reposted := 0
+
+	for courseID, announcements := range m.announcements {
+		for index, announcement := range announcements {
+			if announcement.Recurrence != cpb.AnnouncementRecurrence_WEEKLY.String() ||
+				announcement.NextPostAt.After(now) || !nextAllowedAt(m.quietPeriods[courseID], now).Equal(now) {
+				continue
+			}
+
+			repost := repostOf(announcement, now)
+			if repost.HasFullBody {
+				m.setBody(courseID, repost.AnnouncementID, m.bodies[courseID][announcement.AnnouncementID])
+			}
+
+			m.announcements[courseID] = append(m.announcements[courseID], repost)
+			m.announcements[courseID][index].NextPostAt = nextRecurrence(announcement.NextPostAt, now)
+			reposted++
+		}
+	}
+
+	return reposted, nil
+}
+
+// compareNewestFirst orders announcements newest first, breaking ties by course and announcement ID.
+func compareNewestFirst(left, right Announcement) int {
+	if c := right.CreatedAt.Compare(left.CreatedAt); c != 0 {
+		return c
+	}
+
+	if c := strings.Compare(left.CourseID, right.CourseID); c != 0 {
+		return c
+	}
+
+	return strings.Compare(left.AnnouncementID, right.AnnouncementID)
+}
+
+// GetFeatureOverrides retrieves the feature overrides of a course from the mock database.
+func (m *MockDatabase) GetFeatureOverrides(_ context.Context, courseID string) (map[string]bool, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return maps.Clone(m.features[courseID]), nil
+}
+
+// SetFeatureOverride overrides a feature for a course in the mock database.
+func (m *MockDatabase) SetFeatureOverride(_ context.Context, courseID, feature string, enabled bool) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.courses[courseID]; !exists {
 		return fmt.Errorf("%w", ErrCourseNotFound)
 	}
 
+	if _, exists := m.features[courseID]; !exists {
+		m.features[courseID] = make(map[string]bool)
+	}
+
+	m.features[courseID][feature] = enabled
+
+	return nil
+}
+
+// ClearFeatureOverride removes the override of a feature for a course from the mock database.
+func (m *MockDatabase) ClearFeatureOverride(_ context.Context, courseID, feature string) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.features[courseID], feature)
+
 	return nil
 }
+
+// matchesAllTerms reports whether every term appears as a word of the text.
+func matchesAllTerms(text string, terms []string) bool {
+	words := make(map[string]bool)
+	for _, word := range strings.Fields(text) {
+		words[word] = true
+	}
+
+	for _, term := range terms {
+		if !words[term] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// paginate returns the part of items selected by page.
+func paginate[T any](items []T, page Page) []T {
+	if page.Offset >= len(items) {
+		return []T{}
+	}
+
+	items = items[page.Offset:]
+	if page.Limit > 0 && page.Limit < len(items) {
+		items = items[:page.Limit]
+	}
+
+	return items
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrRosterInconsistent is returned when the indexes of a roster of the mock database disagree with its pairs.
+var ErrRosterInconsistent = errors.New("roster indexes disagree with its pairs")
+
+// membership is a (course, member) pair of a roster.
+type membership struct {
+	courseID string
+	memberID string
+}
+
+// roster is the set of (course, member) pairs of one role in the mock database, such as the
+// enrollments of students, with the data T of each pair. The members of each course and the
+// courses of each member are indexes derived from the pairs. Only set, remove, removeCourse
+// and renameCourse change them, always together with the pairs, so they cannot drift apart.
+type roster[T any] struct {
+	pairs map[membership]T
+	// byCourse and byMember list the members of each course and the courses of each member
+	// in the order they were added.
+	byCourse map[string][]string
+	byMember map[string][]string
+}
+
+// newRoster creates an empty roster.
+func newRoster[T any]() *roster[T] {
+	return &roster[T]{
+		pairs:    make(map[membership]T),
+		byCourse: make(map[string][]string),
+		byMember: make(map[string][]string),
+	}
+}
+
+// get returns the data of a member of a course and whether they are in it.
+func (r *roster[T]) get(courseID, memberID string) (T, bool) {
+	value, ok := r.pairs[membership{courseID: courseID, memberID: memberID}]
+
+	return value, ok
+}
+
+// has reports whether a member is in a course.
+func (r *roster[T]) has(courseID, memberID string) bool {
+	_, ok := r.get(courseID, memberID)
+
+	return ok
+}
+
+// set adds a member to a course, or replaces their data if they already are in it.
+func (r *roster[T]) set(courseID, memberID string, value T) {
+	pair := membership{courseID: courseID, memberID: memberID}
+	if _, ok := r.pairs[pair]; !ok {
+		r.byCourse[courseID] = append(r.byCourse[courseID], memberID)
+		r.byMember[memberID] = append(r.byMember[memberID], courseID)
+	}
+
+	r.pairs[pair] = value
+}
+
+// remove removes a member from a course and reports whether they were in it.
+func (r *roster[T]) remove(courseID, memberID string) bool {
+	pair := membership{courseID: courseID, memberID: memberID}
+	if _, ok := r.pairs[pair]; !ok {
+		return false
+	}
+
+	delete(r.pairs, pair)
+	unlist(r.byCourse, courseID, memberID)
+	unlist(r.byMember, memberID, courseID)
+
+	return true
+}
+
+// removeCourse removes every member of a course, touching only the members of that course.
+func (r *roster[T]) removeCourse(courseID string) {
+	for _, memberID := range r.byCourse[courseID] {
+		delete(r.pairs, membership{courseID: courseID, memberID: memberID})
+		unlist(r.byMember, memberID, courseID)
+	}
+
+	delete(r.byCourse, courseID)
+}
+
+// renameCourse moves every member of a course to the course newID, which must have no members.
+func (r *roster[T]) renameCourse(courseID, newID string) {
+	for _, memberID := range r.byCourse[courseID] {
+		pair := membership{courseID: courseID, memberID: memberID}
+		r.pairs[membership{courseID: newID, memberID: memberID}] = r.pairs[pair]
+		delete(r.pairs, pair)
+
+		courses := r.byMember[memberID]
+		courses[slices.Index(courses, courseID)] = newID
+	}
+
+	if members, ok := r.byCourse[courseID]; ok {
+		r.byCourse[newID] = members
+		delete(r.byCourse, courseID)
+	}
+}
+
+// members returns a copy of the members of a course.
+func (r *roster[T]) members(courseID string) []string {
+	return slices.Clone(r.byCourse[courseID])
+}
+
+// courses returns a copy of the courses of a member.
+func (r *roster[T]) courses(memberID string) []string {
+	return slices.Clone(r.byMember[memberID])
+}
+
+// unlist removes id from the list of key in an index, dropping the key once its list is empty.
+func unlist(index map[string][]string, key, id string) {
+	ids := slices.DeleteFunc(index[key], func(other string) bool { return other == id })
+	if len(ids) == 0 {
+		delete(index, key)
+
+		return
+	}
+
+	index[key] = ids
+}
+
+// checkInvariants verifies that both indexes list exactly the pairs of the roster, once each.
+func (r *roster[T]) checkInvariants() error {
+	if err := checkIndex(r.byCourse, r.pairs, func(courseID, memberID string) membership {
+		return membership{courseID: courseID, memberID: memberID}
+	}); err != nil {
+		return fmt.Errorf("members by course: %w", err)
+	}
+
+	if err := checkIndex(r.byMember, r.pairs, func(memberID, courseID string) membership {
+		return membership{courseID: courseID, memberID: memberID}
+	}); err != nil {
+		return fmt.Errorf("courses by member: %w", err)
+	}
+
+	return nil
+}
+
+// checkIndex verifies that an index lists every pair once and nothing else.
+func checkIndex[T any](index map[string][]string, pairs map[membership]T,
+	pairOf func(key, id string) membership,
+) error {
+	listed := 0
+
+	for key, ids := range index {
+		if len(ids) == 0 {
+			return fmt.Errorf("%w: %q is listed without entries", ErrRosterInconsistent, key)
+		}
+
+		for i, listedID := range ids {
+			if slices.Contains(ids[:i], listedID) {
+				return fmt.Errorf("%w: %q lists %q twice", ErrRosterInconsistent, key, listedID)
+			}
+
+			if _, ok := pairs[pairOf(key, listedID)]; !ok {
+				return fmt.Errorf("%w: %q lists %q, which is not a pair", ErrRosterInconsistent, key, listedID)
+			}
+		}
+
+		listed += len(ids)
+	}
+
+	if listed != len(pairs) {
+		return fmt.Errorf("%w: %d pairs listed, %d pairs held", ErrRosterInconsistent, listed, len(pairs))
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/uptrace/bun"
+	"k8s.io/klog/v2"
+)
+
+var (
+	ErrSelfTestFailed   = errors.New("database self-test failed")
+	ErrSelfTestMismatch = errors.New("database returned other data than written")
+	ErrSchemaOutOfDate  = errors.New("tables lack columns of their models")
+)
+
+// SchemaDifference is a column that a table and its model do not agree on.
+type SchemaDifference struct {
+	Table  string
+	Column string
+	// Missing is set for a column of the model that the table lacks, and unset for a column of
+	// the table that the model does not know.
+	Missing bool
+}
+
+// String describes the difference, e.g. "course_students lacks column status".
+func (d SchemaDifference) String() string {
+	if d.Missing {
+		return d.Table + " lacks column " + d.Column
+	}
+
+	return d.Table + " has unknown column " + d.Column
+}
+
+// compareColumns returns the columns of a table that its model expects but that are not live,
+// then the live columns that the model does not expect, each ordered by name.
+func compareColumns(table string, expected, live []string) []SchemaDifference {
+	var differences []SchemaDifference
+
+	for _, column := range slices.Sorted(slices.Values(expected)) {
+		if !slices.Contains(live, column) {
+			differences = append(differences, SchemaDifference{Table: table, Column: column, Missing: true})
+		}
+	}
+
+	for _, column := range slices.Sorted(slices.Values(live)) {
+		if !slices.Contains(expected, column) {
+			differences = append(differences, SchemaDifference{Table: table, Column: column})
+		}
+	}
+
+	return differences
+}
+
+// schemaDifferences compares the live columns of the table of every model with the columns of
+// the model.
+func schemaDifferences(ctx context.Context, database bun.IDB) ([]SchemaDifference, error) {
+	var differences []SchemaDifference
+
+	for _, model := range schemaModels() {
+		table := database.Dialect().Tables().Get(reflect.TypeOf(model).Elem())
+
+		expected := make([]string, 0, len(table.Fields))
+		for _, field := range table.Fields {
+			expected = append(expected, field.Name)
+		}
+
+		var live []string
+
+		err := database.NewSelect().
+			TableExpr("information_schema.columns").
+			Column("column_name").
+			Where("table_schema = current_schema() AND table_name = ?", table.Name).
+			Scan(ctx, &live)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get columns of %s: %w", table.Name, err)
+		}
+
+		differences = append(differences, compareColumns(table.Name, expected, live)...)
+	}
+
+	return differences, nil
+}
+
+// selfTestStep is one check of the database self-test, run inside its transaction.
+type selfTestStep struct {
+	name string
+	run  func(ctx context.Context, transaction bun.Tx, courseID string) error
+}
+
+// selfTestSteps exercise the paths every request relies on: writing and reading a course and
+// its enrollments, and deleting a course together with its rows.
+func selfTestSteps() []selfTestStep {
+	return []selfTestStep{
+		{"insert course", func(ctx context.Context, transaction bun.Tx, courseID string) error {
+			_, err := transaction.NewInsert().
+				Model(&Course{CourseID: courseID, CourseName: "Self-test", Semester: "Winter_2025"}).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to insert course: %w", err)
+			}
+
+			return nil
+		}},
+		{"read course", func(ctx context.Context, transaction bun.Tx, courseID string) error {
+			course := new(Course)
+			if err := transaction.NewSelect().Model(course).Where("course_id = ?", courseID).Scan(ctx); err != nil {
+				return fmt.Errorf("failed to get course: %w", err)
+			}
+
+			if course.CourseName != "Self-test" || course.Semester != "Winter_2025" {
+				return fmt.Errorf("%w: course %s", ErrSelfTestMismatch, courseID)
+			}
+
+			return nil
+		}},
+		{"insert enrollment", func(ctx context.Context, transaction bun.Tx, courseID string) error {
+			_, err := transaction.NewInsert().
+				Model(&CourseStudent{CourseID: courseID, StudentID: "self-test", Status: studentEnrolled}).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to insert enrollment: %w", err)
+			}
+
+			return nil
+		}},
+		{"verify schema", verifySchema},
+		{"delete course", func(ctx context.Context, transaction bun.Tx, courseID string) error {
+			_, err := transaction.NewDelete().Model((*Course)(nil)).Where("course_id = ?", courseID).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to delete course: %w", err)
+			}
+
+			exists, err := transaction.NewSelect().Model((*CourseStudent)(nil)).
+				Where("course_id = ?", courseID).
+				Exists(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to check enrollments: %w", err)
+			}
+
+			if exists {
+				return fmt.Errorf("%w: enrollments outlived course %s", ErrSelfTestMismatch, courseID)
+			}
+
+			return nil
+		}},
+	}
+}
+
+// verifySchema fails if a table lacks a column of its model. Columns the models do not know, such
+// as those left by earlier versions, are only logged, since they do not break any request.
+func verifySchema(ctx context.Context, transaction bun.Tx, _ string) error {
+	differences, err := schemaDifferences(ctx, transaction)
+	if err != nil {
+		return err
+	}
+
+	missing := make([]string, 0, len(differences))
+
+	for _, difference := range differences {
+		if !difference.Missing {
+			klog.FromContext(ctx).Info("Schema has a column unknown to the models", "difference", difference)
+
+			continue
+		}
+
+		missing = append(missing, difference.String())
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrSchemaOutOfDate, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// SelfTest checks that the database is writable and its schema is what this version expects,
+// by running selfTestSteps on a throwaway course. Everything runs in a transaction that is
+// rolled back, so nothing is left behind.
+func (d *Database) SelfTest(ctx context.Context) error {
+	logger := klog.FromContext(ctx)
+
+	transaction, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to begin transaction: %w", ErrSelfTestFailed, err)
+	}
+
+	defer func() {
+		if err := transaction.Rollback(); err != nil {
+			logger.Error(err, "Failed to roll back self-test transaction")
+		}
+	}()
+
+	courseID := "self-test-" + rand.Text()
+
+	for _, step := range selfTestSteps() {
+		if err := step.run(ctx, transaction, courseID); err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrSelfTestFailed, step.name, err)
+		}
+
+		logger.Info("Self-test step passed", "step", step.name)
+	}
+
+	return nil
+}
+
+// runSelfTest initializes the database and runs its self-test.
+func runSelfTest() error {
+	database, err := InitializeDatabase()
+	if err != nil {
+		return fmt.Errorf("failed to initialize database: %w", err)
+	}
+
+	return database.SelfTest(context.Background())
+}
@@ -1,18 +1,36 @@
 package main
 
 import (
+	"cmp"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"maps"
 	"net"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+	_ "time/tzdata" // The runtime image ships no time zone database.
 
 	cpb "github.com/BetterGR/courses-microservice/protos"
 	ms "github.com/TekClinic/MicroService-Lib"
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/klog/v2"
 )
 
@@ -21,436 +39,3510 @@ const (
 	connectionProtocol = "tcp"
 	// Debugging logs.
 	logLevelDebug = 5
+	// Role required for administrative operations.
+	adminRole = "admin"
+	// Role of lecturers and teaching assistants.
+	staffRole = "staff"
+	// Number of dot-separated parts of a JWT.
+	jwtParts = 3
+	// Number of results per page of list RPCs that do not set a page size.
+	defaultPageSize = 50
+	// Largest number of results per page of list RPCs.
+	maxPageSize = 500
+	// Most courses GetSemesterCourses returns; a semester with more is likely a misconfigured filter.
+	defaultSemesterCoursesLimit = 1000
+	// Environment variable overriding defaultSemesterCoursesLimit.
+	semesterCoursesLimitEnv = "SEMESTER_COURSES_LIMIT"
+	// Environment variables overriding defaultPageSize and maxPageSize.
+	defaultPageSizeEnv = "DEFAULT_PAGE_SIZE"
+	maxPageSizeEnv     = "MAX_PAGE_SIZE"
+	// Environment variable naming the time zone announcements are grouped in.
+	announcementTimezoneEnv = "ANNOUNCEMENT_TIMEZONE"
+	// Period the activity summary of a course covers.
+	activityWindow = 7 * 24 * time.Hour
+	// How often due recurring announcements are reposted.
+	repostInterval = time.Minute
+	// How often lapsed staff assignments are looked for and published.
+	staffLapseInterval = time.Minute
+	// How often the shape of the data is logged for capacity planning.
+	dataShapeInterval = 7 * 24 * time.Hour
+	// How often the database connection is checked for the health service, and how many checks in a
+	// row must fail before the service is reported NOT_SERVING.
+	databaseCheckInterval = 10 * time.Second
+	databaseCheckFailures = 3
+	// Environment variables limiting the courses and credits a student takes per semester.
+	maxSemesterCoursesEnv = "MAX_COURSES_PER_STUDENT_PER_SEMESTER"
+	maxSemesterLoadEnv    = "MAX_CREDITS_PER_STUDENT_PER_SEMESTER"
+	// Environment variable turning off the check that nobody is both a student and staff in a course.
+	roleSeparationEnv = "ENFORCE_ROLE_SEPARATION"
+	// Environment variable making reads of students and staff the service never saw NotFound by default.
+	strictMemberReadsEnv = "STRICT_MEMBER_READS"
+	// Environment variables limiting the requests handled at once and how long excess requests wait.
+	maxConcurrentRequestsEnv = "MAX_CONCURRENT_REQUESTS"
+	maxRequestWaitEnv        = "MAX_REQUEST_QUEUE_WAIT"
+	// Bytes of announcement content above which lists return only an excerpt of that length.
+	defaultAnnouncementExcerptLength = 4 << 10
+	// Most bytes of content an announcement may have.
+	defaultMaxAnnouncementLength = 1 << 20
+	// Environment variables overriding defaultAnnouncementExcerptLength and defaultMaxAnnouncementLength.
+	announcementExcerptLengthEnv = "ANNOUNCEMENT_EXCERPT_LENGTH"
+	maxAnnouncementLengthEnv     = "MAX_ANNOUNCEMENT_LENGTH"
+	// defaultMaxDescriptionLength caps course descriptions, in bytes.
+	defaultMaxDescriptionLength = 16 << 10
+	// maxDescriptionLengthEnv overrides defaultMaxDescriptionLength.
+	maxDescriptionLengthEnv = "MAX_COURSE_DESCRIPTION_LENGTH"
 )
 
+var (
+	ErrInvalidPageSize  = errors.New("page size is negative")
+	ErrInvalidPageToken = errors.New("page token is invalid")
+	ErrMalformedToken   = errors.New("token is malformed")
+	ErrInvalidScope     = errors.New("API key scope is not a course RPC")
+	ErrInvalidLimit     = errors.New("course limit must be a non-negative integer")
+	ErrInvalidPageLimit = errors.New("result size limit must be a positive integer")
+	ErrInvalidToggle    = errors.New("setting must be true or false")
+	ErrInvalidDuration  = errors.New("duration must be non-negative")
+	ErrInvalidWorkers   = errors.New("concurrency limit must be a non-negative integer")
+	ErrInvalidLength    = errors.New("content length must be a positive integer")
+	ErrPortMissing      = errors.New("GRPC_PORT is not set")
+	ErrInvalidPort      = errors.New("GRPC_PORT must be a port number from 0 to 65535")
+
+	ErrAnnouncementTooLong = errors.New("announcement content is too long")
+	ErrDescriptionTooLong  = errors.New("course description is too long")
+
+	ErrEnrollmentLimitReached = errors.New("student reached the course limit for the semester")
+	ErrCreditLimitReached     = errors.New("student reached the credit limit for the semester")
+	ErrEnrollmentClosed       = errors.New("enrollment window of the course is not open")
+)
+
+// subjectClaims is implemented by claims that identify the calling user.
+type subjectClaims interface {
+	GetSubject() string
+}
+
+// verifiedClaims are the claims of a verified token together with the user it was issued to.
+type verifiedClaims struct {
+	ms.Claims
+	subject string
+}
+
+// GetSubject returns the ID of the user the token was issued to.
+func (c verifiedClaims) GetSubject() string {
+	return c.subject
+}
+
 // CoursesServer is an implementation of GRPC Courses microservice.
 type CoursesServer struct {
 	ms.BaseServiceServer
 	db DBInterface
 	cpb.UnimplementedCoursesServiceServer
 	Claims ms.Claims
+	// limits caps what a student takes per semester.
+	limits EnrollmentLimits
+	// location is the time zone announcements are grouped in.
+	location *time.Location
+	// pagination sizes the pages of every list RPC.
+	pagination Pagination
+	// semesterCoursesLimit caps the courses GetSemesterCourses returns.
+	semesterCoursesLimit int
+	// policies is what courses allow their users.
+	policies CoursePolicies
+	// limiter caps the requests handled at once; nil means unlimited.
+	limiter *concurrencyLimiter
+	// inFlight counts the requests being handled, other than health checks.
+	inFlight atomic.Int64
+	// dataShape is the last data shape report, or nil before the first.
+	dataShape atomic.Pointer[DataShape]
+	// announcementSizes bounds announcement content and the excerpts listed in its place.
+	announcementSizes AnnouncementSizes
+	// directory looks up the contacts of staff members.
+	directory StaffDirectory
+	// shares signs the tokens courses are shared with.
+	shares shareSigner
+	// events tells other services about changes to courses.
+	events EventPublisher
+	// health reports whether the service, and separately its writes, are being served.
+	health *health.Server
+	// readOnly is set while writes are refused because the database is read-only.
+	readOnly atomic.Bool
 }
 
-// VerifyToken returns the injected Claims instead of the default.
-func (s *CoursesServer) VerifyToken(ctx context.Context, token string) error {
+// getClaims returns the claims of the caller, preferring the injected Claims and then those
+// authInterceptor verified. Otherwise, the token is verified here.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) getClaims(ctx context.Context, token string) (ms.Claims, error) {
 	if s.Claims != nil {
+		return s.Claims, nil
+	}
+
+	if claims, ok := ctx.Value(claimsContextKey{}).(ms.Claims); ok {
+		return claims, nil
+	}
+
+	return s.verifyToken(ctx, token)
+}
+
+// verifyToken verifies the token and returns its claims together with the user it was issued to.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) verifyToken(ctx context.Context, token string) (ms.Claims, error) {
+	claims, err := s.BaseServiceServer.VerifyToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w", status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	subject, err := tokenSubject(token)
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w", status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	return verifiedClaims{Claims: claims, subject: subject}, nil
+}
+
+// tokenSubject extracts the subject of a JWT. The token must already be verified.
+func tokenSubject(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != jwtParts {
+		return "", fmt.Errorf("%w", ErrMalformedToken)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	var claims struct {
+		Subject string `json:"sub"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	return claims.Subject, nil
+}
+
+// callerID returns the ID of the user the claims were issued to, or an empty string if unknown.
+func callerID(claims ms.Claims) string {
+	if subject, ok := claims.(subjectClaims); ok {
+		return subject.GetSubject()
+	}
+
+	return ""
+}
+
+// callerAuthor returns the ID recorded as the author of what the caller posts.
+// Requests authorized by a course API key are not made by a person and have no author.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) callerAuthor(ctx context.Context, token string) (string, error) {
+	if _, ok := ctx.Value(apiKeyContextKey{}).(*CourseAPIKey); ok {
+		return "", nil
+	}
+
+	claims, err := s.getClaims(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	return callerID(claims), nil
+}
+
+// verifyRole verifies the token and checks that its claims hold at least one of the given roles.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) verifyRole(ctx context.Context, token string, roles ...string) error {
+	claims, err := s.getClaims(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("authorization failed: %w",
+		status.Errorf(codes.PermissionDenied, "requires one of the roles %v", roles))
+}
+
+// verifySelf verifies the token and checks that the caller is the given user or an admin.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) verifySelf(ctx context.Context, token, userID string) error {
+	claims, err := s.getClaims(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	if claims.HasRole(adminRole) || callerID(claims) == userID {
+		return nil
+	}
+
+	return fmt.Errorf("authorization failed: %w",
+		status.Errorf(codes.PermissionDenied, "cannot act on behalf of %q", userID))
+}
+
+// isCourseStaff verifies the token and reports whether the caller is an admin or
+// currently staffs at least one of the given courses. Course API keys never count as staff.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) isCourseStaff(ctx context.Context, token string, courseIDs ...string) (bool, error) {
+	staffed, err := s.staffedCourses(ctx, token, courseIDs...)
+	if err != nil {
+		return false, err
+	}
+
+	return len(staffed) > 0, nil
+}
+
+// visibleAnnouncements verifies the token and returns the filter of the announcements of a course
+// the caller may see. Staff-only notes must never reach students, so only course staff and admins
+// see them. The returned error already carries the matching gRPC status.
+func (s *CoursesServer) visibleAnnouncements(ctx context.Context, token, courseID string) (AnnouncementFilter, error) {
+	staff, err := s.isCourseStaff(ctx, token, courseID)
+	if err != nil {
+		return AnnouncementFilter{}, err
+	}
+
+	return AnnouncementFilter{IncludeStaffOnly: staff}, nil
+}
+
+// staffedCourses verifies the token and returns which of the given courses the caller is an
+// admin or current staff of. Requests authorized by a course API key staff none.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) staffedCourses(ctx context.Context, token string,
+	courseIDs ...string,
+) (map[string]bool, error) {
+	staffed := make(map[string]bool)
+
+	if _, ok := ctx.Value(apiKeyContextKey{}).(*CourseAPIKey); ok {
+		return staffed, nil
+	}
+
+	claims, err := s.getClaims(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := callerID(claims)
+
+	for _, courseID := range courseIDs {
+		if claims.HasRole(adminRole) {
+			staffed[courseID] = true
+
+			continue
+		}
+
+		if userID == "" {
+			continue
+		}
+
+		// Expired staff assignments are excluded, so they confer no permissions.
+		staffIDs, err := s.db.GetCourseStaff(ctx, courseID, false)
+		if err != nil && !errors.Is(err, ErrCourseNotFound) {
+			return nil, fmt.Errorf("failed to check course staff: %w", status.Error(statusCode(err), err.Error()))
+		}
+
+		if slices.Contains(staffIDs, userID) {
+			staffed[courseID] = true
+		}
+	}
+
+	return staffed, nil
+}
+
+// verifyCourseStaff verifies the token and checks that the caller is an admin or
+// currently staffs at least one of the given courses.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) verifyCourseStaff(ctx context.Context, token string, courseIDs ...string) error {
+	staff, err := s.isCourseStaff(ctx, token, courseIDs...)
+	if err != nil {
+		return err
+	}
+
+	if staff {
+		return nil
+	}
+
+	return fmt.Errorf("authorization failed: %w",
+		status.Errorf(codes.PermissionDenied, "requires staffing one of the courses %v", courseIDs))
+}
+
+// verifyCourseStaffOrAPIKey is verifyCourseStaff for RPCs an API key may be scoped to. Requests
+// authorized by a course API key pass, as apiKeyInterceptor already checked the key's scope and course.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) verifyCourseStaffOrAPIKey(ctx context.Context, token, courseID string) error {
+	if _, ok := ctx.Value(apiKeyContextKey{}).(*CourseAPIKey); ok {
 		return nil
 	}
 
-	// Default behavior.
-	if _, err := s.BaseServiceServer.VerifyToken(ctx, token); err != nil {
-		return fmt.Errorf("failed to verify token: %w", err)
+	return s.verifyCourseStaff(ctx, token, courseID)
+}
+
+// statusCode maps an error returned by the database layer to a gRPC status code.
+// Queries interrupted by the request context report why the context ended.
+// Unavailable is reserved for writes refused by a read-only database, see readOnlyInterceptor.
+// Stored values their enum does not know, ErrUnknownEnumValue, are Internal like other corrupt data.
+func statusCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, ErrCourseNotFound):
+		return codes.NotFound
+	case errors.Is(err, ErrCourseNil), errors.Is(err, ErrCourseIDEmpty), errors.Is(err, ErrStudentIDEmpty),
+		errors.Is(err, ErrStaffIDEmpty), errors.Is(err, ErrAnnouncementEmpty), errors.Is(err, ErrSemesterEmpty),
+		errors.Is(err, ErrInvalidWindow), errors.Is(err, ErrInvalidAccess), errors.Is(err, ErrSearchQueryEmpty),
+		errors.Is(err, ErrInvalidQuiet), errors.Is(err, ErrInvalidScope), errors.Is(err, ErrSelfCorequisite),
+		errors.Is(err, ErrInvalidStatus), errors.Is(err, ErrDuplicateStaff), errors.Is(err, ErrGradingComponentEmpty),
+		errors.Is(err, ErrInvalidWeight), errors.Is(err, ErrAnnouncementTooLong), errors.Is(err, ErrUnknownFeature),
+		errors.Is(err, ErrDescriptionTooLong), errors.Is(err, ErrSlugEmpty),
+		errors.Is(err, ErrInvalidMetadata):
+		return codes.InvalidArgument
+	case errors.Is(err, ErrAPIKeyNotFound), errors.Is(err, ErrGradingComponentNotFound),
+		errors.Is(err, ErrAnnouncementNotFound), errors.Is(err, ErrStudentNotFound), errors.Is(err, ErrStaffNotFound):
+		return codes.NotFound
+	case errors.Is(err, ErrAnnouncementExists), errors.Is(err, ErrCourseAlreadyExists):
+		return codes.AlreadyExists
+	case databaseReadOnly(err):
+		return codes.Unavailable
+	case errors.Is(err, ErrQuietPeriod), errors.Is(err, ErrAnnouncementsDisabled),
+		errors.Is(err, ErrEnrollmentLimitReached), errors.Is(err, ErrCapacityExceeded),
+		errors.Is(err, ErrCreditLimitReached), errors.Is(err, ErrEnrollmentClosed),
+		errors.Is(err, ErrGradingSchemeFinalized), errors.Is(err, ErrGradingWeights),
+		errors.Is(err, ErrConflictingRole), errors.Is(err, ErrFeatureDisabled),
+		errors.Is(err, ErrInvalidStatusTransition):
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// Pagination is the page size policy shared by every list RPC.
+type Pagination struct {
+	// DefaultSize is the page size of requests that do not set one.
+	DefaultSize int32
+	// MaxSize caps the page size of every request.
+	MaxSize int32
+}
+
+// paginationFromEnv reads the page size policy, defaulting to defaultPageSize and maxPageSize.
+func paginationFromEnv() (Pagination, error) {
+	pagination := Pagination{DefaultSize: defaultPageSize, MaxSize: maxPageSize}
+
+	sizes := map[string]*int32{defaultPageSizeEnv: &pagination.DefaultSize, maxPageSizeEnv: &pagination.MaxSize}
+	for name, size := range sizes {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || parsed <= 0 {
+			return Pagination{}, fmt.Errorf("invalid %s %q: %w", name, value, ErrInvalidPageLimit)
+		}
+
+		*size = int32(parsed)
+	}
+
+	pagination.DefaultSize = min(pagination.DefaultSize, pagination.MaxSize)
+
+	return pagination, nil
+}
+
+// size returns the page size actually used for a requested one.
+// Unset sizes take the default and sizes above the maximum are silently capped.
+func (p Pagination) size(pageSize int32) int32 {
+	if pageSize == 0 {
+		return p.DefaultSize
+	}
+
+	return min(pageSize, p.MaxSize)
+}
+
+// page builds the page selected by a page size and a page token.
+func (p Pagination) page(pageSize int32, pageToken string) (Page, error) {
+	if pageSize < 0 {
+		return Page{}, fmt.Errorf("%w", ErrInvalidPageSize)
+	}
+
+	page := Page{Limit: int(p.size(pageSize))}
+
+	if pageToken != "" {
+		offset, err := strconv.Atoi(pageToken)
+		if err != nil || offset < 0 {
+			return Page{}, fmt.Errorf("%w", ErrInvalidPageToken)
+		}
+
+		page.Offset = offset
+	}
+
+	return page, nil
+}
+
+// nextPageToken returns the token of the page after page, or an empty token if page was not full.
+func nextPageToken(page Page, count int) string {
+	if count < page.Limit {
+		return ""
+	}
+
+	return strconv.Itoa(page.Offset + page.Limit)
+}
+
+// initCoursesMicroserviceServer initializes the CoursesServer.
+func initCoursesMicroserviceServer() (*CoursesServer, error) {
+	server, err := newCoursesServer()
+	if err != nil {
+		return nil, err
+	}
+
+	database, err := InitializeDatabase()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize database: %w", err)
+	}
+
+	server.db = database
+
+	return server, nil
+}
+
+// newCoursesServer creates a CoursesServer configured from the environment, without a database.
+func newCoursesServer() (*CoursesServer, error) {
+	base, err := ms.CreateBaseServiceServer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base service: %w", err)
+	}
+
+	limits, err := enrollmentLimitsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := announcementLocationFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginationFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	semesterCoursesLimit, err := semesterCoursesLimitFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := coursePoliciesFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	limiter, err := concurrencyLimiterFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	announcementSizes, err := announcementSizesFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	sharing, err := sharingFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CoursesServer{
+		BaseServiceServer:    base,
+		limits:               limits,
+		location:             location,
+		pagination:           pagination,
+		semesterCoursesLimit: semesterCoursesLimit,
+		policies:             policies,
+		limiter:              limiter,
+		announcementSizes:    announcementSizes,
+		directory:            sharing.directory,
+		shares:               sharing.shares,
+		events:               sharing.events,
+		health:               newHealthServer(),
+	}, nil
+}
+
+// sharing is what the service shares about courses beyond its own users.
+type sharing struct {
+	// directory looks up the contacts of staff members.
+	directory StaffDirectory
+	// shares signs the tokens courses are shared with.
+	shares shareSigner
+	// events tells other services about changes to courses.
+	events EventPublisher
+}
+
+// sharingFromEnv loads the directory of staff contacts, the signer of course share tokens and
+// the publisher of events.
+func sharingFromEnv() (sharing, error) {
+	directory, err := staffDirectoryFromEnv()
+	if err != nil {
+		return sharing{}, err
+	}
+
+	shares, err := shareSignerFromEnv()
+	if err != nil {
+		return sharing{}, err
+	}
+
+	events, err := eventPublisherFromEnv()
+	if err != nil {
+		return sharing{}, err
+	}
+
+	return sharing{directory: directory, shares: shares, events: events}, nil
+}
+
+// announcementLocationFromEnv loads the time zone announcements are grouped in, defaulting to UTC.
+func announcementLocationFromEnv() (*time.Location, error) {
+	name := os.Getenv(announcementTimezoneEnv)
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: %w", announcementTimezoneEnv, name, err)
+	}
+
+	return location, nil
+}
+
+// EnrollmentLimits caps what a student takes per semester. Zero values mean unlimited.
+type EnrollmentLimits struct {
+	// Courses caps the courses a student is enrolled in.
+	Courses int
+	// Credits caps the credits of the courses a student is enrolled in.
+	Credits int
+}
+
+// enrollmentLimitsFromEnv reads the per-semester course and credit limits, defaulting to unlimited.
+func enrollmentLimitsFromEnv() (EnrollmentLimits, error) {
+	limits := EnrollmentLimits{}
+
+	names := map[string]*int{maxSemesterCoursesEnv: &limits.Courses, maxSemesterLoadEnv: &limits.Credits}
+	for name, limit := range names {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return EnrollmentLimits{}, fmt.Errorf("invalid %s %q: %w", name, value, ErrInvalidLimit)
+		}
+
+		*limit = parsed
+	}
+
+	return limits, nil
+}
+
+// semesterCoursesLimitFromEnv reads the most courses GetSemesterCourses returns,
+// defaulting to defaultSemesterCoursesLimit.
+func semesterCoursesLimitFromEnv() (int, error) {
+	value := os.Getenv(semesterCoursesLimitEnv)
+	if value == "" {
+		return defaultSemesterCoursesLimit, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		return 0, fmt.Errorf("invalid %s %q: %w", semesterCoursesLimitEnv, value, ErrInvalidPageLimit)
+	}
+
+	return limit, nil
+}
+
+// CoursePolicies is what courses allow their users.
+type CoursePolicies struct {
+	// SeparateRoles rejects making a student of a course its staff, and the other way around.
+	SeparateRoles bool
+	// StrictReads makes reads of students and staff the service never saw NotFound, unless
+	// the request says otherwise.
+	StrictReads bool
+	// Features decides which features are enabled for each course.
+	Features FeatureFlags
+	// MaxDescriptionLength caps the description of a course, in bytes.
+	MaxDescriptionLength int
+}
+
+// coursePoliciesFromEnv loads what courses allow their users. By default, students and staff must
+// be different people, reads of unknown students and staff are empty rather than NotFound and
+// descriptions are capped at defaultMaxDescriptionLength.
+func coursePoliciesFromEnv() (CoursePolicies, error) {
+	separateRoles, err := toggleFromEnv(roleSeparationEnv, true)
+	if err != nil {
+		return CoursePolicies{}, err
+	}
+
+	strictReads, err := toggleFromEnv(strictMemberReadsEnv, false)
+	if err != nil {
+		return CoursePolicies{}, err
+	}
+
+	features, err := featureFlagsFromEnv()
+	if err != nil {
+		return CoursePolicies{}, err
+	}
+
+	maxDescriptionLength, err := maxDescriptionLengthFromEnv()
+	if err != nil {
+		return CoursePolicies{}, err
+	}
+
+	return CoursePolicies{
+		SeparateRoles:        separateRoles,
+		StrictReads:          strictReads,
+		Features:             features,
+		MaxDescriptionLength: maxDescriptionLength,
+	}, nil
+}
+
+// maxDescriptionLengthFromEnv reads the cap on course descriptions, defaulting to defaultMaxDescriptionLength.
+func maxDescriptionLengthFromEnv() (int, error) {
+	value := os.Getenv(maxDescriptionLengthEnv)
+	if value == "" {
+		return defaultMaxDescriptionLength, nil
+	}
+
+	length, err := strconv.Atoi(value)
+	if err != nil || length <= 0 {
+		return 0, fmt.Errorf("invalid %s %q: %w", maxDescriptionLengthEnv, value, ErrInvalidLength)
+	}
+
+	return length, nil
+}
+
+// checkDescription rejects course descriptions longer than the configured maximum.
+func (s *CoursesServer) checkDescription(description string) error {
+	if length := len(description); length > s.policies.MaxDescriptionLength {
+		return fmt.Errorf("%w: %d bytes, at most %d allowed", ErrDescriptionTooLong, length,
+			s.policies.MaxDescriptionLength)
+	}
+
+	return nil
+}
+
+// toggleFromEnv reads a true or false setting from the environment variable name, defaulting to def.
+func toggleFromEnv(name string, def bool) (bool, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return def, nil
+	}
+
+	toggle, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s %q: %w", name, value, ErrInvalidToggle)
+	}
+
+	return toggle, nil
+}
+
+// concurrencyLimiterFromEnv reads how many requests may be handled at once and how long excess
+// requests wait for a slot. Without a limit requests are not limited; by default they do not wait.
+func concurrencyLimiterFromEnv() (*concurrencyLimiter, error) {
+	limit := 0
+
+	if value := os.Getenv(maxConcurrentRequestsEnv); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("invalid %s %q: %w", maxConcurrentRequestsEnv, value, ErrInvalidWorkers)
+		}
+
+		limit = parsed
+	}
+
+	var maxWait time.Duration
+
+	if value := os.Getenv(maxRequestWaitEnv); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("invalid %s %q: %w", maxRequestWaitEnv, value, ErrInvalidDuration)
+		}
+
+		maxWait = parsed
+	}
+
+	return newConcurrencyLimiter(limit, maxWait), nil
+}
+
+// AnnouncementSizes bounds the content of announcements, in bytes.
+type AnnouncementSizes struct {
+	// ExcerptLength is the length above which content is stored apart and only an excerpt
+	// of this length is listed.
+	ExcerptLength int
+	// MaxLength caps the content of an announcement.
+	MaxLength int
+}
+
+// announcementSizesFromEnv reads the announcement size bounds, defaulting to
+// defaultAnnouncementExcerptLength and defaultMaxAnnouncementLength.
+func announcementSizesFromEnv() (AnnouncementSizes, error) {
+	sizes := AnnouncementSizes{
+		ExcerptLength: defaultAnnouncementExcerptLength,
+		MaxLength:     defaultMaxAnnouncementLength,
+	}
+
+	lengths := map[string]*int{
+		announcementExcerptLengthEnv: &sizes.ExcerptLength,
+		maxAnnouncementLengthEnv:     &sizes.MaxLength,
+	}
+	for name, length := range lengths {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			return AnnouncementSizes{}, fmt.Errorf("invalid %s %q: %w", name, value, ErrInvalidLength)
+		}
+
+		*length = parsed
+	}
+
+	return sizes, nil
+}
+
+// checkEnrollmentLimit rejects enrolling a student who already takes the maximum number of
+// courses in the semester of the given course.
+func (s *CoursesServer) checkEnrollmentLimit(ctx context.Context, courseID, studentID string) error {
+	if s.limits.Courses == 0 {
+		return nil
+	}
+
+	count, err := s.db.CountSemesterEnrollments(ctx, courseID, studentID)
+	if err != nil {
+		return fmt.Errorf("failed to check enrollment limit: %w", err)
+	}
+
+	if count >= s.limits.Courses {
+		return fmt.Errorf("%w: enrolled in %d courses", ErrEnrollmentLimitReached, count)
+	}
+
+	return nil
+}
+
+// GetCourse retrieves a course by its ID.
+func (s *CoursesServer) GetCourse(ctx context.Context, req *cpb.GetCourseRequest) (*cpb.GetCourseResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetCourse request",
+		"courseId", req.GetCourseID(), "includeCorequisites", req.GetIncludeCorequisites(),
+		"includeActivity", req.GetIncludeActivity())
+
+	course, err := s.db.GetCourse(ctx, req.GetCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	staff, err := s.isCourseStaff(ctx, req.GetToken(), req.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+
+	newCourse := courseToProto(course, staff)
+	if err := s.addDraftCounts(ctx, newCourse); err != nil {
+		return nil, err
+	}
+
+	contentHash, err := courseContentHash(newCourse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash course: %w", status.Error(codes.Internal, err.Error()))
+	}
+
+	response := &cpb.GetCourseResponse{Course: newCourse, ContentHash: contentHash}
+
+	if req.GetIncludeCorequisites() {
+		response.CorequisiteIDs, err = s.db.GetCorequisites(ctx, req.GetCourseID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get co-requisites: %w", status.Error(statusCode(err), err.Error()))
+		}
+	}
+
+	if req.GetIncludeActivity() {
+		response.Activity, err = s.activitySummary(ctx, req.GetToken(), req.GetCourseID())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+// activitySummary summarizes the activity of a course over the last activityWindow.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) activitySummary(ctx context.Context, token, courseID string) (*cpb.ActivitySummary, error) {
+	filter, err := s.visibleAnnouncements(ctx, token, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.CreatedFrom = time.Now().Add(-activityWindow)
+
+	stats, err := s.db.SummarizeAnnouncements(ctx, courseID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count announcements: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.ActivitySummary{RecentAnnouncements: int64(stats.Count)}, nil
+}
+
+// courseToProto converts a course to its proto message. Capacity and the staff details are
+// internal to the course, so they are left out unless the caller is its staff.
+func courseToProto(course *Course, staff bool) *cpb.Course {
+	pbCourse := &cpb.Course{
+		CourseID:             course.CourseID,
+		CourseName:           course.CourseName,
+		Semester:             course.Semester,
+		Description:          course.Description,
+		Credits:              course.Credits,
+		AnnouncementsEnabled: course.AnnouncementsEnabled,
+		CreatedAt:            timeToProto(course.CreatedAt),
+		UpdatedAt:            timeToProto(course.UpdatedAt),
+		Metadata:             course.Metadata,
+	}
+
+	if staff {
+		pbCourse.Capacity = course.Capacity
+		pbCourse.StaffDetails = &cpb.CourseStaffDetails{
+			GradingFinalizedAt: timeToProto(course.GradingFinalizedAt),
+			EnrollmentOpensAt:  timeToProto(course.EnrollmentOpensAt),
+			EnrollmentClosesAt: timeToProto(course.EnrollmentClosesAt),
+		}
+	}
+
+	return pbCourse
+}
+
+// addDraftCounts reports in the staff details of each course how many components its draft
+// grading scheme has. Courses without staff details are left alone.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) addDraftCounts(ctx context.Context, pbCourses ...*cpb.Course) error {
+	courseIDs := make([]string, 0, len(pbCourses))
+
+	for _, pbCourse := range pbCourses {
+		if pbCourse.GetStaffDetails() != nil {
+			courseIDs = append(courseIDs, pbCourse.GetCourseID())
+		}
+	}
+
+	if len(courseIDs) == 0 {
+		return nil
+	}
+
+	counts, err := s.db.CountDraftGradingComponents(ctx, courseIDs...)
+	if err != nil {
+		return fmt.Errorf("failed to count draft grading components: %w",
+			status.Error(statusCode(err), err.Error()))
+	}
+
+	for _, pbCourse := range pbCourses {
+		if details := pbCourse.GetStaffDetails(); details != nil {
+			details.DraftGradingComponents = int64(counts[pbCourse.GetCourseID()])
+		}
+	}
+
+	return nil
+}
+
+// coursesToProto converts courses to their proto messages, projecting each by whether the caller
+// staffs it. The returned error already carries the matching gRPC status.
+func (s *CoursesServer) coursesToProto(ctx context.Context, token string, courses []*Course) ([]*cpb.Course, error) {
+	courseIDs := make([]string, 0, len(courses))
+	for _, course := range courses {
+		courseIDs = append(courseIDs, course.CourseID)
+	}
+
+	staffed, err := s.staffedCourses(ctx, token, courseIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	pbCourses := make([]*cpb.Course, 0, len(courses))
+	for _, course := range courses {
+		pbCourses = append(pbCourses, courseToProto(course, staffed[course.CourseID]))
+	}
+
+	if err := s.addDraftCounts(ctx, pbCourses...); err != nil {
+		return nil, err
+	}
+
+	return pbCourses, nil
+}
+
+// courseContentHash returns the hex SHA-256 of the deterministic serialization of a course.
+// The time the course was last updated is left out, so saving a course unchanged keeps its hash.
+func courseContentHash(course *cpb.Course) (string, error) {
+	content, _ := proto.Clone(course).(*cpb.Course)
+	content.UpdatedAt = nil
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal course: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// CreateCourse creates a new course.
+func (s *CoursesServer) CreateCourse(
+	ctx context.Context,
+	req *cpb.CreateCourseRequest,
+) (*cpb.CreateCourseResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received CreateCourse request", "courseName", req.GetCourse().GetCourseName())
+
+	if err := s.checkDescription(req.GetCourse().GetDescription()); err != nil {
+		return nil, fmt.Errorf("failed to add course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	staff := initialStaff(req.GetInitialStaff(), claims)
+
+	course, err := s.db.AddCourse(ctx, &cpb.Course{
+		CourseID:    req.GetCourse().GetCourseID(),
+		CourseName:  req.GetCourse().GetCourseName(),
+		Semester:    req.GetCourse().GetSemester(),
+		Description: req.GetCourse().GetDescription(),
+		Capacity:    req.GetCourse().GetCapacity(),
+		Credits:     req.GetCourse().GetCredits(),
+		Metadata:    req.GetCourse().GetMetadata(),
+	}, staff...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	staffIDs := make([]string, 0, len(staff))
+	for _, member := range staff {
+		staffIDs = append(staffIDs, member.StaffID)
+	}
+
+	isStaff, err := s.isCourseStaff(ctx, req.GetToken(), course.CourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	pbCourse := courseToProto(course, isStaff)
+	if err := s.addDraftCounts(ctx, pbCourse); err != nil {
+		return nil, err
+	}
+
+	return &cpb.CreateCourseResponse{Course: pbCourse, StaffIDs: staffIDs}, nil
+}
+
+// initialStaff converts the staff a course is created with, adding the creator if they hold the
+// staff role and are not listed already.
+func initialStaff(assignments []*cpb.StaffAssignment, claims ms.Claims) []CourseStaff {
+	staff := make([]CourseStaff, 0, len(assignments)+1)
+	for _, assignment := range assignments {
+		staff = append(staff, CourseStaff{
+			StaffID:    assignment.GetStaffID(),
+			ValidFrom:  timeFromProto(assignment.GetValidFrom()),
+			ValidUntil: timeFromProto(assignment.GetValidUntil()),
+		})
+	}
+
+	creatorID := callerID(claims)
+	if creatorID == "" || !claims.HasRole(staffRole) {
+		return staff
+	}
+
+	listed := slices.ContainsFunc(staff, func(member CourseStaff) bool { return member.StaffID == creatorID })
+	if !listed {
+		staff = append(staff, CourseStaff{StaffID: creatorID})
+	}
+
+	return staff
+}
+
+// UpdateCourse updates an existing course.
+func (s *CoursesServer) UpdateCourse(
+	ctx context.Context,
+	req *cpb.UpdateCourseRequest,
+) (*cpb.UpdateCourseResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received UpdateCourse request", "courseId", req.GetCourse().GetCourseID())
+
+	if err := s.checkDescription(req.GetCourse().GetDescription()); err != nil {
+		return nil, fmt.Errorf("failed to update course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	updatedCourse, err := s.db.UpdateCourse(ctx, req.GetCourse())
+	if err != nil {
+		return nil, fmt.Errorf("failed to update course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	staff, err := s.isCourseStaff(ctx, req.GetToken(), updatedCourse.CourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	pbCourse := courseToProto(updatedCourse, staff)
+	if err := s.addDraftCounts(ctx, pbCourse); err != nil {
+		return nil, err
+	}
+
+	return &cpb.UpdateCourseResponse{Course: pbCourse}, nil
+}
+
+// DeleteCourse deletes a course by its ID.
+func (s *CoursesServer) DeleteCourse(
+	ctx context.Context,
+	req *cpb.DeleteCourseRequest,
+) (*cpb.DeleteCourseResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received DeleteCourse request", "courseId", req.GetCourseID())
+
+	if err := s.db.DeleteCourse(ctx, req.GetCourseID()); err != nil {
+		return nil, fmt.Errorf("failed to delete course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.DeleteCourseResponse{}, nil
+}
+
+// ChangeCourseID changes the ID of a course, moving its students, staff, announcements and other data along.
+// It corrects courses created with a wrong ID.
+func (s *CoursesServer) ChangeCourseID(ctx context.Context,
+	req *cpb.ChangeCourseIDRequest,
+) (*cpb.ChangeCourseIDResponse, error) {
+	if err := s.verifyRole(ctx, req.GetToken(), adminRole); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ChangeCourseID request",
+		"courseId", req.GetCourseID(), "newCourseId", req.GetNewCourseID())
+
+	if err := s.db.ChangeCourseID(ctx, req.GetCourseID(), req.GetNewCourseID()); err != nil {
+		return nil, fmt.Errorf("failed to change course ID: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.ChangeCourseIDResponse{}, nil
+}
+
+// AddStudentToCourse adds a student to a course.
+func (s *CoursesServer) AddStudentToCourse(
+	ctx context.Context,
+	req *cpb.AddStudentRequest,
+) (*cpb.AddStudentResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received AddStudentToCourse request",
+		"courseId", req.GetCourseID(), "studentId", req.GetStudentID())
+
+	// Only course staff may override the enrollment checks.
+	if req.GetOverrideLimit() || req.GetAllowConflictingRole() {
+		if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+			return nil, err
+		}
+	}
+
+	candidate, err := s.newEnrollment(ctx, req.GetToken(), req.GetCourseID(), req.GetStudentID())
+	if err != nil {
+		return nil, err
+	}
+
+	candidate.overrideLimit = req.GetOverrideLimit()
+	candidate.allowConflictingRole = req.GetAllowConflictingRole()
+
+	blockers, err := s.enrollmentBlockers(ctx, candidate, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add student to course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	// Enrolling an enrolled student again succeeds, so retries do too.
+	if len(blockers) > 0 && !slices.Contains(blockers, cpb.EnrollmentBlocker_BLOCKER_ALREADY_ENROLLED) {
+		err := enrollmentBlockerError(blockers)
+
+		return nil, fmt.Errorf("failed to add student to course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	allowStaff := !s.policies.SeparateRoles || req.GetAllowConflictingRole()
+
+	// The capacity is checked again as the student is inserted, so that concurrent enrollments
+	// cannot take the last place together.
+	err = s.db.AddStudentToCourse(ctx, req.GetCourseID(), req.GetStudentID(), allowStaff, req.GetOverrideLimit())
+	if err != nil {
+		return nil, fmt.Errorf("failed to add student to course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.AddStudentResponse{
+		MissingCorequisites: s.missingCorequisites(ctx, req.GetCourseID(), req.GetStudentID()),
+	}, nil
+}
+
+// BatchAddStudents enrolls many students in a course at once, e.g. from a registrar roster. Students who
+// cannot be enrolled are skipped and reported instead of failing the batch. Course staff only.
+func (s *CoursesServer) BatchAddStudents(ctx context.Context,
+	req *cpb.BatchAddStudentsRequest,
+) (*cpb.BatchAddStudentsResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received BatchAddStudents request",
+		"courseId", req.GetCourseID(), "students", len(req.GetStudentIDs()))
+
+	outcomes, err := s.batchEnrollmentOutcomes(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add students to course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	studentIDs := slices.DeleteFunc(slices.Clone(req.GetStudentIDs()), func(studentID string) bool {
+		_, blocked := outcomes[studentID]
+
+		return blocked
+	})
+
+	allowStaff := !s.policies.SeparateRoles || req.GetAllowConflictingRole()
+
+	enrolled, err := s.db.AddStudentsToCourse(ctx, req.GetCourseID(), studentIDs, allowStaff, req.GetOverrideLimit())
+	if err != nil {
+		return nil, fmt.Errorf("failed to add students to course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	response := &cpb.BatchAddStudentsResponse{}
+
+	for _, studentID := range req.GetStudentIDs() {
+		outcome, blocked := outcomes[studentID]
+		if !blocked {
+			outcome, enrolled = enrolled[0].Outcome, enrolled[1:]
+		}
+
+		if outcome == cpb.BatchEnrollmentOutcome_STUDENT_ADDED {
+			response.AddedCount++
+		} else {
+			response.SkippedCount++
+		}
+
+		response.Results = append(response.Results, &cpb.BatchEnrollmentResult{StudentID: studentID, Outcome: outcome})
+	}
+
+	return response, nil
+}
+
+// batchEnrollmentOutcomes runs the enrollment checks of AddStudentToCourse on every student of a
+// batch and returns the outcome of each student they block. The checks on who is in the course are
+// left to AddStudentsToCourse, which makes them as it inserts the students.
+func (s *CoursesServer) batchEnrollmentOutcomes(ctx context.Context, req *cpb.BatchAddStudentsRequest,
+) (map[string]cpb.BatchEnrollmentOutcome, error) {
+	outcomes := map[cpb.EnrollmentBlocker]cpb.BatchEnrollmentOutcome{
+		cpb.EnrollmentBlocker_BLOCKER_NOT_YET_OPEN:           cpb.BatchEnrollmentOutcome_ENROLLMENT_CLOSED,
+		cpb.EnrollmentBlocker_BLOCKER_CLOSED:                 cpb.BatchEnrollmentOutcome_ENROLLMENT_CLOSED,
+		cpb.EnrollmentBlocker_BLOCKER_SEMESTER_LIMIT_REACHED: cpb.BatchEnrollmentOutcome_SEMESTER_LIMIT_REACHED,
+		cpb.EnrollmentBlocker_BLOCKER_CREDIT_LIMIT_REACHED:   cpb.BatchEnrollmentOutcome_CREDIT_LIMIT_REACHED,
+	}
+
+	blocked := make(map[string]cpb.BatchEnrollmentOutcome)
+
+	for _, studentID := range req.GetStudentIDs() {
+		// Only course staff enroll students in batches, so no student is enrolling themselves.
+		blockers, err := s.enrollmentBlockers(ctx, enrollment{
+			courseID:             req.GetCourseID(),
+			studentID:            studentID,
+			overrideLimit:        req.GetOverrideLimit(),
+			allowConflictingRole: req.GetAllowConflictingRole(),
+		}, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(blockers) > 0 {
+			blocked[studentID] = outcomes[blockers[0]]
+		}
+	}
+
+	return blocked, nil
+}
+
+// newEnrollment returns a student about to be enrolled in a course by the caller holding token, with
+// no checks overridden. The returned error already carries the matching gRPC status.
+func (s *CoursesServer) newEnrollment(ctx context.Context, token, courseID, studentID string) (enrollment, error) {
+	candidate := enrollment{courseID: courseID, studentID: studentID}
+
+	// Requests authorized by a course API key are not made by a person.
+	if _, ok := ctx.Value(apiKeyContextKey{}).(*CourseAPIKey); ok {
+		return candidate, nil
+	}
+
+	// Students enrolling each other are held to self-enrollment like students enrolling themselves.
+	staff, err := s.isCourseStaff(ctx, token, courseID)
+	if err != nil {
+		return enrollment{}, err
+	}
+
+	candidate.selfEnrolling = !staff
+
+	return candidate, nil
+}
+
+// missingCorequisites returns the co-requisites of a course the student is not enrolled in.
+// Missing co-requisites only warn, so failing to look them up does not fail the enrollment.
+func (s *CoursesServer) missingCorequisites(ctx context.Context, courseID, studentID string) []string {
+	logger := klog.FromContext(ctx)
+
+	missingIDs, err := s.db.MissingCorequisites(ctx, courseID, studentID)
+	if err != nil {
+		logger.Error(err, "Failed to check co-requisites", "courseId", courseID, "studentId", studentID)
+
+		return nil
+	}
+
+	if len(missingIDs) > 0 {
+		logger.Info("Student enrolled without co-requisites",
+			"courseId", courseID, "studentId", studentID, "missing", missingIDs)
+	}
+
+	return missingIDs
+}
+
+// CheckEnrollmentEligibility reports whether a student can enroll in a course and every reason they
+// cannot, so that clients can explain a refusal before attempting the enrollment. The student
+// themselves, course staff and admins may check.
+func (s *CoursesServer) CheckEnrollmentEligibility(ctx context.Context,
+	req *cpb.CheckEnrollmentEligibilityRequest,
+) (*cpb.CheckEnrollmentEligibilityResponse, error) {
+	if err := s.verifySelf(ctx, req.GetToken(), req.GetStudentID()); err != nil {
+		if staffErr := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); staffErr != nil {
+			return nil, err
+		}
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received CheckEnrollmentEligibility request",
+		"courseId", req.GetCourseID(), "studentId", req.GetStudentID())
+
+	candidate, err := s.newEnrollment(ctx, req.GetToken(), req.GetCourseID(), req.GetStudentID())
+	if err != nil {
+		return nil, err
+	}
+
+	blockers, err := s.enrollmentBlockers(ctx, candidate, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check enrollment eligibility: %w",
+			status.Error(statusCode(err), err.Error()))
+	}
+
+	missingIDs, err := s.db.MissingCorequisites(ctx, req.GetCourseID(), req.GetStudentID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check co-requisites: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.CheckEnrollmentEligibilityResponse{
+		Eligible:            len(blockers) == 0,
+		Blockers:            blockers,
+		MissingCorequisites: missingIDs,
+	}, nil
+}
+
+// enrollment is a student about to be enrolled in a course, checked by enrollmentBlockers.
+type enrollment struct {
+	courseID  string
+	studentID string
+	// selfEnrolling is set if the enrollment is made by a student rather than by course staff, an
+	// admin or an API key, whichever student it enrolls.
+	selfEnrolling bool
+	// overrideLimit and allowConflictingRole are set by course staff, see AddStudentRequest.
+	overrideLimit        bool
+	allowConflictingRole bool
+}
+
+// enrollmentBlockers runs the enrollment checks on a student and a course, returning the reasons
+// the student cannot enroll. AddStudentToCourse, BatchAddStudents and CheckEnrollmentEligibility
+// share these checks, so the pre-flight refuses exactly the enrollments that would fail. The checks
+// on who is in the course, its capacity, the enrolled students and its staff, only run with roster.
+func (s *CoursesServer) enrollmentBlockers(ctx context.Context, candidate enrollment, roster bool,
+) ([]cpb.EnrollmentBlocker, error) {
+	checks := []func(ctx context.Context, candidate enrollment) (cpb.EnrollmentBlocker, error){
+		s.selfEnrollmentBlocker,
+		s.enrollmentWindowBlocker,
+	}
+
+	if roster {
+		checks = append(checks, s.capacityBlocker, s.enrolledBlocker, s.conflictingRoleBlocker)
+	}
+
+	checks = append(checks, s.enrollmentLimitBlocker, s.creditLimitBlocker)
+
+	var blockers []cpb.EnrollmentBlocker
+
+	for _, check := range checks {
+		blocker, err := check(ctx, candidate)
+		if err != nil {
+			return nil, err
+		}
+
+		if blocker != cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED {
+			blockers = append(blockers, blocker)
+		}
+	}
+
+	return blockers, nil
+}
+
+// enrollmentBlockerError returns the error refusing an enrollment for the given blockers.
+func enrollmentBlockerError(blockers []cpb.EnrollmentBlocker) error {
+	errs := map[cpb.EnrollmentBlocker]error{
+		cpb.EnrollmentBlocker_BLOCKER_SELF_ENROLLMENT_DISABLED: ErrFeatureDisabled,
+		cpb.EnrollmentBlocker_BLOCKER_NOT_YET_OPEN:             ErrEnrollmentClosed,
+		cpb.EnrollmentBlocker_BLOCKER_CLOSED:                   ErrEnrollmentClosed,
+		cpb.EnrollmentBlocker_BLOCKER_COURSE_FULL:              ErrCapacityExceeded,
+		cpb.EnrollmentBlocker_BLOCKER_STAFF_OF_COURSE:          ErrConflictingRole,
+		cpb.EnrollmentBlocker_BLOCKER_SEMESTER_LIMIT_REACHED:   ErrEnrollmentLimitReached,
+		cpb.EnrollmentBlocker_BLOCKER_CREDIT_LIMIT_REACHED:     ErrCreditLimitReached,
+	}
+
+	return fmt.Errorf("%w: %v", errs[blockers[0]], blockers)
+}
+
+// selfEnrollmentBlocker blocks students enrolling themselves in a course that has self-enrollment disabled.
+// Staff enrolling students, including themselves, are not affected.
+func (s *CoursesServer) selfEnrollmentBlocker(ctx context.Context, candidate enrollment,
+) (cpb.EnrollmentBlocker, error) {
+	if !candidate.selfEnrolling {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, nil
+	}
+
+	enabled, err := s.featureEnabled(ctx, candidate.courseID, featureSelfEnroll)
+	if err != nil || enabled {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, err
+	}
+
+	return cpb.EnrollmentBlocker_BLOCKER_SELF_ENROLLMENT_DISABLED, nil
+}
+
+// enrollmentWindowBlocker blocks enrolling outside the enrollment window of the course.
+func (s *CoursesServer) enrollmentWindowBlocker(ctx context.Context, candidate enrollment,
+) (cpb.EnrollmentBlocker, error) {
+	if candidate.overrideLimit {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, nil
+	}
+
+	window, err := s.db.EnrollmentStatus(ctx, candidate.courseID)
+	if err != nil {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, fmt.Errorf("failed to get enrollment status: %w", err)
+	}
+
+	// An open window blocks nothing.
+	blockers := map[cpb.EnrollmentStatus]cpb.EnrollmentBlocker{
+		cpb.EnrollmentStatus_NOT_YET_OPEN: cpb.EnrollmentBlocker_BLOCKER_NOT_YET_OPEN,
+		cpb.EnrollmentStatus_CLOSED:       cpb.EnrollmentBlocker_BLOCKER_CLOSED,
+	}
+
+	return blockers[window.Status], nil
+}
+
+// capacityBlocker blocks enrolling in a course whose places are all taken.
+func (s *CoursesServer) capacityBlocker(ctx context.Context, candidate enrollment) (cpb.EnrollmentBlocker, error) {
+	if candidate.overrideLimit {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, nil
+	}
+
+	course, err := s.db.GetCourse(ctx, candidate.courseID)
+	if err != nil {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, fmt.Errorf("failed to get course: %w", err)
+	}
+
+	if course.Capacity == 0 {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, nil
+	}
+
+	enrolled, err := s.db.CountCourseStudents(ctx, candidate.courseID)
+	if err != nil {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, fmt.Errorf("failed to count students: %w", err)
+	}
+
+	if enrolled >= int(course.Capacity) {
+		return cpb.EnrollmentBlocker_BLOCKER_COURSE_FULL, nil
+	}
+
+	return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, nil
+}
+
+// enrolledBlocker blocks enrolling a student who is already enrolled in the course.
+func (s *CoursesServer) enrolledBlocker(ctx context.Context, candidate enrollment,
+) (cpb.EnrollmentBlocker, error) {
+	students, err := s.db.GetCourseStudents(ctx, candidate.courseID, false)
+	if err != nil {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, fmt.Errorf("failed to get students: %w", err)
+	}
+
+	if slices.Contains(students, candidate.studentID) {
+		return cpb.EnrollmentBlocker_BLOCKER_ALREADY_ENROLLED, nil
+	}
+
+	return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, nil
+}
+
+// conflictingRoleBlocker blocks enrolling staff of the course while roles are kept separate.
+func (s *CoursesServer) conflictingRoleBlocker(ctx context.Context, candidate enrollment,
+) (cpb.EnrollmentBlocker, error) {
+	if !s.policies.SeparateRoles || candidate.allowConflictingRole {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, nil
+	}
+
+	staff, err := s.db.GetCourseStaff(ctx, candidate.courseID, true)
+	if err != nil {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, fmt.Errorf("failed to get staff: %w", err)
+	}
+
+	if slices.Contains(staff, candidate.studentID) {
+		return cpb.EnrollmentBlocker_BLOCKER_STAFF_OF_COURSE, nil
+	}
+
+	return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, nil
+}
+
+// enrollmentLimitBlocker blocks enrolling a student who reached the limit of courses per semester.
+func (s *CoursesServer) enrollmentLimitBlocker(ctx context.Context, candidate enrollment,
+) (cpb.EnrollmentBlocker, error) {
+	if candidate.overrideLimit {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, nil
+	}
+
+	err := s.checkEnrollmentLimit(ctx, candidate.courseID, candidate.studentID)
+	if errors.Is(err, ErrEnrollmentLimitReached) {
+		return cpb.EnrollmentBlocker_BLOCKER_SEMESTER_LIMIT_REACHED, nil
+	}
+
+	return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, err
+}
+
+// creditLimitBlocker blocks enrolling a student whose credits in the semester of the course would
+// exceed the limit with the credits of the course.
+func (s *CoursesServer) creditLimitBlocker(ctx context.Context, candidate enrollment,
+) (cpb.EnrollmentBlocker, error) {
+	if s.limits.Credits == 0 || candidate.overrideLimit {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, nil
+	}
+
+	course, err := s.db.GetCourse(ctx, candidate.courseID)
+	if err != nil {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, fmt.Errorf("failed to get course: %w", err)
+	}
+
+	credits, err := s.db.GetStudentCredits(ctx, candidate.studentID, course.Semester)
+	if err != nil {
+		return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, fmt.Errorf("failed to get student credits: %w", err)
+	}
+
+	if credits+int64(course.Credits) > int64(s.limits.Credits) {
+		return cpb.EnrollmentBlocker_BLOCKER_CREDIT_LIMIT_REACHED, nil
+	}
+
+	return cpb.EnrollmentBlocker_BLOCKER_UNSPECIFIED, nil
+}
+
+// RemoveStudentFromCourse removes a student from a course.
+func (s *CoursesServer) RemoveStudentFromCourse(
+	ctx context.Context,
+	req *cpb.RemoveStudentRequest,
+) (*cpb.RemoveStudentResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received RemoveStudentFromCourse request",
+		"courseId", req.GetCourseID(), "studentId", req.GetStudentID())
+
+	if err := s.db.RemoveStudentFromCourse(ctx, req.GetCourseID(), req.GetStudentID()); err != nil {
+		return nil, fmt.Errorf("failed to remove student from course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.RemoveStudentResponse{}, nil
+}
+
+// SetStudentStatus sets the status of a student in a course.
+func (s *CoursesServer) SetStudentStatus(
+	ctx context.Context,
+	req *cpb.SetStudentStatusRequest,
+) (*cpb.SetStudentStatusResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received SetStudentStatus request",
+		"courseId", req.GetCourseID(), "studentId", req.GetStudentID(), "status", req.GetStatus())
+
+	if err := s.db.SetStudentStatus(ctx, req.GetCourseID(), req.GetStudentID(), req.GetStatus()); err != nil {
+		return nil, fmt.Errorf("failed to set student status: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.SetStudentStatusResponse{}, nil
+}
+
+// GetStudentStatusTransitions returns the statuses a student may move to from each status,
+// so clients only offer changes SetStudentStatus accepts.
+func (s *CoursesServer) GetStudentStatusTransitions(
+	ctx context.Context,
+	_ *cpb.GetStudentStatusTransitionsRequest,
+) (*cpb.GetStudentStatusTransitionsResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetStudentStatusTransitions request")
+
+	allowed := studentStatusTransitions()
+	transitions := make([]*cpb.StudentStatusTransitions, 0, len(allowed))
+
+	for _, from := range slices.Sorted(maps.Keys(allowed)) {
+		transitions = append(transitions, &cpb.StudentStatusTransitions{Status: from, Allowed: allowed[from]})
+	}
+
+	return &cpb.GetStudentStatusTransitionsResponse{Transitions: transitions}, nil
+}
+
+// TransferEnrollments enrolls the students enrolled in a course in another course, skipping those
+// already in it. Nobody is transferred if the students do not all fit into the destination course.
+func (s *CoursesServer) TransferEnrollments(
+	ctx context.Context,
+	req *cpb.TransferEnrollmentsRequest,
+) (*cpb.TransferEnrollmentsResponse, error) {
+	if err := s.verifyRole(ctx, req.GetToken(), adminRole); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received TransferEnrollments request",
+		"fromCourseId", req.GetFromCourseID(), "toCourseId", req.GetToCourseID())
+
+	transfer, err := s.db.TransferEnrollments(ctx, req.GetFromCourseID(), req.GetToCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to transfer enrollments: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.TransferEnrollmentsResponse{
+		TransferredCount: int64(transfer.Transferred),
+		SkippedCount:     int64(transfer.Skipped),
+	}, nil
+}
+
+// FindDuplicateEnrollments lists the students enrolled in a course more than once.
+func (s *CoursesServer) FindDuplicateEnrollments(ctx context.Context,
+	req *cpb.FindDuplicateEnrollmentsRequest,
+) (*cpb.FindDuplicateEnrollmentsResponse, error) {
+	if err := s.verifyRole(ctx, req.GetToken(), adminRole); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received FindDuplicateEnrollments request")
+
+	duplicates, err := s.db.FindDuplicateEnrollments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate enrollments: %w",
+			status.Error(statusCode(err), err.Error()))
+	}
+
+	pbDuplicates := make([]*cpb.DuplicateEnrollment, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		pbDuplicates = append(pbDuplicates, &cpb.DuplicateEnrollment{
+			CourseID:  duplicate.CourseID,
+			StudentID: duplicate.StudentID,
+			Count:     int64(duplicate.Count),
+		})
+	}
+
+	return &cpb.FindDuplicateEnrollmentsResponse{Duplicates: pbDuplicates}, nil
+}
+
+// DeduplicateEnrollments removes all but one enrollment of every student enrolled in a course
+// more than once.
+func (s *CoursesServer) DeduplicateEnrollments(ctx context.Context,
+	req *cpb.DeduplicateEnrollmentsRequest,
+) (*cpb.DeduplicateEnrollmentsResponse, error) {
+	if err := s.verifyRole(ctx, req.GetToken(), adminRole); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received DeduplicateEnrollments request")
+
+	removed, err := s.db.DeduplicateEnrollments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deduplicate enrollments: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	logger.Info("Removed duplicate enrollments", "removed", removed)
+
+	return &cpb.DeduplicateEnrollmentsResponse{RemovedCount: int64(removed)}, nil
+}
+
+// GetDataShapeReport reports how many courses, enrollments and announcements there are.
+func (s *CoursesServer) GetDataShapeReport(ctx context.Context,
+	req *cpb.GetDataShapeReportRequest,
+) (*cpb.GetDataShapeReportResponse, error) {
+	if err := s.verifyRole(ctx, req.GetToken(), adminRole); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetDataShapeReport request")
+
+	shape, err := s.db.GetDataShape(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data shape: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return dataShapeToProto(shape), nil
+}
+
+// GetCourseSnapshot retrieves a course with its students, staff, announcements and quiet periods
+// as they were at a single point in time.
+func (s *CoursesServer) GetCourseSnapshot(ctx context.Context,
+	req *cpb.GetCourseSnapshotRequest,
+) (*cpb.GetCourseSnapshotResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetCourseSnapshot request", "courseId", req.GetCourseID())
+
+	snapshot, err := s.db.GetCourseSnapshot(ctx, req.GetCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course snapshot: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	resp, err := courseSnapshotToProto(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course snapshot: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	if err := s.addDraftCounts(ctx, resp.GetCourse()); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// AddStaffToCourse adds a staff member to a course.
+func (s *CoursesServer) AddStaffToCourse(ctx context.Context, req *cpb.AddStaffRequest) (*cpb.AddStaffResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received AddStaffToCourse request",
+		"courseId", req.GetCourseID(), "staffId", req.GetStaffID())
+
+	if req.GetAllowConflictingRole() {
+		if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+			return nil, err
+		}
+	}
+
+	validFrom, validUntil := timeFromProto(req.GetValidFrom()), timeFromProto(req.GetValidUntil())
+	allowStudent := !s.policies.SeparateRoles || req.GetAllowConflictingRole()
+
+	err := s.db.AddStaffToCourse(ctx, req.GetCourseID(), req.GetStaffID(), validFrom, validUntil, allowStudent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add staff to course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.AddStaffResponse{}, nil
+}
+
+// RemoveStaffFromCourse removes a staff member from a course.
+func (s *CoursesServer) RemoveStaffFromCourse(
+	ctx context.Context,
+	req *cpb.RemoveStaffRequest,
+) (*cpb.RemoveStaffResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received RemoveStaffFromCourse request",
+		"courseId", req.GetCourseID(), "staffId", req.GetStaffID())
+
+	if err := s.db.RemoveStaffFromCourse(ctx, req.GetCourseID(), req.GetStaffID()); err != nil {
+		return nil, fmt.Errorf("failed to remove staff from course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.RemoveStaffResponse{}, nil
+}
+
+// GetCourseStudents retrieves the students enrolled in a course.
+func (s *CoursesServer) GetCourseStudents(
+	ctx context.Context,
+	req *cpb.GetCourseStudentsRequest,
+) (*cpb.GetCourseStudentsResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetCourseStudents request",
+		"courseId", req.GetCourseID(), "includeInactive", req.GetIncludeInactive())
+
+	studentIDs, err := s.db.GetCourseStudents(ctx, req.GetCourseID(), req.GetIncludeInactive())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course students: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.GetCourseStudentsResponse{StudentsIDs: studentIDs}, nil
+}
+
+// GetCourseStudentCount counts the students enrolled in a course, for when their IDs are not needed.
+func (s *CoursesServer) GetCourseStudentCount(ctx context.Context,
+	req *cpb.GetCourseStudentCountRequest,
+) (*cpb.GetCourseStudentCountResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetCourseStudentCount request", "courseId", req.GetCourseID())
+
+	count, err := s.db.CountCourseStudents(ctx, req.GetCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count course students: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.GetCourseStudentCountResponse{Count: int64(count)}, nil
+}
+
+// GetCourseStaff retrieves a page of the staff members assigned to a course.
+func (s *CoursesServer) GetCourseStaff(ctx context.Context,
+	req *cpb.GetCourseStaffRequest,
+) (*cpb.GetCourseStaffResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetCourseStaff request",
+		"courseId", req.GetCourseID(), "includeExpired", req.GetIncludeExpired())
+
+	page, err := s.pagination.page(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("invalid page: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	staff, err := s.db.GetCourseStaffPage(ctx, req.GetCourseID(), req.GetIncludeExpired(), page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course staff: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	resp := &cpb.GetCourseStaffResponse{
+		StaffIDs:      staff.StaffIDs,
+		NextPageToken: nextPageToken(page, len(staff.StaffIDs)),
+		PageSize:      s.pagination.size(req.GetPageSize()),
+		TotalCount:    int64(staff.Total),
+	}
+
+	if req.GetIncludeContacts() {
+		resp.Contacts, resp.ContactsIncomplete = s.staffContacts(ctx, staff.StaffIDs)
+	}
+
+	return resp, nil
+}
+
+// staffContacts looks up the contacts of staff members in the directory and reports whether some
+// are missing because the directory failed. After the first failure the directory is assumed to
+// be down and the remaining contacts are left with only their staff ID.
+func (s *CoursesServer) staffContacts(ctx context.Context, staffIDs []string) ([]*cpb.StaffContact, bool) {
+	contacts := make([]*cpb.StaffContact, 0, len(staffIDs))
+	incomplete := false
+
+	for _, staffID := range staffIDs {
+		pbContact := &cpb.StaffContact{StaffID: staffID}
+		contacts = append(contacts, pbContact)
+
+		if incomplete {
+			continue
+		}
+
+		contact, err := s.directory.GetStaffContact(ctx, staffID)
+		if errors.Is(err, ErrStaffContactNotFound) {
+			continue
+		}
+
+		if err != nil {
+			klog.FromContext(ctx).Error(err, "Staff directory unavailable, returning staff without contacts")
+
+			incomplete = true
+
+			continue
+		}
+
+		pbContact.Name, pbContact.Email = contact.Name, contact.Email
+	}
+
+	return contacts, incomplete
+}
+
+// strictRequest is implemented by reads of a student or staff member that may ask for strict reads.
+type strictRequest interface {
+	proto.Message
+	GetStrict() bool
+}
+
+// strictRead reports whether a read of a student or staff member must find them: as the request
+// asks if it sets strict, as configured otherwise.
+func (s *CoursesServer) strictRead(req strictRequest) bool {
+	message := req.ProtoReflect()
+	if message.Has(message.Descriptor().Fields().ByName("strict")) {
+		return req.GetStrict()
+	}
+
+	return s.policies.StrictReads
+}
+
+// checkKnownStudent fails strict reads of a student who is in no course and never was.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) checkKnownStudent(ctx context.Context, studentID string, req strictRequest) error {
+	if !s.strictRead(req) {
+		return nil
+	}
+
+	known, err := s.db.IsKnownStudent(ctx, studentID)
+	if err == nil && !known {
+		err = fmt.Errorf("%w: %s", ErrStudentNotFound, studentID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to check student: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return nil
+}
+
+// checkKnownStaff fails strict reads of a staff member who is in no course, never was and,
+// if one is configured, is not in the staff directory either.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) checkKnownStaff(ctx context.Context, staffID string, req strictRequest) error {
+	if !s.strictRead(req) {
+		return nil
+	}
+
+	known, err := s.db.IsKnownStaff(ctx, staffID)
+	if err == nil && !known {
+		known, err = s.inStaffDirectory(ctx, staffID)
+	}
+
+	if err == nil && !known {
+		err = fmt.Errorf("%w: %s", ErrStaffNotFound, staffID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to check staff member: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return nil
+}
+
+// inStaffDirectory reports whether the staff directory knows a staff member.
+// Nobody is known without a configured directory.
+func (s *CoursesServer) inStaffDirectory(ctx context.Context, staffID string) (bool, error) {
+	if _, ok := s.directory.(noStaffDirectory); ok || s.directory == nil {
+		return false, nil
+	}
+
+	_, err := s.directory.GetStaffContact(ctx, staffID)
+	if errors.Is(err, ErrStaffContactNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to look up staff member: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetStudentCourses retrieves the courses a student is enrolled in.
+func (s *CoursesServer) GetStudentCourses(ctx context.Context,
+	req *cpb.GetStudentCoursesRequest,
+) (*cpb.GetStudentCoursesResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetStudentCourses request",
+		"studentId", req.GetStudentID(), "semester", req.GetSemester())
+
+	if err := s.checkKnownStudent(ctx, req.GetStudentID(), req); err != nil {
+		return nil, err
+	}
+
+	courseIDs, err := s.db.GetStudentCourses(ctx, req.GetStudentID(), req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student courses: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.GetStudentCoursesResponse{CoursesIDs: courseIDs}, nil
+}
+
+// GetEnrollmentsForStudent retrieves every course a student is enrolled in together with
+// their status in it, ordered by semester. Students may only look up their own enrollments.
+func (s *CoursesServer) GetEnrollmentsForStudent(ctx context.Context,
+	req *cpb.GetEnrollmentsForStudentRequest,
+) (*cpb.GetEnrollmentsForStudentResponse, error) {
+	if err := s.verifySelf(ctx, req.GetToken(), req.GetStudentID()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetEnrollmentsForStudent request", "studentId", req.GetStudentID())
+
+	if err := s.checkKnownStudent(ctx, req.GetStudentID(), req); err != nil {
+		return nil, err
+	}
+
+	enrollments, err := s.db.GetEnrollmentsForStudent(ctx, req.GetStudentID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student enrollments: %w",
+			status.Error(statusCode(err), err.Error()))
+	}
+
+	courses := make([]*Course, 0, len(enrollments))
+	for i := range enrollments {
+		courses = append(courses, &enrollments[i].Course)
+	}
+
+	pbCourses, err := s.coursesToProto(ctx, req.GetToken(), courses)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &cpb.GetEnrollmentsForStudentResponse{
+		Enrollments: make([]*cpb.Enrollment, 0, len(enrollments)),
+	}
+
+	for i, enrollment := range enrollments {
+		response.Enrollments = append(response.Enrollments, &cpb.Enrollment{Course: pbCourses[i], Status: enrollment.Status})
+	}
+
+	return response, nil
+}
+
+// GetStudentCredits sums the credits of the courses a student is enrolled in during a semester.
+// Students may only look up their own credits.
+func (s *CoursesServer) GetStudentCredits(ctx context.Context,
+	req *cpb.GetStudentCreditsRequest,
+) (*cpb.GetStudentCreditsResponse, error) {
+	if err := s.verifySelf(ctx, req.GetToken(), req.GetStudentID()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetStudentCredits request",
+		"studentId", req.GetStudentID(), "semester", req.GetSemester())
+
+	if err := s.checkKnownStudent(ctx, req.GetStudentID(), req); err != nil {
+		return nil, err
+	}
+
+	credits, err := s.db.GetStudentCredits(ctx, req.GetStudentID(), req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student credits: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.GetStudentCreditsResponse{Credits: credits}, nil
+}
+
+// GetStaffCourses retrieves the courses a staff member is associated with.
+func (s *CoursesServer) GetStaffCourses(ctx context.Context,
+	req *cpb.GetStaffCoursesRequest,
+) (*cpb.GetStaffCoursesResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetStaffCourses request",
+		"staffId", req.GetStaffID(), "semester", req.GetSemester())
+
+	if err := s.checkKnownStaff(ctx, req.GetStaffID(), req); err != nil {
+		return nil, err
+	}
+
+	courseIDs, err := s.db.GetStaffCourses(ctx, req.GetStaffID(), req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staff courses: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.GetStaffCoursesResponse{CoursesIDs: courseIDs}, nil
+}
+
+// GetSemesterCourses retrieves all courses for a specific semester.
+// As a guard against loading the whole catalog, at most semesterCoursesLimit courses are returned.
+func (s *CoursesServer) GetSemesterCourses(ctx context.Context,
+	req *cpb.GetSemesterCoursesRequest,
+) (*cpb.GetSemesterCoursesResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetSemesterCourses request", "semester", req.GetSemester())
+
+	// One course past the limit tells whether the result was truncated.
+	courses, err := s.db.GetCoursesBySemester(ctx, req.GetSemester(), s.semesterCoursesLimit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get courses by semester: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	truncated := len(courses) > s.semesterCoursesLimit
+	if truncated {
+		logger.Info("Semester holds more courses than the limit, truncating",
+			"semester", req.GetSemester(), "limit", s.semesterCoursesLimit)
+
+		courses = courses[:s.semesterCoursesLimit]
+	}
+
+	pbCourses, err := s.coursesToProto(ctx, req.GetToken(), courses)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cpb.GetSemesterCoursesResponse{Courses: pbCourses, Truncated: truncated}, nil
+}
+
+// ListCourses lists the courses of the catalog selected by the filters of the request,
+// so the catalog can be browsed without knowing its semesters.
+func (s *CoursesServer) ListCourses(ctx context.Context,
+	req *cpb.ListCoursesRequest,
+) (*cpb.ListCoursesResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ListCourses request", "semester", req.GetSemester(),
+		"nameContains", req.GetNameContains(), "createdAfter", timeFromProto(req.GetCreatedAfter()))
+
+	page, err := s.pagination.page(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("invalid page: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	filter := CourseFilter{
+		Semester:     req.GetSemester(),
+		NameContains: req.GetNameContains(),
+		CreatedAfter: timeFromProto(req.GetCreatedAfter()),
+	}
+
+	courses, err := s.db.ListCourses(ctx, filter, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list courses: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	pbCourses, err := s.coursesToProto(ctx, req.GetToken(), courses)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cpb.ListCoursesResponse{
+		Courses:       pbCourses,
+		NextPageToken: nextPageToken(page, len(courses)),
+		PageSize:      s.pagination.size(req.GetPageSize()),
+	}, nil
+}
+
+// GetCoursesByMetadata lists the courses whose custom field key is set to value,
+// e.g. the courses of a department.
+func (s *CoursesServer) GetCoursesByMetadata(ctx context.Context,
+	req *cpb.GetCoursesByMetadataRequest,
+) (*cpb.GetCoursesByMetadataResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetCoursesByMetadata request", "key", req.GetKey(), "value", req.GetValue())
+
+	page, err := s.pagination.page(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("invalid page: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	courses, err := s.db.GetCoursesByMetadata(ctx, req.GetKey(), req.GetValue(), page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get courses by metadata: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	pbCourses, err := s.coursesToProto(ctx, req.GetToken(), courses)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cpb.GetCoursesByMetadataResponse{
+		Courses:       pbCourses,
+		NextPageToken: nextPageToken(page, len(courses)),
+		PageSize:      s.pagination.size(req.GetPageSize()),
+	}, nil
+}
+
+// ListCoursesWithoutStaff lists the courses in a semester that have no staff assigned,
+// so admins can staff them before the semester starts.
+func (s *CoursesServer) ListCoursesWithoutStaff(ctx context.Context,
+	req *cpb.ListCoursesWithoutStaffRequest,
+) (*cpb.ListCoursesWithoutStaffResponse, error) {
+	if err := s.verifyRole(ctx, req.GetToken(), adminRole); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ListCoursesWithoutStaff request", "semester", req.GetSemester())
+
+	courses, err := s.db.ListCoursesWithoutStaff(ctx, req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list courses without staff: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	pbCourses, err := s.coursesToProto(ctx, req.GetToken(), courses)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cpb.ListCoursesWithoutStaffResponse{Courses: pbCourses}, nil
+}
+
+// AddAnnouncementToCourse adds an announcement to a course and returns it with its ID.
+func (s *CoursesServer) AddAnnouncementToCourse(ctx context.Context,
+	req *cpb.AddAnnouncementRequest,
+) (*cpb.AddAnnouncementResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received AddAnnouncementToCourse request",
+		"courseId", req.GetCourseID())
+
+	if err := s.verifyCourseStaffOrAPIKey(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	author, err := s.callerAuthor(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetAnnouncement() != nil {
+		req.Announcement.Author = author
+	}
+
+	if length := len(req.GetAnnouncement().GetAnnouncementContent()); length > s.announcementSizes.MaxLength {
+		err := fmt.Errorf("%w: %d bytes, at most %d allowed", ErrAnnouncementTooLong, length,
+			s.announcementSizes.MaxLength)
+
+		return nil, fmt.Errorf("failed to add announcement to course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	announcement, err := s.db.AddAnnouncement(ctx, req, s.announcementSizes.ExcerptLength)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add announcement to course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	pbAnnouncement, err := announcementToProto(*announcement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add announcement to course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.AddAnnouncementResponse{Announcement: pbAnnouncement}, nil
+}
+
+// GetCourseAnnouncements retrieves the announcements associated with a course.
+func (s *CoursesServer) GetCourseAnnouncements(ctx context.Context,
+	req *cpb.GetCourseAnnouncementsRequest,
+) (*cpb.GetCourseAnnouncementsResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetCourseAnnouncements request", "courseId", req.GetCourseID())
+
+	page, err := s.pagination.page(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("invalid page: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	filter, err := s.visibleAnnouncements(ctx, req.GetToken(), req.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+
+	filter.CreatedFrom = timeFromProto(req.GetCreatedFrom())
+	filter.CreatedBefore = timeFromProto(req.GetCreatedBefore())
+
+	// The version is taken before the announcements are read, so a concurrent change at worst
+	// makes the client fetch again.
+	stats, err := s.db.SummarizeAnnouncements(ctx, req.GetCourseID(), filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcements version: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	versionToken := announcementsVersionToken(req.GetCourseID(), filter, page, stats)
+	if req.GetKnownVersionToken() == versionToken {
+		return &cpb.GetCourseAnnouncementsResponse{VersionToken: versionToken, NotModified: true}, nil
+	}
+
+	resp, err := s.db.GetAnnouncements(ctx, req.GetCourseID(), filter, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcements: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	announcements, err := announcementsToProto(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcements: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.GetCourseAnnouncementsResponse{
+		Announcements: announcements,
+		VersionToken:  versionToken,
+		NextPageToken: nextPageToken(page, len(resp)),
+		PageSize:      s.pagination.size(req.GetPageSize()),
+	}, nil
+}
+
+// GetAnnouncement retrieves an announcement of a course with its full content, which lists may
+// cut to an excerpt. Staff-only announcements are not found by students.
+func (s *CoursesServer) GetAnnouncement(ctx context.Context,
+	req *cpb.GetAnnouncementRequest,
+) (*cpb.GetAnnouncementResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetAnnouncement request",
+		"courseId", req.GetCourseID(), "announcementId", req.GetAnnouncementID())
+
+	filter, err := s.visibleAnnouncements(ctx, req.GetToken(), req.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+
+	announcement, err := s.db.GetAnnouncement(ctx, req.GetCourseID(), req.GetAnnouncementID(), filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcement: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	pbAnnouncement, err := announcementToProto(*announcement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcement: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.GetAnnouncementResponse{Announcement: pbAnnouncement}, nil
+}
+
+// GetAnnouncementCountByAuthor counts the announcements each staff member posted to a course, showing
+// who communicates with students in a co-taught course. Course staff only.
+func (s *CoursesServer) GetAnnouncementCountByAuthor(ctx context.Context,
+	req *cpb.GetAnnouncementCountByAuthorRequest,
+) (*cpb.GetAnnouncementCountByAuthorResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetAnnouncementCountByAuthor request", "courseId", req.GetCourseID())
+
+	counts, err := s.db.GetAnnouncementCountByAuthor(ctx, req.GetCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count announcements: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	pbCounts := make([]*cpb.AuthorAnnouncementCount, 0, len(counts))
+	for author, count := range counts {
+		pbCounts = append(pbCounts, &cpb.AuthorAnnouncementCount{Author: author, Count: int64(count)})
+	}
+
+	slices.SortFunc(pbCounts, func(left, right *cpb.AuthorAnnouncementCount) int {
+		return cmp.Or(cmp.Compare(right.GetCount(), left.GetCount()), strings.Compare(left.GetAuthor(), right.GetAuthor()))
+	})
+
+	return &cpb.GetAnnouncementCountByAuthorResponse{Counts: pbCounts}, nil
+}
+
+// announcementsVersionToken returns a token that changes whenever the announcements selected by
+// the filter are added, updated or removed. Staff and students get different tokens, since they
+// see different announcements, and so does each page.
+func announcementsVersionToken(courseID string, filter AnnouncementFilter, page Page,
+	stats *AnnouncementStats,
+) string {
+	version := fmt.Sprintf("%s|%t|%d|%d|%d|%d|%d|%d", courseID, filter.IncludeStaffOnly,
+		filter.CreatedFrom.UnixNano(), filter.CreatedBefore.UnixNano(), page.Offset, page.Limit,
+		stats.Count, stats.LastUpdatedAt.UnixNano())
+	sum := sha256.Sum256([]byte(version))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// GetCourseAnnouncementsGrouped groups the announcements of a course by the week or month they were
+// posted in, returning the size and newest announcement of each group.
+func (s *CoursesServer) GetCourseAnnouncementsGrouped(ctx context.Context,
+	req *cpb.GetCourseAnnouncementsGroupedRequest,
+) (*cpb.GetCourseAnnouncementsGroupedResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetCourseAnnouncementsGrouped request",
+		"courseId", req.GetCourseID(), "groupBy", req.GetGroupBy())
+
+	page, err := s.pagination.page(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("invalid page: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	filter, err := s.visibleAnnouncements(ctx, req.GetToken(), req.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.db.GetAnnouncementGroups(ctx, req.GetCourseID(), filter, req.GetGroupBy(), s.location, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to group announcements: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	pbGroups := make([]*cpb.AnnouncementGroup, 0, len(groups))
+
+	for _, group := range groups {
+		newest, err := announcementToProto(group.Newest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to group announcements: %w", status.Error(statusCode(err), err.Error()))
+		}
+
+		pbGroups = append(pbGroups, &cpb.AnnouncementGroup{
+			StartsAt: timeToProto(group.StartsAt),
+			EndsAt:   timeToProto(group.EndsAt),
+			Count:    int64(group.Count),
+			Newest:   newest,
+		})
+	}
+
+	return &cpb.GetCourseAnnouncementsGroupedResponse{
+		Groups:        pbGroups,
+		NextPageToken: nextPageToken(page, len(groups)),
+		PageSize:      s.pagination.size(req.GetPageSize()),
+	}, nil
+}
+
+// RemoveAnnouncementFromCourse removes an announcement from a course.
+func (s *CoursesServer) RemoveAnnouncementFromCourse(ctx context.Context,
+	req *cpb.RemoveAnnouncementRequest,
+) (*cpb.RemoveAnnouncementResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received RemoveAnnouncementFromCourse request",
+		"courseId", req.GetCourseID(), "announcementId", req.GetAnnouncementID())
+
+	if err := s.db.RemoveAnnouncement(ctx, req.GetCourseID(), req.GetAnnouncementID()); err != nil {
+		return nil, fmt.Errorf("failed to remove announcement from course: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.RemoveAnnouncementResponse{}, nil
+}
+
+// UpdateAnnouncement updates the title, content or visibility of an announcement of a course.
+func (s *CoursesServer) UpdateAnnouncement(ctx context.Context,
+	req *cpb.UpdateAnnouncementRequest,
+) (*cpb.UpdateAnnouncementResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received UpdateAnnouncement request",
+		"courseId", req.GetCourseID(), "announcementId", req.GetAnnouncementID())
+
+	if length := len(req.GetAnnouncementContent()); length > s.announcementSizes.MaxLength {
+		err := fmt.Errorf("%w: %d bytes, at most %d allowed", ErrAnnouncementTooLong, length,
+			s.announcementSizes.MaxLength)
+
+		return nil, fmt.Errorf("failed to update announcement: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	// An unset visibility leaves it unchanged.
+	var visibility *cpb.AnnouncementVisibility
+	if message := req.ProtoReflect(); message.Has(message.Descriptor().Fields().ByName("visibility")) {
+		visibility = req.GetVisibility().Enum()
+	}
+
+	announcement, err := s.db.UpdateAnnouncement(ctx, req.GetCourseID(), req.GetAnnouncementID(),
+		req.GetAnnouncementTitle(), req.GetAnnouncementContent(), visibility, s.announcementSizes.ExcerptLength)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update announcement: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	pbAnnouncement, err := announcementToProto(*announcement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update announcement: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.UpdateAnnouncementResponse{Announcement: pbAnnouncement}, nil
+}
+
+// UpsertAnnouncementBySlug updates the title and content of the announcement of a course with a given
+// slug, or adds the announcement if the course has none with that slug, so announcements kept in files
+// can be synced to the course.
+func (s *CoursesServer) UpsertAnnouncementBySlug(ctx context.Context,
+	req *cpb.UpsertAnnouncementBySlugRequest,
+) (*cpb.UpsertAnnouncementBySlugResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received UpsertAnnouncementBySlug request",
+		"courseId", req.GetCourseID(), "slug", req.GetAnnouncement().GetSlug())
+
+	author, err := s.callerAuthor(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetAnnouncement() != nil {
+		req.Announcement.Author = author
+	}
+
+	if length := len(req.GetAnnouncement().GetAnnouncementContent()); length > s.announcementSizes.MaxLength {
+		err := fmt.Errorf("%w: %d bytes, at most %d allowed", ErrAnnouncementTooLong, length,
+			s.announcementSizes.MaxLength)
+
+		return nil, fmt.Errorf("failed to upsert announcement: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	announcement, created, err := s.db.UpsertAnnouncementBySlug(ctx, &cpb.AddAnnouncementRequest{
+		CourseID:     req.GetCourseID(),
+		Announcement: req.GetAnnouncement(),
+	}, s.announcementSizes.ExcerptLength)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert announcement: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	pbAnnouncement, err := announcementToProto(*announcement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert announcement: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.UpsertAnnouncementBySlugResponse{
+		Announcement: pbAnnouncement,
+		Created:      created,
+	}, nil
+}
+
+// BatchRemoveAnnouncements removes several announcements from a course in a single operation.
+func (s *CoursesServer) BatchRemoveAnnouncements(ctx context.Context,
+	req *cpb.BatchRemoveAnnouncementsRequest,
+) (*cpb.BatchRemoveAnnouncementsResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received BatchRemoveAnnouncements request",
+		"courseId", req.GetCourseID(), "announcementIds", req.GetAnnouncementIDs())
+
+	removed, err := s.db.RemoveAnnouncements(ctx, req.GetCourseID(), req.GetAnnouncementIDs())
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove announcements: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	failures := make(map[string]error, len(req.GetAnnouncementIDs()))
+
+	for _, announcementID := range req.GetAnnouncementIDs() {
+		if !slices.Contains(removed, announcementID) {
+			failures[announcementID] = fmt.Errorf("%w: %s", ErrAnnouncementNotFound, announcementID)
+		}
+	}
+
+	return &cpb.BatchRemoveAnnouncementsResponse{
+		RemovedCount: int64(len(removed)),
+		Result:       batchResult(req.GetAnnouncementIDs(), failures),
+	}, nil
+}
+
+// batchResult reports the outcome of each item of a batch request, in request order.
+// Items without an error in failures succeeded.
+func batchResult(itemIDs []string, failures map[string]error) *cpb.BatchResult {
+	result := &cpb.BatchResult{Items: make([]*cpb.BatchItemResult, 0, len(itemIDs))}
+
+	for _, itemID := range itemIDs {
+		item := &cpb.BatchItemResult{Id: itemID, Ok: true}
+
+		if err, failed := failures[itemID]; failed {
+			item.Ok = false
+			item.ErrorCode = uint32(statusCode(err))
+			item.ErrorMessage = err.Error()
+			result.FailedCount++
+		} else {
+			result.SucceededCount++
+		}
+
+		result.Items = append(result.Items, item)
+	}
+
+	return result
+}
+
+// CopyAnnouncements copies all announcements of a course to another course. The caller must staff
+// both courses, since staff-only announcements are copied too.
+func (s *CoursesServer) CopyAnnouncements(ctx context.Context,
+	req *cpb.CopyAnnouncementsRequest,
+) (*cpb.CopyAnnouncementsResponse, error) {
+	for _, courseID := range []string{req.GetFromCourseID(), req.GetToCourseID()} {
+		if err := s.verifyCourseStaff(ctx, req.GetToken(), courseID); err != nil {
+			return nil, err
+		}
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received CopyAnnouncements request",
+		"fromCourseId", req.GetFromCourseID(), "toCourseId", req.GetToCourseID())
+
+	copied, err := s.db.CopyAnnouncements(ctx, req.GetFromCourseID(), req.GetToCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy announcements: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.CopyAnnouncementsResponse{CopiedCount: int64(copied)}, nil
+}
+
+// SetEnrollmentWindow sets the enrollment window of a course.
+func (s *CoursesServer) SetEnrollmentWindow(ctx context.Context,
+	req *cpb.SetEnrollmentWindowRequest,
+) (*cpb.SetEnrollmentWindowResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received SetEnrollmentWindow request", "courseId", req.GetCourseID())
+
+	opensAt, closesAt := timeFromProto(req.GetOpensAt()), timeFromProto(req.GetClosesAt())
+	if err := s.db.SetEnrollmentWindow(ctx, req.GetCourseID(), opensAt, closesAt); err != nil {
+		return nil, fmt.Errorf("failed to set enrollment window: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.SetEnrollmentWindowResponse{}, nil
+}
+
+// SetQuietPeriods sets the periods during which non-urgent announcements may not be posted to a course.
+func (s *CoursesServer) SetQuietPeriods(ctx context.Context,
+	req *cpb.SetQuietPeriodsRequest,
+) (*cpb.SetQuietPeriodsResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received SetQuietPeriods request",
+		"courseId", req.GetCourseID(), "periods", len(req.GetPeriods()))
+
+	periods := make([]QuietPeriod, 0, len(req.GetPeriods()))
+	for _, period := range req.GetPeriods() {
+		periods = append(periods, QuietPeriod{
+			StartsAt: timeFromProto(period.GetStartsAt()),
+			EndsAt:   timeFromProto(period.GetEndsAt()),
+			Weekly:   period.GetWeekly(),
+		})
+	}
+
+	if err := s.db.SetQuietPeriods(ctx, req.GetCourseID(), periods); err != nil {
+		return nil, fmt.Errorf("failed to set quiet periods: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.SetQuietPeriodsResponse{}, nil
+}
+
+// SetAnnouncementsEnabled sets whether new announcements may be posted to a course.
+// Existing announcements stay readable while posting is disabled.
+func (s *CoursesServer) SetAnnouncementsEnabled(ctx context.Context,
+	req *cpb.SetAnnouncementsEnabledRequest,
+) (*cpb.SetAnnouncementsEnabledResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received SetAnnouncementsEnabled request",
+		"courseId", req.GetCourseID(), "enabled", req.GetEnabled())
+
+	if err := s.db.SetAnnouncementsEnabled(ctx, req.GetCourseID(), req.GetEnabled()); err != nil {
+		return nil, fmt.Errorf("failed to set announcements enabled: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.SetAnnouncementsEnabledResponse{}, nil
+}
+
+// GetEnrollmentStatus resolves whether enrollment to a course is not yet open, open or closed.
+func (s *CoursesServer) GetEnrollmentStatus(ctx context.Context,
+	req *cpb.GetEnrollmentStatusRequest,
+) (*cpb.GetEnrollmentStatusResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetEnrollmentStatus request", "courseId", req.GetCourseID())
+
+	window, err := s.db.EnrollmentStatus(ctx, req.GetCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enrollment status: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.GetEnrollmentStatusResponse{
+		Status:            window.Status,
+		OpensAt:           timeToProto(window.OpensAt),
+		ClosesAt:          timeToProto(window.ClosesAt),
+		SecondsUntilOpen:  secondsUntil(window.OpensAt, window.ResolvedAt),
+		SecondsUntilClose: secondsUntil(window.ClosesAt, window.ResolvedAt),
+	}, nil
+}
+
+// secondsUntil counts the seconds from now until bound, rounded up so that only a bound still ahead
+// is positive. An unbounded (zero) bound has no countdown.
+func secondsUntil(bound, now time.Time) *int64 {
+	if bound.IsZero() {
+		return nil
+	}
+
+	remaining := bound.Sub(now)
+
+	seconds := int64(remaining / time.Second)
+	if remaining%time.Second > 0 {
+		seconds++
+	}
+
+	return &seconds
+}
+
+// SearchAllAnnouncements searches announcements across all courses.
+func (s *CoursesServer) SearchAllAnnouncements(ctx context.Context,
+	req *cpb.SearchAllAnnouncementsRequest,
+) (*cpb.SearchAllAnnouncementsResponse, error) {
+	if err := s.verifyRole(ctx, req.GetToken(), adminRole); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received SearchAllAnnouncements request", "query", req.GetQuery())
+
+	page, err := s.pagination.page(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("invalid page: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	announcements, err := s.db.SearchAllAnnouncements(ctx, req.GetQuery(), page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search announcements: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	pbAnnouncements, err := announcementsToProto(announcements)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search announcements: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	matches := make([]*cpb.AnnouncementMatch, 0, len(announcements))
+	for i, announcement := range announcements {
+		matches = append(matches, &cpb.AnnouncementMatch{CourseID: announcement.CourseID, Announcement: pbAnnouncements[i]})
+	}
+
+	return &cpb.SearchAllAnnouncementsResponse{
+		Matches:       matches,
+		NextPageToken: nextPageToken(page, len(announcements)),
+		PageSize:      s.pagination.size(req.GetPageSize()),
+	}, nil
+}
+
+// GetAnnouncementsSince retrieves the announcements of all courses posted after a point in time,
+// so that other services can poll for new announcements.
+func (s *CoursesServer) GetAnnouncementsSince(ctx context.Context,
+	req *cpb.GetAnnouncementsSinceRequest,
+) (*cpb.GetAnnouncementsSinceResponse, error) {
+	if err := s.verifyRole(ctx, req.GetToken(), adminRole); err != nil {
+		return nil, err
+	}
+
+	after := AnnouncementPosition{CreatedAt: timeFromProto(req.GetSince())}
+	if cursor := req.GetAfter(); cursor != nil {
+		after = AnnouncementPosition{
+			CreatedAt: timeFromProto(cursor.GetCreatedAt()), CourseID: cursor.GetCourseID(),
+			AnnouncementID: cursor.GetAnnouncementID(),
+		}
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetAnnouncementsSince request", "after", after)
+
+	pageSize := s.pagination.size(req.GetPageSize())
+
+	announcements, err := s.db.GetAnnouncementsSince(ctx, after, int(pageSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcements: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	if len(announcements) > 0 {
+		last := announcements[len(announcements)-1]
+		after = AnnouncementPosition{
+			CreatedAt: last.CreatedAt, CourseID: last.CourseID, AnnouncementID: last.AnnouncementID,
+		}
+	}
+
+	response := &cpb.GetAnnouncementsSinceResponse{
+		Announcements: make([]*cpb.AnnouncementMatch, 0, len(announcements)),
+		NextSince:     timeToProto(after.CreatedAt),
+		PageSize:      pageSize,
+		NextCursor: &cpb.AnnouncementCursor{
+			CreatedAt: timeToProto(after.CreatedAt), CourseID: after.CourseID, AnnouncementID: after.AnnouncementID,
+		},
+	}
+
+	pbAnnouncements, err := announcementsToProto(announcements)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcements: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	for i, announcement := range announcements {
+		response.Announcements = append(response.Announcements, &cpb.AnnouncementMatch{
+			CourseID:     announcement.CourseID,
+			Announcement: pbAnnouncements[i],
+		})
+	}
+
+	return response, nil
+}
+
+// GetEnrollmentDifference compares the students enrolled in two courses.
+func (s *CoursesServer) GetEnrollmentDifference(ctx context.Context,
+	req *cpb.GetEnrollmentDifferenceRequest,
+) (*cpb.GetEnrollmentDifferenceResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseIDA(), req.GetCourseIDB()); err != nil {
+		return nil, err
 	}
 
-	return nil
-}
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetEnrollmentDifference request",
+		"courseIdA", req.GetCourseIDA(), "courseIdB", req.GetCourseIDB())
 
-// initCoursesMicroserviceServer initializes the CoursesServer.
-func initCoursesMicroserviceServer() (*CoursesServer, error) {
-	base, err := ms.CreateBaseServiceServer()
+	page, err := s.pagination.page(req.GetPageSize(), req.GetPageToken())
 	if err != nil {
-		return nil, fmt.Errorf("failed to create base service: %w", err)
+		return nil, fmt.Errorf("invalid page: %w", status.Error(codes.InvalidArgument, err.Error()))
 	}
 
-	database, err := InitializeDatabase()
+	difference, err := s.db.GetEnrollmentDifference(ctx, req.GetCourseIDA(), req.GetCourseIDB(), page)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize database: %w", err)
+		return nil, fmt.Errorf("failed to compare course students: %w", status.Error(statusCode(err), err.Error()))
 	}
 
-	return &CoursesServer{
-		BaseServiceServer:                 base,
-		db:                                database,
-		UnimplementedCoursesServiceServer: cpb.UnimplementedCoursesServiceServer{},
+	largest := max(len(difference.OnlyInA), len(difference.OnlyInB), len(difference.InBoth))
+
+	return &cpb.GetEnrollmentDifferenceResponse{
+		OnlyInA:       difference.OnlyInA,
+		OnlyInB:       difference.OnlyInB,
+		InBoth:        difference.InBoth,
+		NextPageToken: nextPageToken(page, largest),
+		PageSize:      s.pagination.size(req.GetPageSize()),
 	}, nil
 }
 
-// GetCourse retrieves a course by its ID.
-func (s *CoursesServer) GetCourse(ctx context.Context, req *cpb.GetCourseRequest) (*cpb.GetCourseResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// CreateCourseAPIKey creates an API key granting access to the scoped RPCs of a course.
+// The secret is returned only once; just its hash is stored.
+func (s *CoursesServer) CreateCourseAPIKey(ctx context.Context,
+	req *cpb.CreateCourseAPIKeyRequest,
+) (*cpb.CreateCourseAPIKeyResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received GetCourse request", "courseId", req.GetCourseID())
+	logger.V(logLevelDebug).Info("Received CreateCourseAPIKey request",
+		"courseId", req.GetCourseID(), "scopes", req.GetScopes())
 
-	course, err := s.db.GetCourse(ctx, req.GetCourseID())
+	for _, scope := range req.GetScopes() {
+		if !apiKeyScopable(scope) {
+			err := fmt.Errorf("%w: %s", ErrInvalidScope, scope)
+
+			return nil, fmt.Errorf("failed to create API key: %w", status.Error(statusCode(err), err.Error()))
+		}
+	}
+
+	keyID := rand.Text()
+	secret := keyID + "." + rand.Text()
+	key := &CourseAPIKey{
+		KeyID:      keyID,
+		CourseID:   req.GetCourseID(),
+		SecretHash: hashAPIKeySecret(secret),
+		Scopes:     req.GetScopes(),
+		ExpiresAt:  timeFromProto(req.GetExpiresAt()),
+	}
+
+	if err := s.db.AddAPIKey(ctx, key); err != nil {
+		return nil, fmt.Errorf("failed to create API key: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.CreateCourseAPIKeyResponse{Key: apiKeyToProto(key), Secret: secret}, nil
+}
+
+// ListCourseAPIKeys lists the API keys of a course without their secrets.
+func (s *CoursesServer) ListCourseAPIKeys(ctx context.Context,
+	req *cpb.ListCourseAPIKeysRequest,
+) (*cpb.ListCourseAPIKeysResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ListCourseAPIKeys request", "courseId", req.GetCourseID())
+
+	keys, err := s.db.ListAPIKeys(ctx, req.GetCourseID())
 	if err != nil {
-		return nil, fmt.Errorf("course not found: %w", status.Error(codes.NotFound, err.Error()))
+		return nil, fmt.Errorf("failed to list API keys: %w", status.Error(statusCode(err), err.Error()))
 	}
 
-	newCourse := &cpb.Course{
-		CourseID:    course.CourseID,
-		CourseName:  course.CourseName,
-		Semester:    course.Semester,
-		Description: course.Description,
+	pbKeys := make([]*cpb.CourseAPIKey, 0, len(keys))
+	for i := range keys {
+		pbKeys = append(pbKeys, apiKeyToProto(&keys[i]))
 	}
 
-	return &cpb.GetCourseResponse{Course: newCourse}, nil
+	return &cpb.ListCourseAPIKeysResponse{Keys: pbKeys}, nil
 }
 
-// CreateCourse creates a new course.
-func (s *CoursesServer) CreateCourse(
-	ctx context.Context,
-	req *cpb.CreateCourseRequest,
-) (*cpb.CreateCourseResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// RevokeCourseAPIKey revokes an API key of a course. Requests using it are rejected immediately.
+func (s *CoursesServer) RevokeCourseAPIKey(ctx context.Context,
+	req *cpb.RevokeCourseAPIKeyRequest,
+) (*cpb.RevokeCourseAPIKeyResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received CreateCourse request", "courseName", req.GetCourse().GetCourseName())
+	logger.V(logLevelDebug).Info("Received RevokeCourseAPIKey request",
+		"courseId", req.GetCourseID(), "keyId", req.GetKeyID())
 
-	if _, err := s.db.AddCourse(ctx, &cpb.Course{
-		CourseID:    req.GetCourse().GetCourseID(),
-		CourseName:  req.GetCourse().GetCourseName(),
-		Semester:    req.GetCourse().GetSemester(),
-		Description: req.GetCourse().GetDescription(),
-	}); err != nil {
-		return nil, fmt.Errorf("failed to add course: %w", status.Error(codes.Internal, err.Error()))
+	if err := s.db.RevokeAPIKey(ctx, req.GetCourseID(), req.GetKeyID()); err != nil {
+		return nil, fmt.Errorf("failed to revoke API key: %w", status.Error(statusCode(err), err.Error()))
 	}
 
-	return &cpb.CreateCourseResponse{Course: req.GetCourse()}, nil
+	return &cpb.RevokeCourseAPIKeyResponse{}, nil
 }
 
-// UpdateCourse updates an existing course.
-func (s *CoursesServer) UpdateCourse(
-	ctx context.Context,
-	req *cpb.UpdateCourseRequest,
-) (*cpb.UpdateCourseResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// AddCorequisite requires another course to be taken in the same semester as a course.
+func (s *CoursesServer) AddCorequisite(ctx context.Context,
+	req *cpb.AddCorequisiteRequest,
+) (*cpb.AddCorequisiteResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received UpdateCourse request", "courseId", req.GetCourse().GetCourseID())
+	logger.V(logLevelDebug).Info("Received AddCorequisite request",
+		"courseId", req.GetCourseID(), "corequisiteId", req.GetCorequisiteID())
 
-	updatedCourse, err := s.db.UpdateCourse(ctx, req.GetCourse())
-	if err != nil {
-		return nil, fmt.Errorf("failed to update course: %w", status.Error(codes.Internal, err.Error()))
+	if err := s.db.AddCorequisite(ctx, req.GetCourseID(), req.GetCorequisiteID()); err != nil {
+		return nil, fmt.Errorf("failed to add co-requisite: %w", status.Error(statusCode(err), err.Error()))
 	}
 
-	course := &cpb.Course{
-		CourseID:    updatedCourse.CourseID,
-		CourseName:  updatedCourse.CourseName,
-		Semester:    updatedCourse.Semester,
-		Description: updatedCourse.Description,
+	return &cpb.AddCorequisiteResponse{}, nil
+}
+
+// GetCorequisites retrieves the co-requisites of a course.
+func (s *CoursesServer) GetCorequisites(ctx context.Context,
+	req *cpb.GetCorequisitesRequest,
+) (*cpb.GetCorequisitesResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetCorequisites request", "courseId", req.GetCourseID())
+
+	corequisiteIDs, err := s.db.GetCorequisites(ctx, req.GetCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get co-requisites: %w", status.Error(statusCode(err), err.Error()))
 	}
 
-	return &cpb.UpdateCourseResponse{Course: course}, nil
+	return &cpb.GetCorequisitesResponse{CorequisiteIDs: corequisiteIDs}, nil
 }
 
-// DeleteCourse deletes a course by its ID.
-func (s *CoursesServer) DeleteCourse(
-	ctx context.Context,
-	req *cpb.DeleteCourseRequest,
-) (*cpb.DeleteCourseResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// GetSharedCourses retrieves the courses a staff member currently staffs and a student is
+// enrolled in, so a TA can see which of their courses a student takes.
+func (s *CoursesServer) GetSharedCourses(ctx context.Context,
+	req *cpb.GetSharedCoursesRequest,
+) (*cpb.GetSharedCoursesResponse, error) {
+	if err := s.verifySelf(ctx, req.GetToken(), req.GetStaffID()); err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received DeleteCourse request", "courseId", req.GetCourseID())
+	logger.V(logLevelDebug).Info("Received GetSharedCourses request",
+		"staffId", req.GetStaffID(), "studentId", req.GetStudentID())
 
-	if err := s.db.DeleteCourse(ctx, req.GetCourseID()); err != nil {
-		return nil, fmt.Errorf("failed to delete course: %w", status.Error(codes.Internal, err.Error()))
+	courseIDs, err := s.db.GetSharedCourses(ctx, req.GetStaffID(), req.GetStudentID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shared courses: %w", status.Error(statusCode(err), err.Error()))
 	}
 
-	return &cpb.DeleteCourseResponse{}, nil
+	return &cpb.GetSharedCoursesResponse{CourseIDs: courseIDs}, nil
 }
 
-// AddStudentToCourse adds a student to a course.
-func (s *CoursesServer) AddStudentToCourse(
-	ctx context.Context,
-	req *cpb.AddStudentRequest,
-) (*cpb.AddStudentResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// SetGradingComponent adds or replaces a component of the draft grading scheme of a course.
+func (s *CoursesServer) SetGradingComponent(ctx context.Context,
+	req *cpb.SetGradingComponentRequest,
+) (*cpb.SetGradingComponentResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received AddStudentToCourse request",
-		"courseId", req.GetCourseID(), "studentId", req.GetStudentID())
-
-	if err := s.db.AddStudentToCourse(ctx, req.GetCourseID(), req.GetStudentID()); err != nil {
-		return nil, fmt.Errorf("failed to add student to course: %w", status.Error(codes.Internal, err.Error()))
+	logger.V(logLevelDebug).Info("Received SetGradingComponent request",
+		"courseId", req.GetCourseID(), "component", req.GetComponent().GetName(),
+		"weight", req.GetComponent().GetWeight())
+
+	err := s.db.SetGradingComponent(ctx, GradingComponent{
+		CourseID: req.GetCourseID(),
+		Name:     req.GetComponent().GetName(),
+		Weight:   req.GetComponent().GetWeight(),
+		Kind:     req.GetComponent().GetKind(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set grading component: %w", status.Error(statusCode(err), err.Error()))
 	}
 
-	return &cpb.AddStudentResponse{}, nil
+	return &cpb.SetGradingComponentResponse{}, nil
 }
 
-// RemoveStudentFromCourse removes a student from a course.
-func (s *CoursesServer) RemoveStudentFromCourse(
-	ctx context.Context,
-	req *cpb.RemoveStudentRequest,
-) (*cpb.RemoveStudentResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// RemoveGradingComponent removes a component from the draft grading scheme of a course.
+func (s *CoursesServer) RemoveGradingComponent(ctx context.Context,
+	req *cpb.RemoveGradingComponentRequest,
+) (*cpb.RemoveGradingComponentResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received RemoveStudentFromCourse request",
-		"courseId", req.GetCourseID(), "studentId", req.GetStudentID())
+	logger.V(logLevelDebug).Info("Received RemoveGradingComponent request",
+		"courseId", req.GetCourseID(), "component", req.GetName())
 
-	if err := s.db.RemoveStudentFromCourse(ctx, req.GetCourseID(), req.GetStudentID()); err != nil {
-		return nil, fmt.Errorf("failed to remove student from course: %w", status.Error(codes.Internal, err.Error()))
+	if err := s.db.RemoveGradingComponent(ctx, req.GetCourseID(), req.GetName()); err != nil {
+		return nil, fmt.Errorf("failed to remove grading component: %w", status.Error(statusCode(err), err.Error()))
 	}
 
-	return &cpb.RemoveStudentResponse{}, nil
+	return &cpb.RemoveGradingComponentResponse{}, nil
 }
 
-// AddStaffToCourse adds a staff member to a course.
-func (s *CoursesServer) AddStaffToCourse(ctx context.Context, req *cpb.AddStaffRequest) (*cpb.AddStaffResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// GetGradingScheme retrieves the grading scheme of a course. Any authenticated caller may read
+// it, including students and the grades service.
+func (s *CoursesServer) GetGradingScheme(ctx context.Context,
+	req *cpb.GetGradingSchemeRequest,
+) (*cpb.GetGradingSchemeResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetGradingScheme request", "courseId", req.GetCourseID())
+
+	scheme, err := s.db.GetGradingScheme(ctx, req.GetCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grading scheme: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	return &cpb.GetGradingSchemeResponse{
+		Components:  gradingComponentsToProto(scheme.Components),
+		FinalizedAt: timeToProto(scheme.FinalizedAt),
+	}, nil
+}
+
+// FinalizeGradingScheme checks that the weights of the grading scheme of a course sum to 100
+// and freezes it.
+func (s *CoursesServer) FinalizeGradingScheme(ctx context.Context,
+	req *cpb.FinalizeGradingSchemeRequest,
+) (*cpb.FinalizeGradingSchemeResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received AddStaffToCourse request",
-		"courseId", req.GetCourseID(), "staffId", req.GetStaffID())
+	logger.V(logLevelDebug).Info("Received FinalizeGradingScheme request", "courseId", req.GetCourseID())
 
-	if err := s.db.AddStaffToCourse(ctx, req.GetCourseID(), req.GetStaffID()); err != nil {
-		return nil, fmt.Errorf("failed to add staff to course: %w", status.Error(codes.Internal, err.Error()))
+	scheme, err := s.db.FinalizeGradingScheme(ctx, req.GetCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize grading scheme: %w", status.Error(statusCode(err), err.Error()))
 	}
 
-	return &cpb.AddStaffResponse{}, nil
+	return &cpb.FinalizeGradingSchemeResponse{
+		Components:  gradingComponentsToProto(scheme.Components),
+		FinalizedAt: timeToProto(scheme.FinalizedAt),
+	}, nil
 }
 
-// RemoveStaffFromCourse removes a staff member from a course.
-func (s *CoursesServer) RemoveStaffFromCourse(
-	ctx context.Context,
-	req *cpb.RemoveStaffRequest,
-) (*cpb.RemoveStaffResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// GenerateCourseShareToken creates a token granting read access to a course until it expires,
+// for sharing the course with people who have no account.
+func (s *CoursesServer) GenerateCourseShareToken(ctx context.Context,
+	req *cpb.GenerateCourseShareTokenRequest,
+) (*cpb.GenerateCourseShareTokenResponse, error) {
+	if err := s.verifyCourseStaff(ctx, req.GetToken(), req.GetCourseID()); err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received RemoveStaffFromCourse request",
-		"courseId", req.GetCourseID(), "staffId", req.GetStaffID())
+	logger.V(logLevelDebug).Info("Received GenerateCourseShareToken request",
+		"courseId", req.GetCourseID(), "ttlSeconds", req.GetTtlSeconds())
 
-	if err := s.db.RemoveStaffFromCourse(ctx, req.GetCourseID(), req.GetStaffID()); err != nil {
-		return nil, fmt.Errorf("failed to remove staff from course: %w", status.Error(codes.Internal, err.Error()))
+	if !s.shares.enabled() {
+		return nil, fmt.Errorf("failed to generate share token: %w",
+			status.Error(codes.FailedPrecondition, ErrSharingDisabled.Error()))
 	}
 
-	return &cpb.RemoveStaffResponse{}, nil
+	if _, err := s.db.GetCourse(ctx, req.GetCourseID()); err != nil {
+		return nil, fmt.Errorf("failed to generate share token: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	expiresAt := time.Now().Add(time.Duration(req.GetTtlSeconds()) * time.Second).Truncate(time.Second)
+
+	return &cpb.GenerateCourseShareTokenResponse{
+		ShareToken: s.shares.sign(req.GetCourseID(), expiresAt),
+		ExpiresAt:  timeToProto(expiresAt),
+	}, nil
 }
 
-// GetCourseStudents retrieves the students enrolled in a course.
-func (s *CoursesServer) GetCourseStudents(
-	ctx context.Context,
-	req *cpb.GetCourseStudentsRequest,
-) (*cpb.GetCourseStudentsResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// GetCourseByShareToken gets the course a share token grants access to. The share token
+// replaces the user token.
+func (s *CoursesServer) GetCourseByShareToken(ctx context.Context,
+	req *cpb.GetCourseByShareTokenRequest,
+) (*cpb.GetCourseByShareTokenResponse, error) {
+	courseID, err := s.shares.verify(req.GetShareToken(), time.Now())
+	if errors.Is(err, ErrSharingDisabled) {
+		return nil, fmt.Errorf("failed to get shared course: %w", status.Error(codes.FailedPrecondition, err.Error()))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w", status.Error(codes.Unauthenticated, err.Error()))
 	}
 
 	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received GetCourseStudents request", "courseId", req.GetCourseID())
+	logger.V(logLevelDebug).Info("Received GetCourseByShareToken request", "courseId", courseID)
 
-	studentIDs, err := s.db.GetCourseStudents(ctx, req.GetCourseID())
+	course, err := s.db.GetCourse(ctx, courseID)
 	if err != nil {
-		return nil, fmt.Errorf("course not found: %w", status.Error(codes.NotFound, err.Error()))
+		return nil, fmt.Errorf("failed to get shared course: %w", status.Error(statusCode(err), err.Error()))
 	}
 
-	return &cpb.GetCourseStudentsResponse{StudentsIDs: studentIDs}, nil
+	return &cpb.GetCourseByShareTokenResponse{Course: courseToProto(course, false)}, nil
 }
 
-// GetCourseStaff retrieves the staff members assigned to a course.
-func (s *CoursesServer) GetCourseStaff(ctx context.Context,
-	req *cpb.GetCourseStaffRequest,
-) (*cpb.GetCourseStaffResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// featureEnabled reports whether a feature is enabled for a course, taking its overrides into account.
+// The returned error already carries the matching gRPC status.
+func (s *CoursesServer) featureEnabled(ctx context.Context, courseID, feature string) (bool, error) {
+	overrides, err := s.db.GetFeatureOverrides(ctx, courseID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get feature overrides: %w", status.Error(statusCode(err), err.Error()))
 	}
 
+	return s.policies.Features.enabled(courseID, feature, overrides), nil
+}
+
+// GetCourseFeatures returns whether each known feature is enabled for a course.
+func (s *CoursesServer) GetCourseFeatures(ctx context.Context,
+	req *cpb.GetCourseFeaturesRequest,
+) (*cpb.GetCourseFeaturesResponse, error) {
 	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received GetCourseStaff request", "courseId", req.GetCourseID())
+	logger.V(logLevelDebug).Info("Received GetCourseFeatures request", "courseId", req.GetCourseID())
+
+	if _, err := s.db.GetCourse(ctx, req.GetCourseID()); err != nil {
+		return nil, fmt.Errorf("failed to get course: %w", status.Error(statusCode(err), err.Error()))
+	}
 
-	staffIDs, err := s.db.GetCourseStaff(ctx, req.GetCourseID())
+	overrides, err := s.db.GetFeatureOverrides(ctx, req.GetCourseID())
 	if err != nil {
-		return nil, fmt.Errorf("course not found: %w", status.Error(codes.NotFound, err.Error()))
+		return nil, fmt.Errorf("failed to get feature overrides: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	features := make([]*cpb.CourseFeature, 0, len(s.policies.Features.rules))
+
+	for _, name := range s.policies.Features.names() {
+		_, overridden := overrides[name]
+		features = append(features, &cpb.CourseFeature{
+			Name:       name,
+			Enabled:    s.policies.Features.enabled(req.GetCourseID(), name, overrides),
+			Overridden: overridden,
+		})
 	}
 
-	return &cpb.GetCourseStaffResponse{StaffIDs: staffIDs}, nil
+	return &cpb.GetCourseFeaturesResponse{Features: features}, nil
 }
 
-// GetStudentCourses retrieves the courses a student is enrolled in.
-func (s *CoursesServer) GetStudentCourses(ctx context.Context,
-	req *cpb.GetStudentCoursesRequest,
-) (*cpb.GetStudentCoursesResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// SetCourseFeature overrides whether a feature is enabled for a course, or clears the override
+// so the rollout of the feature applies again. Only admins may override features.
+func (s *CoursesServer) SetCourseFeature(ctx context.Context,
+	req *cpb.SetCourseFeatureRequest,
+) (*cpb.SetCourseFeatureResponse, error) {
+	if err := s.verifyRole(ctx, req.GetToken(), adminRole); err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received GetStudentCourses request", "studentId", req.GetStudentID())
+	logger.V(logLevelDebug).Info("Received SetCourseFeature request",
+		"courseId", req.GetCourseID(), "feature", req.GetFeature(), "override", req.GetOverride())
+
+	if !s.policies.Features.known(req.GetFeature()) {
+		err := fmt.Errorf("%w: %q", ErrUnknownFeature, req.GetFeature())
+
+		return nil, fmt.Errorf("failed to set course feature: %w", status.Error(statusCode(err), err.Error()))
+	}
+
+	var err error
+	if req.GetOverride() == cpb.FeatureOverride_FEATURE_DEFAULT {
+		err = s.db.ClearFeatureOverride(ctx, req.GetCourseID(), req.GetFeature())
+	} else {
+		err = s.db.SetFeatureOverride(ctx, req.GetCourseID(), req.GetFeature(),
+			req.GetOverride() == cpb.FeatureOverride_FEATURE_ON)
+	}
 
-	courseIDs, err := s.db.GetStudentCourses(ctx, req.GetStudentID())
 	if err != nil {
-		return nil, fmt.Errorf("student not found: %w", status.Error(codes.NotFound, err.Error()))
+		return nil, fmt.Errorf("failed to set course feature: %w", status.Error(statusCode(err), err.Error()))
 	}
 
-	return &cpb.GetStudentCoursesResponse{CoursesIDs: courseIDs}, nil
+	return &cpb.SetCourseFeatureResponse{}, nil
 }
 
-// GetStaffCourses retrieves the courses a staff member is associated with.
-func (s *CoursesServer) GetStaffCourses(ctx context.Context,
-	req *cpb.GetStaffCoursesRequest,
-) (*cpb.GetStaffCoursesResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// gradingComponentsToProto converts grading components to their proto messages.
+func gradingComponentsToProto(components []GradingComponent) []*cpb.GradingComponent {
+	pbComponents := make([]*cpb.GradingComponent, 0, len(components))
+	for _, component := range components {
+		pbComponents = append(pbComponents, &cpb.GradingComponent{
+			Name:   component.Name,
+			Weight: component.Weight,
+			Kind:   component.Kind,
+		})
 	}
 
-	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received GetStaffCourses request", "staffId", req.GetStaffID())
+	return pbComponents
+}
+
+// apiKeyScopable reports whether an API key may be scoped to the named RPC.
+// Only RPCs acting on a single course qualify, so keys never grant cross-course access.
+func apiKeyScopable(method string) bool {
+	switch method {
+	case "GetCourse", "GetCourseStudents", "GetCourseStaff",
+		"AddAnnouncementToCourse", "GetCourseAnnouncements", "GetAnnouncement", "RemoveAnnouncementFromCourse":
+		return true
+	default:
+		return false
+	}
+}
+
+// apiKeyToProto converts an API key to its proto message, leaving out the secret hash.
+func apiKeyToProto(key *CourseAPIKey) *cpb.CourseAPIKey {
+	return &cpb.CourseAPIKey{
+		KeyID:     key.KeyID,
+		CourseID:  key.CourseID,
+		Scopes:    key.Scopes,
+		ExpiresAt: timeToProto(key.ExpiresAt),
+		CreatedAt: timeToProto(key.CreatedAt),
+	}
+}
 
-	courseIDs, err := s.db.GetStaffCourses(ctx, req.GetStaffID())
+// announcementToProto converts an announcement to its proto message. It fails on a stored
+// visibility or recurrence that is not a value of its enum.
+func announcementToProto(announcement Announcement) (*cpb.Announcement, error) {
+	visibility, err := visibilityFromDB(announcement.Visibility)
 	if err != nil {
-		return nil, fmt.Errorf("staff not found: %w", status.Error(codes.NotFound, err.Error()))
+		return nil, err
 	}
 
-	return &cpb.GetStaffCoursesResponse{CoursesIDs: courseIDs}, nil
+	recurrence, err := recurrenceFromDB(announcement.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cpb.Announcement{
+		AnnouncementID:      announcement.AnnouncementID,
+		AnnouncementTitle:   announcement.Title,
+		AnnouncementContent: announcement.Content,
+		Author:              announcement.Author,
+		Slug:                announcement.Slug,
+		Visibility:          visibility,
+		Recurrence:          recurrence,
+		NextPostAt:          timeToProto(announcement.NextPostAt),
+		HasFullBody:         announcement.HasFullBody,
+		CreatedAt:           timeToProto(announcement.CreatedAt),
+		UpdatedAt:           timeToProto(announcement.UpdatedAt),
+	}, nil
 }
 
-// GetSemesterCourses retrieves all courses for a specific semester.
-func (s *CoursesServer) GetSemesterCourses(ctx context.Context,
-	req *cpb.GetSemesterCoursesRequest,
-) (*cpb.GetSemesterCoursesResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// announcementsToProto converts announcements to their proto messages.
+func announcementsToProto(announcements []Announcement) ([]*cpb.Announcement, error) {
+	pbAnnouncements := make([]*cpb.Announcement, 0, len(announcements))
+
+	for _, announcement := range announcements {
+		pbAnnouncement, err := announcementToProto(announcement)
+		if err != nil {
+			return nil, err
+		}
+
+		pbAnnouncements = append(pbAnnouncements, pbAnnouncement)
 	}
 
-	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received GetSemesterCourses request", "semester", req.GetSemester())
+	return pbAnnouncements, nil
+}
+
+// dataShapeToProto converts a data shape report to its proto message.
+func dataShapeToProto(shape *DataShape) *cpb.GetDataShapeReportResponse {
+	semesters := make([]*cpb.SemesterCourseCount, 0, len(shape.CoursesPerSemester))
+	for _, semester := range slices.Sorted(maps.Keys(shape.CoursesPerSemester)) {
+		semesters = append(semesters, &cpb.SemesterCourseCount{
+			Semester: semester,
+			Count:    int64(shape.CoursesPerSemester[semester]),
+		})
+	}
+
+	tables := make([]*cpb.TableRowCount, 0, len(shape.TableRows))
+	for _, table := range slices.Sorted(maps.Keys(shape.TableRows)) {
+		tables = append(tables, &cpb.TableRowCount{Table: table, Rows: int64(shape.TableRows[table])})
+	}
 
-	courses, err := s.db.GetCoursesBySemester(ctx, req.GetSemester())
+	return &cpb.GetDataShapeReportResponse{
+		GeneratedAt:            timeToProto(shape.GeneratedAt),
+		CoursesPerSemester:     semesters,
+		EnrollmentsPerCourse:   distributionToProto(shape.EnrollmentsPerCourse),
+		AnnouncementsPerCourse: distributionToProto(shape.AnnouncementsPerCourse),
+		TableRows:              tables,
+	}
+}
+
+// courseSnapshotToProto converts a course snapshot to its proto message.
+func courseSnapshotToProto(snapshot *CourseSnapshot) (*cpb.GetCourseSnapshotResponse, error) {
+	announcements, err := announcementsToProto(snapshot.Announcements)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get courses by semester: %w", status.Error(codes.Internal, err.Error()))
+		return nil, err
+	}
+
+	resp := &cpb.GetCourseSnapshotResponse{
+		TakenAt:       timeToProto(snapshot.TakenAt),
+		Course:        courseToProto(&snapshot.Course, true),
+		Students:      make([]*cpb.CourseStudent, 0, len(snapshot.Students)),
+		Staff:         make([]*cpb.StaffAssignment, 0, len(snapshot.Staff)),
+		Announcements: announcements,
+		QuietPeriods:  make([]*cpb.QuietPeriod, 0, len(snapshot.QuietPeriods)),
+	}
+
+	for _, student := range snapshot.Students {
+		resp.Students = append(resp.Students, &cpb.CourseStudent{StudentID: student.StudentID, Status: student.Status})
+	}
+
+	for _, member := range snapshot.Staff {
+		resp.Staff = append(resp.Staff, &cpb.StaffAssignment{
+			StaffID:    member.StaffID,
+			ValidFrom:  timeToProto(member.ValidFrom),
+			ValidUntil: timeToProto(member.ValidUntil),
+		})
+	}
+
+	for _, period := range snapshot.QuietPeriods {
+		resp.QuietPeriods = append(resp.QuietPeriods, &cpb.QuietPeriod{
+			StartsAt: timeToProto(period.StartsAt),
+			EndsAt:   timeToProto(period.EndsAt),
+			Weekly:   period.Weekly,
+		})
+	}
+
+	return resp, nil
+}
+
+// distributionToProto converts a distribution to its proto message.
+func distributionToProto(distribution Distribution) *cpb.CountDistribution {
+	return &cpb.CountDistribution{
+		P50: int64(distribution.P50),
+		P95: int64(distribution.P95),
+		Max: int64(distribution.Max),
 	}
+}
+
+// reportDataShape gets the data shape report now and every interval after until ctx is done. Each
+// report is logged and its headline numbers are exported as metrics.
+func (s *CoursesServer) reportDataShape(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.updateDataShape(ctx)
 
-	// Convert database courses to proto courses
-	pbCourses := make([]*cpb.Course, len(courses))
-	for i, course := range courses {
-		pbCourses[i] = &cpb.Course{
-			CourseID:    course.CourseID,
-			CourseName:  course.CourseName,
-			Semester:    course.Semester,
-			Description: course.Description,
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
 		}
 	}
+}
+
+// updateDataShape gets the data shape report, logs it and keeps it for the metrics.
+func (s *CoursesServer) updateDataShape(ctx context.Context) {
+	shape, err := s.db.GetDataShape(ctx)
+	if err != nil {
+		klog.Errorf("Failed to get data shape: %v", err)
+
+		return
+	}
 
-	return &cpb.GetSemesterCoursesResponse{Courses: pbCourses}, nil
+	klog.InfoS("Data shape",
+		"coursesPerSemester", shape.CoursesPerSemester,
+		"enrollmentsPerCourse", shape.EnrollmentsPerCourse,
+		"announcementsPerCourse", shape.AnnouncementsPerCourse,
+		"tableRows", shape.TableRows)
+	s.dataShape.Store(shape)
 }
 
-// AddAnnouncementToCourse adds an announcement to a course.
-func (s *CoursesServer) AddAnnouncementToCourse(ctx context.Context,
-	req *cpb.AddAnnouncementRequest,
-) (*cpb.AddAnnouncementResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// reportLapsedStaff publishes a StaffAccessExpired event for every staff assignment that lapses,
+// checking every interval until ctx is done.
+func (s *CoursesServer) reportLapsedStaff(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.publishLapsedStaff(ctx, now)
+		}
 	}
+}
 
-	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received AddAnnouncementToCourse request",
-		"courseId", req.GetCourseID())
+// publishLapsedStaff publishes a StaffAccessExpired event for every staff assignment that lapsed by
+// now and was not reported yet. An assignment whose event fails is tried again next time.
+func (s *CoursesServer) publishLapsedStaff(ctx context.Context, now time.Time) {
+	lapsed, err := s.db.GetLapsedStaff(ctx, now)
+	if err != nil {
+		klog.Errorf("Failed to get lapsed staff: %v", err)
 
-	if err := s.db.AddAnnouncement(ctx, req); err != nil {
-		return nil, fmt.Errorf("failed to add announcement to course: %w", status.Error(codes.Internal, err.Error()))
+		return
 	}
 
-	return &cpb.AddAnnouncementResponse{}, nil
+	for _, staff := range lapsed {
+		event := StaffAccessExpired{CourseID: staff.CourseID, StaffID: staff.StaffID, ExpiredAt: staff.ValidUntil}
+		if err := s.events.PublishStaffAccessExpired(ctx, event); err != nil {
+			klog.Errorf("Failed to publish lapse of %s in %s: %v", staff.StaffID, staff.CourseID, err)
+
+			continue
+		}
+
+		if err := s.db.MarkStaffLapseReported(ctx, staff, now); err != nil {
+			klog.Errorf("Failed to mark lapse of %s in %s reported: %v", staff.StaffID, staff.CourseID, err)
+		}
+	}
 }
 
-// GetCourseAnnouncements retrieves the announcements associated with a course.
-func (s *CoursesServer) GetCourseAnnouncements(ctx context.Context,
-	req *cpb.GetCourseAnnouncementsRequest,
-) (*cpb.GetCourseAnnouncementsResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// monitorDatabase pings the database every interval until ctx is done. The service is reported
+// NOT_SERVING to the health service once databaseCheckFailures pings in a row failed, and SERVING
+// again after the next ping that succeeds.
+func (s *CoursesServer) monitorDatabase(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	failures := 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pingCtx, cancel := context.WithTimeout(ctx, interval)
+			err := s.db.Ping(pingCtx)
+
+			cancel()
+
+			if err == nil {
+				if failures >= databaseCheckFailures {
+					klog.Info("Database is reachable again")
+					s.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+				}
+
+				failures = 0
+
+				continue
+			}
+
+			failures++
+			if failures == databaseCheckFailures {
+				klog.Errorf("Database is unreachable, reporting NOT_SERVING: %v", err)
+				s.health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+			}
+		}
 	}
+}
 
-	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received GetCourseAnnouncements request", "courseId", req.GetCourseID())
+// repostRecurringAnnouncements reposts due recurring announcements every interval until ctx is done.
+func repostRecurringAnnouncements(ctx context.Context, database DBInterface, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			reposted, err := database.RepostDueAnnouncements(ctx, now)
+			if err != nil {
+				klog.Errorf("Failed to repost recurring announcements: %v", err)
+
+				continue
+			}
+
+			if reposted > 0 {
+				klog.V(logLevelDebug).Infof("Reposted %d recurring announcements.", reposted)
+			}
+		}
+	}
+}
 
-	resp, err := s.db.GetAnnouncements(ctx, req.GetCourseID())
-	if err != nil {
-		return nil, fmt.Errorf("course not found: %w", status.Error(codes.NotFound, err.Error()))
+// timeFromProto converts a proto timestamp to a time, mapping an unset timestamp to the zero time.
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
 	}
 
-	announcements := make([]*cpb.Announcement, 0)
-	for _, a := range resp {
-		announcements = append(announcements, &cpb.Announcement{
-			AnnouncementID:      a.AnnouncementID,
-			AnnouncementTitle:   a.Title,
-			AnnouncementContent: a.Content,
-		})
+	return ts.AsTime()
+}
+
+// timeToProto converts a time to a proto timestamp, mapping the zero time to an unset timestamp.
+func timeToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
 	}
 
-	return &cpb.GetCourseAnnouncementsResponse{Announcements: announcements}, nil
+	return timestamppb.New(t)
 }
 
-// RemoveAnnouncementFromCourse removes an announcement from a course.
-func (s *CoursesServer) RemoveAnnouncementFromCourse(ctx context.Context,
-	req *cpb.RemoveAnnouncementRequest,
-) (*cpb.RemoveAnnouncementResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+// listenAddress returns the local address to listen on for the configured port.
+// Port 0 picks a free port; the listener reports which.
+func listenAddress(port string) (string, error) {
+	if port == "" {
+		return "", fmt.Errorf("%w", ErrPortMissing)
 	}
 
-	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received RemoveAnnouncementFromCourse request",
-		"courseId", req.GetCourseID(), "announcementId", req.GetAnnouncementID())
+	number, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		hint := ""
+		if strings.Contains(port, ":") {
+			hint = ", without a host"
+		}
 
-	if err := s.db.RemoveAnnouncement(ctx, req.GetCourseID(), req.GetAnnouncementID()); err != nil {
-		return nil, fmt.Errorf("failed to remove announcement from course: %w", status.Error(codes.Internal, err.Error()))
+		return "", fmt.Errorf("%w%s, got %q", ErrInvalidPort, hint, port)
 	}
 
-	return &cpb.RemoveAnnouncementResponse{}, nil
+	return net.JoinHostPort("localhost", strconv.FormatUint(number, 10)), nil
+}
+
+// listen listens on the configured port of localhost. The bound address, which differs from the
+// configured one for port 0, is the address of the returned listener.
+func listen(port string) (net.Listener, error) {
+	address, err := listenAddress(port)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen(connectionProtocol, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	return listener, nil
 }
 
 func main() {
 	// init klog.
 	klog.InitFlags(nil)
+
+	selfTest := flag.Bool("selftest", false, "check the database is writable and up to date, then exit")
 	flag.Parse()
 
 	if err := godotenv.Load(); err != nil {
 	    klog.Warning("Warning: No .env file loaded, proceeding with environment variables only")
 	}
 
+	// As an init container, the self-test keeps the service from starting on a broken database.
+	if *selfTest {
+		if err := runSelfTest(); err != nil {
+			klog.Fatalf("Self-test failed: %v", err)
+		}
+
+		klog.Info("Self-test passed")
+
+		return
+	}
+
 	// init the CoursesServer.
 	server, err := initCoursesMicroserviceServer()
 	if err != nil {
 		klog.Fatalf("Failed to init CoursesServer: %v", err)
 	}
 
-	// create a listener on port 'address'.
-	address := "localhost:" + os.Getenv("GRPC_PORT")
-
-	lis, err := net.Listen(connectionProtocol, address)
+	// create a listener on port 'GRPC_PORT'.
+	lis, err := listen(os.Getenv("GRPC_PORT"))
 	if err != nil {
 		klog.Fatalf("Failed to listen: %v", err)
 	}
 
-	klog.V(logLevelDebug).Info("Starting CoursesServer on port: ", address)
+	klog.Info("Starting CoursesServer on: ", lis.Addr().String())
 	// create a grpc CoursesServer.
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(serverOptions(server)...)
 	cpb.RegisterCoursesServiceServer(grpcServer, server)
+	healthpb.RegisterHealthServer(grpcServer, server.health)
+
+	go repostRecurringAnnouncements(context.Background(), server.db, repostInterval)
+	go server.reportDataShape(context.Background(), dataShapeInterval)
+	go server.reportLapsedStaff(context.Background(), staffLapseInterval)
+	go server.monitorDatabase(context.Background(), databaseCheckInterval)
+
+	// serve the metrics on port 'METRICS_PORT', if set.
+	if port := os.Getenv(metricsPortEnv); port != "" {
+		metricsListener, err := listen(port)
+		if err != nil {
+			klog.Fatalf("Failed to listen for metrics: %v", err)
+		}
+
+		go func() {
+			if err := serveMetrics(metricsListener, server.metrics()); err != nil {
+				klog.Errorf("Metrics are no longer served: %v", err)
+			}
+		}()
+	}
 
 	// serve the grpc CoursesServer.
 	if err := grpcServer.Serve(lis); err != nil {
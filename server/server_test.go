@@ -1,19 +1,43 @@
 package main
 
 import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
+	"maps"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	cpb "github.com/BetterGR/courses-microservice/protos"
 	ms "github.com/TekClinic/MicroService-Lib"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/klog/v2"
 )
 
@@ -32,6 +56,23 @@ func (m MockClaims) GetRole() string {
 	return "test-role"
 }
 
+// RoleClaims grants only the listed roles to the given subject.
+type RoleClaims struct {
+	ms.Claims
+	subject string
+	roles   []string
+}
+
+// HasRole reports whether role is one of the granted roles.
+func (c RoleClaims) HasRole(role string) bool {
+	return slices.Contains(c.roles, role)
+}
+
+// GetSubject returns the subject the claims were issued to.
+func (c RoleClaims) GetSubject() string {
+	return c.subject
+}
+
 // TestCoursesServer wraps CoursesServer for testing.
 type TestCoursesServer struct {
 	*CoursesServer
@@ -81,26 +122,24 @@ func createTestCourse() *cpb.Course {
 }
 
 // startTestServer initializes a test server with a mock database.
-func startTestServer() (*grpc.Server, net.Listener, *TestCoursesServer, error) {
-	base, err := ms.CreateBaseServiceServer()
+func startTestServer(claims ms.Claims) (*grpc.Server, net.Listener, *TestCoursesServer, error) {
+	server, err := newCoursesServer()
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to create base service: %w", err)
+		return nil, nil, nil, err
 	}
 
-	mockDB := NewMockDatabase()
-	server := &CoursesServer{
-		BaseServiceServer: base,
-		db:                mockDB,
-		Claims:            MockClaims{},
-	}
+	server.db = NewMockDatabase()
+	server.Claims = claims
 
 	testServer := &TestCoursesServer{CoursesServer: server}
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(serverOptions(server)...)
 	cpb.RegisterCoursesServiceServer(grpcServer, testServer)
+	healthpb.RegisterHealthServer(grpcServer, server.health)
 
-	listener, err := net.Listen(connectionProtocol, "localhost:"+os.Getenv("GRPC_PORT"))
+	// Each test server listens on a free port, so test servers and packages never collide.
+	listener, err := listen("0")
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to listen on port %s: %w", os.Getenv("GRPC_PORT"), err)
+		return nil, nil, nil, err
 	}
 
 	go func() {
@@ -112,22 +151,100 @@ func startTestServer() (*grpc.Server, net.Listener, *TestCoursesServer, error) {
 	return grpcServer, listener, testServer, nil
 }
 
+func TestCompareColumns(t *testing.T) {
+	differences := compareColumns("course_students",
+		[]string{"course_id", "student_id", "status", "semester"},
+		[]string{"student_id", "course_id", "grade", "semester", "enrolled_on"})
+
+	assert.Equal(t, []SchemaDifference{
+		{Table: "course_students", Column: "status", Missing: true},
+		{Table: "course_students", Column: "enrolled_on"},
+		{Table: "course_students", Column: "grade"},
+	}, differences)
+	assert.Equal(t, "course_students lacks column status", differences[0].String())
+	assert.Equal(t, "course_students has unknown column grade", differences[2].String())
+
+	assert.Empty(t, compareColumns("courses", []string{"course_id"}, []string{"course_id"}))
+}
+
+func TestListenAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		port    string
+		address string
+	}{
+		{"Port", "50054", "localhost:50054"},
+		{"Ephemeral", "0", "localhost:0"},
+		{"Empty", "", ""},
+		{"WithHost", "localhost:50054", ""},
+		{"NonNumeric", "grpc", ""},
+		{"Negative", "-1", ""},
+		{"OutOfRange", "65536", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			address, err := listenAddress(test.port)
+			if test.address == "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "GRPC_PORT")
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.address, address)
+		})
+	}
+}
+
+func TestListenEphemeralPort(t *testing.T) {
+	listener, err := listen("0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	assert.NotEqual(t, "0", port, "the listener should report the port it was given")
+}
+
 func setupClient(t *testing.T) cpb.CoursesServiceClient {
 	t.Helper()
 
-	grpcServer, listener, _, err := startTestServer()
+	return setupClientWithClaims(t, MockClaims{})
+}
+
+// setupClientWithClaims starts a test server authorizing every request with claims.
+func setupClientWithClaims(t *testing.T, claims ms.Claims) cpb.CoursesServiceClient {
+	t.Helper()
+
+	grpcServer, listener, testServer, err := startTestServer(claims)
 	require.NoError(t, err)
 	t.Cleanup(func() {
 		grpcServer.Stop()
 	})
 
+	// Every test using the default mock database leaves it consistent.
+	t.Cleanup(func() {
+		if mockDB, ok := testServer.db.(*MockDatabase); ok {
+			assert.NoError(t, mockDB.checkInvariants())
+		}
+	})
+
+	return cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+}
+
+// dialTestServer connects to the test server listening on listener.
+func dialTestServer(t *testing.T, listener net.Listener) *grpc.ClientConn {
+	t.Helper()
+
 	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	require.NoError(t, err)
 	t.Cleanup(func() {
 		conn.Close()
 	})
 
-	return cpb.NewCoursesServiceClient(conn)
+	return conn
 }
 
 func createCourse(t *testing.T, client cpb.CoursesServiceClient) *cpb.Course {
@@ -177,6 +294,46 @@ func TestCreateCourseFailureOnDuplicate(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestCreateCourseWithInitialStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "lecturer-1", roles: []string{"staff"}})
+
+	resp, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{
+		Course:       createTestCourse(),
+		InitialStaff: []*cpb.StaffAssignment{{StaffID: "ta-1"}, {StaffID: "ta-2"}},
+		Token:        "test-token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ta-1", "ta-2", "lecturer-1"}, resp.GetStaffIDs(), "the creator joins the staff")
+
+	staff, err := client.GetCourseStaff(t.Context(),
+		&cpb.GetCourseStaffRequest{CourseID: createTestCourse().GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"ta-1", "ta-2", "lecturer-1"}, staff.GetStaffIDs())
+}
+
+func TestCreateCourseRollsBackOnInvalidStaff(t *testing.T) {
+	client := setupClient(t)
+	now := time.Now()
+
+	_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{
+		Course: createTestCourse(),
+		InitialStaff: []*cpb.StaffAssignment{
+			{StaffID: "ta-1"},
+			{StaffID: "ta-2", ValidFrom: timestamppb.New(now), ValidUntil: timestamppb.New(now.Add(-time.Hour))},
+		},
+		Token: "test-token",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetCourse(t.Context(),
+		&cpb.GetCourseRequest{CourseID: createTestCourse().GetCourseID(), Token: "test-token"})
+	assert.Equal(t, codes.NotFound, status.Code(err), "no course should be created")
+
+	courses, err := client.GetStaffCourses(t.Context(), &cpb.GetStaffCoursesRequest{StaffID: "ta-1", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Empty(t, courses.GetCoursesIDs(), "no staff should be assigned")
+}
+
 func TestUpdateCourseSuccessful(t *testing.T) {
 	client := setupClient(t)
 	course := createCourse(t, client)
@@ -226,6 +383,31 @@ func TestAddStudentToCourse(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAddMembersTwice(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	for range 2 {
+		_, err := client.AddStudentToCourse(t.Context(),
+			&cpb.AddStudentRequest{CourseID: course.GetCourseID(), StudentID: "student-1", Token: "test-token"})
+		require.NoError(t, err)
+
+		_, err = client.AddStaffToCourse(t.Context(),
+			&cpb.AddStaffRequest{CourseID: course.GetCourseID(), StaffID: "staff-1", Token: "test-token"})
+		require.NoError(t, err)
+	}
+
+	students, err := client.GetCourseStudents(t.Context(),
+		&cpb.GetCourseStudentsRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"student-1"}, students.GetStudentsIDs())
+
+	staff, err := client.GetCourseStaff(t.Context(),
+		&cpb.GetCourseStaffRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"staff-1"}, staff.GetStaffIDs())
+}
+
 func TestRemoveStudentFromCourse(t *testing.T) {
 	client := setupClient(t)
 	course := createCourse(t, client)
@@ -275,6 +457,126 @@ func TestGetCourseStudents(t *testing.T) {
 	assert.Contains(t, resp.GetStudentsIDs(), "student-1")
 }
 
+func TestGetCourseStudentsActiveOnly(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	enrollStudents(t, client, course.GetCourseID(), "student-1", "student-2", "student-3")
+
+	for studentID, studentStatus := range map[string]string{"student-2": "dropped", "student-3": "withdrawn"} {
+		_, err := client.SetStudentStatus(t.Context(), &cpb.SetStudentStatusRequest{
+			CourseID: course.GetCourseID(), StudentID: studentID, Status: studentStatus, Token: "test-token",
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetCourseStudents(t.Context(),
+		&cpb.GetCourseStudentsRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"student-1"}, resp.GetStudentsIDs(), "the roster should only list enrolled students")
+
+	resp, err = client.GetCourseStudents(t.Context(), &cpb.GetCourseStudentsRequest{
+		CourseID: course.GetCourseID(), IncludeInactive: true, Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"student-1", "student-2", "student-3"}, resp.GetStudentsIDs())
+}
+
+func TestGetCourseStudentCount(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	enrollStudents(t, client, course.GetCourseID(), "student-1", "student-2", "student-3")
+
+	_, err := client.SetStudentStatus(t.Context(), &cpb.SetStudentStatusRequest{
+		CourseID: course.GetCourseID(), StudentID: "student-3", Status: "dropped", Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	resp, err := client.GetCourseStudentCount(t.Context(),
+		&cpb.GetCourseStudentCountRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.GetCount())
+
+	_, err = client.GetCourseStudentCount(t.Context(),
+		&cpb.GetCourseStudentCountRequest{CourseID: "missing-course", Token: "test-token"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSetStudentStatusInvalid(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	enrollStudents(t, client, course.GetCourseID(), "student-1")
+
+	_, err := client.SetStudentStatus(t.Context(), &cpb.SetStudentStatusRequest{
+		CourseID: course.GetCourseID(), StudentID: "student-1", Status: "graduated", Token: "test-token",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.SetStudentStatus(t.Context(), &cpb.SetStudentStatusRequest{
+		CourseID: course.GetCourseID(), StudentID: "student-2", Status: "dropped", Token: "test-token",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err), "only enrolled students have a status")
+}
+
+func TestSetStudentStatusTransitions(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  []string
+		legal bool
+	}{
+		{"Drop", []string{"dropped"}, true},
+		{"Withdraw", []string{"withdrawn"}, true},
+		{"Complete", []string{"completed"}, true},
+		{"ReenrollAfterDrop", []string{"dropped", "enrolled"}, true},
+		{"Unchanged", []string{"completed", "completed"}, true},
+		{"ReenrollAfterCompletion", []string{"completed", "enrolled"}, false},
+		{"ReenrollAfterWithdrawal", []string{"withdrawn", "enrolled"}, false},
+		{"CompleteAfterDrop", []string{"dropped", "completed"}, false},
+		{"DropAfterCompletion", []string{"completed", "dropped"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := setupClient(t)
+			course := createCourse(t, client)
+			enrollStudents(t, client, course.GetCourseID(), "student-1")
+
+			var err error
+			for _, studentStatus := range test.path {
+				_, err = client.SetStudentStatus(t.Context(), &cpb.SetStudentStatusRequest{
+					CourseID: course.GetCourseID(), StudentID: "student-1", Status: studentStatus, Token: "test-token",
+				})
+			}
+
+			if test.legal {
+				require.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestGetStudentStatusTransitions(t *testing.T) {
+	client := setupClient(t)
+
+	resp, err := client.GetStudentStatusTransitions(t.Context(),
+		&cpb.GetStudentStatusTransitionsRequest{Token: "test-token"})
+	require.NoError(t, err)
+
+	transitions := make(map[string][]string)
+	statuses := make([]string, 0, len(resp.GetTransitions()))
+
+	for _, transition := range resp.GetTransitions() {
+		statuses = append(statuses, transition.GetStatus())
+		transitions[transition.GetStatus()] = transition.GetAllowed()
+	}
+
+	assert.Equal(t, []string{"completed", "dropped", "enrolled", "withdrawn"}, statuses)
+	assert.ElementsMatch(t, []string{"dropped", "withdrawn", "completed"}, transitions["enrolled"])
+	assert.Equal(t, []string{"enrolled"}, transitions["dropped"])
+	assert.Empty(t, transitions["completed"])
+}
+
 func TestGetCourseStaff(t *testing.T) {
 	client := setupClient(t)
 	course := createCourse(t, client)
@@ -303,6 +605,92 @@ func TestGetStudentCourses(t *testing.T) {
 	assert.Contains(t, resp.GetCoursesIDs(), course.GetCourseID())
 }
 
+func TestGetEnrollmentsForStudent(t *testing.T) {
+	client := setupClient(t)
+
+	for courseID, semester := range map[string]string{
+		"234218": "Spring_2025", "236781": "Winter_2025", "234123": "Winter_2024", "236363": "Summer_2025",
+	} {
+		course := createTestCourse()
+		course.CourseID, course.Semester = courseID, semester
+		_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+		require.NoError(t, err)
+		enrollStudents(t, client, courseID, "student-1")
+	}
+
+	_, err := client.SetStudentStatus(t.Context(), &cpb.SetStudentStatusRequest{
+		CourseID: "234123", StudentID: "student-1", Status: "withdrawn", Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	resp, err := client.GetEnrollmentsForStudent(t.Context(),
+		&cpb.GetEnrollmentsForStudentRequest{StudentID: "student-1", Token: "test-token"})
+	require.NoError(t, err)
+
+	courseIDs := make([]string, 0, len(resp.GetEnrollments()))
+	for _, enrollment := range resp.GetEnrollments() {
+		courseIDs = append(courseIDs, enrollment.GetCourse().GetCourseID())
+	}
+
+	assert.Equal(t, []string{"234123", "236781", "234218", "236363"}, courseIDs)
+	assert.Equal(t, "withdrawn", resp.GetEnrollments()[0].GetStatus())
+	assert.Equal(t, "enrolled", resp.GetEnrollments()[1].GetStatus())
+}
+
+func TestGetEnrollmentsForStudentPermissions(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+
+	_, err := client.GetEnrollmentsForStudent(t.Context(),
+		&cpb.GetEnrollmentsForStudentRequest{StudentID: "student-1", Token: "test-token"})
+	require.NoError(t, err, "students may look up their own enrollments")
+
+	_, err = client.GetEnrollmentsForStudent(t.Context(),
+		&cpb.GetEnrollmentsForStudentRequest{StudentID: "student-2", Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetStudentCredits(t *testing.T) {
+	client := setupClient(t)
+
+	for _, course := range []*cpb.Course{
+		{CourseID: "234218", Semester: "Winter_2025", Credits: 4},
+		{CourseID: "236363", Semester: "Winter_2025", Credits: 3},
+		{CourseID: "234123", Semester: "Winter_2025", Credits: 5},
+		{CourseID: "236781", Semester: "Spring_2025", Credits: 2},
+	} {
+		_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+		require.NoError(t, err)
+		enrollStudents(t, client, course.GetCourseID(), "student-1")
+	}
+
+	_, err := client.SetStudentStatus(t.Context(), &cpb.SetStudentStatusRequest{
+		CourseID: "234123", StudentID: "student-1", Status: "dropped", Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	resp, err := client.GetStudentCredits(t.Context(),
+		&cpb.GetStudentCreditsRequest{StudentID: "student-1", Semester: "Winter_2025", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), resp.GetCredits(), "dropped courses and other semesters do not count")
+
+	_, err = client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{
+		Course: &cpb.Course{CourseID: "236000", Credits: -1}, Token: "test-token",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "credits must be non-negative")
+}
+
+func TestGetStudentCreditsPermissions(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+
+	_, err := client.GetStudentCredits(t.Context(),
+		&cpb.GetStudentCreditsRequest{StudentID: "student-1", Semester: "Winter_2025", Token: "test-token"})
+	require.NoError(t, err, "students may look up their own credits")
+
+	_, err = client.GetStudentCredits(t.Context(),
+		&cpb.GetStudentCreditsRequest{StudentID: "student-2", Semester: "Winter_2025", Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestGetStaffCourses(t *testing.T) {
 	client := setupClient(t)
 	course := createCourse(t, client)
@@ -317,79 +705,793 @@ func TestGetStaffCourses(t *testing.T) {
 	assert.Contains(t, resp.GetCoursesIDs(), course.GetCourseID())
 }
 
-func TestAddAnnouncementToCourse(t *testing.T) {
-	client := setupClient(t)
-	course := createCourse(t, client)
+// studentReadCodes calls every read of a student and returns the status code of each.
+func studentReadCodes(t *testing.T, client cpb.CoursesServiceClient, studentID string, strict *bool,
+) map[string]codes.Code {
+	t.Helper()
 
-	newAnnouncement := &cpb.Announcement{
-		AnnouncementID:      "1",
-		AnnouncementContent: "This is the first day of class. Please be on time.",
-		AnnouncementTitle:   "First Day of Class",
+	_, coursesErr := client.GetStudentCourses(t.Context(),
+		&cpb.GetStudentCoursesRequest{StudentID: studentID, Strict: strict, Token: "test-token"})
+	_, enrollmentsErr := client.GetEnrollmentsForStudent(t.Context(),
+		&cpb.GetEnrollmentsForStudentRequest{StudentID: studentID, Strict: strict, Token: "test-token"})
+	_, creditsErr := client.GetStudentCredits(t.Context(), &cpb.GetStudentCreditsRequest{
+		StudentID: studentID, Semester: "Spring_2030", Strict: strict, Token: "test-token",
+	})
+
+	return map[string]codes.Code{
+		"GetStudentCourses":        status.Code(coursesErr),
+		"GetEnrollmentsForStudent": status.Code(enrollmentsErr),
+		"GetStudentCredits":        status.Code(creditsErr),
 	}
+}
 
-	_, err := client.AddAnnouncementToCourse(t.Context(),
-		&cpb.AddAnnouncementRequest{
-			CourseID:     course.GetCourseID(),
-			Announcement: newAnnouncement, Token: "test-token",
+func TestStrictStudentReads(t *testing.T) {
+	strict, lenient := true, false
+	tests := []struct {
+		name      string
+		config    string
+		studentID string
+		strict    *bool
+		wantCode  codes.Code
+	}{
+		{name: "unknown by default", studentID: "student-typo", wantCode: codes.OK},
+		{name: "unknown when strict", studentID: "student-typo", strict: &strict, wantCode: codes.NotFound},
+		{name: "current when strict", studentID: "student-1", strict: &strict, wantCode: codes.OK},
+		{name: "historical only when strict", studentID: "student-2", strict: &strict, wantCode: codes.OK},
+		{name: "unknown when configured", config: "true", studentID: "student-typo", wantCode: codes.NotFound},
+		{
+			name: "unknown when configured but lenient", config: "true", studentID: "student-typo", strict: &lenient,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(strictMemberReadsEnv, test.config)
+
+			client := setupClient(t)
+			course := createCourse(t, client)
+			enrollStudents(t, client, course.GetCourseID(), "student-1", "student-2")
+
+			_, err := client.SetStudentStatus(t.Context(), &cpb.SetStudentStatusRequest{
+				CourseID: course.GetCourseID(), StudentID: "student-2", Status: studentDropped, Token: "test-token",
+			})
+			require.NoError(t, err)
+
+			for name, code := range studentReadCodes(t, client, test.studentID, test.strict) {
+				assert.Equal(t, test.wantCode, code, name)
+			}
 		})
-	require.NoError(t, err)
+	}
 }
 
-func TestGetCourseAnnouncements(t *testing.T) {
+func TestStrictStaffReads(t *testing.T) {
+	directory := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != "/staff/lecturer-new" {
+			writer.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = io.WriteString(writer, `{"name": "New Lecturer", "email": "new@example.com"}`)
+	}))
+	t.Cleanup(directory.Close)
+	t.Setenv(staffDirectoryURLEnv, directory.URL)
+
 	client := setupClient(t)
 	course := createCourse(t, client)
 
-	newAnnouncement := &cpb.Announcement{
-		AnnouncementID:      "1",
-		AnnouncementContent: "This is the first day of class. Please be on time.",
-		AnnouncementTitle:   "First Day of Class",
+	_, err := client.AddStaffToCourse(t.Context(), &cpb.AddStaffRequest{
+		CourseID: course.GetCourseID(), StaffID: "ta-expired", Token: "test-token",
+		ValidFrom:  timestamppb.New(time.Now().Add(-2 * time.Hour)),
+		ValidUntil: timestamppb.New(time.Now().Add(-time.Hour)),
+	})
+	require.NoError(t, err)
+
+	strict := true
+	tests := []struct {
+		staffID  string
+		strict   *bool
+		wantCode codes.Code
+	}{
+		{staffID: "ta-typo", wantCode: codes.OK},
+		{staffID: "ta-typo", strict: &strict, wantCode: codes.NotFound},
+		{staffID: "ta-expired", strict: &strict, wantCode: codes.OK},
+		{staffID: "lecturer-new", strict: &strict, wantCode: codes.OK},
 	}
 
-	_, err := client.AddAnnouncementToCourse(t.Context(),
-		&cpb.AddAnnouncementRequest{
-			CourseID:     course.GetCourseID(),
-			Announcement: newAnnouncement, Token: "test-token",
-		})
+	for _, test := range tests {
+		_, err := client.GetStaffCourses(t.Context(),
+			&cpb.GetStaffCoursesRequest{StaffID: test.staffID, Strict: test.strict, Token: "test-token"})
+		assert.Equal(t, test.wantCode, status.Code(err), "staff %q, strict %v", test.staffID, test.strict != nil)
+	}
+}
+
+func TestGetCoursesBySemesterAfterSemesterChange(t *testing.T) {
+	client := setupClient(t)
+
+	for courseID, semester := range map[string]string{"236343": "Winter_2023", "236781": "Winter_2025"} {
+		course := createTestCourse()
+		course.CourseID, course.Semester = courseID, semester
+		_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+		require.NoError(t, err)
+
+		_, err = client.AddStaffToCourse(t.Context(),
+			&cpb.AddStaffRequest{CourseID: courseID, StaffID: "lecturer-1", Token: "test-token"})
+		require.NoError(t, err)
+		enrollStudents(t, client, courseID, "student-1")
+	}
+
+	assertCourses := func(semester string, want ...string) {
+		t.Helper()
+
+		staffCourses, err := client.GetStaffCourses(t.Context(),
+			&cpb.GetStaffCoursesRequest{StaffID: "lecturer-1", Semester: semester, Token: "test-token"})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, want, staffCourses.GetCoursesIDs(), "staff courses of %q", semester)
+
+		studentCourses, err := client.GetStudentCourses(t.Context(),
+			&cpb.GetStudentCoursesRequest{StudentID: "student-1", Semester: semester, Token: "test-token"})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, want, studentCourses.GetCoursesIDs(), "student courses of %q", semester)
+	}
+
+	assertCourses("Winter_2025", "236781")
+	assertCourses("", "236343", "236781")
+
+	_, err := client.UpdateCourse(t.Context(), &cpb.UpdateCourseRequest{
+		Course: &cpb.Course{CourseID: "236343", Semester: "Winter_2025"}, Token: "test-token",
+	})
 	require.NoError(t, err)
 
-	req := &cpb.GetCourseAnnouncementsRequest{CourseID: course.GetCourseID(), Token: "test-token"}
-	resp, err := client.GetCourseAnnouncements(t.Context(), req)
+	assertCourses("Winter_2025", "236343", "236781")
+	assertCourses("Winter_2023")
+}
+
+func TestListCourses(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
 	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
 
-	// Check if the response contains an announcement with matching fields
-	// instead of comparing the entire protocol buffer object
-	found := false
+	start := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+	now := start
+	mockDB := NewMockDatabase()
+	mockDB.now = func() time.Time { return now }
+	testServer.db = mockDB
 
-	for _, announcement := range resp.GetAnnouncements() {
-		if announcement.GetAnnouncementID() == newAnnouncement.GetAnnouncementID() &&
-			announcement.GetAnnouncementTitle() == newAnnouncement.GetAnnouncementTitle() &&
-			announcement.GetAnnouncementContent() == newAnnouncement.GetAnnouncementContent() {
-			found = true
-			break
-		}
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+
+	for i, course := range []*cpb.Course{
+		{CourseID: "234114", CourseName: "Introduction to Computer Science", Semester: "Winter_2025"},
+		{CourseID: "236781", CourseName: "Deep Learning", Semester: "Winter_2025"},
+		{CourseID: "236343", CourseName: "Theory of Computation", Semester: "Spring_2025"},
+	} {
+		now = start.Add(time.Duration(i) * time.Hour)
+		_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+		require.NoError(t, err)
 	}
 
-	assert.True(t, found, "Response should contain the added announcement")
+	tests := []struct {
+		name    string
+		req     *cpb.ListCoursesRequest
+		courses []string
+	}{
+		{"Unfiltered", &cpb.ListCoursesRequest{}, []string{"234114", "236343", "236781"}},
+		{"Semester", &cpb.ListCoursesRequest{Semester: "Winter_2025"}, []string{"234114", "236781"}},
+		{"NameContains", &cpb.ListCoursesRequest{NameContains: "computer"}, []string{"234114"}},
+		{"CreatedAfter", &cpb.ListCoursesRequest{CreatedAfter: timestamppb.New(start)}, []string{"236343", "236781"}},
+		{"Combined", &cpb.ListCoursesRequest{
+			Semester: "Winter_2025", NameContains: "learn", CreatedAfter: timestamppb.New(start),
+		}, []string{"236781"}},
+		{"Paged", &cpb.ListCoursesRequest{PageSize: 1, PageToken: "1"}, []string{"236343"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.req.Token = "test-token"
+			resp, err := client.ListCourses(t.Context(), test.req)
+			require.NoError(t, err)
+
+			courseIDs := make([]string, 0, len(resp.GetCourses()))
+			for _, course := range resp.GetCourses() {
+				courseIDs = append(courseIDs, course.GetCourseID())
+			}
+
+			assert.Equal(t, test.courses, courseIDs)
+		})
+	}
 }
 
-func TestRemoveAnnouncementFromCourse(t *testing.T) {
+func TestGetSemesterCoursesTruncated(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     string
+		courses   []string
+		truncated bool
+	}{
+		{"OverLimit", "2", []string{"course-1", "course-2"}, true},
+		{"AtLimit", "3", []string{"course-1", "course-2", "course-3"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(semesterCoursesLimitEnv, test.limit)
+
+			client := setupClient(t)
+			for _, courseID := range []string{"course-3", "course-1", "course-2"} {
+				createCourseWithID(t, client, courseID)
+			}
+
+			resp, err := client.GetSemesterCourses(t.Context(),
+				&cpb.GetSemesterCoursesRequest{Semester: "Winter_2025", Token: "test-token"})
+			require.NoError(t, err)
+
+			courseIDs := make([]string, 0, len(resp.GetCourses()))
+			for _, course := range resp.GetCourses() {
+				courseIDs = append(courseIDs, course.GetCourseID())
+			}
+
+			assert.Equal(t, test.courses, courseIDs)
+			assert.Equal(t, test.truncated, resp.GetTruncated())
+		})
+	}
+}
+
+func TestGetSemesterCoursesEmptySemester(t *testing.T) {
 	client := setupClient(t)
-	course := createCourse(t, client)
 
-	newAnnouncement := &cpb.Announcement{
-		AnnouncementID:      "1234567890",
-		AnnouncementContent: "This is the first day of class. Please be on time.",
-		AnnouncementTitle:   "First Day of Class",
+	_, err := client.GetSemesterCourses(t.Context(), &cpb.GetSemesterCoursesRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "rejected by request validation")
+
+	server := &CoursesServer{db: NewMockDatabase(), Claims: MockClaims{}}
+
+	_, err = server.GetSemesterCourses(t.Context(), &cpb.GetSemesterCoursesRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "rejected by the database layer")
+}
+
+func TestListCoursesWithoutStaff(t *testing.T) {
+	client := setupClient(t)
+	for _, courseID := range []string{"staffed", "unstaffed-2", "unstaffed-1", "lapsed", "upcoming"} {
+		createCourseWithID(t, client, courseID)
 	}
 
-	_, err := client.AddAnnouncementToCourse(t.Context(),
-		&cpb.AddAnnouncementRequest{
-			CourseID:     course.GetCourseID(),
-			Announcement: newAnnouncement, Token: "test-token",
-		})
+	course := createTestCourse()
+	course.CourseID, course.Semester = "next-semester", "Spring_2025"
+	_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
 	require.NoError(t, err)
 
-	req := &cpb.RemoveAnnouncementRequest{
+	_, err = client.AddStaffToCourse(t.Context(),
+		&cpb.AddStaffRequest{CourseID: "staffed", StaffID: "lecturer-1", Token: "test-token"})
+	require.NoError(t, err)
+
+	now := time.Now()
+	_, err = client.AddStaffToCourse(t.Context(), &cpb.AddStaffRequest{
+		CourseID:   "lapsed",
+		StaffID:    "guest-1",
+		ValidFrom:  timestamppb.New(now.Add(-14 * 24 * time.Hour)),
+		ValidUntil: timestamppb.New(now.Add(-time.Hour)),
+		Token:      "test-token",
+	})
+	require.NoError(t, err)
+
+	_, err = client.AddStaffToCourse(t.Context(), &cpb.AddStaffRequest{
+		CourseID: "upcoming", StaffID: "guest-2", ValidFrom: timestamppb.New(now.Add(time.Hour)), Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	resp, err := client.ListCoursesWithoutStaff(t.Context(),
+		&cpb.ListCoursesWithoutStaffRequest{Semester: "Winter_2025", Token: "test-token"})
+	require.NoError(t, err)
+
+	courseIDs := make([]string, 0, len(resp.GetCourses()))
+	for _, course := range resp.GetCourses() {
+		courseIDs = append(courseIDs, course.GetCourseID())
+	}
+
+	assert.Equal(t, []string{"lapsed", "unstaffed-1", "unstaffed-2", "upcoming"}, courseIDs,
+		"assignments that lapsed or have not started should not count as staff")
+}
+
+func TestListCoursesWithoutStaffRequiresAdmin(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "lecturer-1", roles: []string{"staff"}})
+
+	_, err := client.ListCoursesWithoutStaff(t.Context(),
+		&cpb.ListCoursesWithoutStaffRequest{Semester: "Winter_2025", Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAddAnnouncementToCourse(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	newAnnouncement := &cpb.Announcement{
+		AnnouncementID:      "1",
+		AnnouncementContent: "This is the first day of class. Please be on time.",
+		AnnouncementTitle:   "First Day of Class",
+	}
+
+	resp, err := client.AddAnnouncementToCourse(t.Context(),
+		&cpb.AddAnnouncementRequest{
+			CourseID:     course.GetCourseID(),
+			Announcement: newAnnouncement, Token: "test-token",
+		})
+	require.NoError(t, err)
+	assert.Equal(t, "1", resp.GetAnnouncement().GetAnnouncementID(), "explicit IDs should be kept")
+	assert.Equal(t, newAnnouncement.GetAnnouncementTitle(), resp.GetAnnouncement().GetAnnouncementTitle())
+
+	_, err = client.AddAnnouncementToCourse(t.Context(),
+		&cpb.AddAnnouncementRequest{
+			CourseID:     course.GetCourseID(),
+			Announcement: newAnnouncement, Token: "test-token",
+		})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "IDs should be unique within a course")
+}
+
+func TestMockAnnouncementExists(t *testing.T) {
+	mockDB := NewMockDatabase()
+	course := createTestCourse()
+	_, err := mockDB.AddCourse(t.Context(), course)
+	require.NoError(t, err)
+
+	req := &cpb.AddAnnouncementRequest{
+		CourseID:     course.GetCourseID(),
+		Announcement: &cpb.Announcement{AnnouncementID: "exam", AnnouncementContent: "On Monday."},
+	}
+	_, err = mockDB.AddAnnouncement(t.Context(), req, defaultAnnouncementExcerptLength)
+	require.NoError(t, err)
+
+	_, err = mockDB.AddAnnouncement(t.Context(), req, defaultAnnouncementExcerptLength)
+	require.ErrorIs(t, err, ErrAnnouncementExists)
+
+	announcements, err := mockDB.GetAnnouncements(t.Context(), course.GetCourseID(), AnnouncementFilter{}, Page{})
+	require.NoError(t, err)
+	assert.Len(t, announcements, 1, "a rejected duplicate should not be stored")
+}
+
+func TestAddAnnouncementGeneratesID(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	added := make(map[string]bool)
+
+	for range 2 {
+		resp, err := client.AddAnnouncementToCourse(t.Context(), &cpb.AddAnnouncementRequest{
+			CourseID:     course.GetCourseID(),
+			Announcement: &cpb.Announcement{AnnouncementContent: "Room change."}, Token: "test-token",
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.GetAnnouncement().GetAnnouncementID(), "an ID should be generated")
+
+		added[resp.GetAnnouncement().GetAnnouncementID()] = true
+	}
+
+	require.Len(t, added, 2, "every announcement should get its own ID")
+
+	for announcementID := range added {
+		_, err := client.RemoveAnnouncementFromCourse(t.Context(), &cpb.RemoveAnnouncementRequest{
+			CourseID: course.GetCourseID(), AnnouncementID: announcementID, Token: "test-token",
+		})
+		require.NoError(t, err, "announcements should be removable by their generated ID")
+	}
+}
+
+func TestGetCourseAnnouncements(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	newAnnouncement := &cpb.Announcement{
+		AnnouncementID:      "1",
+		AnnouncementContent: "This is the first day of class. Please be on time.",
+		AnnouncementTitle:   "First Day of Class",
+	}
+
+	_, err := client.AddAnnouncementToCourse(t.Context(),
+		&cpb.AddAnnouncementRequest{
+			CourseID:     course.GetCourseID(),
+			Announcement: newAnnouncement, Token: "test-token",
+		})
+	require.NoError(t, err)
+
+	req := &cpb.GetCourseAnnouncementsRequest{CourseID: course.GetCourseID(), Token: "test-token"}
+	resp, err := client.GetCourseAnnouncements(t.Context(), req)
+	require.NoError(t, err)
+
+	// Check if the response contains an announcement with matching fields
+	// instead of comparing the entire protocol buffer object
+	found := false
+
+	for _, announcement := range resp.GetAnnouncements() {
+		if announcement.GetAnnouncementID() == newAnnouncement.GetAnnouncementID() &&
+			announcement.GetAnnouncementTitle() == newAnnouncement.GetAnnouncementTitle() &&
+			announcement.GetAnnouncementContent() == newAnnouncement.GetAnnouncementContent() {
+			found = true
+			break
+		}
+	}
+
+	assert.True(t, found, "Response should contain the added announcement")
+}
+
+func TestGetCourseAnnouncementsNewestFirst(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	now := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	mockDB := NewMockDatabase()
+	mockDB.now = func() time.Time { return now }
+	testServer.db = mockDB
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	course := createCourse(t, client)
+
+	posted := make(map[string]time.Time)
+
+	for _, announcementID := range []string{"first", "second", "third"} {
+		now = now.Add(time.Hour)
+		posted[announcementID] = now
+
+		addAnnouncement(t, client, course.GetCourseID(),
+			&cpb.Announcement{AnnouncementID: announcementID, AnnouncementContent: "Update."})
+	}
+
+	resp, err := client.GetCourseAnnouncements(t.Context(),
+		&cpb.GetCourseAnnouncementsRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+
+	announcementIDs := make([]string, 0, len(resp.GetAnnouncements()))
+	for _, announcement := range resp.GetAnnouncements() {
+		announcementIDs = append(announcementIDs, announcement.GetAnnouncementID())
+
+		assert.Equal(t, posted[announcement.GetAnnouncementID()], announcement.GetCreatedAt().AsTime(),
+			"the creation time should round-trip")
+		assert.Equal(t, posted[announcement.GetAnnouncementID()], announcement.GetUpdatedAt().AsTime(),
+			"the update time should round-trip")
+	}
+
+	assert.Equal(t, []string{"third", "second", "first"}, announcementIDs, "announcements should be newest first")
+}
+
+func TestGetCourseAnnouncementsPages(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	now := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	mockDB := NewMockDatabase()
+	mockDB.now = func() time.Time { return now }
+	testServer.db = mockDB
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	course := createCourse(t, client)
+
+	for index := range 5 {
+		now = now.Add(time.Hour)
+
+		addAnnouncement(t, client, course.GetCourseID(),
+			&cpb.Announcement{AnnouncementID: strconv.Itoa(index), AnnouncementContent: "Update."})
+	}
+
+	var pages [][]string
+
+	req := &cpb.GetCourseAnnouncementsRequest{CourseID: course.GetCourseID(), PageSize: 2, Token: "test-token"}
+	versions := make(map[string]bool)
+
+	for {
+		resp, err := client.GetCourseAnnouncements(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), resp.GetPageSize())
+
+		page := make([]string, 0, len(resp.GetAnnouncements()))
+		for _, announcement := range resp.GetAnnouncements() {
+			page = append(page, announcement.GetAnnouncementID())
+		}
+
+		pages = append(pages, page)
+		versions[resp.GetVersionToken()] = true
+
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+
+		req.PageToken = resp.GetNextPageToken()
+		req.KnownVersionToken = resp.GetVersionToken()
+	}
+
+	assert.Equal(t, [][]string{{"4", "3"}, {"2", "1"}, {"0"}}, pages, "pages should run from newest to oldest")
+	assert.Len(t, versions, 3, "a page should not be taken as unchanged because another page is")
+
+	_, err = client.GetCourseAnnouncements(t.Context(), &cpb.GetCourseAnnouncementsRequest{
+		CourseID: course.GetCourseID(), PageToken: "not-a-page", Token: "test-token",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// announcementReadsDatabase counts the times the announcements of a course are read in full.
+type announcementReadsDatabase struct {
+	*MockDatabase
+	reads *atomic.Int32
+}
+
+func (d announcementReadsDatabase) GetAnnouncements(ctx context.Context, courseID string,
+	filter AnnouncementFilter, page Page,
+) ([]Announcement, error) {
+	d.reads.Add(1)
+
+	return d.MockDatabase.GetAnnouncements(ctx, courseID, filter, page)
+}
+
+func TestGetCourseAnnouncementsNotModified(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	reads := &atomic.Int32{}
+	testServer.db = announcementReadsDatabase{MockDatabase: NewMockDatabase(), reads: reads}
+
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	course := createCourse(t, client)
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "1", AnnouncementContent: "Welcome to the course.",
+	})
+
+	get := func(knownVersionToken string) *cpb.GetCourseAnnouncementsResponse {
+		t.Helper()
+
+		resp, err := client.GetCourseAnnouncements(t.Context(), &cpb.GetCourseAnnouncementsRequest{
+			CourseID: course.GetCourseID(), KnownVersionToken: knownVersionToken, Token: "test-token",
+		})
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	first := get("")
+	require.NotEmpty(t, first.GetVersionToken())
+	assert.False(t, first.GetNotModified())
+	assert.Len(t, first.GetAnnouncements(), 1)
+	assert.Equal(t, first.GetVersionToken(), get("").GetVersionToken(), "the token is stable while nothing changes")
+
+	reads.Store(0)
+
+	cached := get(first.GetVersionToken())
+	assert.True(t, cached.GetNotModified())
+	assert.Empty(t, cached.GetAnnouncements())
+	assert.Zero(t, reads.Load(), "an unchanged version should not read the announcements")
+
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "2", AnnouncementContent: "Homework 1 is out.",
+	})
+
+	added := get(first.GetVersionToken())
+	assert.False(t, added.GetNotModified(), "adding an announcement invalidates the token")
+	assert.Len(t, added.GetAnnouncements(), 2)
+
+	_, err = client.RemoveAnnouncementFromCourse(t.Context(), &cpb.RemoveAnnouncementRequest{
+		CourseID: course.GetCourseID(), AnnouncementID: "2", Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	removed := get(added.GetVersionToken())
+	assert.False(t, removed.GetNotModified(), "removing an announcement invalidates the token")
+	assert.Len(t, removed.GetAnnouncements(), 1)
+}
+
+func TestAnnouncementsEnabled(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	post := func(announcementID string) codes.Code {
+		_, err := client.AddAnnouncementToCourse(t.Context(), &cpb.AddAnnouncementRequest{
+			CourseID:     course.GetCourseID(),
+			Announcement: &cpb.Announcement{AnnouncementID: announcementID, AnnouncementContent: "Course archived."},
+			Token:        "test-token",
+		})
+
+		return status.Code(err)
+	}
+	setEnabled := func(enabled bool) {
+		_, err := client.SetAnnouncementsEnabled(t.Context(), &cpb.SetAnnouncementsEnabledRequest{
+			CourseID: course.GetCourseID(), Enabled: enabled, Token: "test-token",
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetCourse(t.Context(),
+		&cpb.GetCourseRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	assert.True(t, resp.GetCourse().GetAnnouncementsEnabled(), "new courses accept announcements")
+	require.Equal(t, codes.OK, post("1"))
+
+	setEnabled(false)
+	assert.Equal(t, codes.FailedPrecondition, post("2"))
+
+	announcements, err := client.GetCourseAnnouncements(t.Context(),
+		&cpb.GetCourseAnnouncementsRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	assert.Len(t, announcements.GetAnnouncements(), 1, "existing announcements stay readable")
+
+	setEnabled(true)
+	assert.Equal(t, codes.OK, post("2"))
+}
+
+func TestSetAnnouncementsEnabledRequiresStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+	course := createCourse(t, client)
+
+	_, err := client.SetAnnouncementsEnabled(t.Context(),
+		&cpb.SetAnnouncementsEnabledRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetCourseActivity(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	now := time.Now()
+	mockDB := NewMockDatabase()
+	mockDB.now = func() time.Time { return now }
+	testServer.db = mockDB
+
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	course := createCourse(t, client)
+
+	now = time.Now().Add(-activityWindow - time.Hour)
+
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "old", AnnouncementContent: "Welcome to the course.",
+	})
+
+	now = time.Now()
+
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "recent", AnnouncementContent: "Homework 1 is out.",
+	})
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "rubric", AnnouncementContent: "Grading rubric.",
+		Visibility: cpb.AnnouncementVisibility_STAFF_ONLY,
+	})
+
+	resp, err := client.GetCourse(t.Context(),
+		&cpb.GetCourseRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	assert.Nil(t, resp.GetActivity(), "activity is only included on request")
+
+	resp, err = client.GetCourse(t.Context(),
+		&cpb.GetCourseRequest{CourseID: course.GetCourseID(), IncludeActivity: true, Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.GetActivity().GetRecentAnnouncements())
+}
+
+// studentReads returns the read RPCs a student may call on a course, each returning its response.
+func studentReads(courseID, studentID string) map[string]func(context.Context,
+	cpb.CoursesServiceClient) (proto.Message, error) {
+	return map[string]func(context.Context, cpb.CoursesServiceClient) (proto.Message, error){
+		"GetCourse": func(ctx context.Context, client cpb.CoursesServiceClient) (proto.Message, error) {
+			return client.GetCourse(ctx, &cpb.GetCourseRequest{CourseID: courseID, IncludeActivity: true})
+		},
+		"GetCourseAnnouncements": func(ctx context.Context, client cpb.CoursesServiceClient) (proto.Message, error) {
+			return client.GetCourseAnnouncements(ctx, &cpb.GetCourseAnnouncementsRequest{CourseID: courseID})
+		},
+		"GetAnnouncement": func(ctx context.Context, client cpb.CoursesServiceClient) (proto.Message, error) {
+			return client.GetAnnouncement(ctx, &cpb.GetAnnouncementRequest{CourseID: courseID, AnnouncementID: "rubric"})
+		},
+		"GetCourseAnnouncementsGrouped": func(ctx context.Context,
+			client cpb.CoursesServiceClient,
+		) (proto.Message, error) {
+			return client.GetCourseAnnouncementsGrouped(ctx, &cpb.GetCourseAnnouncementsGroupedRequest{CourseID: courseID})
+		},
+		"SearchAllAnnouncements": func(ctx context.Context, client cpb.CoursesServiceClient) (proto.Message, error) {
+			return client.SearchAllAnnouncements(ctx, &cpb.SearchAllAnnouncementsRequest{Query: "rubric"})
+		},
+		"GetAnnouncementsSince": func(ctx context.Context, client cpb.CoursesServiceClient) (proto.Message, error) {
+			return client.GetAnnouncementsSince(ctx, &cpb.GetAnnouncementsSinceRequest{})
+		},
+		"GetStudentCourses": func(ctx context.Context, client cpb.CoursesServiceClient) (proto.Message, error) {
+			return client.GetStudentCourses(ctx, &cpb.GetStudentCoursesRequest{StudentID: studentID})
+		},
+		"ListCourses": func(ctx context.Context, client cpb.CoursesServiceClient) (proto.Message, error) {
+			return client.ListCourses(ctx, &cpb.ListCoursesRequest{})
+		},
+	}
+}
+
+// TestStaffOnlyAnnouncementsNeverReachStudents seeds a staff-only announcement and checks that no read
+// RPC answers a student any differently than before it was posted, so neither its content nor its
+// existence leaks, e.g. through a count or version token.
+func TestStaffOnlyAnnouncementsNeverReachStudents(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(RoleClaims{subject: "student-1", roles: []string{"student"}})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	mockDB := NewMockDatabase()
+	testServer.db = mockDB
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+
+	course, err := mockDB.AddCourse(t.Context(), createTestCourse())
+	require.NoError(t, err)
+	require.NoError(t, mockDB.AddStudentToCourse(t.Context(), course.CourseID, "student-1", false, false))
+
+	reads := studentReads(course.CourseID, "student-1")
+	before := make(map[string]proto.Message)
+	codesBefore := make(map[string]codes.Code)
+
+	for name, read := range reads {
+		before[name], err = read(t.Context(), client)
+		codesBefore[name] = status.Code(err)
+	}
+
+	_, err = mockDB.AddAnnouncement(t.Context(), &cpb.AddAnnouncementRequest{
+		CourseID: course.CourseID,
+		Announcement: &cpb.Announcement{
+			AnnouncementID: "rubric", AnnouncementContent: "Grading rubric.",
+			Visibility: cpb.AnnouncementVisibility_STAFF_ONLY,
+		},
+	}, defaultAnnouncementExcerptLength)
+	require.NoError(t, err)
+
+	for name, read := range reads {
+		after, err := read(t.Context(), client)
+		assert.Equal(t, codesBefore[name], status.Code(err), "%s should fail the same way", name)
+		assert.True(t, proto.Equal(before[name], after), "%s should not reveal the staff-only announcement", name)
+	}
+}
+
+func TestGetAnnouncementCountByAuthor(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	mockDB := NewMockDatabase()
+	testServer.db = mockDB
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+
+	course, err := mockDB.AddCourse(t.Context(), createTestCourse(),
+		CourseStaff{StaffID: "lecturer-1"}, CourseStaff{StaffID: "ta-1"})
+	require.NoError(t, err)
+
+	for _, author := range []string{"lecturer-1", "ta-1", "lecturer-1"} {
+		testServer.Claims = RoleClaims{subject: author, roles: []string{"staff"}}
+
+		resp, err := client.AddAnnouncementToCourse(t.Context(), &cpb.AddAnnouncementRequest{
+			CourseID:     course.CourseID,
+			Announcement: &cpb.Announcement{AnnouncementContent: "Office hours moved.", Author: "someone-else"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, author, resp.GetAnnouncement().GetAuthor(), "the author should be the caller")
+	}
+
+	resp, err := client.GetAnnouncementCountByAuthor(t.Context(),
+		&cpb.GetAnnouncementCountByAuthorRequest{CourseID: course.CourseID})
+	require.NoError(t, err)
+	require.Len(t, resp.GetCounts(), 2)
+	assert.Equal(t, "lecturer-1", resp.GetCounts()[0].GetAuthor(), "the most active author should come first")
+	assert.Equal(t, int64(2), resp.GetCounts()[0].GetCount())
+	assert.Equal(t, "ta-1", resp.GetCounts()[1].GetAuthor())
+	assert.Equal(t, int64(1), resp.GetCounts()[1].GetCount())
+
+	testServer.Claims = RoleClaims{subject: "student-1", roles: []string{"student"}}
+	_, err = client.GetAnnouncementCountByAuthor(t.Context(),
+		&cpb.GetAnnouncementCountByAuthorRequest{CourseID: course.CourseID})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "students should not see the counts")
+}
+
+func TestRemoveAnnouncementFromCourse(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	newAnnouncement := &cpb.Announcement{
+		AnnouncementID:      "1234567890",
+		AnnouncementContent: "This is the first day of class. Please be on time.",
+		AnnouncementTitle:   "First Day of Class",
+	}
+
+	_, err := client.AddAnnouncementToCourse(t.Context(),
+		&cpb.AddAnnouncementRequest{
+			CourseID:     course.GetCourseID(),
+			Announcement: newAnnouncement, Token: "test-token",
+		})
+	require.NoError(t, err)
+
+	req := &cpb.RemoveAnnouncementRequest{
 		CourseID:       course.GetCourseID(),
 		AnnouncementID: "1234567890",
 		Token:          "test-token",
@@ -397,3 +1499,3991 @@ func TestRemoveAnnouncementFromCourse(t *testing.T) {
 	_, err = client.RemoveAnnouncementFromCourse(t.Context(), req)
 	require.NoError(t, err)
 }
+
+func TestUpdateAnnouncement(t *testing.T) {
+	t.Setenv(announcementExcerptLengthEnv, "64")
+
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "exam", AnnouncementTitle: "Exam", AnnouncementContent: "The exam is on Monday.",
+	})
+
+	resp, err := client.UpdateAnnouncement(t.Context(), &cpb.UpdateAnnouncementRequest{
+		CourseID: course.GetCourseID(), AnnouncementID: "exam", AnnouncementTitle: "Exam moved", Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Exam moved", resp.GetAnnouncement().GetAnnouncementTitle())
+	assert.Equal(t, "The exam is on Monday.", resp.GetAnnouncement().GetAnnouncementContent())
+
+	long := strings.Repeat("The exam is on Tuesday. ", 20)
+	resp, err = client.UpdateAnnouncement(t.Context(), &cpb.UpdateAnnouncementRequest{
+		CourseID: course.GetCourseID(), AnnouncementID: "exam", AnnouncementContent: long, Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Exam moved", resp.GetAnnouncement().GetAnnouncementTitle())
+	assert.True(t, resp.GetAnnouncement().GetHasFullBody())
+
+	full, err := client.GetAnnouncement(t.Context(), &cpb.GetAnnouncementRequest{
+		CourseID: course.GetCourseID(), AnnouncementID: "exam", Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, long, full.GetAnnouncement().GetAnnouncementContent())
+
+	_, err = client.UpdateAnnouncement(t.Context(), &cpb.UpdateAnnouncementRequest{
+		CourseID: course.GetCourseID(), AnnouncementID: "missing", AnnouncementTitle: "Title", Token: "test-token",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUpdateAnnouncementVisibility(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "rubric", AnnouncementContent: "Grading rubric.",
+	})
+
+	resp, err := client.UpdateAnnouncement(t.Context(), &cpb.UpdateAnnouncementRequest{
+		CourseID: course.GetCourseID(), AnnouncementID: "rubric",
+		Visibility: cpb.AnnouncementVisibility_STAFF_ONLY.Enum(), Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, cpb.AnnouncementVisibility_STAFF_ONLY, resp.GetAnnouncement().GetVisibility())
+	assert.Equal(t, "Grading rubric.", resp.GetAnnouncement().GetAnnouncementContent())
+
+	resp, err = client.UpdateAnnouncement(t.Context(), &cpb.UpdateAnnouncementRequest{
+		CourseID: course.GetCourseID(), AnnouncementID: "rubric", AnnouncementTitle: "Rubric", Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, cpb.AnnouncementVisibility_STAFF_ONLY, resp.GetAnnouncement().GetVisibility(),
+		"an unset visibility should leave it unchanged")
+
+	_, err = client.UpdateAnnouncement(t.Context(), &cpb.UpdateAnnouncementRequest{
+		CourseID: course.GetCourseID(), AnnouncementID: "rubric",
+		Visibility: cpb.AnnouncementVisibility(99).Enum(), Token: "test-token",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAddAnnouncementToCourseRequiresStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+
+	_, err := client.AddAnnouncementToCourse(t.Context(), &cpb.AddAnnouncementRequest{
+		CourseID: "236781",
+		Announcement: &cpb.Announcement{
+			AnnouncementID: "exam", AnnouncementContent: "Exam moved.", Visibility: cpb.AnnouncementVisibility_STAFF_ONLY,
+		},
+		Urgent: true,
+		Token:  "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUpdateAnnouncementRequiresStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+
+	_, err := client.UpdateAnnouncement(t.Context(), &cpb.UpdateAnnouncementRequest{
+		CourseID: "236781", AnnouncementID: "exam", AnnouncementTitle: "Exam moved", Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUpsertAnnouncementBySlug(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	upsert := &cpb.UpsertAnnouncementBySlugRequest{
+		CourseID: course.GetCourseID(), Token: "test-token",
+		Announcement: &cpb.Announcement{Slug: "week-1", AnnouncementTitle: "Week 1", AnnouncementContent: "Read 1."},
+	}
+	resp, err := client.UpsertAnnouncementBySlug(t.Context(), upsert)
+	require.NoError(t, err)
+	assert.True(t, resp.GetCreated())
+	assert.Equal(t, "week-1", resp.GetAnnouncement().GetSlug())
+
+	announcementID := resp.GetAnnouncement().GetAnnouncementID()
+
+	upsert.Announcement.AnnouncementContent = "Read chapters 1 and 2."
+	resp, err = client.UpsertAnnouncementBySlug(t.Context(), upsert)
+	require.NoError(t, err)
+	assert.False(t, resp.GetCreated())
+	assert.Equal(t, announcementID, resp.GetAnnouncement().GetAnnouncementID())
+	assert.Equal(t, "Read chapters 1 and 2.", resp.GetAnnouncement().GetAnnouncementContent())
+
+	listed, err := client.GetCourseAnnouncements(t.Context(),
+		&cpb.GetCourseAnnouncementsRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	require.Len(t, listed.GetAnnouncements(), 1)
+	assert.Equal(t, "week-1", listed.GetAnnouncements()[0].GetSlug())
+
+	upsert.Announcement.Slug = ""
+	_, err = client.UpsertAnnouncementBySlug(t.Context(), upsert)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "upserts need a slug")
+
+	upsert.Announcement.Slug = "Week 1"
+	_, err = client.UpsertAnnouncementBySlug(t.Context(), upsert)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "slugs are lowercase words joined by dashes")
+}
+
+func TestAnnouncementSlugUnique(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{Slug: "syllabus", AnnouncementContent: "v1"})
+
+	_, err := client.AddAnnouncementToCourse(t.Context(), &cpb.AddAnnouncementRequest{
+		CourseID: course.GetCourseID(), Token: "test-token",
+		Announcement: &cpb.Announcement{Slug: "syllabus", AnnouncementContent: "v2"},
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "a slug is used once per course")
+
+	createCourseWithID(t, client, "236350")
+	addAnnouncement(t, client, "236350", &cpb.Announcement{Slug: "syllabus", AnnouncementContent: "v1"})
+}
+
+func TestUpsertAnnouncementBySlugRequiresStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+
+	_, err := client.UpsertAnnouncementBySlug(t.Context(), &cpb.UpsertAnnouncementBySlugRequest{
+		CourseID: "236781", Token: "test-token",
+		Announcement: &cpb.Announcement{Slug: "week-1", AnnouncementContent: "Read 1."},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetEnrollmentStatus(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		opensAt  *timestamppb.Timestamp
+		closesAt *timestamppb.Timestamp
+		expected cpb.EnrollmentStatus
+	}{
+		{"NoWindow", nil, nil, cpb.EnrollmentStatus_OPEN},
+		{"OpensInThreeDays", timestamppb.New(now.Add(72 * time.Hour)), nil, cpb.EnrollmentStatus_NOT_YET_OPEN},
+		{"Open", timestamppb.New(now.Add(-time.Hour)), timestamppb.New(now.Add(time.Hour)), cpb.EnrollmentStatus_OPEN},
+		{"Closed", nil, timestamppb.New(now.Add(-time.Hour)), cpb.EnrollmentStatus_CLOSED},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := client.SetEnrollmentWindow(t.Context(), &cpb.SetEnrollmentWindowRequest{
+				CourseID: course.GetCourseID(), OpensAt: test.opensAt, ClosesAt: test.closesAt, Token: "test-token",
+			})
+			require.NoError(t, err)
+
+			resp, err := client.GetEnrollmentStatus(t.Context(),
+				&cpb.GetEnrollmentStatusRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, resp.GetStatus())
+			assert.Equal(t, test.opensAt.AsTime(), resp.GetOpensAt().AsTime())
+			assert.Equal(t, test.closesAt.AsTime(), resp.GetClosesAt().AsTime())
+		})
+	}
+}
+
+// eligibilityCase sets up a course and a student for CheckEnrollmentEligibility and lists the blockers expected.
+type eligibilityCase struct {
+	name     string
+	capacity int32
+	setup    func(t *testing.T, client cpb.CoursesServiceClient)
+	want     []cpb.EnrollmentBlocker
+}
+
+// setWindow returns the setup of an eligibility case giving course 236781 an enrollment window.
+func setWindow(opensAt, closesAt *timestamppb.Timestamp) func(*testing.T, cpb.CoursesServiceClient) {
+	return func(t *testing.T, client cpb.CoursesServiceClient) {
+		t.Helper()
+
+		_, err := client.SetEnrollmentWindow(t.Context(), &cpb.SetEnrollmentWindowRequest{
+			CourseID: "236781", OpensAt: opensAt, ClosesAt: closesAt, Token: "test-token",
+		})
+		require.NoError(t, err)
+	}
+}
+
+// eligibilityCases returns one case for each reason student-1 cannot enroll in course 236781 and
+// one where nothing blocks them.
+func eligibilityCases() []eligibilityCase {
+	now := time.Now()
+
+	return []eligibilityCase{
+		{"AllClear", 0, func(*testing.T, cpb.CoursesServiceClient) {}, nil},
+		{
+			"NotYetOpen", 0, setWindow(timestamppb.New(now.Add(time.Hour)), nil),
+			[]cpb.EnrollmentBlocker{cpb.EnrollmentBlocker_BLOCKER_NOT_YET_OPEN},
+		},
+		{
+			"Closed", 0, setWindow(nil, timestamppb.New(now.Add(-time.Hour))),
+			[]cpb.EnrollmentBlocker{cpb.EnrollmentBlocker_BLOCKER_CLOSED},
+		},
+		{
+			"CourseFull", 1, func(t *testing.T, client cpb.CoursesServiceClient) {
+				t.Helper()
+				enrollStudents(t, client, "236781", "student-2")
+			},
+			[]cpb.EnrollmentBlocker{cpb.EnrollmentBlocker_BLOCKER_COURSE_FULL},
+		},
+		{
+			"AlreadyEnrolled", 0, func(t *testing.T, client cpb.CoursesServiceClient) {
+				t.Helper()
+				enrollStudents(t, client, "236781", "student-1")
+			},
+			[]cpb.EnrollmentBlocker{cpb.EnrollmentBlocker_BLOCKER_ALREADY_ENROLLED},
+		},
+		{
+			"StaffOfCourse", 0, func(t *testing.T, client cpb.CoursesServiceClient) {
+				t.Helper()
+
+				_, err := client.AddStaffToCourse(t.Context(),
+					&cpb.AddStaffRequest{CourseID: "236781", StaffID: "student-1", Token: "test-token"})
+				require.NoError(t, err)
+			},
+			[]cpb.EnrollmentBlocker{cpb.EnrollmentBlocker_BLOCKER_STAFF_OF_COURSE},
+		},
+		{
+			"SemesterLimit", 0, func(t *testing.T, client cpb.CoursesServiceClient) {
+				t.Helper()
+
+				for _, courseID := range []string{"236350", "236351"} {
+					createCourseWithID(t, client, courseID)
+					enrollStudents(t, client, courseID, "student-1")
+				}
+			},
+			[]cpb.EnrollmentBlocker{cpb.EnrollmentBlocker_BLOCKER_SEMESTER_LIMIT_REACHED},
+		},
+		{
+			"CreditLimit", 0, func(t *testing.T, client cpb.CoursesServiceClient) {
+				t.Helper()
+
+				seminar := createTestCourse()
+				seminar.CourseID, seminar.Credits = "236352", 8
+				_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: seminar, Token: "test-token"})
+				require.NoError(t, err)
+				enrollStudents(t, client, seminar.GetCourseID(), "student-1")
+			},
+			[]cpb.EnrollmentBlocker{cpb.EnrollmentBlocker_BLOCKER_CREDIT_LIMIT_REACHED},
+		},
+	}
+}
+
+func TestCheckEnrollmentEligibility(t *testing.T) {
+	t.Setenv(maxSemesterCoursesEnv, "2")
+	t.Setenv(maxSemesterLoadEnv, "10")
+
+	for _, test := range eligibilityCases() {
+		t.Run(test.name, func(t *testing.T) {
+			client := setupClient(t)
+
+			course := createTestCourse()
+			course.Capacity, course.Credits = test.capacity, 4
+			_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+			require.NoError(t, err)
+
+			test.setup(t, client)
+
+			resp, err := client.CheckEnrollmentEligibility(t.Context(), &cpb.CheckEnrollmentEligibilityRequest{
+				CourseID: "236781", StudentID: "student-1", Token: "test-token",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, test.want, resp.GetBlockers())
+			assert.Equal(t, len(test.want) == 0, resp.GetEligible())
+
+			// Enrolling refuses exactly what the pre-flight does, but succeeds again for enrolled students.
+			_, err = client.AddStudentToCourse(t.Context(),
+				&cpb.AddStudentRequest{CourseID: "236781", StudentID: "student-1", Token: "test-token"})
+			if resp.GetEligible() || test.name == "AlreadyEnrolled" {
+				require.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestCheckEnrollmentEligibilityRequiresSelfOrStaff(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(RoleClaims{subject: "student-1", roles: []string{"student"}})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	_, err = testServer.db.AddCourse(t.Context(), createTestCourse())
+	require.NoError(t, err)
+
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+
+	resp, err := client.CheckEnrollmentEligibility(t.Context(), &cpb.CheckEnrollmentEligibilityRequest{
+		CourseID: "236781", StudentID: "student-1", Token: "test-token",
+	})
+	require.NoError(t, err, "students may check themselves")
+	assert.True(t, resp.GetEligible())
+
+	_, err = client.CheckEnrollmentEligibility(t.Context(), &cpb.CheckEnrollmentEligibilityRequest{
+		CourseID: "236781", StudentID: "student-2", Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "students may not check others")
+}
+
+func TestEnrollmentCountdownWithClock(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	opensAt := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+	closesAt := opensAt.Add(time.Hour)
+	now := opensAt.Add(-10 * time.Second)
+	mockDB := NewMockDatabase()
+	mockDB.now = func() time.Time { return now }
+	testServer.db = mockDB
+
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	course := createCourse(t, client)
+
+	unbounded, err := client.GetEnrollmentStatus(t.Context(),
+		&cpb.GetEnrollmentStatusRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+
+	fields := unbounded.ProtoReflect().Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"secondsUntilOpen", "secondsUntilClose"} {
+		assert.False(t, unbounded.ProtoReflect().Has(fields.ByName(name)), "an unbounded window has no countdown")
+	}
+
+	_, err = client.SetEnrollmentWindow(t.Context(), &cpb.SetEnrollmentWindowRequest{
+		CourseID: course.GetCourseID(), OpensAt: timestamppb.New(opensAt), ClosesAt: timestamppb.New(closesAt),
+		Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		now        time.Time
+		status     cpb.EnrollmentStatus
+		untilOpen  int64
+		untilClose int64
+	}{
+		{"BeforeOpening", opensAt.Add(-10 * time.Second), cpb.EnrollmentStatus_NOT_YET_OPEN, 10, 3610},
+		{"RoundsUp", opensAt.Add(-500 * time.Millisecond), cpb.EnrollmentStatus_NOT_YET_OPEN, 1, 3601},
+		{"AtOpening", opensAt, cpb.EnrollmentStatus_OPEN, 0, 3600},
+		{"AtClosing", closesAt, cpb.EnrollmentStatus_CLOSED, -3600, 0},
+		{"AfterClosing", closesAt.Add(2 * time.Second), cpb.EnrollmentStatus_CLOSED, -3602, -2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			now = test.now
+
+			resp, err := client.GetEnrollmentStatus(t.Context(),
+				&cpb.GetEnrollmentStatusRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+			require.NoError(t, err)
+			assert.Equal(t, test.status, resp.GetStatus())
+			assert.Equal(t, test.untilOpen, resp.GetSecondsUntilOpen())
+			assert.Equal(t, test.untilClose, resp.GetSecondsUntilClose())
+		})
+	}
+}
+
+func TestSetEnrollmentWindowInvalid(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	now := time.Now()
+
+	_, err := client.SetEnrollmentWindow(t.Context(), &cpb.SetEnrollmentWindowRequest{
+		CourseID: course.GetCourseID(),
+		OpensAt:  timestamppb.New(now),
+		ClosesAt: timestamppb.New(now.Add(-time.Hour)),
+		Token:    "test-token",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetEnrollmentStatus(t.Context(),
+		&cpb.GetEnrollmentStatusRequest{CourseID: "non-existent-id", Token: "test-token"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSetEnrollmentWindowRequiresStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+	course := createCourse(t, client)
+
+	_, err := client.SetEnrollmentWindow(t.Context(), &cpb.SetEnrollmentWindowRequest{
+		CourseID: course.GetCourseID(),
+		ClosesAt: timestamppb.New(time.Now()),
+		Token:    "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetCourseStaffExcludesExpiredAccess(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	now := time.Now()
+
+	_, err := client.AddStaffToCourse(t.Context(), &cpb.AddStaffRequest{
+		CourseID:   course.GetCourseID(),
+		StaffID:    "guest-lecturer",
+		ValidFrom:  timestamppb.New(now.Add(-14 * 24 * time.Hour)),
+		ValidUntil: timestamppb.New(now.Add(-time.Hour)),
+		Token:      "test-token",
+	})
+	require.NoError(t, err)
+
+	_, err = client.AddStaffToCourse(t.Context(),
+		&cpb.AddStaffRequest{CourseID: course.GetCourseID(), StaffID: "staff-1", Token: "test-token"})
+	require.NoError(t, err)
+
+	resp, err := client.GetCourseStaff(t.Context(),
+		&cpb.GetCourseStaffRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"staff-1"}, resp.GetStaffIDs())
+
+	resp, err = client.GetCourseStaff(t.Context(),
+		&cpb.GetCourseStaffRequest{CourseID: course.GetCourseID(), IncludeExpired: true, Token: "test-token"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"staff-1", "guest-lecturer"}, resp.GetStaffIDs())
+}
+
+func TestAddStaffToCourseInvalidAccess(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	now := time.Now()
+
+	_, err := client.AddStaffToCourse(t.Context(), &cpb.AddStaffRequest{
+		CourseID:   course.GetCourseID(),
+		StaffID:    "guest-lecturer",
+		ValidFrom:  timestamppb.New(now),
+		ValidUntil: timestamppb.New(now),
+		Token:      "test-token",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStaffAccessExpiresWithClock(t *testing.T) {
+	mockDB := NewMockDatabase()
+	now := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+	mockDB.now = func() time.Time { return now }
+
+	_, err := mockDB.AddCourse(t.Context(), createTestCourse())
+	require.NoError(t, err)
+
+	courseID := createTestCourse().GetCourseID()
+	validUntil := now.Add(14 * 24 * time.Hour)
+	require.NoError(t, mockDB.AddStaffToCourse(t.Context(), courseID, "guest-lecturer", now, validUntil, false))
+
+	staff, err := mockDB.GetCourseStaff(t.Context(), courseID, false)
+	require.NoError(t, err)
+	assert.Contains(t, staff, "guest-lecturer", "access should be active before it expires")
+
+	now = validUntil
+	staff, err = mockDB.GetCourseStaff(t.Context(), courseID, false)
+	require.NoError(t, err)
+	assert.NotContains(t, staff, "guest-lecturer", "access should lapse exactly at validUntil")
+}
+
+// recordingPublisher records the events published, failing them while failing is set.
+type recordingPublisher struct {
+	expired []StaffAccessExpired
+	failing bool
+}
+
+func (p *recordingPublisher) PublishStaffAccessExpired(_ context.Context, event StaffAccessExpired) error {
+	if p.failing {
+		return fmt.Errorf("%w", ErrEventNotDelivered)
+	}
+
+	p.expired = append(p.expired, event)
+
+	return nil
+}
+
+func TestStaffAccessExpiredEvents(t *testing.T) {
+	mockDB := NewMockDatabase()
+	now := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+	mockDB.now = func() time.Time { return now }
+
+	_, err := mockDB.AddCourse(t.Context(), createTestCourse())
+	require.NoError(t, err)
+
+	courseID := createTestCourse().GetCourseID()
+	validUntil := now.Add(14 * 24 * time.Hour)
+	require.NoError(t, mockDB.AddStaffToCourse(t.Context(), courseID, "guest-lecturer", now, validUntil, false))
+	require.NoError(t, mockDB.AddStaffToCourse(t.Context(), courseID, "lecturer", time.Time{}, time.Time{}, false))
+
+	publisher := &recordingPublisher{failing: true}
+	server := &CoursesServer{db: mockDB, events: publisher}
+
+	server.publishLapsedStaff(t.Context(), validUntil.Add(-time.Second))
+	server.publishLapsedStaff(t.Context(), validUntil)
+	assert.Empty(t, publisher.expired, "a failed event should not be recorded")
+
+	publisher.failing = false
+
+	server.publishLapsedStaff(t.Context(), validUntil)
+	server.publishLapsedStaff(t.Context(), validUntil.Add(time.Minute))
+
+	want := StaffAccessExpired{CourseID: courseID, StaffID: "guest-lecturer", ExpiredAt: validUntil}
+	assert.Equal(t, []StaffAccessExpired{want}, publisher.expired, "a lapse should be published once, after retries")
+
+	// Extending the assignment lets its new end lapse anew.
+	extended := validUntil.Add(7 * 24 * time.Hour)
+	require.NoError(t, mockDB.AddStaffToCourse(t.Context(), courseID, "guest-lecturer", now, extended, false))
+	server.publishLapsedStaff(t.Context(), extended)
+
+	want.ExpiredAt = extended
+	assert.Equal(t, want, publisher.expired[len(publisher.expired)-1])
+}
+
+func TestHTTPEventPublisher(t *testing.T) {
+	var received StaffAccessExpired
+
+	events := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != "/events/"+staffAccessExpiredEvent {
+			writer.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if err := json.NewDecoder(request.Body).Decode(&received); err != nil {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		writer.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(events.Close)
+	t.Setenv(eventsURLEnv, events.URL+"/")
+
+	publisher, err := eventPublisherFromEnv()
+	require.NoError(t, err)
+
+	event := StaffAccessExpired{
+		CourseID: "236781", StaffID: "guest-lecturer", ExpiredAt: time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, publisher.PublishStaffAccessExpired(t.Context(), event))
+	assert.Equal(t, event, received)
+
+	events.Close()
+	require.ErrorIs(t, publisher.PublishStaffAccessExpired(t.Context(), event), ErrEventNotDelivered)
+
+	t.Setenv(eventsURLEnv, "events.internal")
+
+	_, err = eventPublisherFromEnv()
+	require.ErrorIs(t, err, ErrInvalidEventsURL)
+}
+
+func addAnnouncement(t *testing.T, client cpb.CoursesServiceClient, courseID string, announcement *cpb.Announcement) {
+	t.Helper()
+
+	_, err := client.AddAnnouncementToCourse(t.Context(),
+		&cpb.AddAnnouncementRequest{CourseID: courseID, Announcement: announcement, Token: "test-token"})
+	require.NoError(t, err)
+}
+
+func TestSearchAllAnnouncements(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "1", AnnouncementTitle: "Exam moved", AnnouncementContent: "The exam moved to Sunday.",
+	})
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "2", AnnouncementTitle: "Homework", AnnouncementContent: "Homework 2 was published.",
+	})
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "3", AnnouncementTitle: "Exam material", AnnouncementContent: "Homework 2 is exam material.",
+	})
+
+	resp, err := client.SearchAllAnnouncements(t.Context(),
+		&cpb.SearchAllAnnouncementsRequest{Query: "exam", PageSize: 1, Token: "test-token"})
+	require.NoError(t, err)
+	require.Len(t, resp.GetMatches(), 1)
+	assert.Equal(t, course.GetCourseID(), resp.GetMatches()[0].GetCourseID())
+	assert.NotEmpty(t, resp.GetNextPageToken())
+
+	resp, err = client.SearchAllAnnouncements(t.Context(), &cpb.SearchAllAnnouncementsRequest{
+		Query: "exam", PageSize: 1, PageToken: resp.GetNextPageToken(), Token: "test-token",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetMatches(), 1)
+
+	resp, err = client.SearchAllAnnouncements(t.Context(),
+		&cpb.SearchAllAnnouncementsRequest{Query: "homework exam", Token: "test-token"})
+	require.NoError(t, err)
+	require.Len(t, resp.GetMatches(), 1)
+	assert.Equal(t, "3", resp.GetMatches()[0].GetAnnouncement().GetAnnouncementID())
+	assert.Empty(t, resp.GetNextPageToken())
+
+	_, err = client.SearchAllAnnouncements(t.Context(),
+		&cpb.SearchAllAnnouncementsRequest{Query: " ", Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSearchAllAnnouncementsFullBody(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	content := strings.Repeat("Syllabus. ", defaultAnnouncementExcerptLength/10+1) + "Plagiarism warning."
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "long", AnnouncementTitle: "Course rules", AnnouncementContent: content,
+	})
+
+	resp, err := client.SearchAllAnnouncements(t.Context(),
+		&cpb.SearchAllAnnouncementsRequest{Query: "rules plagiarism", Token: "test-token"})
+	require.NoError(t, err)
+	require.Len(t, resp.GetMatches(), 1, "terms past the excerpt should be found")
+	assert.Equal(t, "long", resp.GetMatches()[0].GetAnnouncement().GetAnnouncementID())
+}
+
+func TestSearchAllAnnouncementsRequiresAdmin(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{roles: []string{"student"}})
+
+	_, err := client.SearchAllAnnouncements(t.Context(),
+		&cpb.SearchAllAnnouncementsRequest{Query: "exam", Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func enrollStudents(t *testing.T, client cpb.CoursesServiceClient, courseID string, studentIDs ...string) {
+	t.Helper()
+
+	for _, studentID := range studentIDs {
+		_, err := client.AddStudentToCourse(t.Context(),
+			&cpb.AddStudentRequest{CourseID: courseID, StudentID: studentID, Token: "test-token"})
+		require.NoError(t, err)
+	}
+}
+
+func createCourseWithID(t *testing.T, client cpb.CoursesServiceClient, courseID string) {
+	t.Helper()
+
+	course := createTestCourse()
+	course.CourseID = courseID
+	_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+	require.NoError(t, err)
+}
+
+func TestDeduplicateEnrollments(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	mockDB := NewMockDatabase()
+	testServer.db = mockDB
+
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	createCourseWithID(t, client, "lecture")
+	enrollStudents(t, client, "lecture", "student-1", "student-2")
+
+	// Seed the rows legacy versions could write twice.
+	for studentID, rows := range map[string]int{"student-1": 3, "student-2": 2} {
+		enrollment, _ := mockDB.students.get("lecture", studentID)
+		enrollment.rows = rows
+		mockDB.students.set("lecture", studentID, enrollment)
+	}
+
+	found, err := client.FindDuplicateEnrollments(t.Context(), &cpb.FindDuplicateEnrollmentsRequest{Token: "test-token"})
+	require.NoError(t, err)
+	require.Len(t, found.GetDuplicates(), 2)
+	assert.Equal(t, "student-1", found.GetDuplicates()[0].GetStudentID())
+	assert.Equal(t, int64(3), found.GetDuplicates()[0].GetCount())
+
+	removed, err := client.DeduplicateEnrollments(t.Context(), &cpb.DeduplicateEnrollmentsRequest{Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), removed.GetRemovedCount())
+
+	found, err = client.FindDuplicateEnrollments(t.Context(), &cpb.FindDuplicateEnrollmentsRequest{Token: "test-token"})
+	require.NoError(t, err)
+	assert.Empty(t, found.GetDuplicates())
+
+	students, err := client.GetCourseStudents(t.Context(),
+		&cpb.GetCourseStudentsRequest{CourseID: "lecture", Token: "test-token"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"student-1", "student-2"}, students.GetStudentsIDs())
+
+	courses, err := client.GetStudentCourses(t.Context(),
+		&cpb.GetStudentCoursesRequest{StudentID: "student-1", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"lecture"}, courses.GetCoursesIDs())
+}
+
+func TestDeduplicateEnrollmentsRequiresAdmin(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "ta-1", roles: []string{staffRole}})
+
+	_, err := client.FindDuplicateEnrollments(t.Context(), &cpb.FindDuplicateEnrollmentsRequest{Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.DeduplicateEnrollments(t.Context(), &cpb.DeduplicateEnrollmentsRequest{Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetDataShapeReport(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	generatedAt := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+	mockDB := NewMockDatabase()
+	mockDB.now = func() time.Time { return generatedAt }
+	testServer.db = mockDB
+
+	// Course i of 20 has i students, so the median course has 10 and the 95th percentile 19.
+	// Only two courses have announcements, 1 and 3 of them.
+	for students := 1; students <= 20; students++ {
+		course := createTestCourse()
+		course.CourseID = fmt.Sprintf("course-%02d", students)
+		course.Semester = []string{"Spring_2025", "Winter_2025"}[students%2]
+		_, err := mockDB.AddCourse(t.Context(), course)
+		require.NoError(t, err)
+
+		studentIDs := make([]string, 0, students)
+		for student := range students {
+			studentIDs = append(studentIDs, fmt.Sprintf("student-%d", student))
+		}
+
+		_, err = mockDB.AddStudentsToCourse(t.Context(), course.GetCourseID(), studentIDs, false, false)
+		require.NoError(t, err)
+	}
+
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	addAnnouncement(t, client, "course-01", &cpb.Announcement{AnnouncementContent: "Welcome!"})
+
+	for range 3 {
+		addAnnouncement(t, client, "course-02", &cpb.Announcement{AnnouncementContent: "Reminder"})
+	}
+
+	report, err := client.GetDataShapeReport(t.Context(), &cpb.GetDataShapeReportRequest{Token: "test-token"})
+	require.NoError(t, err)
+	assert.True(t, report.GetGeneratedAt().AsTime().Equal(generatedAt))
+
+	semesters := make(map[string]int64)
+	for _, semester := range report.GetCoursesPerSemester() {
+		semesters[semester.GetSemester()] = semester.GetCount()
+	}
+
+	assert.Equal(t, map[string]int64{"Spring_2025": 10, "Winter_2025": 10}, semesters)
+	assert.Equal(t, int64(10), report.GetEnrollmentsPerCourse().GetP50())
+	assert.Equal(t, int64(19), report.GetEnrollmentsPerCourse().GetP95())
+	assert.Equal(t, int64(20), report.GetEnrollmentsPerCourse().GetMax())
+	assert.Equal(t, int64(0), report.GetAnnouncementsPerCourse().GetP50())
+	assert.Equal(t, int64(1), report.GetAnnouncementsPerCourse().GetP95())
+	assert.Equal(t, int64(3), report.GetAnnouncementsPerCourse().GetMax())
+
+	tables := make(map[string]int64)
+	for _, table := range report.GetTableRows() {
+		tables[table.GetTable()] = table.GetRows()
+	}
+
+	assert.Equal(t, map[string]int64{
+		"announcements": 4, "course_staffs": 0, "course_students": 210, "courses": 20,
+	}, tables)
+}
+
+func TestDataShapeMetrics(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	generatedAt := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+	mockDB := NewMockDatabase()
+	mockDB.now = func() time.Time { return generatedAt }
+	testServer.db = mockDB
+
+	assert.Nil(t, metric(t, testServer.CoursesServer, "data_shape"), "no report has been made yet")
+
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	course := createCourse(t, client)
+	enrollStudents(t, client, course.GetCourseID(), "student-1", "student-2")
+
+	testServer.updateDataShape(t.Context())
+
+	gauges, ok := metric(t, testServer.CoursesServer, "data_shape").(map[string]any)
+	require.True(t, ok)
+	assert.InDelta(t, generatedAt.Unix(), gauges["generated_at"], 0)
+	assert.Equal(t, map[string]any{course.GetSemester(): float64(1)}, gauges["courses_per_semester"])
+	assert.InDelta(t, 2, gauges["enrollments_per_course_max"], 0)
+	assert.InDelta(t, 0, gauges["announcements_per_course_max"], 0)
+}
+
+func TestGetDataShapeReportRequiresAdmin(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "ta-1", roles: []string{staffRole}})
+
+	_, err := client.GetDataShapeReport(t.Context(), &cpb.GetDataShapeReportRequest{Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetCourseSnapshot(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	enrollStudents(t, client, course.GetCourseID(), "student-2", "student-1")
+
+	_, err := client.SetStudentStatus(t.Context(), &cpb.SetStudentStatusRequest{
+		CourseID: course.GetCourseID(), StudentID: "student-2", Status: "dropped", Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	_, err = client.AddStaffToCourse(t.Context(),
+		&cpb.AddStaffRequest{CourseID: course.GetCourseID(), StaffID: "staff-1", Token: "test-token"})
+	require.NoError(t, err)
+
+	longContent := strings.Repeat("Syllabus. ", defaultAnnouncementExcerptLength/10+1)
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{AnnouncementContent: longContent})
+
+	quietPeriod := &cpb.QuietPeriod{
+		StartsAt: timestamppb.New(time.Now().Add(time.Hour).Truncate(time.Second)),
+		EndsAt:   timestamppb.New(time.Now().Add(2 * time.Hour).Truncate(time.Second)),
+	}
+	_, err = client.SetQuietPeriods(t.Context(), &cpb.SetQuietPeriodsRequest{
+		CourseID: course.GetCourseID(), Periods: []*cpb.QuietPeriod{quietPeriod}, Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	snapshot, err := client.GetCourseSnapshot(t.Context(),
+		&cpb.GetCourseSnapshotRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	assert.NotNil(t, snapshot.GetTakenAt())
+	assert.Equal(t, course.GetCourseID(), snapshot.GetCourse().GetCourseID())
+
+	students := make(map[string]string)
+	for _, student := range snapshot.GetStudents() {
+		students[student.GetStudentID()] = student.GetStatus()
+	}
+
+	assert.Equal(t, map[string]string{"student-1": "enrolled", "student-2": "dropped"}, students)
+
+	staffIDs := make([]string, 0, len(snapshot.GetStaff()))
+	for _, member := range snapshot.GetStaff() {
+		staffIDs = append(staffIDs, member.GetStaffID())
+	}
+
+	assert.Contains(t, staffIDs, "staff-1")
+	require.Len(t, snapshot.GetAnnouncements(), 1)
+	assert.Equal(t, longContent, snapshot.GetAnnouncements()[0].GetAnnouncementContent(),
+		"the snapshot should hold the full content of split announcements")
+	require.Len(t, snapshot.GetQuietPeriods(), 1)
+	assert.True(t, proto.Equal(quietPeriod, snapshot.GetQuietPeriods()[0]))
+
+	_, err = client.GetCourseSnapshot(t.Context(),
+		&cpb.GetCourseSnapshotRequest{CourseID: "missing-course", Token: "test-token"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetCourseSnapshotRequiresStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+
+	_, err := client.GetCourseSnapshot(t.Context(),
+		&cpb.GetCourseSnapshotRequest{CourseID: "236781", Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// TestGetCourseSnapshotConsistent takes snapshots while a writer enrolls students and announces
+// each of them right after. Every snapshot must see a state the writer passed through: as many
+// announcements as students, or one fewer.
+func TestGetCourseSnapshotConsistent(t *testing.T) {
+	mockDB := NewMockDatabase()
+	_, err := mockDB.AddCourse(t.Context(), createTestCourse())
+	require.NoError(t, err)
+
+	courseID := createTestCourse().GetCourseID()
+	done := make(chan error)
+
+	go func() {
+		defer close(done)
+
+		for student := range 200 {
+			studentID := fmt.Sprintf("student-%d", student)
+			if err := mockDB.AddStudentToCourse(t.Context(), courseID, studentID, false, false); err != nil {
+				done <- err
+
+				return
+			}
+
+			_, err := mockDB.AddAnnouncement(t.Context(), &cpb.AddAnnouncementRequest{
+				CourseID: courseID, Announcement: &cpb.Announcement{AnnouncementContent: studentID + " joined"},
+			}, defaultAnnouncementExcerptLength)
+			if err != nil {
+				done <- err
+
+				return
+			}
+		}
+	}()
+
+	for writing := true; writing; {
+		select {
+		case err, ok := <-done:
+			require.NoError(t, err)
+
+			writing = ok
+		default:
+		}
+
+		snapshot, err := mockDB.GetCourseSnapshot(t.Context(), courseID)
+		require.NoError(t, err)
+
+		students, announcements := len(snapshot.Students), len(snapshot.Announcements)
+		assert.Contains(t, []int{students - 1, students}, announcements,
+			"a snapshot of %d students should have %d or %d announcements", students, students-1, students)
+	}
+}
+
+func TestGetAnnouncementsSince(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	start := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+	now := start
+	mockDB := NewMockDatabase()
+	mockDB.now = func() time.Time { return now }
+	testServer.db = mockDB
+
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	createCourseWithID(t, client, "lecture")
+	createCourseWithID(t, client, "lab")
+
+	for i, courseID := range []string{"lab", "lecture", "lab"} {
+		now = start.Add(time.Duration(i) * time.Minute)
+		addAnnouncement(t, client, courseID, &cpb.Announcement{
+			AnnouncementID: strconv.Itoa(i), AnnouncementContent: "Update " + strconv.Itoa(i),
+		})
+	}
+
+	poll := func(since *timestamppb.Timestamp) *cpb.GetAnnouncementsSinceResponse {
+		resp, err := client.GetAnnouncementsSince(t.Context(),
+			&cpb.GetAnnouncementsSinceRequest{Since: since, PageSize: 2, Token: "test-token"})
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	first := poll(nil)
+	require.Len(t, first.GetAnnouncements(), 2)
+	assert.Equal(t, "lab", first.GetAnnouncements()[0].GetCourseID())
+	assert.Equal(t, "0", first.GetAnnouncements()[0].GetAnnouncement().GetAnnouncementID())
+	assert.Equal(t, "1", first.GetAnnouncements()[1].GetAnnouncement().GetAnnouncementID())
+	assert.Equal(t, start.Add(time.Minute), first.GetNextSince().AsTime())
+
+	second := poll(first.GetNextSince())
+	require.Len(t, second.GetAnnouncements(), 1)
+	assert.Equal(t, "2", second.GetAnnouncements()[0].GetAnnouncement().GetAnnouncementID())
+
+	third := poll(second.GetNextSince())
+	assert.Empty(t, third.GetAnnouncements())
+	assert.Equal(t, second.GetNextSince().AsTime(), third.GetNextSince().AsTime(), "an empty poll keeps the cursor")
+}
+
+func TestGetAnnouncementsSinceSameTime(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	now := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+	mockDB := NewMockDatabase()
+	mockDB.now = func() time.Time { return now }
+	testServer.db = mockDB
+
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	createCourseWithID(t, client, "lecture")
+	createCourseWithID(t, client, "lab")
+
+	// A batch posted at once holds more announcements than a page.
+	courseIDs := []string{"lab", "lecture", "lab", "lecture", "lab"}
+	posted := make([]string, 0, len(courseIDs))
+
+	for i, courseID := range courseIDs {
+		announcementID := strconv.Itoa(i)
+		addAnnouncement(t, client, courseID, &cpb.Announcement{
+			AnnouncementID: announcementID, AnnouncementContent: "Update " + announcementID,
+		})
+
+		posted = append(posted, courseID+"/"+announcementID)
+	}
+
+	var polled []string
+
+	req := &cpb.GetAnnouncementsSinceRequest{PageSize: 2, Token: "test-token"}
+
+	for range posted {
+		resp, err := client.GetAnnouncementsSince(t.Context(), req)
+		require.NoError(t, err)
+
+		if len(resp.GetAnnouncements()) == 0 {
+			break
+		}
+
+		for _, match := range resp.GetAnnouncements() {
+			polled = append(polled, match.GetCourseID()+"/"+match.GetAnnouncement().GetAnnouncementID())
+		}
+
+		req.After = resp.GetNextCursor()
+	}
+
+	assert.ElementsMatch(t, posted, polled, "every announcement should be polled exactly once")
+}
+
+func TestGetAnnouncementsSinceRequiresAdmin(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "staff-1", roles: []string{"staff"}})
+
+	_, err := client.GetAnnouncementsSince(t.Context(), &cpb.GetAnnouncementsSinceRequest{Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetEnrollmentDifference(t *testing.T) {
+	tests := []struct {
+		name     string
+		studentA []string
+		studentB []string
+		onlyInA  []string
+		onlyInB  []string
+		inBoth   []string
+	}{
+		{"Identical", []string{"s1", "s2"}, []string{"s2", "s1"}, []string{}, []string{}, []string{"s1", "s2"}},
+		{"Disjoint", []string{"s1", "s2"}, []string{"s3"}, []string{"s1", "s2"}, []string{"s3"}, []string{}},
+		{"Overlapping", []string{"s1", "s2"}, []string{"s2", "s3"}, []string{"s1"}, []string{"s3"}, []string{"s2"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := setupClient(t)
+			createCourseWithID(t, client, "lecture")
+			createCourseWithID(t, client, "lab")
+			enrollStudents(t, client, "lecture", test.studentA...)
+			enrollStudents(t, client, "lab", test.studentB...)
+
+			resp, err := client.GetEnrollmentDifference(t.Context(),
+				&cpb.GetEnrollmentDifferenceRequest{CourseIDA: "lecture", CourseIDB: "lab", Token: "test-token"})
+			require.NoError(t, err)
+			assert.Equal(t, test.onlyInA, append([]string{}, resp.GetOnlyInA()...))
+			assert.Equal(t, test.onlyInB, append([]string{}, resp.GetOnlyInB()...))
+			assert.Equal(t, test.inBoth, append([]string{}, resp.GetInBoth()...))
+			assert.Empty(t, resp.GetNextPageToken())
+		})
+	}
+}
+
+// TestListPageSizeClamped calls every list RPC with an oversized page and expects the maximum.
+func TestListPageSizeClamped(t *testing.T) {
+	const oversized = 1_000_000
+
+	tests := []struct {
+		name string
+		list func(ctx context.Context, client cpb.CoursesServiceClient) (int32, error)
+	}{
+		{"GetCourseAnnouncementsGrouped", func(ctx context.Context, client cpb.CoursesServiceClient) (int32, error) {
+			resp, err := client.GetCourseAnnouncementsGrouped(ctx, &cpb.GetCourseAnnouncementsGroupedRequest{
+				CourseID: "lecture", PageSize: oversized, Token: "test-token",
+			})
+
+			return resp.GetPageSize(), err
+		}},
+		{"SearchAllAnnouncements", func(ctx context.Context, client cpb.CoursesServiceClient) (int32, error) {
+			resp, err := client.SearchAllAnnouncements(ctx, &cpb.SearchAllAnnouncementsRequest{
+				Query: "exam", PageSize: oversized, Token: "test-token",
+			})
+
+			return resp.GetPageSize(), err
+		}},
+		{"GetAnnouncementsSince", func(ctx context.Context, client cpb.CoursesServiceClient) (int32, error) {
+			resp, err := client.GetAnnouncementsSince(ctx, &cpb.GetAnnouncementsSinceRequest{
+				PageSize: oversized, Token: "test-token",
+			})
+
+			return resp.GetPageSize(), err
+		}},
+		{"GetEnrollmentDifference", func(ctx context.Context, client cpb.CoursesServiceClient) (int32, error) {
+			resp, err := client.GetEnrollmentDifference(ctx, &cpb.GetEnrollmentDifferenceRequest{
+				CourseIDA: "lecture", CourseIDB: "lab", PageSize: oversized, Token: "test-token",
+			})
+
+			return resp.GetPageSize(), err
+		}},
+		{"GetCourseStaff", func(ctx context.Context, client cpb.CoursesServiceClient) (int32, error) {
+			resp, err := client.GetCourseStaff(ctx, &cpb.GetCourseStaffRequest{
+				CourseID: "lecture", PageSize: oversized, Token: "test-token",
+			})
+
+			return resp.GetPageSize(), err
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := setupClient(t)
+			createCourseWithID(t, client, "lecture")
+			createCourseWithID(t, client, "lab")
+
+			pageSize, err := test.list(t.Context(), client)
+			require.NoError(t, err)
+			assert.Equal(t, int32(maxPageSize), pageSize)
+		})
+	}
+}
+
+func TestGetCourseStaffPaged(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	for _, staffID := range []string{"ta-3", "ta-1", "ta-2"} {
+		_, err := client.AddStaffToCourse(t.Context(),
+			&cpb.AddStaffRequest{CourseID: course.GetCourseID(), StaffID: staffID, Token: "test-token"})
+		require.NoError(t, err)
+	}
+
+	var staffIDs []string
+
+	req := &cpb.GetCourseStaffRequest{CourseID: course.GetCourseID(), PageSize: 2, Token: "test-token"}
+
+	for {
+		resp, err := client.GetCourseStaff(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), resp.GetTotalCount())
+
+		staffIDs = append(staffIDs, resp.GetStaffIDs()...)
+
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+
+		req.PageToken = resp.GetNextPageToken()
+	}
+
+	assert.Equal(t, []string{"ta-1", "ta-2", "ta-3"}, staffIDs)
+
+	_, err := client.GetCourseStaff(t.Context(),
+		&cpb.GetCourseStaffRequest{CourseID: "non-existent-id", Token: "test-token"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// getStaffContacts starts a server whose staff directory is served by handler and returns
+// GetCourseStaff with contacts for a course with staff "ta-1" and "ta-2".
+func getStaffContacts(t *testing.T, handler http.HandlerFunc) *cpb.GetCourseStaffResponse {
+	t.Helper()
+
+	directory := httptest.NewServer(handler)
+	t.Cleanup(directory.Close)
+	t.Setenv(staffDirectoryURLEnv, directory.URL)
+
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	for _, staffID := range []string{"ta-1", "ta-2"} {
+		_, err := client.AddStaffToCourse(t.Context(),
+			&cpb.AddStaffRequest{CourseID: course.GetCourseID(), StaffID: staffID, Token: "test-token"})
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetCourseStaff(t.Context(),
+		&cpb.GetCourseStaffRequest{CourseID: course.GetCourseID(), IncludeContacts: true, Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ta-1", "ta-2"}, resp.GetStaffIDs())
+
+	return resp
+}
+
+func TestGetCourseStaffContacts(t *testing.T) {
+	resp := getStaffContacts(t, func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != "/staff/ta-1" {
+			http.NotFound(writer, request)
+
+			return
+		}
+
+		_, _ = writer.Write([]byte(`{"name": "Dana Levi", "email": "dana@example.com"}`))
+	})
+
+	assert.False(t, resp.GetContactsIncomplete(), "staff missing from the directory are not an outage")
+	require.Len(t, resp.GetContacts(), 2)
+	assert.Equal(t, "Dana Levi", resp.GetContacts()[0].GetName())
+	assert.Equal(t, "dana@example.com", resp.GetContacts()[0].GetEmail())
+	assert.Equal(t, "ta-2", resp.GetContacts()[1].GetStaffID())
+	assert.Empty(t, resp.GetContacts()[1].GetEmail())
+}
+
+func TestGetCourseStaffDirectoryDown(t *testing.T) {
+	var lookups atomic.Int32
+
+	resp := getStaffContacts(t, func(w http.ResponseWriter, _ *http.Request) {
+		lookups.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	assert.True(t, resp.GetContactsIncomplete())
+	require.Len(t, resp.GetContacts(), 2)
+	assert.Empty(t, resp.GetContacts()[0].GetName())
+	assert.Equal(t, int32(1), lookups.Load(), "lookups should stop once the directory fails")
+}
+
+func TestPaginationFromEnv(t *testing.T) {
+	tests := []struct {
+		name        string
+		defaultSize string
+		maxSize     string
+		want        Pagination
+		wantErr     bool
+	}{
+		{"Unset", "", "", Pagination{DefaultSize: defaultPageSize, MaxSize: maxPageSize}, false},
+		{"Configured", "10", "20", Pagination{DefaultSize: 10, MaxSize: 20}, false},
+		{"DefaultAboveMax", "", "20", Pagination{DefaultSize: 20, MaxSize: 20}, false},
+		{"Zero", "0", "", Pagination{}, true},
+		{"Malformed", "", "many", Pagination{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(defaultPageSizeEnv, test.defaultSize)
+			t.Setenv(maxPageSizeEnv, test.maxSize)
+
+			pagination, err := paginationFromEnv()
+			if test.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPageLimit)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, pagination)
+		})
+	}
+}
+
+func TestGetEnrollmentDifferencePermissions(t *testing.T) {
+	claims := RoleClaims{subject: "staff-1", roles: []string{"staff"}}
+	client := setupClientWithClaims(t, claims)
+	createCourseWithID(t, client, "lecture")
+	createCourseWithID(t, client, "lab")
+
+	// The caller became staff of both courses by creating them.
+	for _, courseID := range []string{"lecture", "lab"} {
+		_, err := client.RemoveStaffFromCourse(t.Context(),
+			&cpb.RemoveStaffRequest{CourseID: courseID, StaffID: "staff-1", Token: "test-token"})
+		require.NoError(t, err)
+	}
+
+	req := &cpb.GetEnrollmentDifferenceRequest{CourseIDA: "lecture", CourseIDB: "lab", Token: "test-token"}
+	_, err := client.GetEnrollmentDifference(t.Context(), req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "caller staffs neither course")
+
+	_, err = client.AddStaffToCourse(t.Context(),
+		&cpb.AddStaffRequest{CourseID: "lab", StaffID: "staff-1", Token: "test-token"})
+	require.NoError(t, err)
+
+	_, err = client.GetEnrollmentDifference(t.Context(), req)
+	assert.NoError(t, err, "caller staffs one of the courses")
+}
+
+func TestRequestValidation(t *testing.T) {
+	client := setupClient(t)
+	course := createTestCourse()
+	course.CourseID = ""
+	course.Semester = "Fall 25"
+
+	_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	var fields []string
+
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+		}
+	}
+
+	assert.ElementsMatch(t, []string{"Course.CourseID", "Course.Semester"}, fields)
+
+	_, err = client.GetCourse(t.Context(), &cpb.GetCourseRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQuietPeriodsWithClock(t *testing.T) {
+	mockDB := NewMockDatabase()
+	start := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
+	now := start.Add(-time.Minute)
+	mockDB.now = func() time.Time { return now }
+
+	_, err := mockDB.AddCourse(t.Context(), createTestCourse())
+	require.NoError(t, err)
+
+	courseID := createTestCourse().GetCourseID()
+	examWeek := QuietPeriod{StartsAt: start, EndsAt: start.Add(7 * 24 * time.Hour)}
+	gradingDays := QuietPeriod{StartsAt: examWeek.EndsAt.Add(-time.Hour), EndsAt: examWeek.EndsAt.Add(48 * time.Hour)}
+	require.NoError(t, mockDB.SetQuietPeriods(t.Context(), courseID, []QuietPeriod{examWeek, gradingDays}))
+
+	post := func(urgent bool) error {
+		_, err := mockDB.AddAnnouncement(t.Context(), &cpb.AddAnnouncementRequest{
+			CourseID:     courseID,
+			Announcement: &cpb.Announcement{AnnouncementContent: "Room change."},
+			Urgent:       urgent,
+		}, defaultAnnouncementExcerptLength)
+
+		return err
+	}
+
+	require.NoError(t, post(false), "posting should be allowed before the quiet period starts")
+
+	now = start
+	err = post(false)
+	require.ErrorIs(t, err, ErrQuietPeriod, "posting should be paused exactly at the start")
+	assert.Contains(t, err.Error(), gradingDays.EndsAt.Format(time.RFC3339),
+		"the next allowed time should skip over overlapping periods")
+
+	require.NoError(t, post(true), "urgent announcements should bypass the quiet period")
+
+	now = gradingDays.EndsAt
+
+	require.NoError(t, post(false), "posting should be allowed again exactly at the end")
+}
+
+func TestWeeklyQuietHoursWithClock(t *testing.T) {
+	mockDB := NewMockDatabase()
+	// Quiet every Friday from 14:00 to Saturday 20:00, starting June 13.
+	start := time.Date(2025, time.June, 13, 14, 0, 0, 0, time.UTC)
+	weekend := QuietPeriod{StartsAt: start, EndsAt: start.Add(30 * time.Hour), Weekly: true}
+	now := start
+	mockDB.now = func() time.Time { return now }
+
+	_, err := mockDB.AddCourse(t.Context(), createTestCourse())
+	require.NoError(t, err)
+
+	courseID := createTestCourse().GetCourseID()
+	require.NoError(t, mockDB.SetQuietPeriods(t.Context(), courseID, []QuietPeriod{weekend}))
+
+	post := func(urgent bool) error {
+		_, err := mockDB.AddAnnouncement(t.Context(), &cpb.AddAnnouncementRequest{
+			CourseID:     courseID,
+			Announcement: &cpb.Announcement{AnnouncementContent: "Room change."},
+			Urgent:       urgent,
+		}, defaultAnnouncementExcerptLength)
+
+		return err
+	}
+
+	tests := []struct {
+		name      string
+		now       time.Time
+		allowedAt time.Time
+	}{
+		{name: "before the first occurrence", now: start.Add(-7 * 24 * time.Hour)},
+		{name: "just before a later occurrence", now: start.Add(3*7*24*time.Hour - time.Second)},
+		{
+			name:      "at the start of a later occurrence",
+			now:       start.Add(3 * 7 * 24 * time.Hour),
+			allowedAt: weekend.EndsAt.Add(3 * 7 * 24 * time.Hour),
+		},
+		{
+			name:      "just before the end of an occurrence",
+			now:       weekend.EndsAt.Add(7*24*time.Hour - time.Second),
+			allowedAt: weekend.EndsAt.Add(7 * 24 * time.Hour),
+		},
+		{name: "at the end of an occurrence", now: weekend.EndsAt.Add(7 * 24 * time.Hour)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			now = test.now
+
+			err := post(false)
+			if test.allowedAt.IsZero() {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, ErrQuietPeriod)
+			assert.Contains(t, err.Error(), test.allowedAt.Format(time.RFC3339))
+			require.NoError(t, post(true), "urgent announcements should bypass quiet hours")
+		})
+	}
+}
+
+func TestRepostDuringQuietPeriod(t *testing.T) {
+	mockDB := NewMockDatabase()
+	now := time.Date(2025, time.March, 3, 10, 0, 0, 0, time.UTC)
+	mockDB.now = func() time.Time { return now }
+
+	_, err := mockDB.AddCourse(t.Context(), createTestCourse())
+	require.NoError(t, err)
+
+	courseID := createTestCourse().GetCourseID()
+	_, err = mockDB.AddAnnouncement(t.Context(), &cpb.AddAnnouncementRequest{
+		CourseID: courseID,
+		Announcement: &cpb.Announcement{
+			AnnouncementID: "office-hours", AnnouncementContent: "Office hours on Monday.",
+			Recurrence: cpb.AnnouncementRecurrence_WEEKLY,
+		},
+	}, defaultAnnouncementExcerptLength)
+	require.NoError(t, err)
+
+	due := now.Add(7 * 24 * time.Hour)
+	examDay := QuietPeriod{StartsAt: due.Add(-time.Hour), EndsAt: due.Add(2 * time.Hour)}
+	require.NoError(t, mockDB.SetQuietPeriods(t.Context(), courseID, []QuietPeriod{examDay}))
+
+	reposted, err := mockDB.RepostDueAnnouncements(t.Context(), due)
+	require.NoError(t, err)
+	assert.Zero(t, reposted, "a repost due during a quiet period should be held")
+
+	reposted, err = mockDB.RepostDueAnnouncements(t.Context(), examDay.EndsAt)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reposted, "a held repost should be posted once the quiet period ends")
+
+	announcements, err := mockDB.GetAnnouncements(t.Context(), courseID,
+		AnnouncementFilter{IncludeStaffOnly: true}, Page{})
+	require.NoError(t, err)
+	require.Len(t, announcements, 2)
+	assert.Equal(t, examDay.EndsAt, announcements[0].CreatedAt)
+	assert.Equal(t, due.Add(7*24*time.Hour), announcements[1].NextPostAt, "the schedule should be kept")
+}
+
+func TestSetQuietPeriods(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	_, err := client.SetQuietPeriods(t.Context(), &cpb.SetQuietPeriodsRequest{
+		CourseID: course.GetCourseID(),
+		Periods: []*cpb.QuietPeriod{{
+			StartsAt: timestamppb.New(time.Now().Add(time.Hour)),
+			EndsAt:   timestamppb.New(time.Now()),
+		}},
+		Token: "test-token",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.SetQuietPeriods(t.Context(), &cpb.SetQuietPeriodsRequest{
+		CourseID: course.GetCourseID(),
+		Periods: []*cpb.QuietPeriod{{
+			StartsAt: timestamppb.New(time.Now()),
+			EndsAt:   timestamppb.New(time.Now().Add(7 * 24 * time.Hour)),
+			Weekly:   true,
+		}},
+		Token: "test-token",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "weekly quiet hours must leave part of the week open")
+
+	_, err = client.SetQuietPeriods(t.Context(), &cpb.SetQuietPeriodsRequest{
+		CourseID: course.GetCourseID(),
+		Periods: []*cpb.QuietPeriod{{
+			StartsAt: timestamppb.New(time.Now().Add(-time.Hour)),
+			EndsAt:   timestamppb.New(time.Now().Add(time.Hour)),
+		}},
+		Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	announcement := &cpb.Announcement{AnnouncementID: "1", AnnouncementContent: "Exam tomorrow."}
+	_, err = client.AddAnnouncementToCourse(t.Context(), &cpb.AddAnnouncementRequest{
+		CourseID: course.GetCourseID(), Announcement: announcement, Token: "test-token",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = client.AddAnnouncementToCourse(t.Context(), &cpb.AddAnnouncementRequest{
+		CourseID: course.GetCourseID(), Announcement: announcement, Urgent: true, Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	_, err = client.SetQuietPeriods(t.Context(),
+		&cpb.SetQuietPeriodsRequest{CourseID: "missing-course", Token: "test-token"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSetQuietPeriodsRequiresStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+	course := createCourse(t, client)
+
+	_, err := client.SetQuietPeriods(t.Context(), &cpb.SetQuietPeriodsRequest{
+		CourseID: course.GetCourseID(),
+		Periods: []*cpb.QuietPeriod{{
+			StartsAt: timestamppb.New(time.Now()),
+			EndsAt:   timestamppb.New(time.Now().Add(time.Hour)),
+		}},
+		Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestCourseAPIKeyScopes(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	createCourseWithID(t, client, "other-course")
+
+	_, err := client.CreateCourseAPIKey(t.Context(), &cpb.CreateCourseAPIKeyRequest{
+		CourseID: course.GetCourseID(), Scopes: []string{"DeleteCourse"}, Token: "test-token",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "keys should not be scoped to course-wide RPCs")
+
+	created, err := client.CreateCourseAPIKey(t.Context(), &cpb.CreateCourseAPIKeyRequest{
+		CourseID: course.GetCourseID(), Scopes: []string{"AddAnnouncementToCourse"}, Token: "test-token",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.GetSecret())
+
+	grader := metadata.AppendToOutgoingContext(t.Context(), apiKeyMetadata, created.GetSecret())
+	announcement := &cpb.Announcement{AnnouncementID: "1", AnnouncementContent: "Grades for HW1 are out."}
+
+	_, err = client.AddAnnouncementToCourse(grader,
+		&cpb.AddAnnouncementRequest{CourseID: course.GetCourseID(), Announcement: announcement})
+	require.NoError(t, err)
+
+	_, err = client.GetCourse(grader, &cpb.GetCourseRequest{CourseID: course.GetCourseID()})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "unscoped RPCs should be denied")
+
+	_, err = client.AddAnnouncementToCourse(grader,
+		&cpb.AddAnnouncementRequest{CourseID: "other-course", Announcement: announcement})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "keys should not grant cross-course access")
+
+	forged := metadata.AppendToOutgoingContext(t.Context(), apiKeyMetadata, created.GetSecret()+"x")
+	_, err = client.AddAnnouncementToCourse(forged,
+		&cpb.AddAnnouncementRequest{CourseID: course.GetCourseID(), Announcement: announcement})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	listed, err := client.ListCourseAPIKeys(t.Context(),
+		&cpb.ListCourseAPIKeysRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	require.Len(t, listed.GetKeys(), 1)
+	assert.Equal(t, created.GetKey().GetKeyID(), listed.GetKeys()[0].GetKeyID())
+
+	_, err = client.RevokeCourseAPIKey(t.Context(), &cpb.RevokeCourseAPIKeyRequest{
+		CourseID: course.GetCourseID(), KeyID: created.GetKey().GetKeyID(), Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	_, err = client.AddAnnouncementToCourse(grader,
+		&cpb.AddAnnouncementRequest{CourseID: course.GetCourseID(), Announcement: announcement})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "revoked keys should be rejected immediately")
+}
+
+func TestCourseAPIKeyExpiresWithClock(t *testing.T) {
+	mockDB := NewMockDatabase()
+	now := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+	mockDB.now = func() time.Time { return now }
+
+	_, err := mockDB.AddCourse(t.Context(), createTestCourse())
+	require.NoError(t, err)
+
+	key := &CourseAPIKey{
+		KeyID:     "grader",
+		CourseID:  createTestCourse().GetCourseID(),
+		Scopes:    []string{"AddAnnouncementToCourse"},
+		ExpiresAt: now.Add(time.Hour),
+	}
+	require.NoError(t, mockDB.AddAPIKey(t.Context(), key))
+
+	_, err = mockDB.GetAPIKey(t.Context(), "grader")
+	require.NoError(t, err)
+
+	now = key.ExpiresAt
+	_, err = mockDB.GetAPIKey(t.Context(), "grader")
+	require.ErrorIs(t, err, ErrAPIKeyNotFound, "keys should expire exactly at expiresAt")
+}
+
+// tokenVerifier verifies the tokens it holds the claims of and rejects any other token as expired.
+type tokenVerifier struct {
+	ms.BaseServiceServer
+	claims map[string]ms.Claims
+}
+
+// VerifyToken returns the claims of a known token.
+func (v tokenVerifier) VerifyToken(_ context.Context, token string) (ms.Claims, error) {
+	if claims, ok := v.claims[token]; ok {
+		return claims, nil
+	}
+
+	return nil, fmt.Errorf("failed to verify token: %w", status.Error(codes.Unauthenticated, "token is expired"))
+}
+
+// testToken returns an unsigned JWT issued to subject.
+func testToken(subject string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+
+	return encode([]byte(`{"alg":"none"}`)) + "." + encode([]byte(`{"sub":"`+subject+`"}`)) + ".signature"
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(nil)
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	admin := testToken("admin-1")
+	testServer.BaseServiceServer = tokenVerifier{claims: map[string]ms.Claims{
+		admin: RoleClaims{roles: []string{adminRole}},
+	}}
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	bearer := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(t.Context(), authorizationMetadata, bearerScheme+token)
+	}
+
+	t.Run("MetadataToken", func(t *testing.T) {
+		_, err := client.CreateCourse(bearer(admin), &cpb.CreateCourseRequest{Course: createTestCourse()})
+		require.NoError(t, err)
+	})
+
+	t.Run("RequestToken", func(t *testing.T) {
+		_, err := client.GetCourse(t.Context(),
+			&cpb.GetCourseRequest{CourseID: createTestCourse().GetCourseID(), Token: admin})
+		require.NoError(t, err)
+	})
+
+	t.Run("MissingToken", func(t *testing.T) {
+		_, err := client.GetCourse(t.Context(), &cpb.GetCourseRequest{CourseID: createTestCourse().GetCourseID()})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("ExpiredToken", func(t *testing.T) {
+		// The metadata takes precedence over a valid token in the request.
+		_, err := client.GetCourse(bearer(testToken("admin-1")+"expired"),
+			&cpb.GetCourseRequest{CourseID: createTestCourse().GetCourseID(), Token: admin})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestAddStudentToCourseEnrollmentLimit(t *testing.T) {
+	t.Setenv(maxSemesterCoursesEnv, "2")
+
+	client := setupClient(t)
+
+	for _, courseID := range []string{"course-1", "course-2", "course-3"} {
+		createCourseWithID(t, client, courseID)
+	}
+
+	enrollStudents(t, client, "course-1", "student-1")
+	enrollStudents(t, client, "course-2", "student-1")
+	// Re-enrolling in a course the student already takes is not counted against the limit.
+	enrollStudents(t, client, "course-2", "student-1")
+
+	_, err := client.AddStudentToCourse(t.Context(),
+		&cpb.AddStudentRequest{CourseID: "course-3", StudentID: "student-1", Token: "test-token"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "enrolling beyond the limit should be rejected")
+
+	_, err = client.AddStudentToCourse(t.Context(), &cpb.AddStudentRequest{
+		CourseID: "course-3", StudentID: "student-1", OverrideLimit: true, Token: "test-token",
+	})
+	require.NoError(t, err, "staff should be able to override the limit")
+}
+
+func TestAddStudentToCourseEnrollmentLimitSkipsDroppedCourses(t *testing.T) {
+	t.Setenv(maxSemesterCoursesEnv, "1")
+
+	client := setupClient(t)
+	createCourseWithID(t, client, "course-1")
+	createCourseWithID(t, client, "course-2")
+	enrollStudents(t, client, "course-1", "student-1")
+	dropStudent(t, client, "course-1", "student-1")
+
+	_, err := client.AddStudentToCourse(t.Context(),
+		&cpb.AddStudentRequest{CourseID: "course-2", StudentID: "student-1", Token: "test-token"})
+	require.NoError(t, err, "a dropped course should not count against the limit")
+}
+
+// dropStudent marks a student enrolled in a course as having dropped it.
+func dropStudent(t *testing.T, client cpb.CoursesServiceClient, courseID, studentID string) {
+	t.Helper()
+
+	_, err := client.SetStudentStatus(t.Context(), &cpb.SetStudentStatusRequest{
+		CourseID: courseID, StudentID: studentID, Status: studentDropped, Token: "test-token",
+	})
+	require.NoError(t, err)
+}
+
+func TestAddStudentToCourseLimitOverrideRequiresStaff(t *testing.T) {
+	t.Setenv(maxSemesterCoursesEnv, "1")
+
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+	createCourseWithID(t, client, "course-1")
+
+	_, err := client.AddStudentToCourse(t.Context(), &cpb.AddStudentRequest{
+		CourseID: "course-1", StudentID: "student-1", OverrideLimit: true, Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestBatchAddStudents(t *testing.T) {
+	t.Setenv(maxSemesterCoursesEnv, "1")
+
+	client := setupClient(t)
+	createCourseWithID(t, client, "course-1")
+	createCourseWithID(t, client, "course-2")
+	enrollStudents(t, client, "course-1", "student-1")
+	enrollStudents(t, client, "course-2", "student-busy")
+
+	_, err := client.AddStaffToCourse(t.Context(),
+		&cpb.AddStaffRequest{CourseID: "course-1", StaffID: "ta-1", Token: "test-token"})
+	require.NoError(t, err)
+
+	resp, err := client.BatchAddStudents(t.Context(), &cpb.BatchAddStudentsRequest{
+		CourseID:   "course-1",
+		StudentIDs: []string{"student-2", "student-1", "ta-1", "student-busy", "student-3", "student-2"},
+		Token:      "test-token",
+	})
+	require.NoError(t, err)
+
+	outcomes := make([]cpb.BatchEnrollmentOutcome, 0, len(resp.GetResults()))
+	for _, result := range resp.GetResults() {
+		outcomes = append(outcomes, result.GetOutcome())
+	}
+
+	assert.Equal(t, []cpb.BatchEnrollmentOutcome{
+		cpb.BatchEnrollmentOutcome_STUDENT_ADDED, cpb.BatchEnrollmentOutcome_ALREADY_IN_COURSE,
+		cpb.BatchEnrollmentOutcome_STAFF_OF_COURSE, cpb.BatchEnrollmentOutcome_SEMESTER_LIMIT_REACHED,
+		cpb.BatchEnrollmentOutcome_STUDENT_ADDED, cpb.BatchEnrollmentOutcome_ALREADY_IN_COURSE,
+	}, outcomes, "every student should be reported in the order requested")
+	assert.Equal(t, int64(2), resp.GetAddedCount())
+	assert.Equal(t, int64(4), resp.GetSkippedCount())
+
+	students, err := client.GetCourseStudents(t.Context(),
+		&cpb.GetCourseStudentsRequest{CourseID: "course-1", Token: "test-token"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"student-1", "student-2", "student-3"}, students.GetStudentsIDs())
+
+	resp, err = client.BatchAddStudents(t.Context(), &cpb.BatchAddStudentsRequest{
+		CourseID: "course-1", StudentIDs: []string{"student-busy", "ta-1"}, OverrideLimit: true,
+		AllowConflictingRole: true, Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.GetAddedCount(), "staff should be able to override both checks")
+}
+
+func TestBatchAddStudentsEnrollmentChecks(t *testing.T) {
+	t.Setenv(maxSemesterLoadEnv, "10")
+
+	client := setupClient(t)
+
+	course := createTestCourse()
+	course.CourseID, course.Capacity, course.Credits = "seminar", 2, 4
+	_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+	require.NoError(t, err)
+
+	heavy := createTestCourse()
+	heavy.CourseID, heavy.Credits = "heavy", 8
+	_, err = client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: heavy, Token: "test-token"})
+	require.NoError(t, err)
+	enrollStudents(t, client, "heavy", "student-loaded")
+	enrollStudents(t, client, "seminar", "student-1")
+
+	batch := func(override bool, studentIDs ...string) []cpb.BatchEnrollmentOutcome {
+		resp, err := client.BatchAddStudents(t.Context(), &cpb.BatchAddStudentsRequest{
+			CourseID: "seminar", StudentIDs: studentIDs, OverrideLimit: override, Token: "test-token",
+		})
+		require.NoError(t, err)
+
+		outcomes := make([]cpb.BatchEnrollmentOutcome, 0, len(resp.GetResults()))
+		for _, result := range resp.GetResults() {
+			outcomes = append(outcomes, result.GetOutcome())
+		}
+
+		return outcomes
+	}
+
+	assert.Equal(t, []cpb.BatchEnrollmentOutcome{
+		cpb.BatchEnrollmentOutcome_CREDIT_LIMIT_REACHED, cpb.BatchEnrollmentOutcome_STUDENT_ADDED,
+		cpb.BatchEnrollmentOutcome_COURSE_FULL,
+	}, batch(false, "student-loaded", "student-2", "student-3"), "the batch should not overfill the course")
+
+	_, err = client.SetEnrollmentWindow(t.Context(), &cpb.SetEnrollmentWindowRequest{
+		CourseID: "seminar", ClosesAt: timestamppb.New(time.Now().Add(-time.Hour)), Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []cpb.BatchEnrollmentOutcome{cpb.BatchEnrollmentOutcome_ENROLLMENT_CLOSED},
+		batch(false, "student-4"))
+	assert.Equal(t, []cpb.BatchEnrollmentOutcome{
+		cpb.BatchEnrollmentOutcome_STUDENT_ADDED, cpb.BatchEnrollmentOutcome_STUDENT_ADDED,
+	}, batch(true, "student-loaded", "student-4"), "overrideLimit should lift the window, capacity and limits")
+}
+
+func TestAddStudentToCourseLastPlace(t *testing.T) {
+	mockDB := NewMockDatabase()
+	course := createTestCourse()
+	course.Capacity = 1
+	_, err := mockDB.AddCourse(t.Context(), course)
+	require.NoError(t, err)
+
+	const students = 10
+
+	var (
+		wait     sync.WaitGroup
+		enrolled atomic.Int32
+	)
+
+	for student := range students {
+		wait.Add(1)
+
+		go func() {
+			defer wait.Done()
+
+			studentID := "student-" + strconv.Itoa(student)
+
+			err := mockDB.AddStudentToCourse(t.Context(), course.GetCourseID(), studentID, false, false)
+			if err == nil {
+				enrolled.Add(1)
+			} else {
+				assert.ErrorIs(t, err, ErrCapacityExceeded)
+			}
+		}()
+	}
+
+	wait.Wait()
+	assert.Equal(t, int32(1), enrolled.Load(), "only one student should take the last place")
+
+	require.NoError(t, mockDB.AddStudentToCourse(t.Context(), course.GetCourseID(), "student-late", false, true),
+		"staff should be able to enroll past the capacity")
+}
+
+func TestBatchAddStudentsRequiresStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+	createCourseWithID(t, client, "course-1")
+
+	_, err := client.BatchAddStudents(t.Context(), &cpb.BatchAddStudentsRequest{
+		CourseID: "course-1", StudentIDs: []string{"student-1"}, Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestConflictingCourseRoles(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	enrollStudents(t, client, course.GetCourseID(), "ta-1")
+
+	_, err := client.AddStaffToCourse(t.Context(),
+		&cpb.AddStaffRequest{CourseID: course.GetCourseID(), StaffID: "ta-1", Token: "test-token"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "a student should not become staff")
+
+	_, err = client.AddStaffToCourse(t.Context(), &cpb.AddStaffRequest{
+		CourseID: course.GetCourseID(), StaffID: "ta-1", AllowConflictingRole: true, Token: "test-token",
+	})
+	require.NoError(t, err, "staff should be able to add an enrolled TA")
+
+	_, err = client.AddStaffToCourse(t.Context(),
+		&cpb.AddStaffRequest{CourseID: course.GetCourseID(), StaffID: "lecturer-1", Token: "test-token"})
+	require.NoError(t, err)
+
+	_, err = client.AddStudentToCourse(t.Context(),
+		&cpb.AddStudentRequest{CourseID: course.GetCourseID(), StudentID: "lecturer-1", Token: "test-token"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "staff should not be enrolled")
+
+	_, err = client.AddStudentToCourse(t.Context(), &cpb.AddStudentRequest{
+		CourseID: course.GetCourseID(), StudentID: "lecturer-1", AllowConflictingRole: true, Token: "test-token",
+	})
+	require.NoError(t, err)
+}
+
+func TestConflictingCourseRolesOverrideRequiresStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+	course := createCourse(t, client)
+
+	_, err := client.AddStudentToCourse(t.Context(), &cpb.AddStudentRequest{
+		CourseID: course.GetCourseID(), StudentID: "student-1", AllowConflictingRole: true, Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestConflictingCourseRolesNotEnforced(t *testing.T) {
+	t.Setenv(roleSeparationEnv, "false")
+
+	client := setupClient(t)
+	course := createCourse(t, client)
+	enrollStudents(t, client, course.GetCourseID(), "ta-1")
+
+	_, err := client.AddStaffToCourse(t.Context(),
+		&cpb.AddStaffRequest{CourseID: course.GetCourseID(), StaffID: "ta-1", Token: "test-token"})
+	require.NoError(t, err)
+}
+
+func TestBatchRemoveAnnouncements(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	for _, announcementID := range []string{"1", "2", "3"} {
+		addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+			AnnouncementID: announcementID, AnnouncementContent: "Flagged post " + announcementID,
+		})
+	}
+
+	resp, err := client.BatchRemoveAnnouncements(t.Context(), &cpb.BatchRemoveAnnouncementsRequest{
+		CourseID: course.GetCourseID(), AnnouncementIDs: []string{"1", "3", "missing"}, Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.GetRemovedCount(), "unknown IDs should not be counted")
+
+	result := resp.GetResult()
+	assert.Equal(t, int64(2), result.GetSucceededCount())
+	assert.Equal(t, int64(1), result.GetFailedCount())
+	require.Len(t, result.GetItems(), 3)
+	assert.Equal(t, "1", result.GetItems()[0].GetId())
+	assert.True(t, result.GetItems()[0].GetOk())
+	assert.True(t, result.GetItems()[1].GetOk())
+	assert.Equal(t, "missing", result.GetItems()[2].GetId())
+	assert.False(t, result.GetItems()[2].GetOk())
+	assert.Equal(t, uint32(codes.NotFound), result.GetItems()[2].GetErrorCode())
+	assert.NotEmpty(t, result.GetItems()[2].GetErrorMessage())
+
+	remaining, err := client.GetCourseAnnouncements(t.Context(),
+		&cpb.GetCourseAnnouncementsRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	require.Len(t, remaining.GetAnnouncements(), 1)
+	assert.Equal(t, "2", remaining.GetAnnouncements()[0].GetAnnouncementID())
+}
+
+func TestBatchRemoveAnnouncementsRequiresStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+	course := createCourse(t, client)
+
+	_, err := client.BatchRemoveAnnouncements(t.Context(), &cpb.BatchRemoveAnnouncementsRequest{
+		CourseID: course.GetCourseID(), AnnouncementIDs: []string{"1"}, Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestCopyAnnouncements(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	createCourseWithID(t, client, "section-2")
+
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "hw1", AnnouncementContent: "Homework 1 is out.",
+	})
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "rubric", AnnouncementContent: "Grading rubric.", Visibility: cpb.AnnouncementVisibility_STAFF_ONLY,
+	})
+
+	resp, err := client.CopyAnnouncements(t.Context(), &cpb.CopyAnnouncementsRequest{
+		FromCourseID: course.GetCourseID(), ToCourseID: "section-2", Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.GetCopiedCount())
+
+	getAnnouncements := func(courseID string) []*cpb.Announcement {
+		resp, err := client.GetCourseAnnouncements(t.Context(),
+			&cpb.GetCourseAnnouncementsRequest{CourseID: courseID, Token: "test-token"})
+		require.NoError(t, err)
+
+		return resp.GetAnnouncements()
+	}
+
+	copies := getAnnouncements("section-2")
+	require.Len(t, copies, 2)
+
+	for _, announcementCopy := range copies {
+		_, err := uuid.Parse(announcementCopy.GetAnnouncementID())
+		require.NoError(t, err, "copies get new IDs like other generated announcement IDs")
+	}
+
+	// Removing the originals leaves the copies in place.
+	_, err = client.BatchRemoveAnnouncements(t.Context(), &cpb.BatchRemoveAnnouncementsRequest{
+		CourseID: course.GetCourseID(), AnnouncementIDs: []string{"hw1", "rubric"}, Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, getAnnouncements(course.GetCourseID()))
+	assert.Len(t, getAnnouncements("section-2"), 2)
+
+	_, err = client.CopyAnnouncements(t.Context(), &cpb.CopyAnnouncementsRequest{
+		FromCourseID: course.GetCourseID(), ToCourseID: "non-existent-id", Token: "test-token",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCopyAnnouncementsRequiresStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+	course := createCourse(t, client)
+
+	_, err := client.CopyAnnouncements(t.Context(), &cpb.CopyAnnouncementsRequest{
+		FromCourseID: course.GetCourseID(), ToCourseID: course.GetCourseID(), Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestStaffOnlyAnnouncementVisibility(t *testing.T) {
+	tests := []struct {
+		name    string
+		claims  ms.Claims
+		visible []string
+	}{
+		{"student", RoleClaims{subject: "student-1", roles: []string{"student"}}, []string{"public"}},
+		{"expired staff", RoleClaims{subject: "ta-old", roles: []string{"staff"}}, []string{"public"}},
+		{"course staff", RoleClaims{subject: "ta-1", roles: []string{"staff"}}, []string{"public", "rubric"}},
+		{"admin", RoleClaims{subject: "admin-1", roles: []string{adminRole}}, []string{"public", "rubric"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			grpcServer, listener, testServer, err := startTestServer(test.claims)
+			require.NoError(t, err)
+			t.Cleanup(grpcServer.Stop)
+
+			client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+			course := createCourse(t, client)
+
+			_, err = client.AddStaffToCourse(t.Context(),
+				&cpb.AddStaffRequest{CourseID: course.GetCourseID(), StaffID: "ta-1", Token: "test-token"})
+			require.NoError(t, err)
+			_, err = client.AddStaffToCourse(t.Context(), &cpb.AddStaffRequest{
+				CourseID: course.GetCourseID(), StaffID: "ta-old", Token: "test-token",
+				ValidFrom:  timestamppb.New(time.Now().Add(-48 * time.Hour)),
+				ValidUntil: timestamppb.New(time.Now().Add(-24 * time.Hour)),
+			})
+			require.NoError(t, err)
+
+			// Only staff may post, so the announcements are added directly for every caller.
+			for _, announcement := range []*cpb.Announcement{
+				{AnnouncementID: "public", AnnouncementContent: "Homework 1 is out."},
+				{
+					AnnouncementID: "rubric", AnnouncementContent: "Grading rubric clarifications.",
+					Visibility: cpb.AnnouncementVisibility_STAFF_ONLY,
+				},
+			} {
+				_, err = testServer.db.AddAnnouncement(t.Context(), &cpb.AddAnnouncementRequest{
+					CourseID: course.GetCourseID(), Announcement: announcement,
+				}, defaultAnnouncementExcerptLength)
+				require.NoError(t, err)
+			}
+
+			resp, err := client.GetCourseAnnouncements(t.Context(),
+				&cpb.GetCourseAnnouncementsRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+			require.NoError(t, err)
+
+			visible := make([]string, 0, len(resp.GetAnnouncements()))
+			for _, announcement := range resp.GetAnnouncements() {
+				visible = append(visible, announcement.GetAnnouncementID())
+			}
+
+			assert.ElementsMatch(t, test.visible, visible)
+		})
+	}
+}
+
+func TestGetCourseContentHash(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	getHash := func() string {
+		t.Helper()
+
+		resp, err := client.GetCourse(t.Context(),
+			&cpb.GetCourseRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.GetContentHash())
+
+		return resp.GetContentHash()
+	}
+
+	original := getHash()
+	assert.Equal(t, original, getHash(), "the hash should be stable across reads")
+
+	_, err := client.UpdateCourse(t.Context(), &cpb.UpdateCourseRequest{Course: course, Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, original, getHash(), "an update without changes should keep the hash")
+
+	course.Description = "Now with transformers."
+	_, err = client.UpdateCourse(t.Context(), &cpb.UpdateCourseRequest{Course: course, Token: "test-token"})
+	require.NoError(t, err)
+	assert.NotEqual(t, original, getHash(), "changing the content should change the hash")
+}
+
+func TestCourseTimestamps(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	created := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+	now := created
+	mockDB := NewMockDatabase()
+	mockDB.now = func() time.Time { return now }
+	testServer.db = mockDB
+
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	course := createTestCourse()
+
+	createResp, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, created, createResp.GetCourse().GetCreatedAt().AsTime())
+	assert.Equal(t, created, createResp.GetCourse().GetUpdatedAt().AsTime())
+
+	updated := created.Add(time.Hour)
+	now = updated
+	course.Description = "Now with transformers."
+
+	updateResp, err := client.UpdateCourse(t.Context(), &cpb.UpdateCourseRequest{Course: course, Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, created, updateResp.GetCourse().GetCreatedAt().AsTime())
+	assert.Equal(t, updated, updateResp.GetCourse().GetUpdatedAt().AsTime())
+
+	getResp, err := client.GetCourse(t.Context(),
+		&cpb.GetCourseRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, created, getResp.GetCourse().GetCreatedAt().AsTime())
+	assert.Equal(t, updated, getResp.GetCourse().GetUpdatedAt().AsTime())
+}
+
+// courseWriter changes a course through one of the RPCs writing courses.
+type courseWriter func(t *testing.T, client cpb.CoursesServiceClient, courseID string)
+
+// courseWriters returns the RPCs changing the fields of a course, by name.
+func courseWriters() map[string]courseWriter {
+	return map[string]courseWriter{
+		"UpdateCourse": func(t *testing.T, client cpb.CoursesServiceClient, courseID string) {
+			t.Helper()
+
+			_, err := client.UpdateCourse(t.Context(), &cpb.UpdateCourseRequest{
+				Course: &cpb.Course{CourseID: courseID, Description: "Now with transformers."}, Token: "test-token",
+			})
+			require.NoError(t, err)
+		},
+		"SetEnrollmentWindow": func(t *testing.T, client cpb.CoursesServiceClient, courseID string) {
+			t.Helper()
+
+			_, err := client.SetEnrollmentWindow(t.Context(), &cpb.SetEnrollmentWindowRequest{
+				CourseID: courseID, ClosesAt: timestamppb.New(time.Now().Add(time.Hour)), Token: "test-token",
+			})
+			require.NoError(t, err)
+		},
+		"SetAnnouncementsEnabled": func(t *testing.T, client cpb.CoursesServiceClient, courseID string) {
+			t.Helper()
+
+			_, err := client.SetAnnouncementsEnabled(t.Context(),
+				&cpb.SetAnnouncementsEnabledRequest{CourseID: courseID, Token: "test-token"})
+			require.NoError(t, err)
+		},
+		"FinalizeGradingScheme": func(t *testing.T, client cpb.CoursesServiceClient, courseID string) {
+			t.Helper()
+
+			setGradingScheme(t, client, courseID, 100)
+			_, err := client.FinalizeGradingScheme(t.Context(),
+				&cpb.FinalizeGradingSchemeRequest{CourseID: courseID, Token: "test-token"})
+			require.NoError(t, err)
+		},
+	}
+}
+
+func TestCourseWritesBumpUpdatedAt(t *testing.T) {
+	for name, write := range courseWriters() {
+		t.Run(name, func(t *testing.T) {
+			grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+			require.NoError(t, err)
+			t.Cleanup(grpcServer.Stop)
+
+			created := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+			now := created
+			mockDB := NewMockDatabase()
+			mockDB.now = func() time.Time { return now }
+			testServer.db = mockDB
+
+			client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+			course := createCourse(t, client)
+
+			now = created.Add(time.Hour)
+
+			write(t, client, course.GetCourseID())
+
+			resp, err := client.GetCourse(t.Context(),
+				&cpb.GetCourseRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+			require.NoError(t, err)
+			assert.True(t, resp.GetCourse().GetUpdatedAt().AsTime().After(resp.GetCourse().GetCreatedAt().AsTime()),
+				"writing a course should move its update time past its creation")
+		})
+	}
+}
+
+func TestCourseMetadata(t *testing.T) {
+	client := setupClient(t)
+
+	for courseID, room := range map[string]string{"236781": "Taub 1", "234114": "Taub 2"} {
+		course := createTestCourse()
+		course.CourseID = courseID
+		course.Metadata = map[string]string{"room": room, "department": "CS"}
+		_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+		require.NoError(t, err)
+	}
+
+	getResp, err := client.GetCourse(t.Context(), &cpb.GetCourseRequest{CourseID: "236781", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"room": "Taub 1", "department": "CS"}, getResp.GetCourse().GetMetadata())
+
+	updateResp, err := client.UpdateCourse(t.Context(), &cpb.UpdateCourseRequest{
+		Course: &cpb.Course{CourseID: "236781", Metadata: map[string]string{"room": "Taub 2", "department": ""}},
+		Token:  "test-token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"room": "Taub 2"}, updateResp.GetCourse().GetMetadata(),
+		"updates should merge, and empty values remove fields")
+
+	listCourseIDs := func(key, value string) []string {
+		t.Helper()
+
+		resp, err := client.GetCoursesByMetadata(t.Context(),
+			&cpb.GetCoursesByMetadataRequest{Key: key, Value: value, Token: "test-token"})
+		require.NoError(t, err)
+
+		courseIDs := make([]string, 0, len(resp.GetCourses()))
+		for _, course := range resp.GetCourses() {
+			courseIDs = append(courseIDs, course.GetCourseID())
+		}
+
+		return courseIDs
+	}
+
+	assert.Equal(t, []string{"234114", "236781"}, listCourseIDs("room", "Taub 2"))
+	assert.Equal(t, []string{"234114"}, listCourseIDs("department", "CS"))
+	assert.Empty(t, listCourseIDs("room", "Taub 1"))
+
+	course := createTestCourse()
+	course.CourseID = "236999"
+	course.Metadata = map[string]string{strings.Repeat("k", maxMetadataKeyLength+1): "value"}
+	_, err = client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMergeMetadata(t *testing.T) {
+	type fields = map[string]string
+
+	full := make(fields, maxMetadataFields)
+	for i := range maxMetadataFields {
+		full["field-"+strconv.Itoa(i)] = "value"
+	}
+
+	replaced := maps.Clone(full)
+	replaced["field-0"] = "other"
+
+	tests := []struct {
+		name     string
+		metadata fields
+		updates  fields
+		want     fields
+		wantErr  bool
+	}{
+		{"Empty", nil, nil, fields{}, false},
+		{"Merge", fields{"room": "Taub 1"}, fields{"floor": "3"}, fields{"room": "Taub 1", "floor": "3"}, false},
+		{"Replace", fields{"room": "Taub 1"}, fields{"room": "Taub 2"}, fields{"room": "Taub 2"}, false},
+		{"Remove", fields{"room": "Taub 1"}, fields{"room": ""}, fields{}, false},
+		{"ReplaceWhenFull", full, fields{"field-0": "other"}, replaced, false},
+		{"TooManyFields", full, fields{"room": "Taub 1"}, nil, true},
+		{"EmptyKey", nil, fields{"": "value"}, nil, true},
+		{"LongValue", nil, fields{"room": strings.Repeat("v", maxMetadataValueLength+1)}, nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			merged, err := mergeMetadata(test.metadata, test.updates)
+			if test.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidMetadata)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, merged)
+		})
+	}
+}
+
+func TestNextRecurrence(t *testing.T) {
+	scheduled := time.Date(2025, time.March, 3, 10, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{"not yet due", scheduled.Add(-time.Minute), scheduled},
+		{"due exactly now", scheduled, scheduled.Add(week)},
+		{"missed reposts are skipped", scheduled.Add(3*week + time.Hour), scheduled.Add(4 * week)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, nextRecurrence(scheduled, test.now))
+		})
+	}
+}
+
+func TestRepostDueAnnouncements(t *testing.T) {
+	mockDB := NewMockDatabase()
+	now := time.Date(2025, time.March, 3, 10, 0, 0, 0, time.UTC)
+	mockDB.now = func() time.Time { return now }
+
+	_, err := mockDB.AddCourse(t.Context(), createTestCourse())
+	require.NoError(t, err)
+
+	courseID := createTestCourse().GetCourseID()
+	for _, announcement := range []*cpb.Announcement{
+		{
+			AnnouncementID: "office-hours", AnnouncementContent: "Office hours on Monday.",
+			Recurrence: cpb.AnnouncementRecurrence_WEEKLY,
+		},
+		{AnnouncementID: "welcome", AnnouncementContent: "Welcome to the course."},
+	} {
+		_, err := mockDB.AddAnnouncement(t.Context(),
+			&cpb.AddAnnouncementRequest{CourseID: courseID, Announcement: announcement}, defaultAnnouncementExcerptLength)
+		require.NoError(t, err)
+	}
+
+	reposted, err := mockDB.RepostDueAnnouncements(t.Context(), now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, reposted, "nothing should be reposted before a week has passed")
+
+	now = now.Add(7 * 24 * time.Hour)
+	reposted, err = mockDB.RepostDueAnnouncements(t.Context(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reposted, "only the weekly announcement should be reposted")
+
+	reposted, err = mockDB.RepostDueAnnouncements(t.Context(), now)
+	require.NoError(t, err)
+	assert.Zero(t, reposted, "a repost should not be repeated")
+
+	announcements, err := mockDB.GetAnnouncements(t.Context(), courseID,
+		AnnouncementFilter{IncludeStaffOnly: true}, Page{})
+	require.NoError(t, err)
+	require.Len(t, announcements, 3)
+
+	repost := announcements[0]
+	assert.Equal(t, "office-hours-2025-03-10", repost.AnnouncementID, "the repost should be listed first")
+	assert.Equal(t, cpb.AnnouncementRecurrence_NONE.String(), repost.Recurrence, "copies should not recur")
+	assert.Equal(t, now.Add(7*24*time.Hour), announcements[1].NextPostAt)
+}
+
+func TestGroupStart(t *testing.T) {
+	jerusalem, err := time.LoadLocation("Asia/Jerusalem")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		posted   time.Time
+		grouping cpb.AnnouncementGrouping
+		loc      *time.Location
+		want     time.Time
+	}{
+		{
+			name:   "sunday night belongs to the week starting the previous monday",
+			posted: time.Date(2025, time.March, 9, 23, 59, 0, 0, time.UTC),
+			loc:    time.UTC,
+			want:   time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "monday midnight starts a new week",
+			posted: time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+			loc:    time.UTC,
+			want:   time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "iso weeks span the new year",
+			posted: time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC),
+			loc:    time.UTC,
+			want:   time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "sunday night in UTC is already monday in Jerusalem",
+			posted: time.Date(2025, time.March, 9, 23, 30, 0, 0, time.UTC),
+			loc:    jerusalem,
+			want:   time.Date(2025, time.March, 10, 0, 0, 0, 0, jerusalem),
+		},
+		{
+			name:     "months start on the first in the configured time zone",
+			posted:   time.Date(2025, time.March, 31, 22, 30, 0, 0, time.UTC),
+			grouping: cpb.AnnouncementGrouping_MONTH,
+			loc:      jerusalem,
+			want:     time.Date(2025, time.April, 1, 0, 0, 0, 0, jerusalem),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.True(t, test.want.Equal(groupStart(test.posted, test.grouping, test.loc)))
+		})
+	}
+}
+
+func TestGetAnnouncementGroups(t *testing.T) {
+	mockDB := NewMockDatabase()
+	now := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	mockDB.now = func() time.Time { return now }
+
+	_, err := mockDB.AddCourse(t.Context(), createTestCourse())
+	require.NoError(t, err)
+
+	courseID := createTestCourse().GetCourseID()
+	post := func(announcementID string, postedAt time.Time) {
+		now = postedAt
+
+		_, err := mockDB.AddAnnouncement(t.Context(), &cpb.AddAnnouncementRequest{
+			CourseID:     courseID,
+			Announcement: &cpb.Announcement{AnnouncementID: announcementID, AnnouncementContent: "Update."},
+		}, defaultAnnouncementExcerptLength)
+		require.NoError(t, err)
+	}
+
+	post("week1-a", time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC))
+	post("week1-b", time.Date(2025, time.March, 9, 23, 0, 0, 0, time.UTC))
+	post("week2-a", time.Date(2025, time.March, 10, 8, 0, 0, 0, time.UTC))
+
+	groups, err := mockDB.GetAnnouncementGroups(t.Context(), courseID, AnnouncementFilter{},
+		cpb.AnnouncementGrouping_WEEK, time.UTC, Page{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, "week2-a", groups[0].Newest.AnnouncementID, "groups should be ordered newest first")
+	assert.Equal(t, 1, groups[0].Count)
+	assert.Equal(t, "week1-b", groups[1].Newest.AnnouncementID)
+	assert.Equal(t, 2, groups[1].Count)
+
+	expanded, err := mockDB.GetAnnouncements(t.Context(), courseID,
+		AnnouncementFilter{CreatedFrom: groups[1].StartsAt, CreatedBefore: groups[1].EndsAt}, Page{})
+	require.NoError(t, err)
+	assert.Len(t, expanded, 2, "a group should expand to exactly its announcements")
+
+	groups, err = mockDB.GetAnnouncementGroups(t.Context(), courseID, AnnouncementFilter{},
+		cpb.AnnouncementGrouping_WEEK, time.UTC, Page{Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, "week1-b", groups[0].Newest.AnnouncementID)
+}
+
+func TestGetCourseAnnouncementsGrouped(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{AnnouncementID: "1", AnnouncementContent: "A"})
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{AnnouncementID: "2", AnnouncementContent: "B"})
+
+	resp, err := client.GetCourseAnnouncementsGrouped(t.Context(), &cpb.GetCourseAnnouncementsGroupedRequest{
+		CourseID: course.GetCourseID(), GroupBy: cpb.AnnouncementGrouping_MONTH, Token: "test-token",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetGroups(), 1)
+	assert.Equal(t, int64(2), resp.GetGroups()[0].GetCount())
+	assert.Equal(t, "2", resp.GetGroups()[0].GetNewest().GetAnnouncementID())
+	assert.Empty(t, resp.GetNextPageToken())
+}
+
+func TestCorequisites(t *testing.T) {
+	client := setupClient(t)
+
+	for _, courseID := range []string{"lecture", "lab", "tutorial"} {
+		createCourseWithID(t, client, courseID)
+	}
+
+	for _, corequisiteID := range []string{"tutorial", "lab", "lab"} {
+		_, err := client.AddCorequisite(t.Context(), &cpb.AddCorequisiteRequest{
+			CourseID: "lecture", CorequisiteID: corequisiteID, Token: "test-token",
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := client.AddCorequisite(t.Context(), &cpb.AddCorequisiteRequest{
+		CourseID: "lecture", CorequisiteID: "lecture", Token: "test-token",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "a course should not be its own co-requisite")
+
+	_, err = client.AddCorequisite(t.Context(), &cpb.AddCorequisiteRequest{
+		CourseID: "lecture", CorequisiteID: "missing", Token: "test-token",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	corequisites, err := client.GetCorequisites(t.Context(),
+		&cpb.GetCorequisitesRequest{CourseID: "lecture", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"lab", "tutorial"}, corequisites.GetCorequisiteIDs())
+
+	course, err := client.GetCourse(t.Context(),
+		&cpb.GetCourseRequest{CourseID: "lecture", IncludeCorequisites: true, Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"lab", "tutorial"}, course.GetCorequisiteIDs())
+
+	course, err = client.GetCourse(t.Context(), &cpb.GetCourseRequest{CourseID: "lecture", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Empty(t, course.GetCorequisiteIDs(), "co-requisites should only be included on request")
+}
+
+func TestAddCorequisiteRequiresStaff(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+
+	for _, courseID := range []string{"lecture", "lab"} {
+		createCourseWithID(t, client, courseID)
+	}
+
+	_, err := client.AddCorequisite(t.Context(), &cpb.AddCorequisiteRequest{
+		CourseID: "lecture", CorequisiteID: "lab", Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAddStudentToCourseWarnsOfMissingCorequisites(t *testing.T) {
+	client := setupClient(t)
+
+	for _, courseID := range []string{"lecture", "lab"} {
+		createCourseWithID(t, client, courseID)
+	}
+
+	_, err := client.AddCorequisite(t.Context(),
+		&cpb.AddCorequisiteRequest{CourseID: "lecture", CorequisiteID: "lab", Token: "test-token"})
+	require.NoError(t, err)
+
+	resp, err := client.AddStudentToCourse(t.Context(),
+		&cpb.AddStudentRequest{CourseID: "lecture", StudentID: "student-1", Token: "test-token"})
+	require.NoError(t, err, "a missing co-requisite should not block the enrollment")
+	assert.Equal(t, []string{"lab"}, resp.GetMissingCorequisites())
+
+	enrollStudents(t, client, "lab", "student-2")
+
+	resp, err = client.AddStudentToCourse(t.Context(),
+		&cpb.AddStudentRequest{CourseID: "lecture", StudentID: "student-2", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetMissingCorequisites())
+
+	enrollStudents(t, client, "lab", "student-3")
+	dropStudent(t, client, "lab", "student-3")
+
+	resp, err = client.AddStudentToCourse(t.Context(),
+		&cpb.AddStudentRequest{CourseID: "lecture", StudentID: "student-3", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"lab"}, resp.GetMissingCorequisites(), "a dropped co-requisite should be missing")
+}
+
+// slowDatabase delays GetCoursesBySemester until latency passes or the request context ends.
+type slowDatabase struct {
+	*MockDatabase
+	latency time.Duration
+}
+
+func (d slowDatabase) GetCoursesBySemester(ctx context.Context, semester string, limit int) ([]*Course, error) {
+	timer := time.NewTimer(d.latency)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to get courses by semester: %w", ctx.Err())
+	case <-timer.C:
+		return d.MockDatabase.GetCoursesBySemester(ctx, semester, limit)
+	}
+}
+
+func TestRequestCancellation(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	server := &CoursesServer{db: slowDatabase{MockDatabase: NewMockDatabase(), latency: time.Minute}, Claims: MockClaims{}}
+
+	tests := []struct {
+		name     string
+		withStop func(ctx context.Context) (context.Context, context.CancelFunc)
+		want     codes.Code
+	}{
+		{
+			name: "client cancels",
+			withStop: func(ctx context.Context) (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(ctx)
+				time.AfterFunc(10*time.Millisecond, cancel)
+
+				return ctx, cancel
+			},
+			want: codes.Canceled,
+		},
+		{
+			name: "deadline passes",
+			withStop: func(ctx context.Context) (context.Context, context.CancelFunc) {
+				return context.WithTimeout(ctx, 10*time.Millisecond)
+			},
+			want: codes.DeadlineExceeded,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := test.withStop(t.Context())
+			defer cancel()
+
+			_, err := server.GetSemesterCourses(ctx,
+				&cpb.GetSemesterCoursesRequest{Semester: "Winter_2025", Token: "test-token"})
+			assert.Equal(t, test.want, status.Code(err))
+		})
+	}
+}
+
+// failingDatabase fails reads of a course and its members with err.
+type failingDatabase struct {
+	*MockDatabase
+	err error
+}
+
+func (d failingDatabase) GetCourse(context.Context, string) (*Course, error) {
+	return nil, d.err
+}
+
+func (d failingDatabase) UpdateCourse(context.Context, *cpb.Course) (*Course, error) {
+	return nil, d.err
+}
+
+func (d failingDatabase) GetCourseStudents(context.Context, string, bool) ([]string, error) {
+	return nil, d.err
+}
+
+func (d failingDatabase) GetCourseStaffPage(context.Context, string, bool, Page) (*StaffPage, error) {
+	return nil, d.err
+}
+
+func (d failingDatabase) GetAnnouncements(context.Context, string, AnnouncementFilter, Page) ([]Announcement, error) {
+	return nil, d.err
+}
+
+func TestCourseReadErrorCodes(t *testing.T) {
+	failures := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"not found", fmt.Errorf("%w", ErrCourseNotFound), codes.NotFound},
+		{"outage", fmt.Errorf("failed to get course: %w", io.ErrUnexpectedEOF), codes.Internal},
+		{"read-only", fmt.Errorf("%w", ErrDatabaseReadOnly), codes.Unavailable},
+	}
+
+	calls := map[string]func(ctx context.Context, server *CoursesServer) error{
+		"GetCourse": func(ctx context.Context, server *CoursesServer) error {
+			_, err := server.GetCourse(ctx, &cpb.GetCourseRequest{CourseID: "236781", Token: "test-token"})
+
+			return err
+		},
+		"UpdateCourse": func(ctx context.Context, server *CoursesServer) error {
+			_, err := server.UpdateCourse(ctx,
+				&cpb.UpdateCourseRequest{Course: createTestCourse(), Token: "test-token"})
+
+			return err
+		},
+		"GetCourseStudents": func(ctx context.Context, server *CoursesServer) error {
+			_, err := server.GetCourseStudents(ctx,
+				&cpb.GetCourseStudentsRequest{CourseID: "236781", Token: "test-token"})
+
+			return err
+		},
+		"GetCourseStaff": func(ctx context.Context, server *CoursesServer) error {
+			_, err := server.GetCourseStaff(ctx, &cpb.GetCourseStaffRequest{CourseID: "236781", Token: "test-token"})
+
+			return err
+		},
+		"GetCourseAnnouncements": func(ctx context.Context, server *CoursesServer) error {
+			_, err := server.GetCourseAnnouncements(ctx,
+				&cpb.GetCourseAnnouncementsRequest{CourseID: "236781", Token: "test-token"})
+
+			return err
+		},
+	}
+
+	for _, failure := range failures {
+		for name, call := range calls {
+			t.Run(failure.name+"/"+name, func(t *testing.T) {
+				server, err := newCoursesServer()
+				require.NoError(t, err)
+
+				// The course exists, so only the reads failingDatabase overrides fail.
+				mockDB := NewMockDatabase()
+				_, err = mockDB.AddCourse(t.Context(), createTestCourse())
+				require.NoError(t, err)
+
+				server.db = failingDatabase{MockDatabase: mockDB, err: failure.err}
+				server.Claims = MockClaims{}
+
+				assert.Equal(t, failure.want, status.Code(call(t.Context(), server)))
+			})
+		}
+	}
+}
+
+func TestTransferEnrollments(t *testing.T) {
+	tests := []struct {
+		name        string
+		capacity    int32
+		wantCode    codes.Code
+		transferred int64
+		wantRoster  []string
+	}{
+		{name: "unlimited", transferred: 2, wantRoster: []string{"student-1", "student-2", "student-3"}},
+		{name: "fits exactly", capacity: 3, transferred: 2, wantRoster: []string{"student-1", "student-2", "student-3"}},
+		{name: "over capacity", capacity: 2, wantCode: codes.FailedPrecondition, wantRoster: []string{"student-2"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := setupClient(t)
+			createCourseWithID(t, client, "intro-winter")
+
+			destination := createTestCourse()
+			destination.CourseID, destination.Capacity = "intro-spring", test.capacity
+			_, err := client.CreateCourse(t.Context(),
+				&cpb.CreateCourseRequest{Course: destination, Token: "test-token"})
+			require.NoError(t, err)
+
+			enrollStudents(t, client, "intro-winter", "student-1", "student-2", "student-3", "student-4")
+			enrollStudents(t, client, "intro-spring", "student-2")
+
+			_, err = client.SetStudentStatus(t.Context(), &cpb.SetStudentStatusRequest{
+				CourseID: "intro-winter", StudentID: "student-4", Status: "dropped", Token: "test-token",
+			})
+			require.NoError(t, err)
+
+			resp, err := client.TransferEnrollments(t.Context(), &cpb.TransferEnrollmentsRequest{
+				FromCourseID: "intro-winter", ToCourseID: "intro-spring", Token: "test-token",
+			})
+			require.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantCode == codes.OK {
+				assert.Equal(t, test.transferred, resp.GetTransferredCount())
+				assert.Equal(t, int64(1), resp.GetSkippedCount(), "the student already enrolled should be skipped")
+			}
+
+			roster, err := client.GetCourseStudents(t.Context(),
+				&cpb.GetCourseStudentsRequest{CourseID: "intro-spring", Token: "test-token"})
+			require.NoError(t, err)
+			assert.ElementsMatch(t, test.wantRoster, roster.GetStudentsIDs(), "dropped students are not transferred")
+		})
+	}
+}
+
+func TestTransferEnrollmentsRequiresAdmin(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "staff-1", roles: []string{"staff"}})
+
+	_, err := client.TransferEnrollments(t.Context(), &cpb.TransferEnrollmentsRequest{
+		FromCourseID: "intro-winter", ToCourseID: "intro-spring", Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestChangeCourseID(t *testing.T) {
+	client := setupClient(t)
+	createCourseWithID(t, client, "intro-wrong")
+	createCourseWithID(t, client, "intro-spring")
+	enrollStudents(t, client, "intro-wrong", "student-1", "student-2")
+
+	_, err := client.AddStaffToCourse(t.Context(),
+		&cpb.AddStaffRequest{CourseID: "intro-wrong", StaffID: "staff-1", Token: "test-token"})
+	require.NoError(t, err)
+
+	addAnnouncement(t, client, "intro-wrong", &cpb.Announcement{
+		AnnouncementID: "welcome", AnnouncementContent: "Welcome to the course.",
+	})
+
+	_, err = client.ChangeCourseID(t.Context(), &cpb.ChangeCourseIDRequest{
+		CourseID: "intro-wrong", NewCourseID: "intro-spring", Token: "test-token",
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "an ID taken by another course is rejected")
+
+	_, err = client.ChangeCourseID(t.Context(), &cpb.ChangeCourseIDRequest{
+		CourseID: "intro-wrong", NewCourseID: "intro-winter", Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetCourse(t.Context(), &cpb.GetCourseRequest{CourseID: "intro-wrong", Token: "test-token"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	course, err := client.GetCourse(t.Context(), &cpb.GetCourseRequest{CourseID: "intro-winter", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, "intro-winter", course.GetCourse().GetCourseID())
+
+	students, err := client.GetCourseStudents(t.Context(),
+		&cpb.GetCourseStudentsRequest{CourseID: "intro-winter", Token: "test-token"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"student-1", "student-2"}, students.GetStudentsIDs())
+
+	staff, err := client.GetCourseStaff(t.Context(),
+		&cpb.GetCourseStaffRequest{CourseID: "intro-winter", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Contains(t, staff.GetStaffIDs(), "staff-1")
+
+	announcements, err := client.GetCourseAnnouncements(t.Context(),
+		&cpb.GetCourseAnnouncementsRequest{CourseID: "intro-winter", Token: "test-token"})
+	require.NoError(t, err)
+	require.Len(t, announcements.GetAnnouncements(), 1)
+	assert.Equal(t, "welcome", announcements.GetAnnouncements()[0].GetAnnouncementID())
+
+	courses, err := client.GetStudentCourses(t.Context(),
+		&cpb.GetStudentCoursesRequest{StudentID: "student-1", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"intro-winter"}, courses.GetCoursesIDs())
+
+	_, err = client.ChangeCourseID(t.Context(), &cpb.ChangeCourseIDRequest{
+		CourseID: "intro-wrong", NewCourseID: "intro-fall", Token: "test-token",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestChangeCourseIDRequiresAdmin(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "staff-1", roles: []string{"staff"}})
+
+	_, err := client.ChangeCourseID(t.Context(), &cpb.ChangeCourseIDRequest{
+		CourseID: "intro-wrong", NewCourseID: "intro-winter", Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// readOnlyDatabase refuses to enroll students while readOnly is set, like Postgres during a failover.
+type readOnlyDatabase struct {
+	*MockDatabase
+	readOnly *atomic.Bool
+}
+
+func (d readOnlyDatabase) AddStudentToCourse(ctx context.Context, courseID, studentID string,
+	allowStaff, allowFull bool,
+) error {
+	if d.readOnly.Load() {
+		return fmt.Errorf("failed to add student to course: %w", ErrDatabaseReadOnly)
+	}
+
+	return d.MockDatabase.AddStudentToCourse(ctx, courseID, studentID, allowStaff, allowFull)
+}
+
+func TestReadOnlyDatabase(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	readOnly := &atomic.Bool{}
+	testServer.db = readOnlyDatabase{MockDatabase: NewMockDatabase(), readOnly: readOnly}
+
+	conn := dialTestServer(t, listener)
+	client, healthClient := cpb.NewCoursesServiceClient(conn), healthpb.NewHealthClient(conn)
+	course := createCourse(t, client)
+	enroll := &cpb.AddStudentRequest{CourseID: course.GetCourseID(), StudentID: "student-1", Token: "test-token"}
+
+	assertHealth := func(service string, want healthpb.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+
+		resp, err := healthClient.Check(t.Context(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		assert.Equal(t, want, resp.GetStatus(), "health of %q", service)
+	}
+
+	readOnly.Store(true)
+
+	_, err = client.AddStudentToCourse(t.Context(), enroll)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	var reason string
+
+	var retryDelay time.Duration
+
+	for _, detail := range status.Convert(err).Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			reason = detail.GetReason()
+		case *errdetails.RetryInfo:
+			retryDelay = detail.GetRetryDelay().AsDuration()
+		}
+	}
+
+	assert.Equal(t, readOnlyReason, reason)
+	assert.Positive(t, retryDelay, "the client should be told when to retry")
+	assertHealth(writesHealthService, healthpb.HealthCheckResponse_NOT_SERVING)
+	assertHealth("", healthpb.HealthCheckResponse_SERVING)
+	assert.Equal(t, true, metric(t, testServer.CoursesServer, "database_read_only"))
+
+	_, err = client.CheckEnrollmentEligibility(t.Context(), &cpb.CheckEnrollmentEligibilityRequest{
+		CourseID: course.GetCourseID(), StudentID: "student-1", Token: "test-token",
+	})
+	require.NoError(t, err, "reads should be served while the database is read-only")
+	assertHealth(writesHealthService, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	readOnly.Store(false)
+
+	_, err = client.AddStudentToCourse(t.Context(), enroll)
+	require.NoError(t, err)
+	assertHealth(writesHealthService, healthpb.HealthCheckResponse_SERVING)
+	assert.Equal(t, false, metric(t, testServer.CoursesServer, "database_read_only"))
+}
+
+// metric returns the named metric of the server as served on the metrics endpoint.
+func metric(t *testing.T, server *CoursesServer, name string) any {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	metricsHandler(server.metrics()).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, metricsPath, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var metrics map[string]any
+
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &metrics))
+	require.Contains(t, metrics, name)
+
+	return metrics[name]
+}
+
+func TestWriteMethodsAreServiceMethods(t *testing.T) {
+	methods := make([]string, 0, len(cpb.CoursesService_ServiceDesc.Methods))
+	for _, method := range cpb.CoursesService_ServiceDesc.Methods {
+		methods = append(methods, "/"+cpb.CoursesService_ServiceDesc.ServiceName+"/"+method.MethodName)
+	}
+
+	for _, method := range writeMethods() {
+		assert.Contains(t, methods, method)
+	}
+
+	assert.NotContains(t, writeMethods(), cpb.CoursesService_CheckEnrollmentEligibility_FullMethodName,
+		"checking eligibility only reads")
+}
+
+// closedDatabase fails every ping while closed is set, as a database whose connection was lost.
+type closedDatabase struct {
+	*MockDatabase
+	closed *atomic.Bool
+}
+
+func (d closedDatabase) Ping(ctx context.Context) error {
+	if d.closed.Load() {
+		return fmt.Errorf("failed to ping the database: %w", sql.ErrConnDone)
+	}
+
+	return d.MockDatabase.Ping(ctx)
+}
+
+func TestMonitorDatabase(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	closed := &atomic.Bool{}
+	testServer.db = closedDatabase{MockDatabase: NewMockDatabase(), closed: closed}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		testServer.monitorDatabase(ctx, time.Millisecond)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	healthClient := healthpb.NewHealthClient(dialTestServer(t, listener))
+	serving := func(want healthpb.HealthCheckResponse_ServingStatus) func() bool {
+		return func() bool {
+			resp, err := healthClient.Check(t.Context(), &healthpb.HealthCheckRequest{})
+
+			return err == nil && resp.GetStatus() == want
+		}
+	}
+
+	assert.True(t, serving(healthpb.HealthCheckResponse_SERVING)(), "a reachable database is served")
+
+	closed.Store(true)
+	assert.Eventually(t, serving(healthpb.HealthCheckResponse_NOT_SERVING), time.Second, time.Millisecond,
+		"failing pings should mark the service NOT_SERVING")
+
+	closed.Store(false)
+	assert.Eventually(t, serving(healthpb.HealthCheckResponse_SERVING), time.Second, time.Millisecond,
+		"the service should be SERVING again once pings succeed")
+}
+
+// blockingDatabase holds GetCourse calls until release is closed, reporting each one on entered.
+type blockingDatabase struct {
+	*MockDatabase
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (d blockingDatabase) GetCourse(ctx context.Context, courseID string) (*Course, error) {
+	d.entered <- struct{}{}
+	<-d.release
+
+	return d.MockDatabase.GetCourse(ctx, courseID)
+}
+
+// saturatedServer is a test server handling one request at once, busy with a GetCourse call
+// blocked until database.release is closed. The error of that call is sent on first.
+type saturatedServer struct {
+	server   *CoursesServer
+	conn     *grpc.ClientConn
+	get      *cpb.GetCourseRequest
+	database blockingDatabase
+	first    <-chan error
+}
+
+// startSaturatedServer starts a saturatedServer letting excess requests wait up to maxWait.
+func startSaturatedServer(t *testing.T, maxWait string) saturatedServer {
+	t.Helper()
+	t.Setenv(maxConcurrentRequestsEnv, "1")
+	t.Setenv(maxRequestWaitEnv, maxWait)
+
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	conn := dialTestServer(t, listener)
+	client := cpb.NewCoursesServiceClient(conn)
+	course := createCourse(t, client)
+
+	mockDB, ok := testServer.db.(*MockDatabase)
+	require.True(t, ok)
+
+	database := blockingDatabase{MockDatabase: mockDB, entered: make(chan struct{}), release: make(chan struct{})}
+	testServer.db = database
+	get := &cpb.GetCourseRequest{CourseID: course.GetCourseID(), Token: "test-token"}
+
+	first := make(chan error, 1)
+
+	go func() {
+		_, err := client.GetCourse(t.Context(), get)
+		first <- err
+	}()
+
+	<-database.entered
+	require.Equal(t, 1, testServer.limiter.inFlight())
+	require.InDelta(t, 1, metric(t, testServer.CoursesServer, "in_flight_requests"), 0)
+
+	return saturatedServer{server: testServer.CoursesServer, conn: conn, get: get, database: database, first: first}
+}
+
+func TestConcurrencyLimitRejects(t *testing.T) {
+	server := startSaturatedServer(t, "")
+
+	_, err := healthpb.NewHealthClient(server.conn).Check(t.Context(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err, "health checks are served at the limit")
+
+	_, err = cpb.NewCoursesServiceClient(server.conn).GetCourse(t.Context(), server.get)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.InDelta(t, 1, metric(t, server.server, "in_flight_requests"), 0, "rejected requests are not in flight")
+
+	close(server.database.release)
+	require.NoError(t, <-server.first)
+	assert.InDelta(t, 0, metric(t, server.server, "in_flight_requests"), 0)
+}
+
+func TestConcurrencyLimitQueues(t *testing.T) {
+	server := startSaturatedServer(t, "5s")
+
+	second := make(chan error, 1)
+
+	go func() {
+		_, err := cpb.NewCoursesServiceClient(server.conn).GetCourse(t.Context(), server.get)
+		second <- err
+	}()
+
+	// The waiting request takes the slot of the first one once it finishes.
+	close(server.database.release)
+	<-server.database.entered
+	require.NoError(t, <-server.first)
+	assert.NoError(t, <-second)
+}
+
+// setGradingScheme sets the grading components of a course, naming them by position.
+func setGradingScheme(t *testing.T, client cpb.CoursesServiceClient, courseID string, weights ...float64) {
+	t.Helper()
+
+	for i, weight := range weights {
+		_, err := client.SetGradingComponent(t.Context(), &cpb.SetGradingComponentRequest{
+			CourseID:  courseID,
+			Component: &cpb.GradingComponent{Name: "component-" + strconv.Itoa(i), Weight: weight},
+			Token:     "test-token",
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestFinalizeGradingSchemeWeights(t *testing.T) {
+	tenths := make([]float64, 1000)
+	for i := range tenths {
+		tenths[i] = 0.1
+	}
+
+	tests := []struct {
+		name    string
+		weights []float64
+		code    codes.Code
+	}{
+		{"Exact", []float64{40, 30, 30}, codes.OK},
+		{"Thirds", []float64{33.33, 33.33, 33.34}, codes.OK},
+		{"Tenths", tenths, codes.OK},
+		{"Under", []float64{40, 30}, codes.FailedPrecondition},
+		{"Over", []float64{60, 50}, codes.FailedPrecondition},
+		{"JustUnder", []float64{99.999, 0.0009}, codes.FailedPrecondition},
+		{"Empty", nil, codes.FailedPrecondition},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := setupClient(t)
+			course := createCourse(t, client)
+			setGradingScheme(t, client, course.GetCourseID(), test.weights...)
+
+			_, err := client.FinalizeGradingScheme(t.Context(),
+				&cpb.FinalizeGradingSchemeRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+			assert.Equal(t, test.code, status.Code(err))
+		})
+	}
+}
+
+func TestGradingSchemeDraftEdits(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+	setGradingScheme(t, client, course.GetCourseID(), 50, 50, 10)
+
+	_, err := client.SetGradingComponent(t.Context(), &cpb.SetGradingComponentRequest{
+		CourseID:  course.GetCourseID(),
+		Component: &cpb.GradingComponent{Name: "component-1", Weight: 40, Kind: "homework"},
+		Token:     "test-token",
+	})
+	require.NoError(t, err, "a component of the same name is replaced")
+
+	_, err = client.RemoveGradingComponent(t.Context(), &cpb.RemoveGradingComponentRequest{
+		CourseID: course.GetCourseID(), Name: "missing", Token: "test-token",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	finalized, err := client.FinalizeGradingScheme(t.Context(),
+		&cpb.FinalizeGradingSchemeRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	require.NotNil(t, finalized.GetFinalizedAt())
+
+	scheme, err := client.GetGradingScheme(t.Context(),
+		&cpb.GetGradingSchemeRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	require.Len(t, scheme.GetComponents(), 3)
+	assert.Equal(t, "homework", scheme.GetComponents()[1].GetKind())
+	assert.Equal(t, finalized.GetFinalizedAt().AsTime(), scheme.GetFinalizedAt().AsTime())
+
+	_, err = client.RemoveGradingComponent(t.Context(), &cpb.RemoveGradingComponentRequest{
+		CourseID: course.GetCourseID(), Name: "component-2", Token: "test-token",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "a finalized scheme cannot change")
+
+	again, err := client.FinalizeGradingScheme(t.Context(),
+		&cpb.FinalizeGradingSchemeRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, finalized.GetFinalizedAt().AsTime(), again.GetFinalizedAt().AsTime())
+}
+
+func TestGradingSchemePermissions(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+	course := createCourse(t, client)
+
+	_, err := client.SetGradingComponent(t.Context(), &cpb.SetGradingComponentRequest{
+		CourseID:  course.GetCourseID(),
+		Component: &cpb.GradingComponent{Name: "exam", Weight: 100},
+		Token:     "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.FinalizeGradingScheme(t.Context(),
+		&cpb.FinalizeGradingSchemeRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.GetGradingScheme(t.Context(),
+		&cpb.GetGradingSchemeRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	assert.NoError(t, err, "students can read the grading scheme")
+}
+
+func TestLongAnnouncementExcerpts(t *testing.T) {
+	t.Setenv(announcementExcerptLengthEnv, "64")
+	t.Setenv(maxAnnouncementLengthEnv, "4096")
+
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	// Each copy is 9 bytes, so the excerpt must not end inside the 2-byte section sign.
+	spec := strings.Repeat("Spec §1. ", 400)
+	for index := range 5 {
+		addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+			AnnouncementID: strconv.Itoa(index), AnnouncementContent: spec,
+		})
+	}
+
+	addAnnouncement(t, client, course.GetCourseID(), &cpb.Announcement{
+		AnnouncementID: "short", AnnouncementContent: "Exam tomorrow.",
+	})
+
+	listed, err := client.GetCourseAnnouncements(t.Context(),
+		&cpb.GetCourseAnnouncementsRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+	require.Len(t, listed.GetAnnouncements(), 6)
+	assert.Less(t, proto.Size(listed), len(spec), "all excerpts together should be smaller than one body")
+
+	for _, announcement := range listed.GetAnnouncements() {
+		if announcement.GetAnnouncementID() == "short" {
+			assert.False(t, announcement.GetHasFullBody())
+			assert.Equal(t, "Exam tomorrow.", announcement.GetAnnouncementContent())
+
+			continue
+		}
+
+		assert.True(t, announcement.GetHasFullBody())
+		assert.LessOrEqual(t, len(announcement.GetAnnouncementContent()), 64)
+		assert.True(t, utf8.ValidString(announcement.GetAnnouncementContent()))
+		assert.True(t, strings.HasPrefix(spec, announcement.GetAnnouncementContent()))
+	}
+
+	full, err := client.GetAnnouncement(t.Context(), &cpb.GetAnnouncementRequest{
+		CourseID: course.GetCourseID(), AnnouncementID: "3", Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, spec, full.GetAnnouncement().GetAnnouncementContent())
+
+	_, err = client.GetAnnouncement(t.Context(), &cpb.GetAnnouncementRequest{
+		CourseID: course.GetCourseID(), AnnouncementID: "missing", Token: "test-token",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.AddAnnouncementToCourse(t.Context(), &cpb.AddAnnouncementRequest{
+		CourseID: course.GetCourseID(), Token: "test-token",
+		Announcement: &cpb.Announcement{AnnouncementID: "huge", AnnouncementContent: strings.Repeat("x", 4097)},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "content above the hard cap should be rejected")
+}
+
+func TestCourseShareToken(t *testing.T) {
+	const secret = "a-course-share-secret-of-32-bytes"
+
+	t.Setenv(courseShareSecretEnv, secret)
+
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	resp, err := client.GenerateCourseShareToken(t.Context(), &cpb.GenerateCourseShareTokenRequest{
+		CourseID: course.GetCourseID(), TtlSeconds: 3600, Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), resp.GetExpiresAt().AsTime(), time.Minute)
+
+	shared, err := client.GetCourseByShareToken(t.Context(),
+		&cpb.GetCourseByShareTokenRequest{ShareToken: resp.GetShareToken()})
+	require.NoError(t, err)
+	assert.Equal(t, course.GetCourseID(), shared.GetCourse().GetCourseID())
+	assert.Equal(t, course.GetCourseName(), shared.GetCourse().GetCourseName())
+
+	signer := shareSigner{secret: []byte(secret)}
+	forged := shareSigner{secret: []byte("another-secret-of-at-least-32-bytes")}
+
+	tests := []struct {
+		name       string
+		shareToken string
+	}{
+		{"expired", signer.sign(course.GetCourseID(), time.Now().Add(-time.Second))},
+		{"forged", forged.sign(course.GetCourseID(), time.Now().Add(time.Hour))},
+		{"tampered", resp.GetShareToken() + "x"},
+		{"malformed", "not-a-share-token"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := client.GetCourseByShareToken(t.Context(),
+				&cpb.GetCourseByShareTokenRequest{ShareToken: test.shareToken})
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+	}
+
+	_, err = client.DeleteCourse(t.Context(),
+		&cpb.DeleteCourseRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+
+	_, err = client.GetCourseByShareToken(t.Context(),
+		&cpb.GetCourseByShareTokenRequest{ShareToken: resp.GetShareToken()})
+	assert.Equal(t, codes.NotFound, status.Code(err), "deleting the course revokes its share tokens")
+}
+
+func TestGenerateCourseShareTokenDisabled(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	_, err := client.GenerateCourseShareToken(t.Context(), &cpb.GenerateCourseShareTokenRequest{
+		CourseID: course.GetCourseID(), TtlSeconds: 3600, Token: "test-token",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Without a secret, tokens would be signed with the empty key that anyone can use.
+	_, err = client.GetCourseByShareToken(t.Context(), &cpb.GetCourseByShareTokenRequest{
+		ShareToken: shareSigner{}.sign(course.GetCourseID(), time.Now().Add(time.Hour)),
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "tokens should be rejected while sharing is off")
+}
+
+func TestGenerateCourseShareTokenRequiresStaff(t *testing.T) {
+	t.Setenv(courseShareSecretEnv, "a-course-share-secret-of-32-bytes")
+
+	client := setupClientWithClaims(t, RoleClaims{subject: "student-1", roles: []string{"student"}})
+	course := createCourse(t, client)
+
+	_, err := client.GenerateCourseShareToken(t.Context(), &cpb.GenerateCourseShareTokenRequest{
+		CourseID: course.GetCourseID(), TtlSeconds: 3600, Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// courseGetter gets a course through one of the RPCs returning courses.
+// It is called by caller, whose claims the client's server holds.
+type courseGetter func(t *testing.T, client cpb.CoursesServiceClient, courseID string, caller RoleClaims) *cpb.Course
+
+// courseGetters returns the RPCs projecting courses by the role of the caller, by name.
+// Share tokens are signed with secret.
+func courseGetters(secret string) map[string]courseGetter {
+	getters := courseListers()
+	maps.Copy(getters, map[string]courseGetter{
+		"GetCourse": func(t *testing.T, client cpb.CoursesServiceClient, courseID string, _ RoleClaims) *cpb.Course {
+			t.Helper()
+
+			resp, err := client.GetCourse(t.Context(), &cpb.GetCourseRequest{CourseID: courseID, Token: "test-token"})
+			require.NoError(t, err)
+
+			return resp.GetCourse()
+		},
+		"UpdateCourse": func(t *testing.T, client cpb.CoursesServiceClient, courseID string, _ RoleClaims) *cpb.Course {
+			t.Helper()
+
+			resp, err := client.UpdateCourse(t.Context(), &cpb.UpdateCourseRequest{
+				Course: &cpb.Course{CourseID: courseID, Description: "Updated."}, Token: "test-token",
+			})
+			require.NoError(t, err)
+
+			return resp.GetCourse()
+		},
+		"GetCourseByShareToken": func(t *testing.T, client cpb.CoursesServiceClient, courseID string,
+			_ RoleClaims,
+		) *cpb.Course {
+			t.Helper()
+
+			signer := shareSigner{secret: []byte(secret)}
+			resp, err := client.GetCourseByShareToken(t.Context(),
+				&cpb.GetCourseByShareTokenRequest{ShareToken: signer.sign(courseID, time.Now().Add(time.Hour))})
+			require.NoError(t, err)
+
+			return resp.GetCourse()
+		},
+	})
+
+	return getters
+}
+
+// courseListers returns the RPCs listing courses, by name. Each expects the course to be the only one listed.
+func courseListers() map[string]courseGetter {
+	return map[string]courseGetter{
+		"ListCourses": func(t *testing.T, client cpb.CoursesServiceClient, _ string, _ RoleClaims) *cpb.Course {
+			t.Helper()
+
+			resp, err := client.ListCourses(t.Context(), &cpb.ListCoursesRequest{Token: "test-token"})
+			require.NoError(t, err)
+			require.Len(t, resp.GetCourses(), 1)
+
+			return resp.GetCourses()[0]
+		},
+		"GetSemesterCourses": func(t *testing.T, client cpb.CoursesServiceClient, _ string, _ RoleClaims) *cpb.Course {
+			t.Helper()
+
+			resp, err := client.GetSemesterCourses(t.Context(),
+				&cpb.GetSemesterCoursesRequest{Semester: createTestCourse().GetSemester(), Token: "test-token"})
+			require.NoError(t, err)
+			require.Len(t, resp.GetCourses(), 1)
+
+			return resp.GetCourses()[0]
+		},
+		"GetEnrollmentsForStudent": func(t *testing.T, client cpb.CoursesServiceClient, courseID string,
+			caller RoleClaims,
+		) *cpb.Course {
+			t.Helper()
+
+			// Staff enroll in their own course only by overriding the role conflict.
+			_, err := client.AddStudentToCourse(t.Context(), &cpb.AddStudentRequest{
+				CourseID: courseID, StudentID: caller.subject, Token: "test-token",
+				AllowConflictingRole: slices.Contains(caller.roles, staffRole),
+			})
+			require.NoError(t, err)
+
+			resp, err := client.GetEnrollmentsForStudent(t.Context(),
+				&cpb.GetEnrollmentsForStudentRequest{StudentID: caller.subject, Token: "test-token"})
+			require.NoError(t, err)
+			require.Len(t, resp.GetEnrollments(), 1)
+
+			return resp.GetEnrollments()[0].GetCourse()
+		},
+		"ListCoursesWithoutStaff": func(t *testing.T, client cpb.CoursesServiceClient, _ string,
+			caller RoleClaims,
+		) *cpb.Course {
+			t.Helper()
+
+			// Only admins list these, and courses created by staff are staffed by them.
+			if !slices.Contains(caller.roles, adminRole) {
+				t.Skip("only admins list courses without staff")
+			}
+
+			resp, err := client.ListCoursesWithoutStaff(t.Context(),
+				&cpb.ListCoursesWithoutStaffRequest{Semester: createTestCourse().GetSemester(), Token: "test-token"})
+			require.NoError(t, err)
+			require.Len(t, resp.GetCourses(), 1)
+
+			return resp.GetCourses()[0]
+		},
+	}
+}
+
+func TestCourseStaffProjection(t *testing.T) {
+	const secret = "a-course-share-secret-of-32-bytes"
+
+	t.Setenv(courseShareSecretEnv, secret)
+
+	endpoints := courseGetters(secret)
+
+	roles := []struct {
+		name   string
+		claims RoleClaims
+		staff  bool
+	}{
+		{"student", RoleClaims{subject: "student-1", roles: []string{"student"}}, false},
+		{"course staff", RoleClaims{subject: "ta-1", roles: []string{staffRole}}, true},
+		{"admin", RoleClaims{subject: "admin-1", roles: []string{adminRole}}, true},
+	}
+
+	for _, role := range roles {
+		for endpoint, getCourse := range endpoints {
+			t.Run(role.name+"/"+endpoint, func(t *testing.T) {
+				client := setupClientWithClaims(t, role.claims)
+
+				course := createTestCourse()
+				course.Capacity = 30
+				course.Metadata = map[string]string{"room": "Taub 1"}
+				created, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+				require.NoError(t, err)
+				assertCourseProjection(t, created.GetCourse(), role.staff)
+
+				got := getCourse(t, client, course.GetCourseID(), role.claims)
+				assert.Equal(t, course.GetCourseName(), got.GetCourseName(), "base fields are always set")
+				assert.Equal(t, course.GetMetadata(), got.GetMetadata(), "base fields are always set")
+				assert.NotNil(t, got.GetCreatedAt(), "base fields are always set")
+				assert.NotNil(t, got.GetUpdatedAt(), "base fields are always set")
+
+				// Share tokens grant the view of people without an account.
+				assertCourseProjection(t, got, role.staff && endpoint != "GetCourseByShareToken")
+			})
+		}
+	}
+}
+
+func TestCourseStaffDraftCounts(t *testing.T) {
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	draftCount := func() int64 {
+		resp, err := client.GetCourse(t.Context(),
+			&cpb.GetCourseRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+		require.NoError(t, err)
+
+		return resp.GetCourse().GetStaffDetails().GetDraftGradingComponents()
+	}
+
+	assert.Zero(t, draftCount(), "a course without a grading scheme has no draft components")
+
+	for _, component := range []*cpb.GradingComponent{{Name: "exam", Weight: 70}, {Name: "homework", Weight: 30}} {
+		_, err := client.SetGradingComponent(t.Context(), &cpb.SetGradingComponentRequest{
+			CourseID: course.GetCourseID(), Component: component, Token: "test-token",
+		})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int64(2), draftCount())
+
+	listed, err := client.ListCourses(t.Context(), &cpb.ListCoursesRequest{Token: "test-token"})
+	require.NoError(t, err)
+	require.Len(t, listed.GetCourses(), 1)
+	assert.Equal(t, int64(2), listed.GetCourses()[0].GetStaffDetails().GetDraftGradingComponents())
+
+	_, err = client.FinalizeGradingScheme(t.Context(),
+		&cpb.FinalizeGradingSchemeRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	require.NoError(t, err)
+
+	assert.Zero(t, draftCount(), "a finalized scheme is no longer a draft")
+}
+
+// assertCourseProjection checks that a course holds the capacity of 30 and the staff details
+// exactly if it was projected for staff.
+func assertCourseProjection(t *testing.T, course *cpb.Course, staff bool) {
+	t.Helper()
+
+	if staff {
+		assert.Equal(t, int32(30), course.GetCapacity())
+		assert.NotNil(t, course.GetStaffDetails())
+	} else {
+		assert.Zero(t, course.GetCapacity())
+		assert.Nil(t, course.GetStaffDetails())
+	}
+}
+
+func TestGetSharedCourses(t *testing.T) {
+	client := setupClient(t)
+	now := time.Now()
+
+	for _, courseID := range []string{"taught-b", "taught-a", "dropped", "expired", "not-taught"} {
+		createCourseWithID(t, client, courseID)
+		enrollStudents(t, client, courseID, "student-1")
+	}
+
+	for _, courseID := range []string{"taught-b", "taught-a", "dropped"} {
+		_, err := client.AddStaffToCourse(t.Context(),
+			&cpb.AddStaffRequest{CourseID: courseID, StaffID: "ta-1", Token: "test-token"})
+		require.NoError(t, err)
+	}
+
+	_, err := client.AddStaffToCourse(t.Context(), &cpb.AddStaffRequest{
+		CourseID:   "expired",
+		StaffID:    "ta-1",
+		ValidFrom:  timestamppb.New(now.Add(-14 * 24 * time.Hour)),
+		ValidUntil: timestamppb.New(now.Add(-time.Hour)),
+		Token:      "test-token",
+	})
+	require.NoError(t, err)
+
+	_, err = client.SetStudentStatus(t.Context(), &cpb.SetStudentStatusRequest{
+		CourseID: "dropped", StudentID: "student-1", Status: "dropped", Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	resp, err := client.GetSharedCourses(t.Context(),
+		&cpb.GetSharedCoursesRequest{StaffID: "ta-1", StudentID: "student-1", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"taught-a", "taught-b"}, resp.GetCourseIDs())
+
+	resp, err = client.GetSharedCourses(t.Context(),
+		&cpb.GetSharedCoursesRequest{StaffID: "ta-1", StudentID: "student-2", Token: "test-token"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetCourseIDs())
+}
+
+func TestGetSharedCoursesPermissions(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "ta-1", roles: []string{staffRole}})
+
+	_, err := client.GetSharedCourses(t.Context(),
+		&cpb.GetSharedCoursesRequest{StaffID: "ta-1", StudentID: "student-1", Token: "test-token"})
+	require.NoError(t, err, "staff may look up their own shared courses")
+
+	_, err = client.GetSharedCourses(t.Context(),
+		&cpb.GetSharedCoursesRequest{StaffID: "ta-2", StudentID: "student-1", Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestFeatureFlagPrecedence(t *testing.T) {
+	const courseID = "236781"
+
+	bucket := rolloutBucket(courseID, featureQA)
+	require.NotZero(t, bucket, "a rollout of 0% would leave the default in charge")
+
+	tests := []struct {
+		name      string
+		rule      FeatureRule
+		overrides map[string]bool
+		want      bool
+	}{
+		{"DefaultOff", FeatureRule{}, nil, false},
+		{"DefaultOn", FeatureRule{Enabled: true}, nil, true},
+		{"RolloutIncludes", FeatureRule{Rollout: bucket + 1}, nil, true},
+		{"RolloutExcludes", FeatureRule{Rollout: bucket}, nil, false},
+		{"RolloutBeatsDefault", FeatureRule{Enabled: true, Rollout: bucket}, nil, false},
+		{"OverrideOnBeatsRollout", FeatureRule{Rollout: bucket}, map[string]bool{featureQA: true}, true},
+		{"OverrideOffBeatsRollout", FeatureRule{Rollout: rolloutBuckets}, map[string]bool{featureQA: false}, false},
+		{"OtherOverrideIgnored", FeatureRule{}, map[string]bool{featureFeedback: true}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flags := FeatureFlags{rules: map[string]FeatureRule{featureQA: test.rule}}
+			assert.Equal(t, test.want, flags.enabled(courseID, featureQA, test.overrides))
+		})
+	}
+}
+
+func TestRolloutBucket(t *testing.T) {
+	// Changing the hash would move courses in and out of every running rollout.
+	assert.Equal(t, uint32(67), rolloutBucket("236781", featureQA), "a course must stay in the same bucket")
+	assert.Equal(t, uint32(60), rolloutBucket("236781", featureFeedback), "features are rolled out independently")
+
+	flags := FeatureFlags{rules: map[string]FeatureRule{featureQA: {Rollout: 25}}}
+	wider := FeatureFlags{rules: map[string]FeatureRule{featureQA: {Rollout: 50}}}
+	enabled := 0
+
+	for i := range 1000 {
+		courseID := strconv.Itoa(230000 + i)
+		require.Less(t, rolloutBucket(courseID, featureQA), uint32(rolloutBuckets))
+
+		if flags.enabled(courseID, featureQA, nil) {
+			enabled++
+
+			assert.True(t, wider.enabled(courseID, featureQA, nil), "widening a rollout must keep its courses")
+		}
+	}
+
+	assert.InDelta(t, 250, enabled, 75, "a 25% rollout should enable about a quarter of the courses")
+}
+
+func TestFeatureFlagsFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string]FeatureRule
+		wantErr error
+	}{
+		{"Unset", "", defaultFeatureFlags().rules, nil},
+		{"Configured", "qa=on, self_enroll=off,feedback=25%", map[string]FeatureRule{
+			featureQA: {Enabled: true}, featureSelfEnroll: {}, featureFeedback: {Rollout: 25},
+		}, nil},
+		{"UnknownFeature", "chat=on", nil, ErrUnknownFeature},
+		{"MalformedRule", "qa=yes", nil, ErrInvalidFeatureRule},
+		{"RolloutAboveAll", "qa=101%", nil, ErrInvalidFeatureRule},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(courseFeaturesEnv, test.value)
+
+			flags, err := featureFlagsFromEnv()
+			if test.wantErr != nil {
+				assert.ErrorIs(t, err, test.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, flags.rules)
+		})
+	}
+}
+
+func TestAutoCreateDatabaseFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    bool
+		wantErr error
+	}{
+		{"Unset", "", true, nil},
+		{"On", "true", true, nil},
+		{"Off", "false", false, nil},
+		{"Malformed", "sometimes", false, ErrInvalidToggle},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(autoCreateDatabaseEnv, test.value)
+
+			autoCreate, err := autoCreateDatabaseFromEnv()
+			if test.wantErr != nil {
+				assert.ErrorIs(t, err, test.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, autoCreate)
+		})
+	}
+}
+
+func TestMaxDescriptionLengthFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int
+		wantErr error
+	}{
+		{"Unset", "", defaultMaxDescriptionLength, nil},
+		{"Set", "512", 512, nil},
+		{"Zero", "0", 0, ErrInvalidLength},
+		{"Malformed", "long", 0, ErrInvalidLength},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(maxDescriptionLengthEnv, test.value)
+
+			length, err := maxDescriptionLengthFromEnv()
+			if test.wantErr != nil {
+				assert.ErrorIs(t, err, test.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, length)
+		})
+	}
+}
+
+func TestCourseDescriptionLength(t *testing.T) {
+	t.Setenv(maxDescriptionLengthEnv, "64")
+
+	client := setupClient(t)
+
+	course := createTestCourse()
+	course.Description = strings.Repeat("x", 65)
+	_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "descriptions above the limit should be rejected")
+
+	course.Description = strings.Repeat("x", 64)
+	_, err = client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+	require.NoError(t, err, "descriptions at the limit should be accepted")
+
+	course.Description = strings.Repeat("y", 65)
+	_, err = client.UpdateCourse(t.Context(), &cpb.UpdateCourseRequest{Course: course, Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "updates above the limit should be rejected")
+
+	course.Description = strings.Repeat("y", 64)
+	updated, err := client.UpdateCourse(t.Context(), &cpb.UpdateCourseRequest{Course: course, Token: "test-token"})
+	require.NoError(t, err, "updates at the limit should be accepted")
+	assert.Equal(t, course.GetDescription(), updated.GetCourse().GetDescription())
+}
+
+func TestCourseFeatures(t *testing.T) {
+	t.Setenv(courseFeaturesEnv, "qa=off,feedback=100%")
+
+	client := setupClient(t)
+	course := createCourse(t, client)
+
+	getFeatures := func() map[string]*cpb.CourseFeature {
+		t.Helper()
+
+		resp, err := client.GetCourseFeatures(t.Context(),
+			&cpb.GetCourseFeaturesRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+		require.NoError(t, err)
+
+		features := make(map[string]*cpb.CourseFeature)
+		for _, feature := range resp.GetFeatures() {
+			features[feature.GetName()] = feature
+		}
+
+		return features
+	}
+	setFeature := func(feature string, override cpb.FeatureOverride) codes.Code {
+		_, err := client.SetCourseFeature(t.Context(), &cpb.SetCourseFeatureRequest{
+			CourseID: course.GetCourseID(), Feature: feature, Override: override, Token: "test-token",
+		})
+
+		return status.Code(err)
+	}
+
+	features := getFeatures()
+	assert.Len(t, features, 3)
+	assert.False(t, features[featureQA].GetEnabled())
+	assert.True(t, features[featureFeedback].GetEnabled())
+	assert.True(t, features[featureSelfEnroll].GetEnabled())
+
+	require.Equal(t, codes.OK, setFeature(featureQA, cpb.FeatureOverride_FEATURE_ON))
+	require.Equal(t, codes.OK, setFeature(featureFeedback, cpb.FeatureOverride_FEATURE_OFF))
+
+	features = getFeatures()
+	assert.True(t, features[featureQA].GetEnabled())
+	assert.True(t, features[featureQA].GetOverridden())
+	assert.False(t, features[featureFeedback].GetEnabled())
+
+	require.Equal(t, codes.OK, setFeature(featureQA, cpb.FeatureOverride_FEATURE_DEFAULT))
+	assert.False(t, getFeatures()[featureQA].GetOverridden())
+
+	assert.Equal(t, codes.InvalidArgument, setFeature("chat", cpb.FeatureOverride_FEATURE_ON))
+
+	_, err := client.GetCourseFeatures(t.Context(),
+		&cpb.GetCourseFeaturesRequest{CourseID: "non-existent-id", Token: "test-token"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSetCourseFeatureRequiresAdmin(t *testing.T) {
+	client := setupClientWithClaims(t, RoleClaims{subject: "ta-1", roles: []string{staffRole}})
+	course := createCourse(t, client)
+
+	_, err := client.SetCourseFeature(t.Context(), &cpb.SetCourseFeatureRequest{
+		CourseID: course.GetCourseID(), Feature: featureQA, Override: cpb.FeatureOverride_FEATURE_ON, Token: "test-token",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestSelfEnrollmentFeature(t *testing.T) {
+	t.Setenv(courseFeaturesEnv, "self_enroll=off")
+
+	server, err := newCoursesServer()
+	require.NoError(t, err)
+
+	server.db = NewMockDatabase()
+	course, err := server.db.AddCourse(t.Context(), createTestCourse())
+	require.NoError(t, err)
+
+	enroll := func(claims ms.Claims, studentID string) error {
+		server.Claims = claims
+		_, err := server.AddStudentToCourse(t.Context(),
+			&cpb.AddStudentRequest{CourseID: course.CourseID, StudentID: studentID, Token: "test-token"})
+
+		return err
+	}
+	student := RoleClaims{subject: "student-1", roles: []string{"student"}}
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(enroll(student, "student-1")))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(enroll(student, "student-3")),
+		"students should not enroll each other either")
+
+	server.Claims = student
+	resp, err := server.CheckEnrollmentEligibility(t.Context(), &cpb.CheckEnrollmentEligibilityRequest{
+		CourseID: course.CourseID, StudentID: "student-1", Token: "test-token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []cpb.EnrollmentBlocker{cpb.EnrollmentBlocker_BLOCKER_SELF_ENROLLMENT_DISABLED}, resp.GetBlockers(),
+		"the pre-flight should refuse what enrolling does")
+
+	require.NoError(t, enroll(RoleClaims{subject: "admin-1", roles: []string{adminRole}}, "student-2"),
+		"staff enroll students regardless of self-enrollment")
+
+	require.NoError(t, server.db.SetFeatureOverride(t.Context(), course.CourseID, featureSelfEnroll, true))
+	require.NoError(t, enroll(student, "student-1"), "courses piloting self-enrollment accept it")
+}
+
+// mockOperations returns the operations changing the rosters of the mock database, applied by
+// FuzzMockDatabaseInvariants to a course and a member picked by the fuzzer. Errors are expected
+// for many of them, such as removing a member who is not in the course.
+func mockOperations() []func(ctx context.Context, mockDB *MockDatabase, courseID, memberID string) {
+	return []func(ctx context.Context, mockDB *MockDatabase, courseID, memberID string){
+		func(ctx context.Context, mockDB *MockDatabase, courseID, _ string) {
+			course := createTestCourse()
+			course.CourseID = courseID
+			_, _ = mockDB.AddCourse(ctx, course, CourseStaff{StaffID: "staff-0"})
+		},
+		func(ctx context.Context, mockDB *MockDatabase, courseID, _ string) {
+			_ = mockDB.DeleteCourse(ctx, courseID)
+		},
+		func(ctx context.Context, mockDB *MockDatabase, courseID, memberID string) {
+			_ = mockDB.AddStudentToCourse(ctx, courseID, memberID, false, false)
+		},
+		func(ctx context.Context, mockDB *MockDatabase, courseID, memberID string) {
+			_, _ = mockDB.AddStudentsToCourse(ctx, courseID, []string{memberID, "student-0", memberID}, true, false)
+		},
+		func(ctx context.Context, mockDB *MockDatabase, courseID, memberID string) {
+			_ = mockDB.RemoveStudentFromCourse(ctx, courseID, memberID)
+		},
+		func(ctx context.Context, mockDB *MockDatabase, courseID, memberID string) {
+			_ = mockDB.SetStudentStatus(ctx, courseID, memberID, studentDropped)
+		},
+		func(ctx context.Context, mockDB *MockDatabase, courseID, memberID string) {
+			_ = mockDB.AddStaffToCourse(ctx, courseID, memberID, time.Time{}, time.Time{}, false)
+		},
+		func(ctx context.Context, mockDB *MockDatabase, courseID, memberID string) {
+			_ = mockDB.RemoveStaffFromCourse(ctx, courseID, memberID)
+		},
+		func(ctx context.Context, mockDB *MockDatabase, courseID, _ string) {
+			_, _ = mockDB.TransferEnrollments(ctx, courseID, "course-0")
+		},
+		func(ctx context.Context, mockDB *MockDatabase, _, _ string) {
+			_, _ = mockDB.DeduplicateEnrollments(ctx)
+		},
+		func(ctx context.Context, mockDB *MockDatabase, courseID, _ string) {
+			_ = mockDB.ChangeCourseID(ctx, courseID, "course-0")
+		},
+	}
+}
+
+func FuzzMockDatabaseInvariants(f *testing.F) {
+	f.Add([]byte{0, 0, 0, 2, 0, 1, 6, 0, 2, 1, 0, 0})
+	f.Add([]byte{0, 1, 0, 0, 2, 0, 3, 1, 1, 8, 1, 0, 5, 0, 1, 4, 0, 1, 7, 1, 0})
+	f.Add([]byte{0, 0, 0, 3, 0, 2, 2, 0, 0, 6, 0, 0, 1, 0, 0, 0, 0, 0, 2, 0, 3})
+
+	f.Fuzz(func(t *testing.T, operations []byte) {
+		mockDB := NewMockDatabase()
+		allOperations := mockOperations()
+
+		for step := 0; step+2 < len(operations); step += 3 {
+			operation := allOperations[int(operations[step])%len(allOperations)]
+			courseID := fmt.Sprintf("course-%d", operations[step+1]%3)
+			memberID := fmt.Sprintf("student-%d", operations[step+2]%4)
+
+			operation(t.Context(), mockDB, courseID, memberID)
+			require.NoError(t, mockDB.checkInvariants(), "after operation %d", step/3)
+		}
+	})
+}
+
+func TestEnumColumns(t *testing.T) {
+	columns := make(map[string]enumColumn)
+	for _, column := range enumColumns() {
+		columns[column.column] = column
+	}
+
+	fields := (&cpb.Announcement{}).ProtoReflect().Descriptor().Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if field.Kind() != protoreflect.EnumKind {
+			continue
+		}
+
+		column, found := columns[string(field.Name())]
+		require.True(t, found, "enum field %s should be stored in an enum column", field.Name())
+		assert.Equal(t, "announcements", column.table)
+		assert.Equal(t, field.Enum().FullName(), column.enum.FullName())
+	}
+
+	for _, column := range enumColumns() {
+		migration := checkConstraintMigration(column.table, column.column, column.names())
+
+		values := column.enum.Values()
+		for i := range values.Len() {
+			value := values.Get(i)
+
+			number, err := column.number(string(value.Name()))
+			require.NoError(t, err)
+			assert.Equal(t, value.Number(), number, "%s should map %s back to itself", column, value.Name())
+			assert.Contains(t, migration, "'"+string(value.Name())+"'", "%s should accept %s", column, value.Name())
+		}
+
+		_, err := column.number("UNKNOWN_VALUE")
+		require.ErrorIs(t, err, ErrUnknownEnumValue)
+		assert.Contains(t, err.Error(), column.String(), "the error should name the column")
+	}
+
+	for _, studentStatus := range studentStatuses() {
+		require.NoError(t, validateStudentStatus(studentStatus))
+		assert.Contains(t, studentStatusTransitions(), studentStatus, "every status should have its transitions")
+	}
+}
+
+func TestUnknownStoredEnumValue(t *testing.T) {
+	grpcServer, listener, testServer, err := startTestServer(MockClaims{})
+	require.NoError(t, err)
+	t.Cleanup(grpcServer.Stop)
+
+	mockDB := NewMockDatabase()
+	testServer.db = mockDB
+	client := cpb.NewCoursesServiceClient(dialTestServer(t, listener))
+	course := createCourse(t, client)
+	addAnnouncement(t, client, course.GetCourseID(),
+		&cpb.Announcement{AnnouncementID: "1", AnnouncementContent: "Welcome."})
+
+	// Stored values are changed directly, as a manual fix of the database would.
+	mockDB.announcements[course.GetCourseID()][0].Visibility = "SECRET"
+
+	_, err = client.GetCourseAnnouncements(t.Context(),
+		&cpb.GetCourseAnnouncementsRequest{CourseID: course.GetCourseID(), Token: "test-token"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "announcements.visibility")
+
+	mockDB.announcements[course.GetCourseID()][0].Visibility = cpb.AnnouncementVisibility_EVERYONE.String()
+	mockDB.announcements[course.GetCourseID()][0].Recurrence = "HOURLY"
+
+	createCourseWithID(t, client, "236782")
+
+	_, err = client.CopyAnnouncements(t.Context(), &cpb.CopyAnnouncementsRequest{
+		FromCourseID: course.GetCourseID(), ToCourseID: "236782", Token: "test-token",
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "announcements.recurrence")
+	assert.Empty(t, mockDB.announcements["236782"], "no announcement should be copied")
+}
+
+func TestAddStudentToCourseOverridesChecks(t *testing.T) {
+	client := setupClient(t)
+
+	course := createTestCourse()
+	course.Capacity = 1
+	_, err := client.CreateCourse(t.Context(), &cpb.CreateCourseRequest{Course: course, Token: "test-token"})
+	require.NoError(t, err)
+	enrollStudents(t, client, course.GetCourseID(), "student-1")
+
+	_, err = client.SetEnrollmentWindow(t.Context(), &cpb.SetEnrollmentWindowRequest{
+		CourseID: course.GetCourseID(), ClosesAt: timestamppb.New(time.Now().Add(-time.Hour)), Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	_, err = client.AddStudentToCourse(t.Context(),
+		&cpb.AddStudentRequest{CourseID: course.GetCourseID(), StudentID: "student-2", Token: "test-token"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = client.AddStudentToCourse(t.Context(), &cpb.AddStudentRequest{
+		CourseID: course.GetCourseID(), StudentID: "student-2", OverrideLimit: true, Token: "test-token",
+	})
+	require.NoError(t, err, "staff may enroll past the window and the capacity")
+}
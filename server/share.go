@@ -0,0 +1,106 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Environment variable with the secret course share tokens are signed with; sharing is off without it.
+	courseShareSecretEnv = "COURSE_SHARE_SECRET"
+	// Fewest bytes of secret a share token signature may rely on.
+	minShareSecretLength = 32
+	// Number of dot-separated parts of a share token.
+	shareTokenParts = 3
+)
+
+var (
+	ErrSharingDisabled    = errors.New("course sharing is not configured")
+	ErrInvalidShareSecret = errors.New("course share secret must be at least 32 bytes")
+	ErrInvalidShareToken  = errors.New("share token is invalid")
+	ErrShareTokenExpired  = errors.New("share token expired")
+)
+
+// shareSigner signs and verifies course share tokens of the form
+// <expiry unix seconds>.<base64 course ID>.<base64 HMAC-SHA256 of the first two parts>.
+// Tokens are not stored, so they stay valid until they expire or their course is deleted.
+// A signer without a secret cannot share courses.
+type shareSigner struct {
+	secret []byte
+}
+
+// enabled reports whether the signer has a secret to sign tokens with.
+func (s shareSigner) enabled() bool {
+	return len(s.secret) > 0
+}
+
+// sign returns a token granting read access to a course until expiresAt.
+func (s shareSigner) sign(courseID string, expiresAt time.Time) string {
+	payload := strconv.FormatInt(expiresAt.Unix(), 10) + "." + base64.RawURLEncoding.EncodeToString([]byte(courseID))
+
+	return payload + "." + base64.RawURLEncoding.EncodeToString(s.mac(payload))
+}
+
+// verify returns the course a token grants access to, if it is authentic and not expired at now.
+// A signer without a secret accepts no token, as anyone could sign one with the empty key.
+func (s shareSigner) verify(token string, now time.Time) (string, error) {
+	if !s.enabled() {
+		return "", fmt.Errorf("%w", ErrSharingDisabled)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != shareTokenParts {
+		return "", fmt.Errorf("%w", ErrInvalidShareToken)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, s.mac(parts[0]+"."+parts[1])) {
+		return "", fmt.Errorf("%w", ErrInvalidShareToken)
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("%w", ErrInvalidShareToken)
+	}
+
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return "", fmt.Errorf("%w", ErrShareTokenExpired)
+	}
+
+	courseID, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("%w", ErrInvalidShareToken)
+	}
+
+	return string(courseID), nil
+}
+
+// mac signs a token payload with the secret.
+func (s shareSigner) mac(payload string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+
+	return mac.Sum(nil)
+}
+
+// shareSignerFromEnv returns a signer using the secret in COURSE_SHARE_SECRET,
+// or one that cannot share courses if it is unset.
+func shareSignerFromEnv() (shareSigner, error) {
+	secret := os.Getenv(courseShareSecretEnv)
+	if secret == "" {
+		return shareSigner{}, nil
+	}
+
+	if len(secret) < minShareSecretLength {
+		return shareSigner{}, fmt.Errorf("invalid %s: %w", courseShareSecretEnv, ErrInvalidShareSecret)
+	}
+
+	return shareSigner{secret: []byte(secret)}, nil
+}